@@ -42,6 +42,8 @@ func main() {
 	memProfile := pflag.String("memProfile", "", "Path to store a memory profile. Profiling is skipped if empty")
 	timeoutSeconds := pflag.Int32("timeoutSeconds", 1, "Duration in seconds the probe will wait for the guest command to return.")
 	guestAgentPing := pflag.Bool("guestAgentPing", false, "Flag to specify readiness probe based of guest-agent ping")
+	grpcPort := pflag.Int32("grpcPort", 0, "Port of a gRPC health service in the guest to probe through the guest agent")
+	grpcService := pflag.String("grpcService", "", "Name of the service to report in the gRPC health check request")
 
 	pflag.CommandLine.AddGoFlag(goflag.CommandLine.Lookup("v"))
 	pflag.Parse()
@@ -63,7 +65,12 @@ func main() {
 		os.Exit(0)
 	}
 
-	exitCode, stdOut, err := client.Exec(*domainName, *command, pflag.Args(), *timeoutSeconds)
+	guestCommand, guestArgs := *command, pflag.Args()
+	if *grpcPort > 0 {
+		guestCommand, guestArgs = grpcHealthProbeCommand(*grpcPort, *grpcService, *timeoutSeconds)
+	}
+
+	exitCode, stdOut, err := client.Exec(*domainName, guestCommand, guestArgs, *timeoutSeconds)
 	if len(stdOut) > 0 {
 		fmt.Println(stdOut)
 	}
@@ -75,6 +82,22 @@ func main() {
 	os.Exit(exitCode)
 }
 
+// grpcHealthProbeCommand returns the grpc_health_probe invocation which checks the
+// gRPC health service on the guest loopback address. The tool has to be installed in
+// the guest, since the guest agent can only run guest commands.
+func grpcHealthProbeCommand(port int32, service string, timeoutSeconds int32) (string, []string) {
+	timeout := fmt.Sprintf("%ds", timeoutSeconds)
+	args := []string{
+		fmt.Sprintf("-addr=127.0.0.1:%d", port),
+		"-connect-timeout=" + timeout,
+		"-rpc-timeout=" + timeout,
+	}
+	if service != "" {
+		args = append(args, "-service="+service)
+	}
+	return "grpc_health_probe", args
+}
+
 func saveMemoryProfile(path string) {
 	if len(path) > 0 {
 		log.Log.Info("creating memory profile")
@@ -0,0 +1,314 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package memorydump
+
+import (
+	"context"
+	"fmt"
+
+	k8score "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+)
+
+const (
+	ErrorReason = "MemoryDumpError"
+	failed      = "Memory dump failed"
+)
+
+// HasCompleted returns true if the memory dump request of the VM is done and its volume is no longer needed
+func HasCompleted(vm *v1.VirtualMachine) bool {
+	return vm.Status.MemoryDumpRequest != nil &&
+		vm.Status.MemoryDumpRequest.Phase != v1.MemoryDumpAssociating &&
+		vm.Status.MemoryDumpRequest.Phase != v1.MemoryDumpInProgress
+}
+
+// HandleRequest adds and removes the memory dump volume of the VM and the VMI
+// according to the phase of the memory dump request
+func HandleRequest(client kubecli.KubevirtClient, vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance, pvcStore cache.Store) error {
+	request := vm.Status.MemoryDumpRequest
+	if request == nil {
+		return nil
+	}
+
+	vmiVolumeMap := make(map[string]v1.Volume)
+	if vmi != nil {
+		for _, volume := range vmi.Spec.Volumes {
+			vmiVolumeMap[volume.Name] = volume
+		}
+	}
+
+	switch request.Phase {
+	case v1.MemoryDumpAssociating:
+		if vmi == nil || vmi.DeletionTimestamp != nil || !vmi.IsRunning() {
+			return nil
+		}
+		// When in state associating we want to add the memory dump pvc
+		// as a volume in the vm and in the vmi to trigger the mount
+		// to virt launcher and the memory dump
+		vm.Spec.Template.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vm.Spec.Template.Spec, request)
+		if _, exists := vmiVolumeMap[request.ClaimName]; exists {
+			return nil
+		}
+		if err := generateVMIMemoryDumpVolumePatch(client, vmi, request, true); err != nil {
+			log.Log.Object(vmi).Errorf("unable to patch vmi to add memory dump volume: %v", err)
+			return err
+		}
+	case v1.MemoryDumpUnmounting, v1.MemoryDumpFailed:
+		if err := patchMemoryDumpPVCAnnotation(vm, pvcStore, client); err != nil {
+			return err
+		}
+		// Check if the memory dump is in the vmi list of volumes,
+		// if it still exists remove it
+		if vmi == nil || vmi.DeletionTimestamp != nil {
+			return nil
+		}
+		if _, exists := vmiVolumeMap[request.ClaimName]; !exists {
+			return nil
+		}
+		if err := generateVMIMemoryDumpVolumePatch(client, vmi, request, false); err != nil {
+			log.Log.Object(vmi).Errorf("unable to patch vmi to remove memory dump volume: %v", err)
+			return err
+		}
+	case v1.MemoryDumpDissociating:
+		// Check if the memory dump is in the vmi list of volumes,
+		// if it still exists remove it
+		if vmi != nil && vmi.DeletionTimestamp == nil {
+			if _, exists := vmiVolumeMap[request.ClaimName]; exists {
+				if err := generateVMIMemoryDumpVolumePatch(client, vmi, request, false); err != nil {
+					log.Log.Object(vmi).Errorf("unable to patch vmi to remove memory dump volume: %v", err)
+					return err
+				}
+			}
+		}
+		vm.Spec.Template.Spec = *RemoveMemoryDumpVolumeFromVMISpec(&vm.Spec.Template.Spec, request.ClaimName)
+	}
+
+	return nil
+}
+
+// UpdateRequest moves the memory dump request of the VM to its next phase
+// according to the memory dump volume status of the VMI
+func UpdateRequest(vm *v1.VirtualMachine, vmi *v1.VirtualMachineInstance) {
+	if vm.Status.MemoryDumpRequest == nil {
+		return
+	}
+
+	updatedMemoryDumpReq := vm.Status.MemoryDumpRequest.DeepCopy()
+
+	if vm.Status.MemoryDumpRequest.Remove {
+		updatedMemoryDumpReq.Phase = v1.MemoryDumpDissociating
+	}
+
+	switch vm.Status.MemoryDumpRequest.Phase {
+	case v1.MemoryDumpCompleted:
+		// Once memory dump completed, there is no update needed,
+		// A new update will come from the VM (remove request or new dump)
+		return
+	case v1.MemoryDumpAssociating:
+		// Update Phase to InProgress once the memory dump
+		// is in the list of vm volumes and in vmi volumeStatus
+		if vmi == nil {
+			return
+		}
+		for _, volumeStatus := range vmi.Status.VolumeStatus {
+			if volumeStatus.Name == updatedMemoryDumpReq.ClaimName &&
+				volumeStatus.MemoryDumpVolume != nil {
+				switch volumeStatus.Phase {
+				case v1.MemoryDumpVolumeInProgress:
+					updatedMemoryDumpReq.Phase = v1.MemoryDumpInProgress
+				case v1.MemoryDumpVolumeFailed:
+					updatedMemoryDumpReq.Phase = v1.MemoryDumpFailed
+					updatedMemoryDumpReq.Message = volumeStatus.Message
+					updatedMemoryDumpReq.EndTimestamp = volumeStatus.MemoryDumpVolume.EndTimestamp
+				}
+			}
+		}
+	case v1.MemoryDumpInProgress:
+		// Update to unmounting once getting update in the vmi volume status
+		// that the dump timestamp is updated
+		if vmi == nil {
+			return
+		}
+		for _, volumeStatus := range vmi.Status.VolumeStatus {
+			if volumeStatus.Name == updatedMemoryDumpReq.ClaimName &&
+				volumeStatus.MemoryDumpVolume != nil {
+				switch volumeStatus.Phase {
+				case v1.MemoryDumpVolumeCompleted:
+					updatedMemoryDumpReq.Phase = v1.MemoryDumpUnmounting
+					updatedMemoryDumpReq.EndTimestamp = volumeStatus.MemoryDumpVolume.EndTimestamp
+					updatedMemoryDumpReq.FileName = &volumeStatus.MemoryDumpVolume.TargetFileName
+				case v1.MemoryDumpVolumeFailed:
+					updatedMemoryDumpReq.Phase = v1.MemoryDumpFailed
+					updatedMemoryDumpReq.Message = volumeStatus.Message
+					updatedMemoryDumpReq.EndTimestamp = volumeStatus.MemoryDumpVolume.EndTimestamp
+				}
+			}
+		}
+	case v1.MemoryDumpUnmounting:
+		// Update memory dump as completed once the memory dump has been
+		// unmounted - not a part of the vmi volume status
+		if vmi != nil && hasVolumeStatus(vmi, updatedMemoryDumpReq.ClaimName) {
+			return
+		}
+		updatedMemoryDumpReq.Phase = v1.MemoryDumpCompleted
+	case v1.MemoryDumpDissociating:
+		// Make sure the memory dump is not in the vmi list of volumes
+		// and is not in the vm volumes list
+		if vmi != nil && hasVolumeStatus(vmi, updatedMemoryDumpReq.ClaimName) {
+			return
+		}
+		for _, volume := range vm.Spec.Template.Spec.Volumes {
+			if volume.Name == updatedMemoryDumpReq.ClaimName {
+				return
+			}
+		}
+		updatedMemoryDumpReq = nil
+	}
+
+	vm.Status.MemoryDumpRequest = updatedMemoryDumpReq
+}
+
+// RemoveMemoryDumpVolumeFromVMISpec drops the memory dump volume of the claim from the spec
+func RemoveMemoryDumpVolumeFromVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, claimName string) *v1.VirtualMachineInstanceSpec {
+	newVolumesList := []v1.Volume{}
+	for _, volume := range vmiSpec.Volumes {
+		if volume.Name != claimName {
+			newVolumesList = append(newVolumesList, volume)
+		}
+	}
+	vmiSpec.Volumes = newVolumesList
+	return vmiSpec
+}
+
+func hasVolumeStatus(vmi *v1.VirtualMachineInstance, name string) bool {
+	for _, volumeStatus := range vmi.Status.VolumeStatus {
+		if volumeStatus.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// applyMemoryDumpVolumeRequestOnVMISpec adds the memory dump volume of the request to the spec.
+// The volume carries the format of the request, virt-launcher dumps the memory in the format of
+// the volume and the format of a hotplugged volume can't be changed afterwards.
+func applyMemoryDumpVolumeRequestOnVMISpec(vmiSpec *v1.VirtualMachineInstanceSpec, request *v1.VirtualMachineMemoryDumpRequest) *v1.VirtualMachineInstanceSpec {
+	for _, volume := range vmiSpec.Volumes {
+		if volume.Name == request.ClaimName {
+			return vmiSpec
+		}
+	}
+
+	memoryDumpVolume := v1.Volume{
+		Name: request.ClaimName,
+		VolumeSource: v1.VolumeSource{
+			MemoryDump: &v1.MemoryDumpVolumeSource{
+				PersistentVolumeClaimVolumeSource: v1.PersistentVolumeClaimVolumeSource{
+					PersistentVolumeClaimVolumeSource: k8score.PersistentVolumeClaimVolumeSource{
+						ClaimName: request.ClaimName,
+					},
+					Hotpluggable: true,
+				},
+				Format: request.Format,
+			},
+		},
+	}
+
+	newSpec := vmiSpec.DeepCopy()
+	newSpec.Volumes = append(newSpec.Volumes, memoryDumpVolume)
+
+	return newSpec
+}
+
+func generateVMIMemoryDumpVolumePatch(client kubecli.KubevirtClient, vmi *v1.VirtualMachineInstance, request *v1.VirtualMachineMemoryDumpRequest, addVolume bool) error {
+	foundRemoveVol := false
+	for _, volume := range vmi.Spec.Volumes {
+		if request.ClaimName == volume.Name {
+			if addVolume {
+				return fmt.Errorf("Unable to add volume [%s] because it already exists", volume.Name)
+			}
+			foundRemoveVol = true
+		}
+	}
+
+	if !foundRemoveVol && !addVolume {
+		return fmt.Errorf("Unable to remove volume [%s] because it does not exist", request.ClaimName)
+	}
+
+	vmiCopy := vmi.DeepCopy()
+	if addVolume {
+		vmiCopy.Spec = *applyMemoryDumpVolumeRequestOnVMISpec(&vmiCopy.Spec, request)
+	} else {
+		vmiCopy.Spec = *RemoveMemoryDumpVolumeFromVMISpec(&vmiCopy.Spec, request.ClaimName)
+	}
+
+	patchSet := patch.New()
+	if len(vmi.Spec.Volumes) > 0 {
+		patchSet.AddOption(patch.WithTest("/spec/volumes", vmi.Spec.Volumes))
+	}
+	patchSet.AddOption(patch.WithAdd("/spec/volumes", vmiCopy.Spec.Volumes))
+	patchBytes, err := patchSet.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	_, err = client.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func patchMemoryDumpPVCAnnotation(vm *v1.VirtualMachine, pvcStore cache.Store, client kubecli.KubevirtClient) error {
+	request := vm.Status.MemoryDumpRequest
+	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vm.Namespace, request.ClaimName, pvcStore)
+	if err != nil {
+		log.Log.Object(vm).Errorf("Error getting PersistentVolumeClaim to update memory dump annotation: %v", err)
+		return err
+	}
+	if pvc == nil {
+		return fmt.Errorf("Error when trying to update memory dump annotation, pvc %s not found", request.ClaimName)
+	}
+
+	annoValue := failed
+	if request.Phase == v1.MemoryDumpUnmounting && request.FileName != nil {
+		annoValue = *request.FileName
+	}
+
+	if value, ok := pvc.Annotations[v1.PVCMemoryDumpAnnotation]; ok && value == annoValue {
+		return nil
+	}
+
+	pvc = pvc.DeepCopy()
+	if pvc.Annotations == nil {
+		pvc.Annotations = make(map[string]string)
+	}
+	pvc.Annotations[v1.PVCMemoryDumpAnnotation] = annoValue
+	if _, err := client.CoreV1().PersistentVolumeClaims(vm.Namespace).Update(context.Background(), pvc, metav1.UpdateOptions{}); err != nil {
+		log.Log.Object(vm).Errorf("Error updating memory dump annotation on pvc %s: %v", pvc.Name, err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package memorydump
+
+import (
+	"fmt"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
+	kutil "kubevirt.io/kubevirt/pkg/util"
+)
+
+const (
+	pvcVolumeModeErr = "pvc should be filesystem pvc"
+	pvcAccessModeErr = "pvc access mode can't be read only"
+	pvcSizeErrFmt    = "pvc size [%s] should be bigger then [%s]"
+)
+
+// InvalidClaimError reports why the memory of a VMI can't be dumped to a pvc
+type InvalidClaimError struct {
+	ClaimName string
+	Reason    string
+}
+
+func (e *InvalidClaimError) Error() string {
+	return fmt.Sprintf("invalid memory dump pvc [%s]: %s", e.ClaimName, e.Reason)
+}
+
+// ValidateClaim checks that the pvc can hold the memory dump of the VMI.
+// A nil cdiConfig falls back to the default filesystem overhead.
+// Errors about the pvc itself are returned as *InvalidClaimError.
+func ValidateClaim(vmi *v1.VirtualMachineInstance, pvc *k8sv1.PersistentVolumeClaim, cdiConfig *cdiv1.CDIConfig) error {
+	if storagetypes.IsPVCBlock(pvc.Spec.VolumeMode) {
+		return &InvalidClaimError{ClaimName: pvc.Name, Reason: pvcVolumeModeErr}
+	}
+
+	if storagetypes.IsReadOnlyAccessMode(pvc.Spec.AccessModes) {
+		return &InvalidClaimError{ClaimName: pvc.Name, Reason: pvcAccessModeErr}
+	}
+
+	pvcSize := pvc.Spec.Resources.Requests.Storage()
+	scaledPvcSize := resource.NewScaledQuantity(pvcSize.ScaledValue(resource.Kilo), resource.Kilo)
+
+	expectedMemoryDumpSize := kutil.CalcExpectedMemoryDumpSize(vmi)
+	var expectedPvcSize *resource.Quantity
+	var err error
+	if cdiConfig == nil {
+		log.Log.Object(vmi).V(3).Infof(storagetypes.FSOverheadMsg)
+		expectedPvcSize, err = storagetypes.GetSizeIncludingDefaultFSOverhead(expectedMemoryDumpSize)
+	} else {
+		expectedPvcSize, err = storagetypes.GetSizeIncludingFSOverhead(expectedMemoryDumpSize, pvc.Spec.StorageClassName, pvc.Spec.VolumeMode, cdiConfig)
+	}
+	if err != nil {
+		return err
+	}
+	if scaledPvcSize.Cmp(*expectedPvcSize) < 0 {
+		return &InvalidClaimError{ClaimName: pvc.Name, Reason: fmt.Sprintf(pvcSizeErrFmt, scaledPvcSize.String(), expectedPvcSize.String())}
+	}
+
+	return nil
+}
@@ -94,6 +94,9 @@ func VMIEvictionStrategy(clusterConfig *virtconfig.ClusterConfig, vmi *v1.Virtua
 	if vmi != nil && vmi.Spec.EvictionStrategy != nil {
 		return vmi.Spec.EvictionStrategy
 	}
+	if vmi != nil {
+		clusterConfig = clusterConfig.ForNamespace(vmi.Namespace)
+	}
 	clusterStrategy := clusterConfig.GetConfig().EvictionStrategy
 	return clusterStrategy
 }
@@ -0,0 +1,226 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	dockerHubRegistry   = "docker.io"
+	dockerHubAPIHost    = "registry-1.docker.io"
+	dockerHubRepoPrefix = "library/"
+	defaultTag          = "latest"
+
+	digestHeader   = "Docker-Content-Digest"
+	defaultTimeout = 30 * time.Second
+)
+
+// manifestMediaTypes are the manifests a tag may point to, multi-arch indexes first so the digest
+// of the index is returned for multi-arch images
+var manifestMediaTypes = []string{
+	"application/vnd.oci.image.index.v1+json",
+	"application/vnd.docker.distribution.manifest.list.v2+json",
+	"application/vnd.oci.image.manifest.v1+json",
+	"application/vnd.docker.distribution.manifest.v2+json",
+}
+
+// DigestResolver looks up the digests of the manifests image tags point to with the registry v2 API.
+// Registries are accessed anonymously, tokens are requested for registries which challenge for a bearer token.
+type DigestResolver struct {
+	client *http.Client
+}
+
+// NewDigestResolver returns a DigestResolver which accesses the registries with the given client,
+// or with a client with a default timeout if it is nil
+func NewDigestResolver(client *http.Client) *DigestResolver {
+	if client == nil {
+		client = &http.Client{Timeout: defaultTimeout}
+	}
+	return &DigestResolver{client: client}
+}
+
+// Resolve returns the digest of the manifest the tag of the image reference points to, or the digest
+// of the reference if it already has one
+func (r *DigestResolver) Resolve(ctx context.Context, image string) (string, error) {
+	host, repository, reference := parseReference(image)
+	if strings.Contains(reference, ":") {
+		return reference, nil
+	}
+
+	manifestURL := fmt.Sprintf("https://%s/v2/%s/manifests/%s", host, repository, reference)
+	resp, err := r.getManifest(ctx, http.MethodHead, manifestURL, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve the tag of image %s: %v", image, err)
+	}
+	resp.Body.Close()
+
+	token := ""
+	if resp.StatusCode == http.StatusUnauthorized {
+		if token, err = r.getToken(ctx, resp.Header.Get("WWW-Authenticate"), repository); err != nil {
+			return "", fmt.Errorf("failed to authenticate to the registry of image %s: %v", image, err)
+		}
+		if resp, err = r.getManifest(ctx, http.MethodHead, manifestURL, token); err != nil {
+			return "", fmt.Errorf("failed to resolve the tag of image %s: %v", image, err)
+		}
+		resp.Body.Close()
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to resolve the tag of image %s: registry returned %s", image, resp.Status)
+	}
+	if digest := resp.Header.Get(digestHeader); digest != "" {
+		return digest, nil
+	}
+
+	// registries don't have to report the digest, it is the hash of the manifest then
+	if resp, err = r.getManifest(ctx, http.MethodGet, manifestURL, token); err != nil {
+		return "", fmt.Errorf("failed to get the manifest of image %s: %v", image, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("failed to get the manifest of image %s: registry returned %s", image, resp.Status)
+	}
+	manifest, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("failed to read the manifest of image %s: %v", image, err)
+	}
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(manifest)), nil
+}
+
+func (r *DigestResolver) getManifest(ctx context.Context, method, manifestURL, token string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, method, manifestURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", strings.Join(manifestMediaTypes, ", "))
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	return r.client.Do(req)
+}
+
+// getToken requests an anonymous pull token from the token server a registry challenged with
+func (r *DigestResolver) getToken(ctx context.Context, challenge, repository string) (string, error) {
+	scheme, params := parseChallenge(challenge)
+	if !strings.EqualFold(scheme, "bearer") || params["realm"] == "" {
+		return "", fmt.Errorf("unsupported authentication challenge %q", challenge)
+	}
+
+	query := url.Values{}
+	if service := params["service"]; service != "" {
+		query.Set("service", service)
+	}
+	scope := params["scope"]
+	if scope == "" {
+		scope = fmt.Sprintf("repository:%s:pull", repository)
+	}
+	query.Set("scope", scope)
+
+	tokenURL, err := url.Parse(params["realm"])
+	if err != nil {
+		return "", fmt.Errorf("invalid token realm %q: %v", params["realm"], err)
+	}
+	tokenURL.RawQuery = query.Encode()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, tokenURL.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("token server returned %s", resp.Status)
+	}
+
+	tokenResponse := struct {
+		Token       string `json:"token"`
+		AccessToken string `json:"access_token"`
+	}{}
+	if err := json.NewDecoder(resp.Body).Decode(&tokenResponse); err != nil {
+		return "", fmt.Errorf("failed to decode the token response: %v", err)
+	}
+	if tokenResponse.Token != "" {
+		return tokenResponse.Token, nil
+	}
+	if tokenResponse.AccessToken != "" {
+		return tokenResponse.AccessToken, nil
+	}
+	return "", fmt.Errorf("token server returned no token")
+}
+
+// parseReference splits an image reference into the host of its registry, its repository and its tag or digest,
+// defaulting to Docker Hub and the latest tag the same way container runtimes do
+func parseReference(image string) (host, repository, reference string) {
+	name := image
+	reference = defaultTag
+	if i := strings.Index(name, "@"); i != -1 {
+		name, reference = name[:i], name[i+1:]
+	} else if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name, reference = name[:i], name[i+1:]
+	}
+
+	host = dockerHubRegistry
+	repository = name
+	if parts := strings.SplitN(name, "/", 2); len(parts) == 2 && (strings.ContainsAny(parts[0], ".:") || parts[0] == "localhost") {
+		host, repository = parts[0], parts[1]
+	}
+	if host == dockerHubRegistry {
+		host = dockerHubAPIHost
+		if !strings.Contains(repository, "/") {
+			repository = dockerHubRepoPrefix + repository
+		}
+	}
+	return host, repository, reference
+}
+
+// parseChallenge splits a WWW-Authenticate header into its scheme and its parameters
+func parseChallenge(challenge string) (string, map[string]string) {
+	scheme, rest, _ := strings.Cut(strings.TrimSpace(challenge), " ")
+	params := map[string]string{}
+	for rest = strings.TrimSpace(rest); rest != ""; rest = strings.TrimLeft(rest, ", ") {
+		key, value, found := strings.Cut(rest, "=")
+		if !found {
+			break
+		}
+		key = strings.ToLower(strings.TrimSpace(key))
+		if strings.HasPrefix(value, `"`) {
+			end := strings.Index(value[1:], `"`)
+			if end == -1 {
+				params[key] = value[1:]
+				break
+			}
+			params[key], rest = value[1:end+1], value[end+2:]
+		} else {
+			params[key], rest, _ = strings.Cut(value, ",")
+		}
+	}
+	return scheme, params
+}
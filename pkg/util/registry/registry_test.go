@@ -0,0 +1,136 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package registry
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("DigestResolver", func() {
+	const (
+		manifest = `{"schemaVersion":2}`
+		digest   = "sha256:5cfa6ac2ac38e5e4c8b1a1c8ad3d5a3e9dbe2a5c2b0e2d6b6c0b4ea04e7c1a11"
+		token    = "pull-token"
+	)
+
+	var (
+		server       *httptest.Server
+		resolver     *DigestResolver
+		host         string
+		requireToken bool
+		reportDigest bool
+		requests     []string
+	)
+
+	BeforeEach(func() {
+		requireToken = false
+		reportDigest = true
+		requests = nil
+
+		mux := http.NewServeMux()
+		mux.HandleFunc("/v2/kubevirt/virt-api/manifests/", func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.Method+" "+r.URL.Path)
+			if requireToken && r.Header.Get("Authorization") != "Bearer "+token {
+				w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry.example.com"`, server.URL))
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			if !strings.HasSuffix(r.URL.Path, "/v1.3.0") {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+			Expect(r.Header.Get("Accept")).To(ContainSubstring("application/vnd.oci.image.index.v1+json"))
+			if reportDigest {
+				w.Header().Set(digestHeader, digest)
+			}
+			if r.Method == http.MethodGet {
+				fmt.Fprint(w, manifest)
+			}
+		})
+		mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+			Expect(r.URL.Query().Get("service")).To(Equal("registry.example.com"))
+			Expect(r.URL.Query().Get("scope")).To(Equal("repository:kubevirt/virt-api:pull"))
+			fmt.Fprintf(w, `{"token":"%s"}`, token)
+		})
+		server = httptest.NewTLSServer(mux)
+		DeferCleanup(server.Close)
+
+		host = strings.TrimPrefix(server.URL, "https://")
+		resolver = NewDigestResolver(server.Client())
+	})
+
+	It("should resolve a tag to the digest the registry reports", func() {
+		Expect(resolver.Resolve(context.Background(), host+"/kubevirt/virt-api:v1.3.0")).To(Equal(digest))
+		Expect(requests).To(Equal([]string{"HEAD /v2/kubevirt/virt-api/manifests/v1.3.0"}))
+	})
+
+	It("should request a token if the registry challenges for one", func() {
+		requireToken = true
+		Expect(resolver.Resolve(context.Background(), host+"/kubevirt/virt-api:v1.3.0")).To(Equal(digest))
+		Expect(requests).To(HaveLen(2))
+	})
+
+	It("should hash the manifest if the registry does not report its digest", func() {
+		reportDigest = false
+		Expect(resolver.Resolve(context.Background(), host+"/kubevirt/virt-api:v1.3.0")).To(
+			Equal(fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(manifest)))))
+	})
+
+	It("should not access the registry for images referenced by their digest", func() {
+		Expect(resolver.Resolve(context.Background(), host+"/kubevirt/virt-api@"+digest)).To(Equal(digest))
+		Expect(requests).To(BeEmpty())
+	})
+
+	It("should fail for unknown tags", func() {
+		_, err := resolver.Resolve(context.Background(), host+"/kubevirt/virt-api:v0.0.0")
+		Expect(err).To(MatchError(ContainSubstring("registry returned 404 Not Found")))
+	})
+
+	DescribeTable("should parse the image reference", func(image, host, repository, reference string) {
+		parsedHost, parsedRepository, parsedReference := parseReference(image)
+		Expect(parsedHost).To(Equal(host))
+		Expect(parsedRepository).To(Equal(repository))
+		Expect(parsedReference).To(Equal(reference))
+	},
+		Entry("with a registry and a tag", "quay.io/kubevirt/virt-api:v1.3.0", "quay.io", "kubevirt/virt-api", "v1.3.0"),
+		Entry("with a registry port", "registry:5000/virt-api", "registry:5000", "virt-api", "latest"),
+		Entry("with a digest", "quay.io/kubevirt/virt-api@"+digest, "quay.io", "kubevirt/virt-api", digest),
+		Entry("on Docker Hub", "kubevirt/virt-api:v1.3.0", "registry-1.docker.io", "kubevirt/virt-api", "v1.3.0"),
+		Entry("of an official Docker Hub image", "docker.io/busybox", "registry-1.docker.io", "library/busybox", "latest"),
+	)
+
+	It("should parse the authentication challenge", func() {
+		scheme, params := parseChallenge(`Bearer realm="https://auth.example.com/token",service="registry.example.com",scope="repository:kubevirt/virt-api:pull"`)
+		Expect(scheme).To(Equal("Bearer"))
+		Expect(params).To(Equal(map[string]string{
+			"realm":   "https://auth.example.com/token",
+			"service": "registry.example.com",
+			"scope":   "repository:kubevirt/virt-api:pull",
+		}))
+	})
+})
@@ -0,0 +1,305 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vmquota
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+)
+
+// ExceededError is returned when admitting a workload would exceed a VirtualMachineQuota
+type ExceededError struct {
+	Quota   string
+	Reasons []string
+}
+
+func (e *ExceededError) Error() string {
+	return fmt.Sprintf("exceeded VirtualMachineQuota %s: %s", e.Quota, strings.Join(e.Reasons, ", "))
+}
+
+// VMIUsage returns the resources a VirtualMachineInstance with the given spec consumes
+func VMIUsage(spec *v1.VirtualMachineInstanceSpec) v1.VirtualMachineQuotaResources {
+	usage := v1.VirtualMachineQuotaResources{
+		RunningVMIs: pointer.P(int64(1)),
+		GuestMemory: guestMemory(spec),
+		VCPUs:       pointer.P(vCPUs(spec)),
+	}
+
+	for _, gpu := range spec.Domain.Devices.GPUs {
+		addDevice(&usage, gpu.DeviceName)
+	}
+	for _, hostDevice := range spec.Domain.Devices.HostDevices {
+		addDevice(&usage, hostDevice.DeviceName)
+	}
+	return usage
+}
+
+// MigrationUsage returns the resources a VirtualMachineInstanceMigration consumes
+func MigrationUsage() v1.VirtualMachineQuotaResources {
+	return v1.VirtualMachineQuotaResources{
+		Migrations: pointer.P(int64(1)),
+	}
+}
+
+// Usage returns the resources consumed by the VirtualMachineInstances and VirtualMachineInstanceMigrations
+// which are not in a final phase
+func Usage(vmis []*v1.VirtualMachineInstance, migrations []*v1.VirtualMachineInstanceMigration) v1.VirtualMachineQuotaResources {
+	usage := v1.VirtualMachineQuotaResources{}
+	for _, vmi := range vmis {
+		if !vmi.IsFinal() {
+			usage = Add(usage, VMIUsage(&vmi.Spec))
+		}
+	}
+	for _, migration := range migrations {
+		if !migration.IsFinal() {
+			usage = Add(usage, MigrationUsage())
+		}
+	}
+	return usage
+}
+
+// Add returns the sum of the given resources. Resources which are set in neither stay unset.
+func Add(a, b v1.VirtualMachineQuotaResources) v1.VirtualMachineQuotaResources {
+	sum := v1.VirtualMachineQuotaResources{
+		RunningVMIs: addInt(a.RunningVMIs, b.RunningVMIs),
+		VCPUs:       addInt(a.VCPUs, b.VCPUs),
+		Migrations:  addInt(a.Migrations, b.Migrations),
+	}
+	if a.GuestMemory != nil || b.GuestMemory != nil {
+		memory := resource.Quantity{}
+		if a.GuestMemory != nil {
+			memory.Add(*a.GuestMemory)
+		}
+		if b.GuestMemory != nil {
+			memory.Add(*b.GuestMemory)
+		}
+		sum.GuestMemory = &memory
+	}
+	for _, devices := range []map[string]int64{a.Devices, b.Devices} {
+		for name, count := range devices {
+			if sum.Devices == nil {
+				sum.Devices = map[string]int64{}
+			}
+			sum.Devices[name] += count
+		}
+	}
+	return sum
+}
+
+// Mask returns the usage of the resources which hard limits. Limited resources without usage are zero.
+func Mask(used, hard v1.VirtualMachineQuotaResources) v1.VirtualMachineQuotaResources {
+	masked := v1.VirtualMachineQuotaResources{}
+	if hard.RunningVMIs != nil {
+		masked.RunningVMIs = pointer.P(intValue(used.RunningVMIs))
+	}
+	if hard.GuestMemory != nil {
+		masked.GuestMemory = resource.NewQuantity(0, resource.BinarySI)
+		if used.GuestMemory != nil {
+			masked.GuestMemory = pointer.P(used.GuestMemory.DeepCopy())
+		}
+	}
+	if hard.VCPUs != nil {
+		masked.VCPUs = pointer.P(intValue(used.VCPUs))
+	}
+	if hard.Migrations != nil {
+		masked.Migrations = pointer.P(intValue(used.Migrations))
+	}
+	for name := range hard.Devices {
+		if masked.Devices == nil {
+			masked.Devices = map[string]int64{}
+		}
+		masked.Devices[name] = used.Devices[name]
+	}
+	return masked
+}
+
+// Exceeded describes every limit of hard which the requested resources would exceed on top of the used ones.
+// Limits are only checked for resources which are requested, so that lowering a limit below the current
+// usage does not block workloads which don't consume the resource.
+func Exceeded(hard, used, requested v1.VirtualMachineQuotaResources) []string {
+	var reasons []string
+	exceedsInt := func(name string, hard, used, requested *int64) {
+		if hard != nil && intValue(requested) > 0 && intValue(used)+intValue(requested) > *hard {
+			reasons = append(reasons, fmt.Sprintf("%s: requested %d, used %d, limited to %d", name, intValue(requested), intValue(used), *hard))
+		}
+	}
+
+	exceedsInt("runningVMIs", hard.RunningVMIs, used.RunningVMIs, requested.RunningVMIs)
+	if hard.GuestMemory != nil && requested.GuestMemory != nil && !requested.GuestMemory.IsZero() {
+		total := requested.GuestMemory.DeepCopy()
+		if used.GuestMemory != nil {
+			total.Add(*used.GuestMemory)
+		}
+		if total.Cmp(*hard.GuestMemory) > 0 {
+			usedMemory := resource.Quantity{}
+			if used.GuestMemory != nil {
+				usedMemory = *used.GuestMemory
+			}
+			reasons = append(reasons, fmt.Sprintf("guestMemory: requested %s, used %s, limited to %s", requested.GuestMemory.String(), usedMemory.String(), hard.GuestMemory.String()))
+		}
+	}
+	exceedsInt("vcpus", hard.VCPUs, used.VCPUs, requested.VCPUs)
+
+	names := make([]string, 0, len(hard.Devices))
+	for name := range hard.Devices {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		limit := hard.Devices[name]
+		if requested.Devices[name] > 0 && used.Devices[name]+requested.Devices[name] > limit {
+			reasons = append(reasons, fmt.Sprintf("devices[%s]: requested %d, used %d, limited to %d", name, requested.Devices[name], used.Devices[name], limit))
+		}
+	}
+
+	exceedsInt("migrations", hard.Migrations, used.Migrations, requested.Migrations)
+	return reasons
+}
+
+// NewInformer returns an informer for the VirtualMachineQuotas of all namespaces, indexed by namespace
+func NewInformer(restClient cache.Getter) cache.SharedIndexInformer {
+	lw := cache.NewListWatchFromClient(restClient, "virtualmachinequotas", k8sv1.NamespaceAll, fields.Everything())
+	return cache.NewSharedIndexInformer(lw, &v1.VirtualMachineQuota{}, 0, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
+// Indexers are the informer caches Check reads the quotas and the usage of a namespace from.
+// They have to be indexed by namespace.
+type Indexers struct {
+	Quotas     cache.Indexer
+	VMIs       cache.Indexer
+	Migrations cache.Indexer
+}
+
+// Check returns an ExceededError if consuming the requested resources in the namespace would exceed one of its
+// VirtualMachineQuotas. The usage is computed from the VirtualMachineInstances and VirtualMachineInstanceMigrations
+// of the namespace in the informer caches, only the kinds of objects the request accounts for are looked at.
+// Quotas are not enforced without indexers.
+//
+// Requests which are admitted before the objects of each other show up in the caches don't account for each
+// other, so a burst of requests may exceed a quota by the resources it requested. The quota status which
+// virt-controller maintains reports the actual usage in that case.
+func Check(indexers *Indexers, namespace string, requested v1.VirtualMachineQuotaResources) error {
+	if indexers == nil {
+		return nil
+	}
+
+	var quotas []*v1.VirtualMachineQuota
+	err := cache.ListAllByNamespace(indexers.Quotas, namespace, labels.Everything(), func(obj interface{}) {
+		quotas = append(quotas, obj.(*v1.VirtualMachineQuota))
+	})
+	if err != nil {
+		return fmt.Errorf("failed to list VirtualMachineQuotas: %v", err)
+	}
+	if len(quotas) == 0 {
+		return nil
+	}
+
+	var vmis []*v1.VirtualMachineInstance
+	if requestsVMIResources(requested) {
+		err = cache.ListAllByNamespace(indexers.VMIs, namespace, labels.Everything(), func(obj interface{}) {
+			vmis = append(vmis, obj.(*v1.VirtualMachineInstance))
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list VirtualMachineInstances: %v", err)
+		}
+	}
+	var migrations []*v1.VirtualMachineInstanceMigration
+	if requested.Migrations != nil {
+		err = cache.ListAllByNamespace(indexers.Migrations, namespace, labels.Everything(), func(obj interface{}) {
+			migrations = append(migrations, obj.(*v1.VirtualMachineInstanceMigration))
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list VirtualMachineInstanceMigrations: %v", err)
+		}
+	}
+
+	used := Usage(vmis, migrations)
+	sort.Slice(quotas, func(i, j int) bool {
+		return quotas[i].Name < quotas[j].Name
+	})
+	for _, quota := range quotas {
+		if reasons := Exceeded(quota.Spec.Hard, used, requested); len(reasons) > 0 {
+			return &ExceededError{Quota: quota.Name, Reasons: reasons}
+		}
+	}
+	return nil
+}
+
+// requestsVMIResources returns whether the request asks for resources which VirtualMachineInstances consume,
+// like a new VirtualMachineInstance or the CPUs and memory hotplugged into a running one
+func requestsVMIResources(requested v1.VirtualMachineQuotaResources) bool {
+	return requested.RunningVMIs != nil || requested.GuestMemory != nil || requested.VCPUs != nil || len(requested.Devices) > 0
+}
+
+func guestMemory(spec *v1.VirtualMachineInstanceSpec) *resource.Quantity {
+	if spec.Domain.Memory != nil && spec.Domain.Memory.Guest != nil {
+		return pointer.P(spec.Domain.Memory.Guest.DeepCopy())
+	}
+	if memory, ok := spec.Domain.Resources.Requests[k8sv1.ResourceMemory]; ok {
+		return pointer.P(memory.DeepCopy())
+	}
+	if memory, ok := spec.Domain.Resources.Limits[k8sv1.ResourceMemory]; ok {
+		return pointer.P(memory.DeepCopy())
+	}
+	return resource.NewQuantity(0, resource.BinarySI)
+}
+
+func vCPUs(spec *v1.VirtualMachineInstanceSpec) int64 {
+	if spec.Domain.CPU == nil {
+		return 1
+	}
+	if count := hwutil.GetNumberOfVCPUs(spec.Domain.CPU); count > 0 {
+		return count
+	}
+	return 1
+}
+
+func addDevice(usage *v1.VirtualMachineQuotaResources, name string) {
+	if usage.Devices == nil {
+		usage.Devices = map[string]int64{}
+	}
+	usage.Devices[name]++
+}
+
+func addInt(a, b *int64) *int64 {
+	if a == nil && b == nil {
+		return nil
+	}
+	return pointer.P(intValue(a) + intValue(b))
+}
+
+func intValue(i *int64) int64 {
+	if i == nil {
+		return 0
+	}
+	return *i
+}
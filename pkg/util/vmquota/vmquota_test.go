@@ -0,0 +1,233 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package vmquota_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
+)
+
+var _ = Describe("VirtualMachineQuota", func() {
+	const namespace = "tenant"
+
+	newVMI := func(name string, phase v1.VirtualMachineInstancePhase, memory string, sockets uint32, gpus ...string) *v1.VirtualMachineInstance {
+		vmi := &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{
+					CPU: &v1.CPU{Sockets: sockets, Cores: 2, Threads: 1},
+					Resources: v1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{k8sv1.ResourceMemory: resource.MustParse(memory)},
+					},
+				},
+			},
+			Status: v1.VirtualMachineInstanceStatus{Phase: phase},
+		}
+		for _, gpu := range gpus {
+			vmi.Spec.Domain.Devices.GPUs = append(vmi.Spec.Domain.Devices.GPUs, v1.GPU{Name: gpu, DeviceName: "nvidia.com/A100"})
+		}
+		return vmi
+	}
+
+	Context("VMIUsage", func() {
+		It("should account for the guest memory, vCPUs and devices", func() {
+			vmi := newVMI("vmi", v1.Running, "1Gi", 2, "gpu1", "gpu2")
+			vmi.Spec.Domain.Memory = &v1.Memory{Guest: pointer.P(resource.MustParse("2Gi"))}
+			vmi.Spec.Domain.Devices.HostDevices = []v1.HostDevice{{Name: "nic", DeviceName: "intel.com/sriov"}}
+
+			usage := vmquota.VMIUsage(&vmi.Spec)
+			Expect(usage.RunningVMIs).To(HaveValue(BeEquivalentTo(1)))
+			Expect(usage.GuestMemory.Cmp(resource.MustParse("2Gi"))).To(BeZero())
+			Expect(usage.VCPUs).To(HaveValue(BeEquivalentTo(4)))
+			Expect(usage.Devices).To(Equal(map[string]int64{"nvidia.com/A100": 2, "intel.com/sriov": 1}))
+			Expect(usage.Migrations).To(BeNil())
+		})
+
+		It("should count a single vCPU without a CPU topology", func() {
+			vmi := newVMI("vmi", v1.Running, "1Gi", 1)
+			vmi.Spec.Domain.CPU = nil
+			Expect(vmquota.VMIUsage(&vmi.Spec).VCPUs).To(HaveValue(BeEquivalentTo(1)))
+		})
+	})
+
+	It("Usage should ignore VMIs and migrations in a final phase", func() {
+		usage := vmquota.Usage(
+			[]*v1.VirtualMachineInstance{
+				newVMI("running", v1.Running, "1Gi", 1),
+				newVMI("pending", v1.Pending, "2Gi", 2, "gpu"),
+				newVMI("succeeded", v1.Succeeded, "4Gi", 4),
+			},
+			[]*v1.VirtualMachineInstanceMigration{
+				{Status: v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationRunning}},
+				{Status: v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationFailed}},
+			},
+		)
+		Expect(usage.RunningVMIs).To(HaveValue(BeEquivalentTo(2)))
+		Expect(usage.GuestMemory.Cmp(resource.MustParse("3Gi"))).To(BeZero())
+		Expect(usage.VCPUs).To(HaveValue(BeEquivalentTo(6)))
+		Expect(usage.Devices).To(Equal(map[string]int64{"nvidia.com/A100": 1}))
+		Expect(usage.Migrations).To(HaveValue(BeEquivalentTo(1)))
+	})
+
+	It("Mask should only keep the limited resources", func() {
+		used := v1.VirtualMachineQuotaResources{
+			RunningVMIs: pointer.P(int64(3)),
+			VCPUs:       pointer.P(int64(8)),
+			Devices:     map[string]int64{"nvidia.com/A100": 1},
+		}
+		hard := v1.VirtualMachineQuotaResources{
+			RunningVMIs: pointer.P(int64(5)),
+			GuestMemory: pointer.P(resource.MustParse("8Gi")),
+			Devices:     map[string]int64{"intel.com/sriov": 2},
+		}
+
+		masked := vmquota.Mask(used, hard)
+		Expect(masked.RunningVMIs).To(HaveValue(BeEquivalentTo(3)))
+		Expect(masked.GuestMemory.IsZero()).To(BeTrue())
+		Expect(masked.VCPUs).To(BeNil())
+		Expect(masked.Devices).To(Equal(map[string]int64{"intel.com/sriov": 0}))
+		Expect(masked.Migrations).To(BeNil())
+	})
+
+	DescribeTable("Exceeded should", func(requested v1.VirtualMachineQuotaResources, expected []string) {
+		hard := v1.VirtualMachineQuotaResources{
+			RunningVMIs: pointer.P(int64(2)),
+			GuestMemory: pointer.P(resource.MustParse("4Gi")),
+			VCPUs:       pointer.P(int64(8)),
+			Devices:     map[string]int64{"nvidia.com/A100": 1},
+			Migrations:  pointer.P(int64(1)),
+		}
+		used := v1.VirtualMachineQuotaResources{
+			RunningVMIs: pointer.P(int64(1)),
+			GuestMemory: pointer.P(resource.MustParse("2Gi")),
+			VCPUs:       pointer.P(int64(4)),
+			Devices:     map[string]int64{"nvidia.com/A100": 1},
+			Migrations:  pointer.P(int64(1)),
+		}
+		Expect(vmquota.Exceeded(hard, used, requested)).To(Equal(expected))
+	},
+		Entry("accept a VMI which fits", v1.VirtualMachineQuotaResources{
+			RunningVMIs: pointer.P(int64(1)),
+			GuestMemory: pointer.P(resource.MustParse("2Gi")),
+			VCPUs:       pointer.P(int64(4)),
+		}, nil),
+		Entry("reject a VMI with too much memory and vCPUs", v1.VirtualMachineQuotaResources{
+			RunningVMIs: pointer.P(int64(1)),
+			GuestMemory: pointer.P(resource.MustParse("3Gi")),
+			VCPUs:       pointer.P(int64(6)),
+		}, []string{
+			"guestMemory: requested 3Gi, used 2Gi, limited to 4Gi",
+			"vcpus: requested 6, used 4, limited to 8",
+		}),
+		Entry("reject a VMI with an exhausted device", v1.VirtualMachineQuotaResources{
+			RunningVMIs: pointer.P(int64(1)),
+			Devices:     map[string]int64{"nvidia.com/A100": 1},
+		}, []string{
+			"devices[nvidia.com/A100]: requested 1, used 1, limited to 1",
+		}),
+		Entry("reject a concurrent migration", vmquota.MigrationUsage(), []string{
+			"migrations: requested 1, used 1, limited to 1",
+		}),
+	)
+
+	Context("Check", func() {
+		var quota *v1.VirtualMachineQuota
+
+		newIndexers := func(objs ...interface{}) *vmquota.Indexers {
+			indexers := &vmquota.Indexers{
+				Quotas:     cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+				VMIs:       cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+				Migrations: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+			}
+			for _, obj := range objs {
+				switch obj.(type) {
+				case *v1.VirtualMachineQuota:
+					Expect(indexers.Quotas.Add(obj)).To(Succeed())
+				case *v1.VirtualMachineInstance:
+					Expect(indexers.VMIs.Add(obj)).To(Succeed())
+				case *v1.VirtualMachineInstanceMigration:
+					Expect(indexers.Migrations.Add(obj)).To(Succeed())
+				}
+			}
+			return indexers
+		}
+
+		BeforeEach(func() {
+			quota = &v1.VirtualMachineQuota{
+				ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: namespace},
+				Spec: v1.VirtualMachineQuotaSpec{
+					Hard: v1.VirtualMachineQuotaResources{RunningVMIs: pointer.P(int64(2))},
+				},
+			}
+		})
+
+		It("should accept any request without quotas", func() {
+			indexers := newIndexers(newVMI("vmi1", v1.Running, "1Gi", 1), newVMI("vmi2", v1.Running, "1Gi", 1))
+			vmi := newVMI("vmi3", "", "1Gi", 1)
+			Expect(vmquota.Check(indexers, namespace, vmquota.VMIUsage(&vmi.Spec))).To(Succeed())
+		})
+
+		It("should accept any request without indexers", func() {
+			vmi := newVMI("vmi3", "", "1Gi", 1)
+			Expect(vmquota.Check(nil, namespace, vmquota.VMIUsage(&vmi.Spec))).To(Succeed())
+		})
+
+		It("should accept a VMI within the quota", func() {
+			indexers := newIndexers(quota, newVMI("vmi1", v1.Running, "1Gi", 1), newVMI("vmi2", v1.Failed, "1Gi", 1))
+			vmi := newVMI("vmi3", "", "1Gi", 1)
+			Expect(vmquota.Check(indexers, namespace, vmquota.VMIUsage(&vmi.Spec))).To(Succeed())
+		})
+
+		It("should reject a VMI exceeding the quota", func() {
+			indexers := newIndexers(quota, newVMI("vmi1", v1.Running, "1Gi", 1), newVMI("vmi2", v1.Scheduled, "1Gi", 1))
+			vmi := newVMI("vmi3", "", "1Gi", 1)
+			err := vmquota.Check(indexers, namespace, vmquota.VMIUsage(&vmi.Spec))
+			Expect(err).To(MatchError("exceeded VirtualMachineQuota quota: runningVMIs: requested 1, used 2, limited to 2"))
+
+			var exceededErr *vmquota.ExceededError
+			Expect(err).To(BeAssignableToTypeOf(exceededErr))
+		})
+
+		It("should account for the running VMIs when hotplugging vCPUs", func() {
+			quota.Spec.Hard = v1.VirtualMachineQuotaResources{VCPUs: pointer.P(int64(6))}
+			indexers := newIndexers(quota, newVMI("vmi1", v1.Running, "1Gi", 2))
+			Expect(vmquota.Check(indexers, namespace, v1.VirtualMachineQuotaResources{VCPUs: pointer.P(int64(2))})).To(Succeed())
+			Expect(vmquota.Check(indexers, namespace, v1.VirtualMachineQuotaResources{VCPUs: pointer.P(int64(4))})).To(
+				MatchError("exceeded VirtualMachineQuota quota: vcpus: requested 4, used 4, limited to 6"))
+		})
+
+		It("should only account for the namespace of the request", func() {
+			quota.Namespace = "other"
+			indexers := newIndexers(quota, newVMI("vmi1", v1.Running, "1Gi", 1), newVMI("vmi2", v1.Running, "1Gi", 1))
+			vmi := newVMI("vmi3", "", "1Gi", 1)
+			Expect(vmquota.Check(indexers, namespace, vmquota.VMIUsage(&vmi.Spec))).To(Succeed())
+		})
+	})
+})
@@ -40,10 +40,14 @@ import (
 	restful "github.com/emicklei/go-restful/v3"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	flag "github.com/spf13/pflag"
+	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8coresv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
 	certificate2 "k8s.io/client-go/util/certificate"
 	"k8s.io/client-go/util/flowcontrol"
 	aggregatorclient "k8s.io/kube-aggregator/pkg/client/clientset_generated/clientset"
@@ -70,6 +74,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/service"
 	"kubevirt.io/kubevirt/pkg/util"
 	"kubevirt.io/kubevirt/pkg/util/openapi"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
 	"kubevirt.io/kubevirt/pkg/virt-api/rest"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
@@ -142,6 +147,9 @@ type virtAPIApp struct {
 	reInitChan chan string
 
 	kubeVirtServiceAccounts map[string]struct{}
+
+	// quotaIndexers are the informer caches the VirtualMachineQuotas are enforced with
+	quotaIndexers *vmquota.Indexers
 }
 
 var (
@@ -230,6 +238,13 @@ func (app *virtAPIApp) composeSubresources() {
 
 	var subwss []*restful.WebService
 
+	var recorder record.EventRecorder
+	if app.virtCli != nil {
+		eventBroadcaster := record.NewBroadcaster()
+		eventBroadcaster.StartRecordingToSink(&k8coresv1.EventSinkImpl{Interface: app.virtCli.CoreV1().Events(k8sv1.NamespaceAll)})
+		recorder = eventBroadcaster.NewRecorder(scheme.Scheme, k8sv1.EventSource{Component: "virt-api"})
+	}
+
 	for _, version := range v1.SubresourceGroupVersions {
 		subresourcesvmGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachines"}
 		subresourcesvmiGVR := schema.GroupVersionResource{Group: version.Group, Version: version.Version, Resource: "virtualmachineinstances"}
@@ -240,6 +255,15 @@ func (app *virtAPIApp) composeSubresources() {
 		subws.Path(definitions.GroupVersionBasePath(version))
 
 		subresourceApp := rest.NewSubresourceAPIApp(app.virtCli, app.consoleServerPort, app.handlerTLSConfiguration, app.clusterConfig)
+		if app.certmanager != nil {
+			subresourceApp.SetVNCTokenCertificate(app.certmanager.Current)
+		}
+		if recorder != nil {
+			subresourceApp.SetEventRecorder(recorder)
+		}
+		if app.quotaIndexers != nil {
+			subresourceApp.SetVirtualMachineQuotaIndexers(app.quotaIndexers)
+		}
 
 		restartRouteBuilder := subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("restart")).
 			To(subresourceApp.RestartVMRequestHandler).
@@ -309,6 +333,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("freeze")).
+			To(subresourceApp.FreezeStatusVMIRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"FreezeStatus").
+			Produces(restful.MIME_JSON).
+			Doc("Get the outcome of the freeze hook steps of the last freeze and thaw of a VirtualMachineInstance object.").
+			Returns(http.StatusOK, "OK", v1.FreezeHooksStatus{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("unfreeze")).
 			To(subresourceApp.UnfreezeVMIRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
@@ -366,11 +400,36 @@ func (app *virtAPIApp) composeSubresources() {
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
 			Operation(version.Version + "VNC").
 			Doc("Open a websocket connection to connect to VNC on the specified VirtualMachineInstance."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("spice")).
+			To(subresourceApp.SPICERequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version + "SPICE").
+			Doc("Open a websocket connection to connect to SPICE on the specified VirtualMachineInstance."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc/screenshot")).
 			To(subresourceApp.VNCScreenshotRequestHandler).
 			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).Param(definitions.MoveCursorParam(subws)).
 			Operation(version.Version + "VNCScreenshot").
 			Doc("Get a PNG VNC screenshot of the specified VirtualMachineInstance."))
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("vnc/thumbnails")).
+			To(subresourceApp.VNCThumbnailsRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.IntervalParam(subws)).Param(definitions.MaxWidthParam(subws)).
+			Operation(version.Version + "VNCThumbnails").
+			Doc("Open a websocket connection which periodically receives PNG thumbnails of the VNC display of the specified VirtualMachineInstance as binary messages."))
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("vnctoken")).
+			To(subresourceApp.VNCTokenRequestHandler).
+			Reads(v1.VNCTokenOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"VNCToken").
+			Doc("Issue a short-lived token granting access to the VNC console of the specified VirtualMachineInstance through the vncproxy endpoint.").
+			Returns(http.StatusOK, "OK", v1.VNCToken{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+		subws.Route(subws.GET(definitions.SubResourcePath("vncproxy")).
+			To(subresourceApp.VNCProxyRequestHandler).
+			Param(definitions.VNCTokenParam(subws)).
+			Operation(version.Version + "VNCProxy").
+			Doc("Open a noVNC compatible websocket connection to VNC on the VirtualMachineInstance referenced by the token."))
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR) + definitions.SubResourcePath("usbredir")).
 			To(subresourceApp.USBRedirRequestHandler).
 			Param(definitions.NamespaceParam(subws)).
@@ -484,6 +543,50 @@ func (app *virtAPIApp) composeSubresources() {
 			Writes(v1.VirtualMachineInstanceFileSystemList{}).
 			Returns(http.StatusOK, "OK", v1.VirtualMachineInstanceFileSystemList{}))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("efivars")).
+			To(subresourceApp.EFIVarsRequestHandler).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"EFIVars").
+			Doc("Get the EFI variable store of a VirtualMachineInstance").
+			Writes(v1.EFIVars{}).
+			Returns(http.StatusOK, "OK", v1.EFIVars{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile/read")).
+			To(subresourceApp.GuestFileReadRequestHandler).
+			Produces(restful.MIME_JSON).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Param(definitions.GuestPathParam(subws)).
+			Operation(version.Version+"GuestFileRead").
+			Doc("Read a file from the guest via guest agent").
+			Writes(v1.GuestFile{}).
+			Returns(http.StatusOK, "OK", v1.GuestFile{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestfile/write")).
+			To(subresourceApp.GuestFileWriteRequestHandler).
+			Consumes(restful.MIME_JSON).
+			Reads(v1.GuestFile{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestFileWrite").
+			Doc("Write a file to the guest via guest agent").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusRequestEntityTooLarge, "Request Entity Too Large", ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("guestexec")).
+			To(subresourceApp.GuestExecRequestHandler).
+			Consumes(restful.MIME_JSON).
+			Produces(restful.MIME_JSON).
+			Reads(v1.GuestExecOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"GuestExec").
+			Doc("Execute a command in the guest via guest agent and stream its output, followed by its exit code").
+			Writes(v1.GuestExecResult{}).
+			Returns(http.StatusOK, "OK", v1.GuestExecResult{}).
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMIAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -504,6 +607,16 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
 
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("setlinkstate")).
+			To(subresourceApp.SetInterfaceLinkStateRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.SetInterfaceLinkStateOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"vmi-setlinkstate").
+			Doc("Set the link state of an interface of a running Virtual Machine Instance").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, ""))
+
 		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("addvolume")).
 			To(subresourceApp.VMAddVolumeRequestHandler).
 			Consumes(mime.MIME_ANY).
@@ -542,6 +655,35 @@ func (app *virtAPIApp) composeSubresources() {
 			Returns(http.StatusOK, "OK", "").
 			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
 
+		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("persistentstate")).
+			To(subresourceApp.PersistentStateVMRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Produces(restful.MIME_JSON).
+			Operation(version.Version+"PersistentState").
+			Doc("Get the PVC holding the persistent TPM and EFI state of a Virtual Machine.").
+			Writes(v1.VirtualMachinePersistentState{}).
+			Returns(http.StatusOK, "OK", v1.VirtualMachinePersistentState{}).
+			Returns(http.StatusNotFound, httpStatusNotFoundMessage, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("resetpersistentstate")).
+			To(subresourceApp.ResetPersistentStateVMRequestHandler).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"ResetPersistentState").
+			Doc("Delete the persistent TPM and EFI state of a stopped Virtual Machine.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
+		subws.Route(subws.PUT(definitions.NamespacedResourcePath(subresourcesvmGVR)+definitions.SubResourcePath("importpersistentstate")).
+			To(subresourceApp.ImportPersistentStateVMRequestHandler).
+			Consumes(mime.MIME_ANY).
+			Reads(v1.ImportPersistentStateOptions{}).
+			Param(definitions.NamespaceParam(subws)).Param(definitions.NameParam(subws)).
+			Operation(version.Version+"ImportPersistentState").
+			Doc("Replace the persistent TPM and EFI state of a stopped Virtual Machine with the one in a PVC.").
+			Returns(http.StatusOK, "OK", "").
+			Returns(http.StatusBadRequest, httpStatusBadRequestMessage, "").
+			Returns(http.StatusInternalServerError, httpStatusInternalServerError, ""))
+
 		// AMD SEV endpoints
 		subws.Route(subws.GET(definitions.NamespacedResourcePath(subresourcesvmiGVR)+definitions.SubResourcePath("sev/fetchcertchain")).
 			To(subresourceApp.SEVFetchCertChainRequestHandler).
@@ -605,6 +747,14 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/vnc",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/vnctoken",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/spice",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/console",
 						Namespaced: true,
@@ -657,6 +807,18 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachines/expand-spec",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachines/persistentstate",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/resetpersistentstate",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachines/importpersistentstate",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/guestosinfo",
 						Namespaced: true,
@@ -669,6 +831,18 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/filesystemlist",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/guestfile",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/efivars",
+						Namespaced: true,
+					},
+					{
+						Name:       "virtualmachineinstances/guestexec",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/addvolume",
 						Namespaced: true,
@@ -677,6 +851,10 @@ func (app *virtAPIApp) composeSubresources() {
 						Name:       "virtualmachineinstances/removevolume",
 						Namespaced: true,
 					},
+					{
+						Name:       "virtualmachineinstances/setlinkstate",
+						Namespaced: true,
+					},
 					{
 						Name:       "virtualmachineinstances/sev/fetchcertchain",
 						Namespaced: true,
@@ -882,7 +1060,7 @@ func (app *virtAPIApp) prepareCertManager() {
 
 func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers) {
 	http.HandleFunc(components.VMICreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, app.kubeVirtServiceAccounts,
+		validating_webhook.ServeVMICreate(w, r, app.clusterConfig, app.quotaIndexers, app.kubeVirtServiceAccounts,
 			func(field *field.Path, vmiSpec *v1.VirtualMachineInstanceSpec, clusterCfg *virtconfig.ClusterConfig) []metav1.StatusCause {
 				return netadmitter.Validate(field, vmiSpec, clusterCfg)
 			},
@@ -904,7 +1082,7 @@ func (app *virtAPIApp) registerValidatingWebhooks(informers *webhooks.Informers)
 		validating_webhook.ServeVMIPreset(w, r)
 	})
 	http.HandleFunc(components.MigrationCreateValidatePath, func(w http.ResponseWriter, r *http.Request) {
-		validating_webhook.ServeMigrationCreate(w, r, app.virtCli)
+		validating_webhook.ServeMigrationCreate(w, r, app.virtCli, app.quotaIndexers, app.kubeVirtServiceAccounts)
 	})
 	http.HandleFunc(components.MigrationUpdateValidatePath, func(w http.ResponseWriter, r *http.Request) {
 		validating_webhook.ServeMigrationUpdate(w, r)
@@ -1090,6 +1268,8 @@ func (app *virtAPIApp) Run() {
 	vmiPresetInformer := kubeInformerFactory.VirtualMachinePreset()
 	vmRestoreInformer := kubeInformerFactory.VirtualMachineRestore()
 	namespaceInformer := kubeInformerFactory.Namespace()
+	vmiInformer := kubeInformerFactory.VMI()
+	migrationInformer := kubeInformerFactory.VirtualMachineInstanceMigration()
 
 	stopChan := make(chan struct{}, 1)
 	defer close(stopChan)
@@ -1100,6 +1280,18 @@ func (app *virtAPIApp) Run() {
 	if err != nil {
 		panic(err)
 	}
+	namespaceConfigInformer := virtconfig.NewNamespaceConfigInformer(app.virtCli.RestClient())
+	go namespaceConfigInformer.Run(stopChan)
+	cache.WaitForCacheSync(stopChan, namespaceConfigInformer.HasSynced)
+	app.clusterConfig.SetNamespaceConfigInformer(namespaceConfigInformer)
+	vmQuotaInformer := vmquota.NewInformer(app.virtCli.RestClient())
+	go vmQuotaInformer.Run(stopChan)
+	cache.WaitForCacheSync(stopChan, vmQuotaInformer.HasSynced)
+	app.quotaIndexers = &vmquota.Indexers{
+		Quotas:     vmQuotaInformer.GetIndexer(),
+		VMIs:       vmiInformer.GetIndexer(),
+		Migrations: migrationInformer.GetIndexer(),
+	}
 	app.hasCDIDataSource = app.clusterConfig.HasDataSourceAPI()
 	app.clusterConfig.SetConfigModifiedCallback(app.configModificationCallback)
 	app.clusterConfig.SetConfigModifiedCallback(app.shouldChangeLogVerbosity)
@@ -568,6 +568,10 @@ const (
 	NamespaceParamName  = "namespace"
 	NameParamName       = "name"
 	MoveCursorParamName = "moveCursor"
+	VNCTokenParamName   = "token"
+	IntervalParamName   = "interval"
+	MaxWidthParamName   = "maxWidth"
+	GuestPathParamName  = "path"
 )
 
 func NameParam(ws *restful.WebService) *restful.Parameter {
@@ -582,6 +586,22 @@ func MoveCursorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter(MoveCursorParamName, "Move the cursor on the VNC display to wake up the screen").DataType("boolean").DefaultValue("false")
 }
 
+func IntervalParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(IntervalParamName, "Seconds between two thumbnails").DataType("integer").DefaultValue("5")
+}
+
+func MaxWidthParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(MaxWidthParamName, "Maximum width of a thumbnail in pixels, larger screens are scaled down preserving the aspect ratio").DataType("integer").DefaultValue("320")
+}
+
+func GuestPathParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(GuestPathParamName, "Absolute path of the file inside the guest").Required(true)
+}
+
+func VNCTokenParam(ws *restful.WebService) *restful.Parameter {
+	return ws.QueryParameter(VNCTokenParamName, "Token issued by the vnctoken subresource").Required(true)
+}
+
 func labelSelectorParam(ws *restful.WebService) *restful.Parameter {
 	return ws.QueryParameter("labelSelector", "A selector to restrict the list of returned objects by their labels. Defaults to everything")
 }
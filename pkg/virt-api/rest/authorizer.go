@@ -44,6 +44,15 @@ const (
 
 	namespacedResourceAttributesMinParts  = 9
 	namespacedResourceBaseAttributesParts = 7
+
+	// guestExecSubresource is authorized with the dedicated exec verb instead of
+	// the verb derived from the http method, so that granting update on the other
+	// subresources does not allow running commands inside the guest
+	guestExecSubresource = "guestexec"
+	guestExecVerb        = "exec"
+
+	// authorizedUserAttribute holds the name of the user a request was authorized for
+	authorizedUserAttribute = "kubevirt.io/authorized-user"
 )
 
 var noAuthEndpoints = map[string]struct{}{
@@ -64,6 +73,10 @@ var noAuthEndpoints = map[string]struct{}{
 	"/apis/subresources.kubevirt.io/v1alpha3/version": {},
 	"/apis/subresources.kubevirt.io/v1alpha3/guestfs": {},
 	"/apis/subresources.kubevirt.io/v1alpha3/healthz": {},
+	// the vncproxy endpoints authenticate requests with
+	// the signed token passed as query parameter
+	"/apis/subresources.kubevirt.io/v1/vncproxy":       {},
+	"/apis/subresources.kubevirt.io/v1alpha3/vncproxy": {},
 	// the profiler endpoints are blocked by a feature gate
 	// to restrict the usage to development environments
 	"/start-profiler": {},
@@ -229,6 +242,9 @@ func addNamespacedResourceAttributes(pathSplit []string, requestMethod string, r
 	if err != nil {
 		return err
 	}
+	if resource == "virtualmachineinstances" && subresource == guestExecSubresource {
+		verb = guestExecVerb
+	}
 
 	r.Spec.ResourceAttributes = &authv1.ResourceAttributes{
 		Namespace:   namespace,
@@ -345,6 +361,7 @@ func (a *authorizor) Authorize(req *restful.Request) (bool, string, error) {
 	}
 
 	if result.Status.Allowed {
+		req.SetAttribute(authorizedUserAttribute, r.Spec.User)
 		return true, "", nil
 	}
 
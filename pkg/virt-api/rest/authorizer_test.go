@@ -131,6 +131,29 @@ var _ = Describe("Authorizer", func() {
 					result, _, err := app.Authorize(req)
 					Expect(err).ToNot(HaveOccurred())
 					Expect(result).To(BeTrue())
+					Expect(req.Attribute(authorizedUserAttribute)).To(Equal("user"))
+				})
+			})
+
+			Context("with guestexec subresource", func() {
+				BeforeEach(func() {
+					req.Request.Method = http.MethodPut
+					req.Request.URL.Path = "/apis/subresources.kubevirt.io/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec"
+				})
+
+				It("should require the exec verb", func() {
+					allowedFn = func(sar *authv1.SubjectAccessReview) (*authv1.SubjectAccessReview, error) {
+						Expect(sar.Spec.ResourceAttributes).ToNot(BeNil())
+						Expect(sar.Spec.ResourceAttributes.Verb).To(Equal("exec"))
+						Expect(sar.Spec.ResourceAttributes.Resource).To(Equal("virtualmachineinstances"))
+						Expect(sar.Spec.ResourceAttributes.Subresource).To(Equal("guestexec"))
+						sar.Status.Allowed = true
+						return sar, nil
+					}
+
+					result, _, err := app.Authorize(req)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(result).To(BeTrue())
 				})
 			})
 
@@ -211,6 +234,7 @@ var _ = Describe("Authorizer", func() {
 				Entry("subresource v1 version", "/apis/subresources.kubevirt.io/v1/version"),
 				Entry("subresource v1 guestfs", "/apis/subresources.kubevirt.io/v1/guestfs"),
 				Entry("subresource v1 healthz", "/apis/subresources.kubevirt.io/v1/healthz"),
+				Entry("subresource v1 vncproxy", "/apis/subresources.kubevirt.io/v1/vncproxy"),
 				Entry("subresource v1 start profiler", "/apis/subresources.kubevirt.io/v1/start-cluster-profiler"),
 				Entry("subresource v1 stop profiler", "/apis/subresources.kubevirt.io/v1/stop-cluster-profiler"),
 				Entry("subresource v1 dump profiler", "/apis/subresources.kubevirt.io/v1/dump-cluster-profiler"),
@@ -219,6 +243,7 @@ var _ = Describe("Authorizer", func() {
 				Entry("subresource v1alpha3 version", "/apis/subresources.kubevirt.io/v1alpha3/version"),
 				Entry("subresource v1alpha3 guestfs", "/apis/subresources.kubevirt.io/v1alpha3/guestfs"),
 				Entry("subresource v1alpha3 healthz", "/apis/subresources.kubevirt.io/v1alpha3/healthz"),
+				Entry("subresource v1alpha3 vncproxy", "/apis/subresources.kubevirt.io/v1alpha3/vncproxy"),
 				Entry("subresource v1alpha3 start profiler", "/apis/subresources.kubevirt.io/v1alpha3/start-cluster-profiler"),
 				Entry("subresource v1alpha3 stop profiler", "/apis/subresources.kubevirt.io/v1alpha3/stop-cluster-profiler"),
 				Entry("subresource v1alpha3 dump profiler", "/apis/subresources.kubevirt.io/v1alpha3/dump-cluster-profiler"),
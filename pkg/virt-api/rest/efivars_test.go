@@ -0,0 +1,150 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("EFI variable store subresource", func() {
+	const nodeName = "mynode"
+
+	var (
+		backend    *ghttp.Server
+		recorder   *httptest.ResponseRecorder
+		request    *restful.Request
+		response   *restful.Response
+		virtClient *kubevirtfake.Clientset
+		app        *SubresourceAPIApp
+	)
+
+	createVMI := func(opts ...libvmi.Option) {
+		opts = append(opts,
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(v1.Running),
+				libvmistatus.WithNodeName(nodeName),
+			)),
+		)
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), libvmi.New(opts...), metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		backend = ghttp.NewTLSServer()
+		backendAddr := strings.Split(backend.Addr(), ":")
+		backendPort, err := strconv.Atoi(backendAddr[1])
+		Expect(err).ToNot(HaveOccurred())
+
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		})
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "madeup-name",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: backendAddr[0],
+			},
+		}
+
+		virtClient = kubevirtfake.NewSimpleClientset()
+		kubeClient := fake.NewSimpleClientset(pod)
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		app = NewSubresourceAPIApp(mockVirtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+	})
+
+	AfterEach(func() {
+		backend.Close()
+	})
+
+	It("should reject a VMI which does not boot with EFI", func() {
+		createVMI()
+
+		app.EFIVarsRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+
+	It("should return the EFI variable store", func() {
+		createVMI(libvmi.WithUefi(true))
+		expected := v1.EFIVars{Contents: []byte("vars")}
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/v1/namespaces/default/virtualmachineinstances/testvmi/efivars"),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, expected),
+			),
+		)
+
+		app.EFIVarsRequestHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		efiVars := &v1.EFIVars{}
+		Expect(json.NewDecoder(recorder.Body).Decode(efiVars)).To(Succeed())
+		Expect(*efiVars).To(Equal(expected))
+	})
+})
@@ -0,0 +1,182 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	guestExecReason       = "GuestExec"
+	guestExecFailedReason = "GuestExecFailed"
+
+	// guestExecTimeoutMargin is added to the command timeout to leave virt-handler
+	// and virt-launcher enough time to report the timeout back
+	guestExecTimeoutMargin = 15 * time.Second
+)
+
+// SetEventRecorder sets the recorder used to emit events for audited subresources like guestexec
+func (app *SubresourceAPIApp) SetEventRecorder(recorder record.EventRecorder) {
+	app.recorder = recorder
+}
+
+func validateGuestExecOptions(options *v1.GuestExecOptions) *errors.StatusError {
+	if options.Command == "" {
+		return errors.NewBadRequest("command is required")
+	}
+	if options.TimeoutSeconds == nil {
+		timeout := int32(v1.GuestExecDefaultTimeoutSeconds)
+		options.TimeoutSeconds = &timeout
+	}
+	if *options.TimeoutSeconds <= 0 || *options.TimeoutSeconds > v1.GuestExecMaxTimeoutSeconds {
+		return errors.NewBadRequest(fmt.Sprintf("timeoutSeconds must be between 1 and %d", v1.GuestExecMaxTimeoutSeconds))
+	}
+	return nil
+}
+
+// GuestExecRequestHandler handles the subresource for executing a command in the guest via guest agent
+func (app *SubresourceAPIApp) GuestExecRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.GuestExecEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.GuestExecGate)), response)
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body"), response)
+		return
+	}
+	defer request.Request.Body.Close()
+
+	options := &v1.GuestExecOptions{}
+	if err := decodeBody(request, options); err != nil {
+		writeError(err, response)
+		return
+	}
+	if err := validateGuestExecOptions(options); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(request.PathParameter("namespace"), request.PathParameter("name"), validateVMIForGuestFile)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	user, _ := request.Attribute(authorizedUserAttribute).(string)
+	result, err := app.guestExec(vmi, options, response)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("User %q failed to execute guest command %q", user, options.Command)
+		app.recordEvent(vmi, k8sv1.EventTypeWarning, guestExecFailedReason,
+			fmt.Sprintf("User %q failed to execute command %q with %d argument(s) in the guest: %v", user, options.Command, len(options.Args), err))
+		// once the output is streamed the status is sent, the client notices the failure by the missing exit code
+		if result == nil {
+			writeError(errors.NewInternalError(err), response)
+		}
+		return
+	}
+
+	log.Log.Object(vmi).Infof("User %q executed guest command %q, exit code %d", user, options.Command, result.ExitCode)
+	app.recordEvent(vmi, k8sv1.EventTypeNormal, guestExecReason,
+		fmt.Sprintf("User %q executed command %q with %d argument(s) in the guest, exit code %d", user, options.Command, len(options.Args), result.ExitCode))
+}
+
+// guestExec streams the results virt-handler sends while the command runs to the response and returns the last one.
+// It uses a dedicated client, since commands may run longer than the default virt-handler request timeout.
+// The returned result is only nil if the streaming did not start yet.
+func (app *SubresourceAPIApp) guestExec(vmi *v1.VirtualMachineInstance, options *v1.GuestExecOptions, response *restful.Response) (*v1.GuestExecResult, error) {
+	client := &http.Client{
+		Transport: app.handlerHttpClient.Transport,
+		Timeout:   time.Duration(*options.TimeoutSeconds)*time.Second + guestExecTimeoutMargin,
+	}
+	conn := kubecli.NewVirtHandlerClient(app.virtCli, client).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName)
+	url, err := conn.GuestExecURI(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := json.Marshal(options)
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPut, url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", restful.MIME_JSON)
+	req.Header.Set("Accept", restful.MIME_JSON)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		respBody, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, fmt.Errorf("cannot read response body %v", err)
+		}
+		return nil, fmt.Errorf("unexpected return code %d (%s), message: %s", resp.StatusCode, resp.Status, string(respBody))
+	}
+
+	response.Header().Set("Content-Type", restful.MIME_JSON)
+	response.WriteHeader(http.StatusOK)
+	decoder := json.NewDecoder(resp.Body)
+	encoder := json.NewEncoder(response)
+	result := &v1.GuestExecResult{}
+	for !result.Exited {
+		result = &v1.GuestExecResult{}
+		if err := decoder.Decode(result); err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("the output ended before the command exited")
+			}
+			return result, err
+		}
+		if err := encoder.Encode(result); err != nil {
+			return result, err
+		}
+		response.Flush()
+	}
+	return result, nil
+}
+
+func (app *SubresourceAPIApp) recordEvent(vmi *v1.VirtualMachineInstance, eventType, reason, message string) {
+	if app.recorder == nil {
+		return
+	}
+	app.recorder.Event(vmi, eventType, reason, message)
+}
@@ -0,0 +1,266 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/record"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Guest exec subresource", func() {
+	const (
+		nodeName = "mynode"
+		userName = "alice"
+	)
+
+	var (
+		backend       *ghttp.Server
+		backendIP     string
+		backendPort   int
+		recorder      *httptest.ResponseRecorder
+		request       *restful.Request
+		response      *restful.Response
+		virtClient    *kubevirtfake.Clientset
+		eventRecorder *record.FakeRecorder
+		app           *SubresourceAPIApp
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: featureGates,
+					},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		})
+
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "madeup-name",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: backendIP,
+			},
+		}
+
+		kubeClient := fake.NewSimpleClientset(pod)
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		app := NewSubresourceAPIApp(mockVirtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+		app.SetEventRecorder(eventRecorder)
+		return app
+	}
+
+	createVMI := func() {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(
+				libvmistatus.WithPhase(v1.Running),
+				libvmistatus.WithNodeName(nodeName),
+				libvmistatus.WithCondition(v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceAgentConnected,
+					Status: k8sv1.ConditionTrue,
+				}),
+			)),
+		)
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	setOptionsBody := func(options *v1.GuestExecOptions) {
+		body, err := json.Marshal(options)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	BeforeEach(func() {
+		backend = ghttp.NewTLSServer()
+		backendAddr := strings.Split(backend.Addr(), ":")
+		var err error
+		backendPort, err = strconv.Atoi(backendAddr[1])
+		Expect(err).ToNot(HaveOccurred())
+		backendIP = backendAddr[0]
+
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		request.SetAttribute(authorizedUserAttribute, userName)
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		virtClient = kubevirtfake.NewSimpleClientset()
+		eventRecorder = record.NewFakeRecorder(10)
+		app = newApp(featuregate.GuestExecGate)
+	})
+
+	AfterEach(func() {
+		backend.Close()
+	})
+
+	It("should fail if the feature gate is disabled", func() {
+		app = newApp()
+		setOptionsBody(&v1.GuestExecOptions{Command: "/usr/bin/ls"})
+
+		app.GuestExecRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+
+	DescribeTable("should reject invalid options", func(options *v1.GuestExecOptions) {
+		setOptionsBody(options)
+
+		app.GuestExecRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	},
+		Entry("without command", &v1.GuestExecOptions{}),
+		Entry("with a negative timeout", &v1.GuestExecOptions{Command: "/usr/bin/ls", TimeoutSeconds: pointer.P(int32(-1))}),
+		Entry("with a timeout above the maximum", &v1.GuestExecOptions{Command: "/usr/bin/ls", TimeoutSeconds: pointer.P(int32(v1.GuestExecMaxTimeoutSeconds + 1))}),
+	)
+
+	It("should stream the output of the command and record an event without the arguments", func() {
+		createVMI()
+		expected := []v1.GuestExecResult{
+			{Stdout: "out"},
+			{Stderr: "err"},
+			{Exited: true, ExitCode: 1},
+		}
+		var body bytes.Buffer
+		for _, result := range expected {
+			Expect(json.NewEncoder(&body).Encode(result)).To(Succeed())
+		}
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPut, "/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec"),
+				ghttp.VerifyJSONRepresenting(&v1.GuestExecOptions{
+					Command:        "/usr/bin/cat",
+					Args:           []string{"/etc/secret"},
+					TimeoutSeconds: pointer.P(int32(v1.GuestExecDefaultTimeoutSeconds)),
+				}),
+				ghttp.RespondWith(http.StatusOK, body.String()),
+			),
+		)
+		setOptionsBody(&v1.GuestExecOptions{Command: "/usr/bin/cat", Args: []string{"/etc/secret"}})
+
+		app.GuestExecRequestHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+		Expect(recorder.Flushed).To(BeTrue())
+
+		decoder := json.NewDecoder(recorder.Body)
+		for _, expectedResult := range expected {
+			result := &v1.GuestExecResult{}
+			Expect(decoder.Decode(result)).To(Succeed())
+			Expect(*result).To(Equal(expectedResult))
+		}
+		Expect(decoder.More()).To(BeFalse())
+
+		Expect(eventRecorder.Events).To(HaveLen(1))
+		event := <-eventRecorder.Events
+		Expect(event).To(HavePrefix(k8sv1.EventTypeNormal + " " + guestExecReason))
+		Expect(event).To(ContainSubstring(userName))
+		Expect(event).To(ContainSubstring("/usr/bin/cat"))
+		Expect(event).To(ContainSubstring("exit code 1"))
+		Expect(event).ToNot(ContainSubstring("/etc/secret"))
+	})
+
+	It("should record a warning event if the output ends before the command exited", func() {
+		createVMI()
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPut, "/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec"),
+				ghttp.RespondWith(http.StatusOK, `{"stdout":"out"}`),
+			),
+		)
+		setOptionsBody(&v1.GuestExecOptions{Command: "/usr/bin/ls"})
+
+		app.GuestExecRequestHandler(request, response)
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		result := &v1.GuestExecResult{}
+		Expect(json.NewDecoder(recorder.Body).Decode(result)).To(Succeed())
+		Expect(result.Exited).To(BeFalse())
+
+		Expect(eventRecorder.Events).To(HaveLen(1))
+		Expect(<-eventRecorder.Events).To(HavePrefix(k8sv1.EventTypeWarning + " " + guestExecFailedReason))
+	})
+
+	It("should record a warning event if the execution fails", func() {
+		createVMI()
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPut, "/v1/namespaces/default/virtualmachineinstances/testvmi/guestexec"),
+				ghttp.RespondWith(http.StatusInternalServerError, "agent not connected"),
+			),
+		)
+		setOptionsBody(&v1.GuestExecOptions{Command: "/usr/bin/ls"})
+
+		app.GuestExecRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusInternalServerError)
+
+		Expect(eventRecorder.Events).To(HaveLen(1))
+		Expect(<-eventRecorder.Events).To(HavePrefix(k8sv1.EventTypeWarning + " " + guestExecFailedReason))
+	})
+})
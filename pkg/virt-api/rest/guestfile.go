@@ -0,0 +1,138 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+
+	"github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+// maxGuestFileRequestSize leaves room for the base64 encoding of the contents and the remaining fields
+const maxGuestFileRequestSize = v1.GuestFileMaxSize/3*4 + 64*1024
+
+func (app *SubresourceAPIApp) ensureGuestAgentFileTransferEnabled(response *restful.Response) bool {
+	if !app.clusterConfig.GuestAgentFileTransferEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.GuestAgentFileTransferGate)), response)
+		return false
+	}
+	return true
+}
+
+func validateGuestFilePath(guestPath string) *errors.StatusError {
+	if guestPath == "" {
+		return errors.NewBadRequest("path is required")
+	}
+	if !path.IsAbs(guestPath) && !isWindowsAbsPath(guestPath) {
+		return errors.NewBadRequest(fmt.Sprintf("path %q must be absolute", guestPath))
+	}
+	return nil
+}
+
+// isWindowsAbsPath accepts paths like C:\Windows or C:/Windows which are used by windows guests
+func isWindowsAbsPath(guestPath string) bool {
+	return len(guestPath) >= 3 && guestPath[1] == ':' && (guestPath[2] == '\\' || guestPath[2] == '/')
+}
+
+func validateVMIForGuestFile(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if vmi == nil || vmi.Status.Phase != v1.Running {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiNotRunning))
+	}
+	condManager := controller.NewVirtualMachineInstanceConditionManager()
+	if !condManager.HasCondition(vmi, v1.VirtualMachineInstanceAgentConnected) {
+		return errors.NewConflict(v1.Resource("virtualmachineinstance"), vmi.Name, fmt.Errorf(vmiGuestAgentErr))
+	}
+	return nil
+}
+
+// GuestFileReadRequestHandler handles the subresource for reading a file from the guest via guest agent
+func (app *SubresourceAPIApp) GuestFileReadRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.ensureGuestAgentFileTransferEnabled(response) {
+		return
+	}
+
+	guestPath := request.QueryParameter(definitions.GuestPathParamName)
+	if err := validateGuestFilePath(guestPath); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestFileReadURI(vmi, guestPath)
+	}
+
+	app.httpGetRequestHandler(request, response, validateVMIForGuestFile, getURL, v1.GuestFile{})
+}
+
+// GuestFileWriteRequestHandler handles the subresource for writing a file to the guest via guest agent
+func (app *SubresourceAPIApp) GuestFileWriteRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.ensureGuestAgentFileTransferEnabled(response) {
+		return
+	}
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body"), response)
+		return
+	}
+	defer request.Request.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(request.Request.Body, maxGuestFileRequestSize+1))
+	if err != nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+	if len(body) > maxGuestFileRequestSize {
+		writeError(errors.NewRequestEntityTooLargeError(fmt.Sprintf("contents exceed the maximum size of %d bytes", v1.GuestFileMaxSize)), response)
+		return
+	}
+
+	guestFile := &v1.GuestFile{}
+	if err := json.Unmarshal(body, guestFile); err != nil {
+		writeError(errors.NewBadRequest(fmt.Sprintf(unmarshalRequestErrFmt, err)), response)
+		return
+	}
+	if err := validateGuestFilePath(guestFile.Path); err != nil {
+		writeError(err, response)
+		return
+	}
+	if len(guestFile.Contents) > v1.GuestFileMaxSize {
+		writeError(errors.NewRequestEntityTooLargeError(fmt.Sprintf("contents exceed the maximum size of %d bytes", v1.GuestFileMaxSize)), response)
+		return
+	}
+	request.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+		return conn.GuestFileWriteURI(vmi)
+	}
+
+	app.putRequestHandler(request, response, validateVMIForGuestFile, getURL, false)
+}
@@ -0,0 +1,240 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+var _ = Describe("Guest file subresources", func() {
+	const nodeName = "mynode"
+
+	var (
+		backend     *ghttp.Server
+		backendIP   string
+		backendPort int
+		recorder    *httptest.ResponseRecorder
+		request     *restful.Request
+		response    *restful.Response
+		virtClient  *kubevirtfake.Clientset
+		app         *SubresourceAPIApp
+	)
+
+	newApp := func(featureGates ...string) *SubresourceAPIApp {
+		config, _, _ := testutils.NewFakeClusterConfigUsingKV(&v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{
+						FeatureGates: featureGates,
+					},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		})
+
+		pod := &k8sv1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "madeup-name",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-handler"},
+			},
+			Spec: k8sv1.PodSpec{
+				NodeName: nodeName,
+			},
+			Status: k8sv1.PodStatus{
+				Phase: k8sv1.PodRunning,
+				PodIP: backendIP,
+			},
+		}
+
+		kubeClient := fake.NewSimpleClientset(pod)
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		mockVirtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		return NewSubresourceAPIApp(mockVirtClient, backendPort, &tls.Config{InsecureSkipVerify: true}, config)
+	}
+
+	createVMI := func(agentConnected bool) {
+		status := []libvmistatus.Option{
+			libvmistatus.WithPhase(v1.Running),
+			libvmistatus.WithNodeName(nodeName),
+		}
+		if agentConnected {
+			status = append(status, libvmistatus.WithCondition(v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceAgentConnected,
+				Status: k8sv1.ConditionTrue,
+			}))
+		}
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmi.WithNamespace(metav1.NamespaceDefault),
+			libvmistatus.WithStatus(libvmistatus.New(status...)),
+		)
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	setGuestFileBody := func(guestFile *v1.GuestFile) {
+		body, err := json.Marshal(guestFile)
+		Expect(err).ToNot(HaveOccurred())
+		request.Request.Body = io.NopCloser(bytes.NewReader(body))
+	}
+
+	BeforeEach(func() {
+		backend = ghttp.NewTLSServer()
+		backendAddr := strings.Split(backend.Addr(), ":")
+		var err error
+		backendPort, err = strconv.Atoi(backendAddr[1])
+		Expect(err).ToNot(HaveOccurred())
+		backendIP = backendAddr[0]
+
+		request = restful.NewRequest(&http.Request{URL: &url.URL{}})
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		virtClient = kubevirtfake.NewSimpleClientset()
+		app = newApp(featuregate.GuestAgentFileTransferGate)
+	})
+
+	AfterEach(func() {
+		backend.Close()
+	})
+
+	DescribeTable("should fail if the feature gate is disabled", func(fn func(*SubresourceAPIApp) func(*restful.Request, *restful.Response)) {
+		app = newApp()
+		fn(app)(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	},
+		Entry("for read", func(app *SubresourceAPIApp) func(*restful.Request, *restful.Response) {
+			return app.GuestFileReadRequestHandler
+		}),
+		Entry("for write", func(app *SubresourceAPIApp) func(*restful.Request, *restful.Response) {
+			return app.GuestFileWriteRequestHandler
+		}),
+	)
+
+	DescribeTable("should reject reading an invalid path", func(path string) {
+		request.Request.URL.RawQuery = url.Values{"path": []string{path}}.Encode()
+		app.GuestFileReadRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	},
+		Entry("which is empty", ""),
+		Entry("which is relative", "etc/hosts"),
+	)
+
+	It("should fail to read a file if the guest agent is not connected", func() {
+		createVMI(false)
+		request.Request.URL.RawQuery = "path=/etc/hosts"
+
+		app.GuestFileReadRequestHandler(request, response)
+		Expect(response.Error()).To(MatchError(ContainSubstring(vmiGuestAgentErr)))
+	})
+
+	DescribeTable("should read a file from the guest", func(path string) {
+		createVMI(true)
+		expected := v1.GuestFile{Path: path, Contents: []byte("127.0.0.1 localhost")}
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile/read", "path="+url.QueryEscape(path)),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, expected),
+			),
+		)
+		request.Request.URL.RawQuery = url.Values{"path": []string{path}}.Encode()
+
+		app.GuestFileReadRequestHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(recorder.Code).To(Equal(http.StatusOK))
+
+		guestFile := &v1.GuestFile{}
+		Expect(json.NewDecoder(recorder.Body).Decode(guestFile)).To(Succeed())
+		Expect(*guestFile).To(Equal(expected))
+	},
+		Entry("on linux", "/etc/hosts"),
+		Entry("on windows", `C:\Windows\System32\drivers\etc\hosts`),
+	)
+
+	It("should reject writing a file exceeding the maximum size", func() {
+		setGuestFileBody(&v1.GuestFile{Path: "/tmp/file", Contents: make([]byte, v1.GuestFileMaxSize+1)})
+
+		app.GuestFileWriteRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusRequestEntityTooLarge)
+	})
+
+	It("should reject writing a file with a relative path", func() {
+		setGuestFileBody(&v1.GuestFile{Path: "tmp/file"})
+
+		app.GuestFileWriteRequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+	})
+
+	It("should write a file to the guest", func() {
+		createVMI(true)
+		guestFile := &v1.GuestFile{Path: "/tmp/file", Contents: []byte("hello")}
+		backend.AppendHandlers(
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPut, "/v1/namespaces/default/virtualmachineinstances/testvmi/guestfile/write"),
+				ghttp.VerifyJSONRepresenting(guestFile),
+				ghttp.RespondWith(http.StatusAccepted, nil),
+			),
+		)
+		setGuestFileBody(guestFile)
+
+		app.GuestFileWriteRequestHandler(request, response)
+		Expect(response.Error()).ToNot(HaveOccurred())
+		Expect(backend.ReceivedRequests()).To(HaveLen(1))
+	})
+})
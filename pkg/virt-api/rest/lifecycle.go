@@ -21,9 +21,11 @@ package rest
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/emicklei/go-restful/v3"
 
@@ -41,6 +43,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/controller"
 	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 )
 
 func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, response *restful.Response) {
@@ -96,6 +99,10 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 	// RunStrategyOnce           -> doesn't make sense
 	switch runStrategy {
 	case v1.RunStrategyHalted:
+		if statusErr := app.checkVirtualMachineQuota(vm); statusErr != nil {
+			writeError(statusErr, response)
+			return
+		}
 		pausedStartStrategy := v1.StartStrategyPaused
 		// Send start request if VM should start paused. virt-controller will update RunStrategy upon this request.
 		// No need to send the request if StartStrategy is already set to Paused in VMI Spec.
@@ -129,6 +136,10 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 			writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf("%v does not support starting VM from failed state", v1.RunStrategyRerunOnFailure)), response)
 			return
 		}
+		if statusErr := app.checkVirtualMachineQuota(vm); statusErr != nil {
+			writeError(statusErr, response)
+			return
+		}
 
 		var patchBytes []byte
 		if needsRestart {
@@ -162,6 +173,34 @@ func (app *SubresourceAPIApp) StartVMRequestHandler(request *restful.Request, re
 	response.WriteHeader(http.StatusAccepted)
 }
 
+// SetVirtualMachineQuotaIndexers sets the caches used to check VirtualMachineQuotas when VMs are started
+func (app *SubresourceAPIApp) SetVirtualMachineQuotaIndexers(quotaIndexers *vmquota.Indexers) {
+	app.quotaIndexers = quotaIndexers
+}
+
+// checkVirtualMachineQuota ensures that starting the VM does not exceed the VirtualMachineQuotas of its namespace
+func (app *SubresourceAPIApp) checkVirtualMachineQuota(vm *v1.VirtualMachine) *errors.StatusError {
+	if vm.Spec.Template == nil {
+		return nil
+	}
+	if app.instancetypeExpander != nil {
+		expandedVM, err := app.instancetypeExpander.Expand(vm)
+		if err != nil {
+			return errors.NewInternalError(err)
+		}
+		vm = expandedVM
+	}
+
+	err := vmquota.Check(app.quotaIndexers, vm.Namespace, vmquota.VMIUsage(&vm.Spec.Template.Spec))
+	var exceededErr *vmquota.ExceededError
+	if goerrors.As(err, &exceededErr) {
+		return errors.NewForbidden(v1.Resource("virtualmachine"), vm.Name, err)
+	} else if err != nil {
+		return errors.NewInternalError(err)
+	}
+	return nil
+}
+
 func (app *SubresourceAPIApp) StopVMRequestHandler(request *restful.Request, response *restful.Response) {
 	// RunStrategyHalted         -> force stop if grace period in request is shorter than before, otherwise doesn't make sense
 	// RunStrategyManual         -> send stop request
@@ -355,7 +394,24 @@ func (app *SubresourceAPIApp) FreezeVMIRequestHandler(request *restful.Request,
 		return conn.FreezeURI(vmi)
 	}
 
-	app.putRequestHandler(request, response, validate, getURL, false)
+	app.freezeRequestHandler(request, response, validate, getURL)
+}
+
+// FreezeStatusVMIRequestHandler returns the outcome of the freeze hook steps of the last freeze and thaw
+func (app *SubresourceAPIApp) FreezeStatusVMIRequestHandler(request *restful.Request, response *restful.Response) {
+	vmi, statusErr := app.FetchVirtualMachineInstance(request.PathParameter("namespace"), request.PathParameter("name"))
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	freezeHooksStatus := vmi.Status.FreezeHooks
+	if freezeHooksStatus == nil {
+		freezeHooksStatus = &v1.FreezeHooksStatus{}
+	}
+	if err := response.WriteEntity(freezeHooksStatus); err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to write freeze hooks status")
+	}
 }
 
 func (app *SubresourceAPIApp) UnfreezeVMIRequestHandler(request *restful.Request, response *restful.Response) {
@@ -369,10 +425,39 @@ func (app *SubresourceAPIApp) UnfreezeVMIRequestHandler(request *restful.Request
 	getURL := func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
 		return conn.UnfreezeURI(vmi)
 	}
-	app.putRequestHandler(request, response, validate, getURL, false)
+	app.freezeRequestHandler(request, response, validate, getURL)
 
 }
 
+// freezeRequestHandler forwards freeze and unfreeze requests to virt-handler.
+// The request timeout is extended by the time the freeze hooks of the VMI may run in the guest.
+func (app *SubresourceAPIApp) freezeRequestHandler(request *restful.Request, response *restful.Response, validate validation, getURL URLResolver) {
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(request.PathParameter("namespace"), request.PathParameter("name"), validate)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	client := app.handlerHttpClient
+	if hooksTimeout := vmi.Spec.FreezeHooks.GetHooksTimeoutSeconds(); hooksTimeout > 0 {
+		client = &http.Client{
+			Transport: app.handlerHttpClient.Transport,
+			Timeout:   app.handlerHttpClient.Timeout + time.Duration(hooksTimeout)*time.Second,
+		}
+	}
+	conn := kubecli.NewVirtHandlerClient(app.virtCli, client).Port(app.consoleServerPort).ForNode(vmi.Status.NodeName)
+	url, err := getURL(vmi, conn)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Unable to retrieve target handler URL")
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	if err := conn.Put(url, request.Request.Body); err != nil {
+		writeError(errors.NewInternalError(err), response)
+	}
+}
+
 func (app *SubresourceAPIApp) ResetVMIRequestHandler(request *restful.Request, response *restful.Response) {
 
 	// Post process any error responses in order to append human
@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+)
+
+const (
+	vmiInterfacesPath = "/spec/domain/devices/interfaces"
+	vmInterfacesPath  = "/spec/template/spec/domain/devices/interfaces"
+)
+
+// SetInterfaceLinkStateRequestHandler sets the link state of an interface of a running VMI. When the VMI is owned by
+// a VM only the VM template is patched and the VM controller applies the state to the VMI, so that it survives
+// migrations and restarts.
+func (app *SubresourceAPIApp) SetInterfaceLinkStateRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body, SetInterfaceLinkStateOptions are expected as the request body"), response)
+		return
+	}
+
+	opts := &v1.SetInterfaceLinkStateOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+
+	if opts.InterfaceName == "" {
+		writeError(errors.NewBadRequest("SetInterfaceLinkStateOptions requires interfaceName to be set"), response)
+		return
+	}
+	if opts.State != v1.InterfaceStateLinkUp && opts.State != v1.InterfaceStateLinkDown {
+		writeError(errors.NewBadRequest(fmt.Sprintf("SetInterfaceLinkStateOptions state must be %q or %q", v1.InterfaceStateLinkUp, v1.InterfaceStateLinkDown)), response)
+		return
+	}
+
+	vmi, statErr := app.FetchVirtualMachineInstance(namespace, name)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if !vmi.IsRunning() {
+		writeError(errors.NewConflict(v1.Resource("virtualmachineinstance"), name, fmt.Errorf(vmiNotRunning)), response)
+		return
+	}
+
+	if err := verifyInterfaceLinkStateOption(vmi.Spec.Domain.Devices.Interfaces, opts); err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+
+	if owner := metav1.GetControllerOf(vmi); owner != nil && owner.Kind == v1.VirtualMachineGroupVersionKind.Kind {
+		if statErr := app.vmInterfaceLinkStatePatch(owner.Name, namespace, opts); statErr != nil {
+			writeError(statErr, response)
+			return
+		}
+		response.WriteHeader(http.StatusAccepted)
+		return
+	}
+
+	vmiPatch, err := generateInterfaceLinkStatePatch(vmiInterfacesPath, vmi.Spec.Domain.Devices.Interfaces, opts)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	log.Log.Object(vmi).V(4).Infof("Patching VMI: %s", string(vmiPatch))
+	if _, err := app.virtCli.VirtualMachineInstance(namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, vmiPatch, metav1.PatchOptions{DryRun: opts.DryRun}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi: %v", err)
+		writeError(errors.NewInternalError(fmt.Errorf("unable to patch vmi: %v", err)), response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// vmInterfaceLinkStatePatch stores the link state in the VM template. Interfaces which are not part of the
+// template, like the automatically attached pod network interface, can't be changed on a VM owned VMI.
+func (app *SubresourceAPIApp) vmInterfaceLinkStatePatch(name, namespace string, opts *v1.SetInterfaceLinkStateOptions) *errors.StatusError {
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		return statErr
+	}
+
+	interfaces := vm.Spec.Template.Spec.Domain.Devices.Interfaces
+	if err := verifyInterfaceLinkStateOption(interfaces, opts); err != nil {
+		return errors.NewConflict(v1.Resource("virtualmachine"), name, err)
+	}
+
+	patchBytes, err := generateInterfaceLinkStatePatch(vmInterfacesPath, interfaces, opts)
+	if err != nil {
+		return errors.NewInternalError(err)
+	}
+
+	log.Log.Object(vm).V(4).Infof(patchingVMFmt, string(patchBytes))
+	if _, err := app.virtCli.VirtualMachine(namespace).Patch(context.Background(), vm.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{DryRun: opts.DryRun}); err != nil {
+		log.Log.Object(vm).Errorf("unable to patch vm: %v", err)
+		if errors.IsInvalid(err) {
+			if statErr, ok := err.(*errors.StatusError); ok {
+				return statErr
+			}
+		}
+		return errors.NewInternalError(fmt.Errorf("unable to patch vm: %v", err))
+	}
+	return nil
+}
+
+func verifyInterfaceLinkStateOption(interfaces []v1.Interface, opts *v1.SetInterfaceLinkStateOptions) error {
+	for _, iface := range interfaces {
+		if iface.Name != opts.InterfaceName {
+			continue
+		}
+		if iface.State == v1.InterfaceStateAbsent {
+			return fmt.Errorf("Unable to set link state of interface [%s] because it is being unplugged", opts.InterfaceName)
+		}
+		return nil
+	}
+	return fmt.Errorf("Unable to set link state of interface [%s] because it does not exist", opts.InterfaceName)
+}
+
+func generateInterfaceLinkStatePatch(path string, interfaces []v1.Interface, opts *v1.SetInterfaceLinkStateOptions) ([]byte, error) {
+	updated := make([]v1.Interface, len(interfaces))
+	for i := range interfaces {
+		interfaces[i].DeepCopyInto(&updated[i])
+		if updated[i].Name == opts.InterfaceName {
+			updated[i].State = opts.State
+		}
+	}
+
+	return patch.New(
+		patch.WithTest(path, interfaces),
+		patch.WithReplace(path, updated),
+	).GeneratePayload()
+}
@@ -0,0 +1,164 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+)
+
+var _ = Describe("Interface link state Subresource api", func() {
+	var (
+		request   *restful.Request
+		recorder  *httptest.ResponseRecorder
+		response  *restful.Response
+		vmClient  *kubecli.MockVirtualMachineInterface
+		vmiClient *kubecli.MockVirtualMachineInstanceInterface
+		app       *SubresourceAPIApp
+		vm        *v1.VirtualMachine
+		vmi       *v1.VirtualMachineInstance
+	)
+
+	newBody := func(opts *v1.SetInterfaceLinkStateOptions) io.ReadCloser {
+		optsJson, _ := json.Marshal(opts)
+		return &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	verifyInterfacesPatch := func(data []byte, path string, expectedState v1.InterfaceState) {
+		var ops []struct {
+			Op    string         `json:"op"`
+			Path  string         `json:"path"`
+			Value []v1.Interface `json:"value"`
+		}
+		Expect(json.Unmarshal(data, &ops)).To(Succeed())
+		Expect(ops).To(HaveLen(2))
+		Expect(ops[0].Op).To(Equal("test"))
+		Expect(ops[1].Op).To(Equal("replace"))
+		Expect(ops[1].Path).To(Equal(path))
+		Expect(ops[1].Value).To(HaveLen(1))
+		Expect(ops[1].Value[0].State).To(Equal(expectedState))
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		vmi = libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault))
+		vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "red"}}
+		vmi.Status.Phase = v1.Running
+		vm = libvmi.NewVirtualMachine(vmi.DeepCopy())
+		vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)}
+
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+		vmClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vm, nil).AnyTimes()
+		vmiClient.EXPECT().Get(context.Background(), testVMName, metav1.GetOptions{}).Return(vmi, nil).AnyTimes()
+
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil)
+	})
+
+	It("should only set the link state on the VM template when the VMI is owned by a VM", func() {
+		request.Request.Body = newBody(&v1.SetInterfaceLinkStateOptions{InterfaceName: "red", State: v1.InterfaceStateLinkDown})
+		vmClient.EXPECT().Patch(context.Background(), testVMName, types.JSONPatchType, gomock.Any(), metav1.PatchOptions{}).
+			DoAndReturn(func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*v1.VirtualMachine, error) {
+				verifyInterfacesPatch(data, vmInterfacesPath, v1.InterfaceStateLinkDown)
+				return vm, nil
+			})
+
+		app.SetInterfaceLinkStateRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should set the link state on the VMI when it is not owned by a VM", func() {
+		vmi.OwnerReferences = nil
+		request.Request.Body = newBody(&v1.SetInterfaceLinkStateOptions{InterfaceName: "red", State: v1.InterfaceStateLinkUp})
+		vmiClient.EXPECT().Patch(context.Background(), testVMName, types.JSONPatchType, gomock.Any(), metav1.PatchOptions{}).
+			DoAndReturn(func(_ context.Context, _ string, _ types.PatchType, data []byte, _ metav1.PatchOptions, _ ...string) (*v1.VirtualMachineInstance, error) {
+				verifyInterfacesPatch(data, vmiInterfacesPath, v1.InterfaceStateLinkUp)
+				return vmi, nil
+			})
+
+		app.SetInterfaceLinkStateRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+	})
+
+	It("should fail when the interface is not part of the VM template", func() {
+		vm.Spec.Template.Spec.Domain.Devices.Interfaces = nil
+		request.Request.Body = newBody(&v1.SetInterfaceLinkStateOptions{InterfaceName: "red", State: v1.InterfaceStateLinkUp})
+
+		app.SetInterfaceLinkStateRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+	})
+
+	DescribeTable("should fail", func(opts *v1.SetInterfaceLinkStateOptions, mutateVMI func(*v1.VirtualMachineInstance), statusCode int) {
+		if mutateVMI != nil {
+			mutateVMI(vmi)
+		}
+		request.Request.Body = newBody(opts)
+
+		app.SetInterfaceLinkStateRequestHandler(request, response)
+
+		Expect(response.StatusCode()).To(Equal(statusCode))
+	},
+		Entry("without an interface name",
+			&v1.SetInterfaceLinkStateOptions{State: v1.InterfaceStateLinkDown}, nil, http.StatusBadRequest),
+		Entry("with an invalid state",
+			&v1.SetInterfaceLinkStateOptions{InterfaceName: "red", State: v1.InterfaceStateAbsent}, nil, http.StatusBadRequest),
+		Entry("with an unknown interface",
+			&v1.SetInterfaceLinkStateOptions{InterfaceName: "blue", State: v1.InterfaceStateLinkDown}, nil, http.StatusBadRequest),
+		Entry("with an interface being unplugged",
+			&v1.SetInterfaceLinkStateOptions{InterfaceName: "red", State: v1.InterfaceStateLinkDown},
+			func(vmi *v1.VirtualMachineInstance) {
+				vmi.Spec.Domain.Devices.Interfaces[0].State = v1.InterfaceStateAbsent
+			},
+			http.StatusBadRequest),
+		Entry("when the VMI is not running",
+			&v1.SetInterfaceLinkStateOptions{InterfaceName: "red", State: v1.InterfaceStateLinkDown},
+			func(vmi *v1.VirtualMachineInstance) { vmi.Status.Phase = v1.Scheduled },
+			http.StatusConflict),
+	)
+})
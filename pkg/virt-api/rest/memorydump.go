@@ -21,6 +21,7 @@ package rest
 
 import (
 	"context"
+	goerrors "errors"
 	"fmt"
 	"net/http"
 
@@ -28,7 +29,6 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	v1 "kubevirt.io/api/core/v1"
@@ -36,15 +36,13 @@ import (
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/storage/memorydump"
 	storagetypes "kubevirt.io/kubevirt/pkg/storage/types"
-	kutil "kubevirt.io/kubevirt/pkg/util"
 )
 
 const (
-	pvcVolumeModeErr          = "pvc should be filesystem pvc"
-	pvcAccessModeErr          = "pvc access mode can't be read only"
-	pvcSizeErrFmt             = "pvc size [%s] should be bigger then [%s]"
 	memoryDumpNameConflictErr = "can't request memory dump for pvc [%s] while pvc [%s] is still associated as the memory dump pvc"
+	memoryDumpFormatErrFmt    = "unsupported memory dump format [%s]"
 )
 
 func (app *SubresourceAPIApp) fetchPersistentVolumeClaim(name string, namespace string) (*k8sv1.PersistentVolumeClaim, *errors.StatusError) {
@@ -70,45 +68,33 @@ func (app *SubresourceAPIApp) fetchCDIConfig() (*cdiv1.CDIConfig, *errors.Status
 }
 
 func (app *SubresourceAPIApp) validateMemoryDumpClaim(vmi *v1.VirtualMachineInstance, claimName, namespace string) *errors.StatusError {
-	pvc, err := app.fetchPersistentVolumeClaim(claimName, namespace)
-	if err != nil {
-		return err
-	}
-	if storagetypes.IsPVCBlock(pvc.Spec.VolumeMode) {
-		return errors.NewConflict(v1.Resource("persistentvolumeclaim"), claimName, fmt.Errorf(pvcVolumeModeErr))
+	pvc, statErr := app.fetchPersistentVolumeClaim(claimName, namespace)
+	if statErr != nil {
+		return statErr
 	}
-
-	if storagetypes.IsReadOnlyAccessMode(pvc.Spec.AccessModes) {
-		return errors.NewConflict(v1.Resource("persistentvolumeclaim"), claimName, fmt.Errorf(pvcAccessModeErr))
+	cdiConfig, statErr := app.fetchCDIConfig()
+	if statErr != nil {
+		return statErr
 	}
 
-	pvcSize := pvc.Spec.Resources.Requests.Storage()
-	scaledPvcSize := resource.NewScaledQuantity(pvcSize.ScaledValue(resource.Kilo), resource.Kilo)
-
-	expectedMemoryDumpSize := kutil.CalcExpectedMemoryDumpSize(vmi)
-	cdiConfig, err := app.fetchCDIConfig()
-	if err != nil {
-		return err
-	}
-	var expectedPvcSize *resource.Quantity
-	var overheadErr error
-	if cdiConfig == nil {
-		log.Log.Object(vmi).V(3).Infof(storagetypes.FSOverheadMsg)
-		expectedPvcSize, overheadErr = storagetypes.GetSizeIncludingDefaultFSOverhead(expectedMemoryDumpSize)
-	} else {
-		expectedPvcSize, overheadErr = storagetypes.GetSizeIncludingFSOverhead(expectedMemoryDumpSize, pvc.Spec.StorageClassName, pvc.Spec.VolumeMode, cdiConfig)
-	}
-	if overheadErr != nil {
-		return errors.NewInternalError(overheadErr)
-	}
-	if scaledPvcSize.Cmp(*expectedPvcSize) < 0 {
-		return errors.NewConflict(v1.Resource("persistentvolumeclaim"), claimName, fmt.Errorf(pvcSizeErrFmt, scaledPvcSize.String(), expectedPvcSize.String()))
+	if err := memorydump.ValidateClaim(vmi, pvc, cdiConfig); err != nil {
+		var invalidClaimErr *memorydump.InvalidClaimError
+		if goerrors.As(err, &invalidClaimErr) {
+			return errors.NewConflict(v1.Resource("persistentvolumeclaim"), claimName, goerrors.New(invalidClaimErr.Reason))
+		}
+		return errors.NewInternalError(err)
 	}
 
 	return nil
 }
 
 func (app *SubresourceAPIApp) validateMemoryDumpRequest(vm *v1.VirtualMachine, memoryDumpReq *v1.VirtualMachineMemoryDumpRequest) *errors.StatusError {
+	switch memoryDumpReq.Format {
+	case "", v1.MemoryDumpFormatRaw, v1.MemoryDumpFormatKdumpZlib, v1.MemoryDumpFormatKdumpLzo, v1.MemoryDumpFormatKdumpSnappy, v1.MemoryDumpFormatWinDmp:
+	default:
+		return errors.NewBadRequest(fmt.Sprintf(memoryDumpFormatErrFmt, memoryDumpReq.Format))
+	}
+
 	if memoryDumpReq.ClaimName == "" && vm.Status.MemoryDumpRequest == nil {
 		return errors.NewBadRequest("Memory dump requires claim name to be set")
 	} else if vm.Status.MemoryDumpRequest != nil && memoryDumpReq.ClaimName != "" {
@@ -0,0 +1,257 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
+)
+
+const (
+	noPersistentStateErr        = "VM has no persistent state"
+	persistentStateVMRunningErr = "VM must be stopped to change its persistent state"
+	persistentStateClaimUsedErr = "pvc [%s] holds the persistent state of VM [%s]"
+)
+
+// listPersistentStatePVCs returns the backend storage PVCs of a VM, including the legacy one without label
+func (app *SubresourceAPIApp) listPersistentStatePVCs(vm *v1.VirtualMachine) ([]k8sv1.PersistentVolumeClaim, *errors.StatusError) {
+	selector := labels.Set{backendstorage.PVCPrefix: vm.Name}.AsSelector().String()
+	pvcList, err := app.virtCli.CoreV1().PersistentVolumeClaims(vm.Namespace).List(context.Background(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to list persistent state pvcs: %v", err))
+	}
+	pvcs := pvcList.Items
+
+	legacyName := backendstorage.PVCPrefix + "-" + vm.Name
+	legacyPVC, err := app.virtCli.CoreV1().PersistentVolumeClaims(vm.Namespace).Get(context.Background(), legacyName, metav1.GetOptions{})
+	if err == nil {
+		if _, labelled := legacyPVC.Labels[backendstorage.PVCPrefix]; !labelled {
+			pvcs = append(pvcs, *legacyPVC)
+		}
+	} else if !errors.IsNotFound(err) {
+		return nil, errors.NewInternalError(fmt.Errorf("unable to retrieve pvc [%s]: %v", legacyName, err))
+	}
+
+	return pvcs, nil
+}
+
+func (app *SubresourceAPIApp) fetchStoppedVirtualMachine(name, namespace string) (*v1.VirtualMachine, *errors.StatusError) {
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		return nil, statErr
+	}
+
+	_, statErr = app.FetchVirtualMachineInstance(namespace, name)
+	if statErr == nil {
+		return nil, errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(persistentStateVMRunningErr))
+	}
+	if !errors.IsNotFound(statErr) {
+		return nil, statErr
+	}
+
+	return vm, nil
+}
+
+func (app *SubresourceAPIApp) deletePersistentStatePVCs(vm *v1.VirtualMachine, keep string) *errors.StatusError {
+	pvcs, statErr := app.listPersistentStatePVCs(vm)
+	if statErr != nil {
+		return statErr
+	}
+
+	for _, pvc := range pvcs {
+		if pvc.Name == keep {
+			continue
+		}
+		log.Log.Object(vm).Infof("deleting persistent state pvc %s", pvc.Name)
+		err := app.virtCli.CoreV1().PersistentVolumeClaims(vm.Namespace).Delete(context.Background(), pvc.Name, metav1.DeleteOptions{})
+		if err != nil && !errors.IsNotFound(err) {
+			return errors.NewInternalError(fmt.Errorf("unable to delete pvc [%s]: %v", pvc.Name, err))
+		}
+	}
+
+	return nil
+}
+
+func (app *SubresourceAPIApp) PersistentStateVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	vm, statErr := app.fetchVirtualMachine(name, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	pvcs, statErr := app.listPersistentStatePVCs(vm)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	// A VM only has more than one state pvc while a migration is in flight, the one in use is the oldest.
+	var current *k8sv1.PersistentVolumeClaim
+	for i := range pvcs {
+		if pvcs[i].DeletionTimestamp != nil {
+			continue
+		}
+		if current == nil || pvcs[i].CreationTimestamp.Before(&current.CreationTimestamp) {
+			current = &pvcs[i]
+		}
+	}
+	if current == nil {
+		writeError(errors.NewNotFound(v1.Resource("persistentvolumeclaim"), backendstorage.PVCPrefix+"-"+name), response)
+		return
+	}
+
+	if err := response.WriteEntity(v1.VirtualMachinePersistentState{ClaimName: current.Name}); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+func (app *SubresourceAPIApp) ResetPersistentStateVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	vm, statErr := app.fetchStoppedVirtualMachine(name, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	if statErr = app.deletePersistentStatePVCs(vm, ""); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+func (app *SubresourceAPIApp) ImportPersistentStateVMRequestHandler(request *restful.Request, response *restful.Response) {
+	name := request.PathParameter("name")
+	namespace := request.PathParameter("namespace")
+
+	if request.Request.Body == nil {
+		writeError(errors.NewBadRequest("Request with no body"), response)
+		return
+	}
+	opts := &v1.ImportPersistentStateOptions{}
+	defer request.Request.Body.Close()
+	if err := decodeBody(request, opts); err != nil {
+		writeError(err, response)
+		return
+	}
+	if opts.ClaimName == "" {
+		writeError(errors.NewBadRequest("Import of persistent state requires claim name to be set"), response)
+		return
+	}
+
+	vm, statErr := app.fetchStoppedVirtualMachine(name, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+	if !backendstorage.IsBackendStorageNeededForVM(vm) {
+		writeError(errors.NewConflict(v1.Resource("virtualmachine"), name, fmt.Errorf(noPersistentStateErr)), response)
+		return
+	}
+
+	pvc, statErr := app.fetchPersistentVolumeClaim(opts.ClaimName, namespace)
+	if statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+	if owner, exists := pvc.Labels[backendstorage.PVCPrefix]; exists && owner != name {
+		writeError(errors.NewConflict(v1.Resource("persistentvolumeclaim"), pvc.Name, fmt.Errorf(persistentStateClaimUsedErr, pvc.Name, owner)), response)
+		return
+	}
+
+	patchBytes, err := adoptPersistentStatePVCPatch(vm, pvc)
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+
+	// The new state is adopted before the old one is deleted, so a failure never leaves the VM without state
+	log.Log.Object(vm).Infof("importing persistent state from pvc %s", pvc.Name)
+	if _, err = app.virtCli.CoreV1().PersistentVolumeClaims(namespace).Patch(context.Background(), pvc.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("unable to patch pvc [%s]: %v", pvc.Name, err)), response)
+		return
+	}
+
+	if statErr = app.deletePersistentStatePVCs(vm, pvc.Name); statErr != nil {
+		writeError(statErr, response)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
+
+// adoptPersistentStatePVCPatch labels the pvc as the persistent state of the VM and adds the VM to its owners.
+// The VM only becomes the controller of the pvc if it has none yet.
+func adoptPersistentStatePVCPatch(vm *v1.VirtualMachine, pvc *k8sv1.PersistentVolumeClaim) ([]byte, error) {
+	pvcLabels := map[string]string{}
+	for k, v := range pvc.Labels {
+		pvcLabels[k] = v
+	}
+	pvcLabels[backendstorage.PVCPrefix] = vm.Name
+
+	patchSet := patch.New()
+	if pvc.Labels != nil {
+		patchSet.AddOption(patch.WithTest("/metadata/labels", pvc.Labels))
+	}
+	patchSet.AddOption(patch.WithAdd("/metadata/labels", pvcLabels))
+
+	for _, ref := range pvc.OwnerReferences {
+		if ref.UID == vm.UID {
+			return patchSet.GeneratePayload()
+		}
+	}
+
+	ownerRef := metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind)
+	if metav1.GetControllerOf(pvc) != nil {
+		ownerRef.Controller = pointer.P(false)
+	}
+	if len(pvc.OwnerReferences) == 0 {
+		patchSet.AddOption(patch.WithAdd("/metadata/ownerReferences", []metav1.OwnerReference{*ownerRef}))
+	} else {
+		patchSet.AddOption(
+			patch.WithTest("/metadata/ownerReferences", pvc.OwnerReferences),
+			patch.WithAdd("/metadata/ownerReferences/-", ownerRef),
+		)
+	}
+
+	return patchSet.GeneratePayload()
+}
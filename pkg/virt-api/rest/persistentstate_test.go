@@ -0,0 +1,270 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Persistent state Subresource api", func() {
+	const (
+		stateLabel    = "persistent-state-for"
+		statePVCName  = "persistent-state-for-testvm-abcde"
+		importPVCName = "imported-state"
+	)
+
+	var (
+		request    *restful.Request
+		recorder   *httptest.ResponseRecorder
+		response   *restful.Response
+		kubeClient *fake.Clientset
+		vmClient   *kubecli.MockVirtualMachineInterface
+		vmiClient  *kubecli.MockVirtualMachineInstanceInterface
+		app        *SubresourceAPIApp
+		vm         *v1.VirtualMachine
+	)
+
+	newPVC := func(name string, pvcLabels map[string]string) *k8sv1.PersistentVolumeClaim {
+		return &k8sv1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: metav1.NamespaceDefault,
+				Labels:    pvcLabels,
+			},
+		}
+	}
+
+	setup := func(vmiRunning bool, objects ...runtime.Object) {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		kubeClient = fake.NewSimpleClientset(objects...)
+		vmClient = kubecli.NewMockVirtualMachineInterface(ctrl)
+		vmiClient = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+
+		virtClient.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		virtClient.EXPECT().VirtualMachine(metav1.NamespaceDefault).Return(vmClient).AnyTimes()
+		virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiClient).AnyTimes()
+
+		vmClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(vm, nil).AnyTimes()
+		if vmiRunning {
+			vmiClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(libvmi.New(libvmi.WithName(vm.Name)), nil).AnyTimes()
+		} else {
+			vmiClient.EXPECT().Get(context.Background(), vm.Name, metav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), vm.Name)).AnyTimes()
+		}
+
+		app = NewSubresourceAPIApp(virtClient, 0, nil, nil)
+	}
+
+	pvcExists := func(name string) bool {
+		_, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.Background(), name, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			return false
+		}
+		Expect(err).ToNot(HaveOccurred())
+		return true
+	}
+
+	newImportBody := func(opts *v1.ImportPersistentStateOptions) io.ReadCloser {
+		optsJson, _ := json.Marshal(opts)
+		return &readCloserWrapper{bytes.NewReader(optsJson)}
+	}
+
+	BeforeEach(func() {
+		request = restful.NewRequest(&http.Request{})
+		request.PathParameters()["name"] = testVMName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+		recorder = httptest.NewRecorder()
+		response = restful.NewResponse(recorder)
+		response.SetRequestAccepts(restful.MIME_JSON)
+
+		vm = libvmi.NewVirtualMachine(libvmi.New(libvmi.WithName(testVMName), libvmi.WithNamespace(metav1.NamespaceDefault)))
+		vm.UID = "vm-uid"
+		vm.Spec.Template.Spec.Domain.Devices.TPM = &v1.TPMDevice{Persistent: pointer.P(true)}
+	})
+
+	Context("get", func() {
+		It("should return the claim holding the state", func() {
+			setup(false, newPVC(statePVCName, map[string]string{stateLabel: testVMName}))
+
+			app.PersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			state := &v1.VirtualMachinePersistentState{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), state)).To(Succeed())
+			Expect(state.ClaimName).To(Equal(statePVCName))
+		})
+
+		It("should return the legacy claim holding the state", func() {
+			setup(false, newPVC("persistent-state-for-"+testVMName, nil))
+
+			app.PersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			state := &v1.VirtualMachinePersistentState{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), state)).To(Succeed())
+			Expect(state.ClaimName).To(Equal("persistent-state-for-" + testVMName))
+		})
+
+		It("should fail if the VM has no state", func() {
+			setup(false, newPVC(statePVCName, map[string]string{stateLabel: "othervm"}))
+
+			app.PersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusNotFound))
+		})
+	})
+
+	Context("reset", func() {
+		It("should delete the state of a stopped VM", func() {
+			setup(false,
+				newPVC(statePVCName, map[string]string{stateLabel: testVMName}),
+				newPVC("persistent-state-for-othervm", map[string]string{stateLabel: "othervm"}),
+			)
+
+			app.ResetPersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+			Expect(pvcExists(statePVCName)).To(BeFalse())
+			Expect(pvcExists("persistent-state-for-othervm")).To(BeTrue())
+		})
+
+		It("should fail if the VM is running", func() {
+			setup(true, newPVC(statePVCName, map[string]string{stateLabel: testVMName}))
+
+			app.ResetPersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+			Expect(pvcExists(statePVCName)).To(BeTrue())
+		})
+	})
+
+	Context("import", func() {
+		It("should replace the state of a stopped VM", func() {
+			setup(false,
+				newPVC(statePVCName, map[string]string{stateLabel: testVMName}),
+				newPVC(importPVCName, map[string]string{"app": "backup"}),
+			)
+			request.Request.Body = newImportBody(&v1.ImportPersistentStateOptions{ClaimName: importPVCName})
+
+			app.ImportPersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+			Expect(pvcExists(statePVCName)).To(BeFalse())
+			pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.Background(), importPVCName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.Labels).To(HaveKeyWithValue(stateLabel, testVMName))
+			Expect(pvc.Labels).To(HaveKeyWithValue("app", "backup"))
+			Expect(pvc.OwnerReferences).To(HaveLen(1))
+			Expect(pvc.OwnerReferences[0].UID).To(Equal(vm.UID))
+		})
+
+		It("should keep the owners of the imported claim", func() {
+			importPVC := newPVC(importPVCName, nil)
+			importPVC.OwnerReferences = []metav1.OwnerReference{{
+				APIVersion: "cdi.kubevirt.io/v1beta1",
+				Kind:       "DataVolume",
+				Name:       importPVCName,
+				UID:        "dv-uid",
+				Controller: pointer.P(true),
+			}}
+			setup(false, newPVC(statePVCName, map[string]string{stateLabel: testVMName}), importPVC)
+			request.Request.Body = newImportBody(&v1.ImportPersistentStateOptions{ClaimName: importPVCName})
+
+			app.ImportPersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusAccepted))
+			pvc, err := kubeClient.CoreV1().PersistentVolumeClaims(metav1.NamespaceDefault).Get(context.Background(), importPVCName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pvc.OwnerReferences).To(HaveLen(2))
+			Expect(pvc.OwnerReferences[0].UID).To(BeEquivalentTo("dv-uid"))
+			Expect(pvc.OwnerReferences[1].UID).To(Equal(vm.UID))
+			Expect(pvc.OwnerReferences[1].Controller).To(HaveValue(BeFalse()))
+		})
+
+		It("should keep the current state if the claim cannot be adopted", func() {
+			setup(false,
+				newPVC(statePVCName, map[string]string{stateLabel: testVMName}),
+				newPVC(importPVCName, nil),
+			)
+			kubeClient.Fake.PrependReactor("patch", "persistentvolumeclaims", func(_ testing.Action) (bool, runtime.Object, error) {
+				return true, nil, fmt.Errorf("conflict")
+			})
+			request.Request.Body = newImportBody(&v1.ImportPersistentStateOptions{ClaimName: importPVCName})
+
+			app.ImportPersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusInternalServerError))
+			Expect(pvcExists(statePVCName)).To(BeTrue())
+		})
+
+		DescribeTable("should fail", func(vmiRunning bool, opts *v1.ImportPersistentStateOptions, importPVC *k8sv1.PersistentVolumeClaim, statusCode int) {
+			objects := []runtime.Object{newPVC(statePVCName, map[string]string{stateLabel: testVMName})}
+			if importPVC != nil {
+				objects = append(objects, importPVC)
+			}
+			setup(vmiRunning, objects...)
+			request.Request.Body = newImportBody(opts)
+
+			app.ImportPersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(statusCode))
+			Expect(pvcExists(statePVCName)).To(BeTrue())
+		},
+			Entry("without claim name", false, &v1.ImportPersistentStateOptions{}, nil, http.StatusBadRequest),
+			Entry("if the VM is running", true, &v1.ImportPersistentStateOptions{ClaimName: importPVCName}, newPVC(importPVCName, nil), http.StatusConflict),
+			Entry("if the claim does not exist", false, &v1.ImportPersistentStateOptions{ClaimName: importPVCName}, nil, http.StatusNotFound),
+			Entry("if the claim holds the state of another VM", false, &v1.ImportPersistentStateOptions{ClaimName: importPVCName},
+				newPVC(importPVCName, map[string]string{stateLabel: "othervm"}), http.StatusConflict),
+		)
+
+		It("should fail if the VM has no persistent devices", func() {
+			vm.Spec.Template.Spec.Domain.Devices.TPM = nil
+			setup(false, newPVC(importPVCName, nil))
+			request.Request.Body = newImportBody(&v1.ImportPersistentStateOptions{ClaimName: importPVCName})
+
+			app.ImportPersistentStateVMRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusConflict))
+		})
+	})
+})
@@ -0,0 +1,67 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"fmt"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+)
+
+func (app *SubresourceAPIApp) SPICERequestHandler(request *restful.Request, response *restful.Response) {
+	defer apimetrics.SetVMILastConnectionTimestamp(request.PathParameter("namespace"), request.PathParameter("name"))
+
+	streamer := NewRawStreamer(
+		app.FetchVirtualMachineInstance,
+		validateVMIForSPICE,
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.SPICEURI(vmi)
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+func validateVMIForSPICE(vmi *v1.VirtualMachineInstance) *errors.StatusError {
+	if !hasSPICEGraphics(vmi) {
+		err := fmt.Errorf("No SPICE graphics device is present.")
+		log.Log.Object(vmi).Reason(err).Error("Can't establish SPICE connection.")
+		return errors.NewBadRequest(err.Error())
+	}
+	if !vmi.IsRunning() {
+		return errors.NewBadRequest(vmiNotRunning)
+	}
+	return nil
+}
+
+func hasSPICEGraphics(vmi *v1.VirtualMachineInstance) bool {
+	devices := vmi.Spec.Domain.Devices
+	if devices.AutoattachGraphicsDevice != nil && !*devices.AutoattachGraphicsDevice {
+		return false
+	}
+	return devices.Graphics != nil && devices.Graphics.SPICE != nil
+}
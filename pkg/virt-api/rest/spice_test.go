@@ -0,0 +1,124 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto/tls"
+	"net/http"
+	"net/http/httptest"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("SPICE Subresource api", func() {
+	var (
+		recorder   *httptest.ResponseRecorder
+		request    *restful.Request
+		response   *restful.Response
+		virtClient *kubevirtfake.Clientset
+		app        *SubresourceAPIApp
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt",
+				Namespace: "kubevirt",
+			},
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					DeveloperConfiguration: &v1.DeveloperConfiguration{},
+				},
+			},
+			Status: v1.KubeVirtStatus{
+				Phase: v1.KubeVirtPhaseDeploying,
+			},
+		}
+	)
+
+	config, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
+
+	BeforeEach(func() {
+		recorder = httptest.NewRecorder()
+		request = restful.NewRequest(&http.Request{})
+		response = restful.NewResponse(recorder)
+
+		mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+		virtClient = kubevirtfake.NewSimpleClientset()
+		mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+		app = NewSubresourceAPIApp(mockVirtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+
+		request.PathParameters()["name"] = testVMIName
+		request.PathParameters()["namespace"] = metav1.NamespaceDefault
+	})
+
+	createVMI := func(devices v1.Devices, phase v1.VirtualMachineInstancePhase) {
+		vmi := libvmi.New(
+			libvmi.WithName(testVMIName),
+			libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(phase))),
+		)
+		vmi.Spec.Domain.Devices = devices
+		_, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("should fail if vmi is not found", func() {
+		app.SPICERequestHandler(request, response)
+		ExpectStatusErrorWithCode(recorder, http.StatusNotFound)
+	})
+
+	DescribeTable("request validation", func(devices v1.Devices, phase v1.VirtualMachineInstancePhase, expectedMessage string) {
+		createVMI(devices, phase)
+
+		app.SPICERequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		ExpectMessage(recorder, Equal(expectedMessage))
+	},
+		Entry("should fail if there is no graphics device",
+			v1.Devices{AutoattachGraphicsDevice: pointer.P(false)}, v1.Running, "No SPICE graphics device is present."),
+		Entry("should fail if the graphics device uses vnc",
+			v1.Devices{Graphics: &v1.GraphicsDevice{VNC: &v1.VNCGraphics{}}}, v1.Running, "No SPICE graphics device is present."),
+		Entry("should fail if vmi is not running",
+			v1.Devices{Graphics: &v1.GraphicsDevice{SPICE: &v1.SPICEGraphics{}}}, v1.Scheduling, vmiNotRunning),
+	)
+
+	It("should refuse a VNC connection if the graphics device uses spice", func() {
+		createVMI(v1.Devices{Graphics: &v1.GraphicsDevice{SPICE: &v1.SPICEGraphics{}}}, v1.Running)
+
+		app.VNCRequestHandler(request, response)
+
+		ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		ExpectMessage(recorder, Equal("The graphics device is configured for SPICE."))
+	})
+})
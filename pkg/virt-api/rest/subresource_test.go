@@ -49,6 +49,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/uuid"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
@@ -59,6 +60,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 )
 
 const (
@@ -654,6 +656,38 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusConflict)
 			Expect(statusErr.Error()).To(ContainSubstring("VM recovery required"))
 		})
+
+		DescribeTable("should fail when starting the VM exceeds a VirtualMachineQuota", func(runStrategy v1.VirtualMachineRunStrategy) {
+			vm := newVirtualMachineWithRunStrategy(runStrategy)
+			vm.Spec.Template = &v1.VirtualMachineInstanceTemplateSpec{}
+			running := newVirtualMachineInstanceInPhase(v1.Running)
+			running.Name = "running"
+			running.Namespace = k8smetav1.NamespaceDefault
+			quotaIndexers := &vmquota.Indexers{
+				Quotas:     cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+				VMIs:       cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+				Migrations: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+			}
+			Expect(quotaIndexers.VMIs.Add(running)).To(Succeed())
+			Expect(quotaIndexers.Quotas.Add(&v1.VirtualMachineQuota{
+				ObjectMeta: k8smetav1.ObjectMeta{Name: "quota", Namespace: k8smetav1.NamespaceDefault},
+				Spec: v1.VirtualMachineQuotaSpec{
+					Hard: v1.VirtualMachineQuotaResources{RunningVMIs: pointer.P(int64(1))},
+				},
+			})).To(Succeed())
+			app.SetVirtualMachineQuotaIndexers(quotaIndexers)
+
+			vmClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(vm, nil)
+			vmiClient.EXPECT().Get(context.Background(), vm.Name, k8smetav1.GetOptions{}).Return(nil, errors.NewNotFound(v1.Resource("virtualmachineinstance"), vm.Name))
+
+			app.StartVMRequestHandler(request, response)
+
+			statusErr := ExpectStatusErrorWithCode(recorder, http.StatusForbidden)
+			Expect(statusErr.Error()).To(ContainSubstring("exceeded VirtualMachineQuota quota: runningVMIs: requested 1, used 1, limited to 1"))
+		},
+			Entry("Halted RunStrategy", v1.RunStrategyHalted),
+			Entry("Manual RunStrategy", v1.RunStrategyManual),
+		)
 	})
 
 	Context("Subresource api - error handling for StopVMRequestHandler", func() {
@@ -1184,6 +1218,38 @@ var _ = Describe("VirtualMachineInstance Subresources", func() {
 
 			Expect(response.StatusCode()).To(Equal(http.StatusOK))
 		})
+
+		It("Should return the freeze hook steps of a VMI", func() {
+			freezeHooksStatus := &v1.FreezeHooksStatus{
+				Steps: []v1.FreezeHookStepStatus{
+					{Name: v1.FreezeHookStepPreFreeze, Phase: v1.FreezeHookStepSucceeded, ExitCode: pointer.P(int32(0))},
+					{Name: v1.FreezeHookStepFreeze, Phase: v1.FreezeHookStepFailed, Message: "mountpoint not found"},
+				},
+			}
+			expectVMI(Running, UnPaused, func(vmi *v1.VirtualMachineInstance) {
+				vmi.Status.FreezeHooks = freezeHooksStatus
+			})
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			app.FreezeStatusVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := &v1.FreezeHooksStatus{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), result)).To(Succeed())
+			Expect(result).To(Equal(freezeHooksStatus))
+		})
+
+		It("Should return empty freeze hook steps if the VMI was not frozen", func() {
+			expectVMI(Running, UnPaused)
+			response.SetRequestAccepts(restful.MIME_JSON)
+
+			app.FreezeStatusVMIRequestHandler(request, response)
+
+			Expect(response.StatusCode()).To(Equal(http.StatusOK))
+			result := &v1.FreezeHooksStatus{}
+			Expect(json.Unmarshal(recorder.Body.Bytes(), result)).To(Succeed())
+			Expect(result.Steps).To(BeEmpty())
+		})
 	})
 
 	Context("Reset", func() {
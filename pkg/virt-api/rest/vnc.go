@@ -89,26 +89,57 @@ func (app *SubresourceAPIApp) VNCScreenshotRequestHandler(request *restful.Reque
 		_ = c.PointerEvent(0, 1, 1)
 	}
 
-	// Then send a buffer update request
-	err = c.FramebufferUpdateRequest(false, 0, 0, c.FrameBufferWidth, c.FrameBufferHeight)
+	img, err := captureVNCScreen(c, ch)
 	if err != nil {
 		writeError(errors.NewInternalError(err), response)
 		return
 	}
 
+	pipeReader, pipeWriter := io.Pipe()
+	encodeErrChan := make(chan error, 1)
+	copyErrChan := make(chan error, 1)
+	go func() {
+		encodeErrChan <- png.Encode(pipeWriter, img)
+		defer pipeWriter.Close()
+	}()
+
+	response.AddHeader("Content-Type", "image/png")
+	go func() {
+		_, err := io.Copy(response, pipeReader)
+		copyErrChan <- err
+	}()
+
+	encodeErr := <-encodeErrChan
+	if encodeErr != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("failed to encode the image: %v", encodeErr)), response)
+		return
+	}
+
+	copyErr := <-copyErrChan
+	if copyErr != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("failed to stream the image: %v", copyErr)), response)
+		return
+	}
+}
+
+// captureVNCScreen requests a full framebuffer update from the VNC server and converts it into an image.
+func captureVNCScreen(c *vnc.ClientConn, ch <-chan vnc.ServerMessage) (*image.RGBA, error) {
+	err := c.FramebufferUpdateRequest(false, 0, 0, c.FrameBufferWidth, c.FrameBufferHeight)
+	if err != nil {
+		return nil, err
+	}
+
 	var msg vnc.ServerMessage
 	select {
 	case msg = <-ch:
 	case <-time.After(2 * time.Second):
-		writeError(errors.NewInternalError(fmt.Errorf("timed out waiting for VNC server messages")), response)
-		return
+		return nil, fmt.Errorf("timed out waiting for VNC server messages")
 	}
 
 	fbMsg, ok := msg.(*vnc.FramebufferUpdateMessage)
 
 	if !ok || len(fbMsg.Rectangles) == 0 {
-		writeError(errors.NewInternalError(fmt.Errorf("failed to retrieve the VNC screen")), response)
-		return
+		return nil, fmt.Errorf("failed to retrieve the VNC screen")
 	}
 	rects := fbMsg.Rectangles
 
@@ -131,31 +162,7 @@ func (app *SubresourceAPIApp) VNCScreenshotRequestHandler(request *restful.Reque
 		i++
 	}
 
-	pipeReader, pipeWriter := io.Pipe()
-	encodeErrChan := make(chan error, 1)
-	copyErrChan := make(chan error, 1)
-	go func() {
-		encodeErrChan <- png.Encode(pipeWriter, img)
-		defer pipeWriter.Close()
-	}()
-
-	response.AddHeader("Content-Type", "image/png")
-	go func() {
-		_, err := io.Copy(response, pipeReader)
-		copyErrChan <- err
-	}()
-
-	encodeErr := <-encodeErrChan
-	if encodeErr != nil {
-		writeError(errors.NewInternalError(fmt.Errorf("failed to encode the image: %v", encodeErr)), response)
-		return
-	}
-
-	copyErr := <-copyErrChan
-	if copyErr != nil {
-		writeError(errors.NewInternalError(fmt.Errorf("failed to stream the image: %v", copyErr)), response)
-		return
-	}
+	return img, nil
 }
 
 func validateVMIForVNC(vmi *v1.VirtualMachineInstance) *errors.StatusError {
@@ -165,6 +172,12 @@ func validateVMIForVNC(vmi *v1.VirtualMachineInstance) *errors.StatusError {
 		log.Log.Object(vmi).Reason(err).Error("Can't establish VNC connection.")
 		return errors.NewBadRequest(err.Error())
 	}
+	// SPICE replaces the VNC server of the VMI
+	if hasSPICEGraphics(vmi) {
+		err := fmt.Errorf("The graphics device is configured for SPICE.")
+		log.Log.Object(vmi).Reason(err).Error("Can't establish VNC connection.")
+		return errors.NewBadRequest(err.Error())
+	}
 	if !vmi.IsRunning() {
 		return errors.NewBadRequest(vmiNotRunning)
 	}
@@ -0,0 +1,200 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/png"
+	"strconv"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorilla/websocket"
+	"github.com/mitchellh/go-vnc"
+	"k8s.io/apimachinery/pkg/api/errors"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+)
+
+const (
+	defaultThumbnailInterval = 5 * time.Second
+	minThumbnailInterval     = 1 * time.Second
+	maxThumbnailInterval     = 60 * time.Second
+
+	defaultThumbnailMaxWidth = 320
+	maxThumbnailMaxWidth     = 1920
+)
+
+// VNCThumbnailsRequestHandler opens a websocket based VNC connection to virt-handler and periodically sends
+// a PNG thumbnail of the screen as binary message to the client, until the client closes the connection.
+func (app *SubresourceAPIApp) VNCThumbnailsRequestHandler(request *restful.Request, response *restful.Response) {
+	activeConnectionMetric := apimetrics.NewActiveVNCConnection(request.PathParameter("namespace"), request.PathParameter("name"))
+	defer activeConnectionMetric.Dec()
+
+	defer apimetrics.SetVMILastConnectionTimestamp(request.PathParameter("namespace"), request.PathParameter("name"))
+
+	interval, maxWidth, statusErr := parseThumbnailParameters(request)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	dialer := NewDirectDialer(
+		app.FetchVirtualMachineInstance,
+		validateVMIForVNC,
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.VNCURI(vmi)
+		}),
+	)
+	namespace := request.PathParameter(definitions.NamespaceParamName)
+	name := request.PathParameter(definitions.NameParamName)
+
+	nc, statusErr := dialer.Dial(namespace, name)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	done := make(chan struct{})
+	streamer := kvcorev1.NewWebsocketStreamer(nc, done)
+	defer close(done)
+
+	ch := make(chan vnc.ServerMessage)
+	c, err := vnc.Client(streamer.AsConn(), &vnc.ClientConfig{
+		Exclusive:       false,
+		ServerMessageCh: ch,
+		ServerMessages:  []vnc.ServerMessage{new(vnc.FramebufferUpdateMessage)},
+	})
+	if err != nil {
+		writeError(errors.NewInternalError(err), response)
+		return
+	}
+	defer c.Close()
+
+	clientConn, err := clientConnectionUpgrade(request, response)
+	if err != nil {
+		writeError(errors.NewBadRequest(err.Error()), response)
+		return
+	}
+	defer clientConn.Close()
+
+	// The client is not expected to send anything, reading is only required to notice when it goes away
+	clientClosed := make(chan struct{})
+	go func() {
+		defer close(clientClosed)
+		for {
+			if _, _, err := clientConn.ReadMessage(); err != nil {
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		thumbnail, err := captureVNCThumbnail(c, ch, maxWidth)
+		if err != nil {
+			log.Log.Reason(err).Errorf("Failed to capture thumbnail of VMI %s/%s", namespace, name)
+			_ = clientConn.WriteControl(websocket.CloseMessage,
+				websocket.FormatCloseMessage(websocket.CloseInternalServerErr, err.Error()), time.Now().Add(streamTimeout))
+			return
+		}
+		if err := clientConn.WriteMessage(websocket.BinaryMessage, thumbnail); err != nil {
+			log.Log.Reason(err).V(3).Infof("Failed to send thumbnail of VMI %s/%s", namespace, name)
+			return
+		}
+
+		select {
+		case <-clientClosed:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func parseThumbnailParameters(request *restful.Request) (time.Duration, int, *errors.StatusError) {
+	interval := defaultThumbnailInterval
+	if param := request.QueryParameter(definitions.IntervalParamName); param != "" {
+		seconds, err := strconv.Atoi(param)
+		if err != nil {
+			return 0, 0, errors.NewBadRequest(fmt.Sprintf("invalid interval %q: %v", param, err))
+		}
+		interval = time.Duration(seconds) * time.Second
+		if interval < minThumbnailInterval || interval > maxThumbnailInterval {
+			return 0, 0, errors.NewBadRequest(fmt.Sprintf("interval must be between %d and %d seconds",
+				int(minThumbnailInterval.Seconds()), int(maxThumbnailInterval.Seconds())))
+		}
+	}
+
+	maxWidth := defaultThumbnailMaxWidth
+	if param := request.QueryParameter(definitions.MaxWidthParamName); param != "" {
+		width, err := strconv.Atoi(param)
+		if err != nil {
+			return 0, 0, errors.NewBadRequest(fmt.Sprintf("invalid maxWidth %q: %v", param, err))
+		}
+		if width < 1 || width > maxThumbnailMaxWidth {
+			return 0, 0, errors.NewBadRequest(fmt.Sprintf("maxWidth must be between 1 and %d", maxThumbnailMaxWidth))
+		}
+		maxWidth = width
+	}
+
+	return interval, maxWidth, nil
+}
+
+func captureVNCThumbnail(c *vnc.ClientConn, ch <-chan vnc.ServerMessage, maxWidth int) ([]byte, error) {
+	img, err := captureVNCScreen(c, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	buf := &bytes.Buffer{}
+	if err := png.Encode(buf, scaleToWidth(img, maxWidth)); err != nil {
+		return nil, fmt.Errorf("failed to encode the image: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// scaleToWidth scales the image down to the given width, preserving the aspect ratio.
+// Nearest neighbour sampling is good enough for thumbnails.
+func scaleToWidth(src *image.RGBA, width int) *image.RGBA {
+	bounds := src.Bounds()
+	if bounds.Dx() <= width {
+		return src
+	}
+
+	height := max(bounds.Dy()*width/bounds.Dx(), 1)
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for y := 0; y < height; y++ {
+		srcY := bounds.Min.Y + y*bounds.Dy()/height
+		for x := 0; x < width; x++ {
+			srcX := bounds.Min.X + x*bounds.Dx()/width
+			dst.SetRGBA(x, y, src.RGBAAt(srcX, srcY))
+		}
+	}
+	return dst
+}
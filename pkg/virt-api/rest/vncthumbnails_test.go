@@ -0,0 +1,98 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"image"
+	"image/color"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("VNC thumbnails", func() {
+	Context("parameters", func() {
+		newRequest := func(query url.Values) *restful.Request {
+			return restful.NewRequest(&http.Request{URL: &url.URL{RawQuery: query.Encode()}})
+		}
+
+		It("should use defaults if no parameters are given", func() {
+			interval, maxWidth, err := parseThumbnailParameters(newRequest(url.Values{}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(interval).To(Equal(defaultThumbnailInterval))
+			Expect(maxWidth).To(Equal(defaultThumbnailMaxWidth))
+		})
+
+		It("should accept valid parameters", func() {
+			interval, maxWidth, err := parseThumbnailParameters(newRequest(url.Values{
+				"interval": []string{"10"},
+				"maxWidth": []string{"640"},
+			}))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(interval).To(Equal(10 * time.Second))
+			Expect(maxWidth).To(Equal(640))
+		})
+
+		DescribeTable("should reject", func(param, value string) {
+			_, _, err := parseThumbnailParameters(newRequest(url.Values{param: []string{value}}))
+			Expect(err).To(HaveOccurred())
+			Expect(err.ErrStatus.Code).To(BeEquivalentTo(http.StatusBadRequest))
+		},
+			Entry("a non numeric interval", "interval", "5s"),
+			Entry("an interval below the minimum", "interval", "0"),
+			Entry("an interval above the maximum", "interval", "61"),
+			Entry("a non numeric maxWidth", "maxWidth", "wide"),
+			Entry("a negative maxWidth", "maxWidth", "-1"),
+			Entry("a maxWidth above the maximum", "maxWidth", "4096"),
+		)
+	})
+
+	Context("scaling", func() {
+		newImage := func(width, height int) *image.RGBA {
+			img := image.NewRGBA(image.Rect(0, 0, width, height))
+			for x := 0; x < width; x++ {
+				for y := 0; y < height; y++ {
+					img.SetRGBA(x, y, color.RGBA{R: uint8(x), G: uint8(y), A: 255})
+				}
+			}
+			return img
+		}
+
+		It("should not scale images which are small enough", func() {
+			img := newImage(100, 50)
+			Expect(scaleToWidth(img, 100)).To(BeIdenticalTo(img))
+		})
+
+		It("should scale down preserving the aspect ratio", func() {
+			scaled := scaleToWidth(newImage(200, 100), 50)
+			Expect(scaled.Bounds()).To(Equal(image.Rect(0, 0, 50, 25)))
+			Expect(scaled.RGBAAt(0, 0)).To(Equal(color.RGBA{R: 0, G: 0, A: 255}))
+			Expect(scaled.RGBAAt(49, 24)).To(Equal(color.RGBA{R: 196, G: 96, A: 255}))
+		})
+
+		It("should keep at least one line", func() {
+			Expect(scaleToWidth(newImage(1000, 1), 10).Bounds()).To(Equal(image.Rect(0, 0, 10, 1)))
+		})
+	})
+})
@@ -0,0 +1,270 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	apimetrics "kubevirt.io/kubevirt/pkg/monitoring/metrics/virt-api"
+	"kubevirt.io/kubevirt/pkg/virt-api/definitions"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+const (
+	defaultVNCTokenTTL = 5 * time.Minute
+	maxVNCTokenTTL     = time.Hour
+
+	invalidVNCTokenErr = "invalid or expired VNC token"
+)
+
+// vncTokenClaims is the signed payload of a VNC token. The token is bound to the
+// UID of the VMI, so that it can't be reused for a recreated VMI with the same name.
+type vncTokenClaims struct {
+	Namespace string    `json:"ns"`
+	Name      string    `json:"name"`
+	UID       types.UID `json:"uid"`
+	Expiry    int64     `json:"exp"`
+}
+
+// SetVNCTokenCertificate sets the source of the certificate whose private key is used to
+// sign and verify VNC tokens. All virt-api replicas share the same serving certificate,
+// so a token issued by one replica is accepted by all others. Rotating the certificate
+// invalidates all outstanding tokens.
+func (app *SubresourceAPIApp) SetVNCTokenCertificate(certificate func() *tls.Certificate) {
+	app.vncTokenCertificate = certificate
+}
+
+// VNCTokenRequestHandler issues a short-lived token which grants access to the VNC console of
+// the VMI through the vncproxy endpoint. The endpoint is compatible with noVNC and does not
+// require any further authentication. Requests which reach virt-api through the Kubernetes API
+// server are authenticated there, so console links for users without access to the cluster
+// point to the external URL virt-api is exposed at.
+func (app *SubresourceAPIApp) VNCTokenRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.VNCTokenAccessEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.VNCTokenAccessGate)), response)
+		return
+	}
+
+	opts := &v1.VNCTokenOptions{}
+	if request.Request.Body != nil {
+		if err := decodeBody(request, opts); err != nil {
+			writeError(err, response)
+			return
+		}
+	}
+
+	ttl := defaultVNCTokenTTL
+	if opts.TTLDuration != nil {
+		ttl = opts.TTLDuration.Duration
+	}
+	if ttl <= 0 || ttl > maxVNCTokenTTL {
+		writeError(errors.NewBadRequest(fmt.Sprintf("ttlDuration must be greater than 0 and at most %s", maxVNCTokenTTL)), response)
+		return
+	}
+
+	namespace := request.PathParameter(definitions.NamespaceParamName)
+	name := request.PathParameter(definitions.NameParamName)
+	vmi, statusErr := app.fetchAndValidateVirtualMachineInstance(namespace, name, validateVMIForVNC)
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	cert, statusErr := app.currentVNCTokenCertificate()
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	expiration := time.Now().Add(ttl)
+	token, err := signVNCToken(cert, &vncTokenClaims{
+		Namespace: vmi.Namespace,
+		Name:      vmi.Name,
+		UID:       vmi.UID,
+		Expiry:    expiration.Unix(),
+	})
+	if err != nil {
+		writeError(errors.NewInternalError(fmt.Errorf("failed to sign VNC token: %v", err)), response)
+		return
+	}
+
+	query := url.Values{}
+	query.Set(definitions.VNCTokenParamName, token)
+	vncToken := v1.VNCToken{
+		Token:               token,
+		Path:                definitions.GroupVersionBasePath(v1.SubresourceStorageGroupVersion) + definitions.SubResourcePath("vncproxy") + "?" + query.Encode(),
+		ExpirationTimestamp: metav1.NewTime(time.Unix(expiration.Unix(), 0)),
+	}
+	if tokenAccess := app.clusterConfig.GetConfig().VNCTokenAccess; tokenAccess != nil && tokenAccess.ExternalURL != "" {
+		vncToken.URL = strings.TrimSuffix(tokenAccess.ExternalURL, "/") + vncToken.Path
+	}
+
+	log.Log.Object(vmi).Infof("Issued VNC token valid until %s", vncToken.ExpirationTimestamp.UTC().Format(time.RFC3339))
+	if err := response.WriteEntity(vncToken); err != nil {
+		log.Log.Reason(err).Error("Failed to write http response.")
+	}
+}
+
+// VNCProxyRequestHandler opens a websocket based VNC connection to the VMI referenced by the
+// token passed as query parameter. The token has to be valid at the time the connection is
+// established, an already open connection is not terminated once the token expires.
+func (app *SubresourceAPIApp) VNCProxyRequestHandler(request *restful.Request, response *restful.Response) {
+	if !app.clusterConfig.VNCTokenAccessEnabled() {
+		writeError(errors.NewBadRequest(fmt.Sprintf(featureGateDisabledErrFmt, featuregate.VNCTokenAccessGate)), response)
+		return
+	}
+
+	cert, statusErr := app.currentVNCTokenCertificate()
+	if statusErr != nil {
+		writeError(statusErr, response)
+		return
+	}
+
+	claims, err := verifyVNCToken(cert, request.QueryParameter(definitions.VNCTokenParamName), time.Now())
+	if err != nil {
+		log.Log.Reason(err).Warning("Rejected VNC proxy connection")
+		writeError(errors.NewUnauthorized(invalidVNCTokenErr), response)
+		return
+	}
+
+	activeConnectionMetric := apimetrics.NewActiveVNCConnection(claims.Namespace, claims.Name)
+	defer activeConnectionMetric.Dec()
+
+	defer apimetrics.SetVMILastConnectionTimestamp(claims.Namespace, claims.Name)
+
+	// The target VMI is taken from the token, the path does not carry a namespace and name.
+	fetchTokenVMI := func(_, _ string) (*v1.VirtualMachineInstance, *errors.StatusError) {
+		vmi, statusErr := app.FetchVirtualMachineInstance(claims.Namespace, claims.Name)
+		if statusErr != nil {
+			return nil, statusErr
+		}
+		if vmi.UID != claims.UID {
+			return nil, errors.NewUnauthorized(invalidVNCTokenErr)
+		}
+		return vmi, nil
+	}
+
+	streamer := NewRawStreamer(
+		fetchTokenVMI,
+		validateVMIForVNC,
+		app.virtHandlerDialer(func(vmi *v1.VirtualMachineInstance, conn kubecli.VirtHandlerConn) (string, error) {
+			return conn.VNCURI(vmi)
+		}),
+	)
+
+	streamer.Handle(request, response)
+}
+
+func (app *SubresourceAPIApp) currentVNCTokenCertificate() (*tls.Certificate, *errors.StatusError) {
+	if app.vncTokenCertificate == nil {
+		return nil, errors.NewInternalError(fmt.Errorf("no certificate configured for VNC tokens"))
+	}
+	cert := app.vncTokenCertificate()
+	if cert == nil {
+		return nil, errors.NewInternalError(fmt.Errorf("no certificate available for VNC tokens"))
+	}
+	return cert, nil
+}
+
+func signVNCToken(cert *tls.Certificate, claims *vncTokenClaims) (string, error) {
+	signer, ok := cert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return "", fmt.Errorf("private key of type %T can't be used for signing", cert.PrivateKey)
+	}
+
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", err
+	}
+	encodedPayload := base64.RawURLEncoding.EncodeToString(payload)
+
+	digest := sha256.Sum256([]byte(encodedPayload))
+	signature, err := signer.Sign(rand.Reader, digest[:], crypto.SHA256)
+	if err != nil {
+		return "", err
+	}
+
+	return encodedPayload + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
+
+func verifyVNCToken(cert *tls.Certificate, token string, now time.Time) (*vncTokenClaims, error) {
+	signer, ok := cert.PrivateKey.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("private key of type %T can't be used for verification", cert.PrivateKey)
+	}
+
+	encodedPayload, encodedSignature, found := strings.Cut(token, ".")
+	if !found {
+		return nil, fmt.Errorf("malformed token")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(encodedSignature)
+	if err != nil {
+		return nil, fmt.Errorf("malformed token signature: %v", err)
+	}
+
+	digest := sha256.Sum256([]byte(encodedPayload))
+	switch publicKey := signer.Public().(type) {
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(publicKey, crypto.SHA256, digest[:], signature); err != nil {
+			return nil, fmt.Errorf("invalid token signature: %v", err)
+		}
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(publicKey, digest[:], signature) {
+			return nil, fmt.Errorf("invalid token signature")
+		}
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", publicKey)
+	}
+
+	payload, err := base64.RawURLEncoding.DecodeString(encodedPayload)
+	if err != nil {
+		return nil, fmt.Errorf("malformed token payload: %v", err)
+	}
+	claims := &vncTokenClaims{}
+	if err := json.Unmarshal(payload, claims); err != nil {
+		return nil, fmt.Errorf("malformed token payload: %v", err)
+	}
+
+	if !now.Before(time.Unix(claims.Expiry, 0)) {
+		return nil, fmt.Errorf("token expired at %s", time.Unix(claims.Expiry, 0).UTC().Format(time.RFC3339))
+	}
+
+	return claims, nil
+}
@@ -0,0 +1,286 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/emicklei/go-restful/v3"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	libvmistatus "kubevirt.io/kubevirt/pkg/libvmi/status"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
+)
+
+func newVNCTokenTestCertificate(key crypto.Signer) *tls.Certificate {
+	return &tls.Certificate{PrivateKey: key}
+}
+
+func newECDSAVNCTokenTestCertificate() *tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+	return newVNCTokenTestCertificate(key)
+}
+
+func newRSAVNCTokenTestCertificate() *tls.Certificate {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).ToNot(HaveOccurred())
+	return newVNCTokenTestCertificate(key)
+}
+
+var _ = Describe("VNC token", func() {
+	Context("signing and verification", func() {
+		var claims *vncTokenClaims
+
+		BeforeEach(func() {
+			claims = &vncTokenClaims{
+				Namespace: metav1.NamespaceDefault,
+				Name:      testVMIName,
+				UID:       "1234",
+				Expiry:    time.Now().Add(time.Minute).Unix(),
+			}
+		})
+
+		DescribeTable("should accept a valid token", func(newCert func() *tls.Certificate) {
+			cert := newCert()
+			token, err := signVNCToken(cert, claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			verified, err := verifyVNCToken(cert, token, time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(verified).To(Equal(claims))
+		},
+			Entry("signed with an ECDSA key", newECDSAVNCTokenTestCertificate),
+			Entry("signed with an RSA key", newRSAVNCTokenTestCertificate),
+		)
+
+		It("should reject an expired token", func() {
+			cert := newECDSAVNCTokenTestCertificate()
+			token, err := signVNCToken(cert, claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = verifyVNCToken(cert, token, time.Unix(claims.Expiry, 0))
+			Expect(err).To(MatchError(ContainSubstring("token expired")))
+		})
+
+		It("should reject a token signed with a different key", func() {
+			token, err := signVNCToken(newECDSAVNCTokenTestCertificate(), claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = verifyVNCToken(newECDSAVNCTokenTestCertificate(), token, time.Now())
+			Expect(err).To(MatchError(ContainSubstring("invalid token signature")))
+		})
+
+		It("should reject a token with a modified payload", func() {
+			cert := newECDSAVNCTokenTestCertificate()
+			token, err := signVNCToken(cert, claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			claims.Name = "othervmi"
+			otherToken, err := signVNCToken(cert, claims)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, signature, _ := strings.Cut(token, ".")
+			otherPayload, _, _ := strings.Cut(otherToken, ".")
+			_, err = verifyVNCToken(cert, otherPayload+"."+signature, time.Now())
+			Expect(err).To(MatchError(ContainSubstring("invalid token signature")))
+		})
+
+		DescribeTable("should reject a malformed token", func(token string) {
+			_, err := verifyVNCToken(newECDSAVNCTokenTestCertificate(), token, time.Now())
+			Expect(err).To(MatchError(ContainSubstring("malformed token")))
+		},
+			Entry("without signature", "eyJucyI6ImRlZmF1bHQifQ"),
+			Entry("with invalid signature encoding", "eyJucyI6ImRlZmF1bHQifQ.!!!"),
+		)
+	})
+
+	Context("subresources", func() {
+		var (
+			recorder   *httptest.ResponseRecorder
+			request    *restful.Request
+			response   *restful.Response
+			virtClient *kubevirtfake.Clientset
+			app        *SubresourceAPIApp
+			cert       *tls.Certificate
+		)
+
+		newKubeVirt := func(featureGates ...string) *v1.KubeVirt {
+			return &v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "kubevirt",
+					Namespace: "kubevirt",
+				},
+				Spec: v1.KubeVirtSpec{
+					Configuration: v1.KubeVirtConfiguration{
+						DeveloperConfiguration: &v1.DeveloperConfiguration{
+							FeatureGates: featureGates,
+						},
+					},
+				},
+				Status: v1.KubeVirtStatus{
+					Phase: v1.KubeVirtPhaseDeploying,
+				},
+			}
+		}
+
+		newApp := func(kv *v1.KubeVirt) *SubresourceAPIApp {
+			config, _, _ := testutils.NewFakeClusterConfigUsingKV(kv)
+			mockVirtClient := kubecli.NewMockKubevirtClient(gomock.NewController(GinkgoT()))
+			mockVirtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault)).AnyTimes()
+
+			app := NewSubresourceAPIApp(mockVirtClient, 0, &tls.Config{InsecureSkipVerify: true}, config)
+			app.SetVNCTokenCertificate(func() *tls.Certificate { return cert })
+			return app
+		}
+
+		createRunningVMI := func() *v1.VirtualMachineInstance {
+			vmi := libvmi.New(
+				libvmi.WithName(testVMIName),
+				libvmi.WithNamespace(metav1.NamespaceDefault),
+				libvmistatus.WithStatus(libvmistatus.New(libvmistatus.WithPhase(v1.Running))),
+			)
+			vmi.UID = "1234"
+			vmi, err := virtClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.TODO(), vmi, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return vmi
+		}
+
+		BeforeEach(func() {
+			recorder = httptest.NewRecorder()
+			request = restful.NewRequest(&http.Request{})
+			response = restful.NewResponse(recorder)
+			response.SetRequestAccepts(restful.MIME_JSON)
+			virtClient = kubevirtfake.NewSimpleClientset()
+			cert = newECDSAVNCTokenTestCertificate()
+			app = newApp(newKubeVirt(featuregate.VNCTokenAccessGate))
+		})
+
+		It("should fail to issue a token if the feature gate is disabled", func() {
+			app = newApp(newKubeVirt())
+			app.VNCTokenRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		It("should fail to open a connection if the feature gate is disabled", func() {
+			app = newApp(newKubeVirt())
+			app.VNCProxyRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		})
+
+		DescribeTable("should reject an invalid ttl", func(ttl string) {
+			request.Request.Body = io.NopCloser(strings.NewReader(`{"ttlDuration":"` + ttl + `"}`))
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = metav1.NamespaceDefault
+
+			app.VNCTokenRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusBadRequest)
+		},
+			Entry("exceeding the maximum", "2h"),
+			Entry("which is negative", "-1m"),
+		)
+
+		It("should issue a token bound to the VMI", func() {
+			vmi := createRunningVMI()
+			request.Request.Body = io.NopCloser(strings.NewReader(`{"ttlDuration":"10m"}`))
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = metav1.NamespaceDefault
+
+			app.VNCTokenRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			vncToken := &v1.VNCToken{}
+			Expect(json.NewDecoder(recorder.Body).Decode(vncToken)).To(Succeed())
+			Expect(vncToken.ExpirationTimestamp.Time).To(BeTemporally("~", time.Now().Add(10*time.Minute), 5*time.Second))
+
+			path, err := url.Parse(vncToken.Path)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(path.Path).To(Equal("/apis/subresources.kubevirt.io/v1/vncproxy"))
+			Expect(path.Query().Get("token")).To(Equal(vncToken.Token))
+			Expect(vncToken.URL).To(BeEmpty())
+
+			claims, err := verifyVNCToken(cert, vncToken.Token, time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(claims.Namespace).To(Equal(vmi.Namespace))
+			Expect(claims.Name).To(Equal(vmi.Name))
+			Expect(claims.UID).To(Equal(vmi.UID))
+		})
+
+		It("should return the URL below the external URL of virt-api", func() {
+			kv := newKubeVirt(featuregate.VNCTokenAccessGate)
+			kv.Spec.Configuration.VNCTokenAccess = &v1.VNCTokenAccessConfiguration{ExternalURL: "https://vnc.example.com/kubevirt/"}
+			app = newApp(kv)
+			createRunningVMI()
+			request.PathParameters()["name"] = testVMIName
+			request.PathParameters()["namespace"] = metav1.NamespaceDefault
+
+			app.VNCTokenRequestHandler(request, response)
+			Expect(recorder.Code).To(Equal(http.StatusOK))
+
+			vncToken := &v1.VNCToken{}
+			Expect(json.NewDecoder(recorder.Body).Decode(vncToken)).To(Succeed())
+			Expect(vncToken.URL).To(Equal("https://vnc.example.com/kubevirt" + vncToken.Path))
+		})
+
+		It("should reject a connection with an invalid token", func() {
+			request.Request.URL = &url.URL{RawQuery: "token=invalid"}
+
+			app.VNCProxyRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusUnauthorized)
+		})
+
+		It("should reject a connection if the VMI was recreated", func() {
+			createRunningVMI()
+			token, err := signVNCToken(cert, &vncTokenClaims{
+				Namespace: metav1.NamespaceDefault,
+				Name:      testVMIName,
+				UID:       "5678",
+				Expiry:    time.Now().Add(time.Minute).Unix(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+			request.Request.URL = &url.URL{RawQuery: url.Values{"token": []string{token}}.Encode()}
+
+			app.VNCProxyRequestHandler(request, response)
+			ExpectStatusErrorWithCode(recorder, http.StatusUnauthorized)
+		})
+	})
+})
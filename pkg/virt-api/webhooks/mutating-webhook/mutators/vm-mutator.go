@@ -85,7 +85,7 @@ func (mutator *VMsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1.
 	log.Log.Object(vm).V(4).Info("Apply defaults")
 
 	preferenceSpec, _ := mutator.instancetypeMutator.FindPreference(vm)
-	defaults.SetVirtualMachineDefaults(vm, mutator.ClusterConfig, preferenceSpec)
+	defaults.SetVirtualMachineDefaults(vm, mutator.ClusterConfig.ForNamespace(ar.Request.Namespace), preferenceSpec)
 
 	patchBytes, err := patch.New(
 		patch.WithReplace("/spec", vm.Spec),
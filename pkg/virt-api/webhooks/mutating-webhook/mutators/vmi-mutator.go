@@ -68,6 +68,9 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 
 	// Patch the spec, metadata and status with defaults if we deal with a create operation
 	if ar.Request.Operation == admissionv1.Create {
+		// Defaults come from the cluster-wide configuration merged with the overrides of the namespace
+		clusterConfig := mutator.ClusterConfig.ForNamespace(ar.Request.Namespace)
+
 		// Apply presets
 		err = applyPresets(newVMI, mutator.VMIPresetInformer)
 		if err != nil {
@@ -81,14 +84,14 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 
 		// Set VirtualMachineInstance defaults
 		log.Log.Object(newVMI).V(4).Info("Apply defaults")
-		if err = defaults.SetDefaultVirtualMachineInstance(mutator.ClusterConfig, newVMI); err != nil {
+		if err = defaults.SetDefaultVirtualMachineInstance(clusterConfig, newVMI); err != nil {
 			return webhookutils.ToAdmissionResponseError(err)
 		}
 
 		if newVMI.Spec.Domain.CPU.IsolateEmulatorThread {
-			_, emulatorThreadCompleteToEvenParityAnnotationExists := mutator.ClusterConfig.GetConfigFromKubeVirtCR().Annotations[v1.EmulatorThreadCompleteToEvenParity]
+			_, emulatorThreadCompleteToEvenParityAnnotationExists := clusterConfig.GetConfigFromKubeVirtCR().Annotations[v1.EmulatorThreadCompleteToEvenParity]
 			if emulatorThreadCompleteToEvenParityAnnotationExists &&
-				mutator.ClusterConfig.AlignCPUsEnabled() {
+				clusterConfig.AlignCPUsEnabled() {
 				log.Log.V(4).Infof("Copy %s annotation from Kubevirt CR", v1.EmulatorThreadCompleteToEvenParity)
 				if newVMI.Annotations == nil {
 					newVMI.Annotations = map[string]string{}
@@ -109,7 +112,7 @@ func (mutator *VMIsMutator) Mutate(ar *admissionv1.AdmissionReview) *admissionv1
 			PhaseTransitionTimestamp: now,
 		})
 
-		if !mutator.ClusterConfig.RootEnabled() {
+		if !clusterConfig.RootEnabled() {
 			markAsNonroot(newVMI)
 		}
 
@@ -255,6 +255,35 @@ var _ = Describe("VirtualMachineInstance Mutator", func() {
 		Entry("on arm64", "arm64", v1.CPUModeHostPassthrough),
 	)
 
+	DescribeTable("should apply the allowed overrides of the namespace on VMI create", func(allowedOverrides []v1.NamespaceConfigOverride, cpuModel string) {
+		const namespace = "tenant"
+		testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+			Spec: v1.KubeVirtSpec{
+				Configuration: v1.KubeVirtConfiguration{
+					CPUModel: cpuModelFromConfig,
+					NamespaceConfiguration: &v1.NamespaceConfiguration{
+						AllowedOverrides: allowedOverrides,
+					},
+				},
+			},
+		})
+		namespaceConfigInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.KubeVirtNamespaceConfig{}, cache.Indexers{
+			cache.NamespaceIndex: cache.MetaNamespaceIndexFunc,
+		})
+		Expect(namespaceConfigInformer.GetStore().Add(&v1.KubeVirtNamespaceConfig{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "defaults", Namespace: namespace},
+			Spec:       v1.KubeVirtNamespaceConfigSpec{CPUModel: "Skylake-Client"},
+		})).To(Succeed())
+		mutator.ClusterConfig.SetNamespaceConfigInformer(namespaceConfigInformer)
+		vmi.Namespace = namespace
+
+		_, vmiSpec, _ := getMetaSpecStatusFromAdmit("amd64")
+		Expect(vmiSpec.Domain.CPU.Model).To(Equal(cpuModel))
+	},
+		Entry("when the CPU model override is allowed", []v1.NamespaceConfigOverride{v1.NamespaceConfigOverrideCPUModel}, "Skylake-Client"),
+		Entry("when the CPU model override is not allowed", []v1.NamespaceConfigOverride{v1.NamespaceConfigOverrideEvictionStrategy}, cpuModelFromConfig),
+	)
+
 	DescribeTable("it should", func(given []v1.Volume, expected []v1.Volume) {
 		vmi.Spec.Volumes = given
 		_, vmiSpec, _ := getMetaSpecStatusFromAdmit(rt.GOARCH)
@@ -34,17 +34,22 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubevirt"
 
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 )
 
 type MigrationCreateAdmitter struct {
-	virtClient kubevirt.Interface
+	virtClient              kubevirt.Interface
+	quotaIndexers           *vmquota.Indexers
+	kubeVirtServiceAccounts map[string]struct{}
 }
 
-func NewMigrationCreateAdmitter(virtClient kubevirt.Interface) *MigrationCreateAdmitter {
+func NewMigrationCreateAdmitter(virtClient kubevirt.Interface, quotaIndexers *vmquota.Indexers, kubeVirtServiceAccounts map[string]struct{}) *MigrationCreateAdmitter {
 	return &MigrationCreateAdmitter{
-		virtClient: virtClient,
+		virtClient:              virtClient,
+		quotaIndexers:           quotaIndexers,
+		kubeVirtServiceAccounts: kubeVirtServiceAccounts,
 	}
 }
 
@@ -122,6 +127,15 @@ func (admitter *MigrationCreateAdmitter) Admit(ctx context.Context, ar *admissio
 		return webhookutils.ToAdmissionResponseError(err)
 	}
 
+	// migrations KubeVirt creates itself, like for evacuations and workload updates, must not be held back by
+	// the quotas of the tenants
+	if _, isKubeVirtServiceAccount := admitter.kubeVirtServiceAccounts[ar.Request.UserInfo.Username]; !isKubeVirtServiceAccount {
+		err = vmquota.Check(admitter.quotaIndexers, migration.Namespace, vmquota.MigrationUsage())
+		if err != nil {
+			return webhookutils.ToAdmissionResponseError(err)
+		}
+	}
+
 	reviewResponse := admissionv1.AdmissionResponse{}
 	reviewResponse.Allowed = true
 	return &reviewResponse
@@ -29,11 +29,13 @@ import (
 	k8sv1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
 	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks/validating-webhook/admitters"
 )
@@ -60,7 +62,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			},
 		}
 		virtClient := kubevirtfake.NewSimpleClientset(vmi, inFlightMigration)
-		migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+		migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, nil, nil)
 		ar, err := newAdmissionReviewForVMIMCreation(migration)
 		Expect(err).ToNot(HaveOccurred())
 
@@ -80,7 +82,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset()
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, nil, nil)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -102,7 +104,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				},
 			}
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, nil, nil)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -128,7 +130,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, nil, nil)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -136,6 +138,65 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			Expect(resp.Allowed).To(BeTrue())
 		})
 
+		DescribeTable("should enforce the VirtualMachineQuota of concurrent migrations", func(username string, allowed bool) {
+			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
+			otherMigration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "other-migration",
+					Namespace: vmi.Namespace,
+					Labels:    map[string]string{v1.MigrationSelectorLabel: "other-vmi"},
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName: "other-vmi",
+				},
+				Status: v1.VirtualMachineInstanceMigrationStatus{
+					Phase: v1.MigrationRunning,
+				},
+			}
+			maxMigrations := int64(1)
+			quota := &v1.VirtualMachineQuota{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "quota",
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineQuotaSpec{
+					Hard: v1.VirtualMachineQuotaResources{Migrations: &maxMigrations},
+				},
+			}
+			quotaIndexers := &vmquota.Indexers{
+				Quotas:     cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+				VMIs:       cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+				Migrations: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+			}
+			Expect(quotaIndexers.Quotas.Add(quota)).To(Succeed())
+			Expect(quotaIndexers.Migrations.Add(otherMigration)).To(Succeed())
+
+			migration := &v1.VirtualMachineInstanceMigration{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: vmi.Namespace,
+				},
+				Spec: v1.VirtualMachineInstanceMigrationSpec{
+					VMIName: vmi.Name,
+				},
+			}
+
+			virtClient := kubevirtfake.NewSimpleClientset(vmi, otherMigration)
+			kubeVirtServiceAccounts := webhooks.KubeVirtServiceAccounts("kubevirt")
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, quotaIndexers, kubeVirtServiceAccounts)
+			ar, err := newAdmissionReviewForVMIMCreation(migration)
+			Expect(err).ToNot(HaveOccurred())
+			ar.Request.UserInfo.Username = username
+
+			resp := migrationCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(Equal(allowed))
+			if !allowed {
+				Expect(resp.Result.Message).To(ContainSubstring("exceeded VirtualMachineQuota quota: migrations: requested 1, used 1, limited to 1"))
+			}
+		},
+			Entry("and reject migrations of users once it is exhausted", "tenant", false),
+			Entry("but not for the migrations of KubeVirt itself", "system:serviceaccount:kubevirt:kubevirt-controller", true),
+		)
+
 		It("should reject Migration spec on create when VMI is finalized", func() {
 			vmi := libvmi.New(libvmi.WithNamespace(k8sv1.NamespaceDefault))
 			vmi.Status.Phase = v1.Succeeded
@@ -150,7 +211,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 			}
 
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, nil, nil)
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
 
@@ -183,7 +244,7 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				},
 			}
 			virtClient := kubevirtfake.NewSimpleClientset(vmi)
-			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient)
+			migrationCreateAdmitter := admitters.NewMigrationCreateAdmitter(virtClient, nil, nil)
 
 			ar, err := newAdmissionReviewForVMIMCreation(migration)
 			Expect(err).ToNot(HaveOccurred())
@@ -213,13 +274,13 @@ var _ = Describe("Validating MigrationCreate Admitter", func() {
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
+				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(), nil, nil).Admit,
 			),
 			Entry("Migration update",
 				`{"very": "unknown", "spec": { "extremely": "unknown" }}`,
 				`.very in body is a forbidden property, spec.extremely in body is a forbidden property`,
 				webhooks.MigrationGroupVersionResource,
-				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset()).Admit,
+				admitters.NewMigrationCreateAdmitter(kubevirtfake.NewSimpleClientset(), nil, nil).Admit,
 			),
 		)
 	})
@@ -108,6 +108,10 @@ func (admitter *VirtualMachineCloneAdmitter) Admit(ctx context.Context, ar *admi
 		causes = append(causes, newCauses...)
 	}
 
+	if newCauses := validatePersistentState(vmClone); newCauses != nil {
+		causes = append(causes, newCauses...)
+	}
+
 	if len(causes) > 0 {
 		return webhookutils.ToAdmissionResponse(causes)
 	}
@@ -260,6 +264,36 @@ func validateNewMacAddresses(vmClone *clone.VirtualMachineClone) []metav1.Status
 	return causes
 }
 
+func validatePersistentState(vmClone *clone.VirtualMachineClone) []metav1.StatusCause {
+	policy := vmClone.Spec.PersistentState
+	if policy == nil {
+		return nil
+	}
+
+	field := k8sfield.NewPath("spec").Child("persistentState").String()
+
+	switch *policy {
+	case clone.PersistentStateRegenerate:
+		return nil
+	case clone.PersistentStateCopy:
+		if vmClone.Spec.Source != nil && vmClone.Spec.Source.Kind != virtualMachineKind {
+			return []metav1.StatusCause{{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("persistent state can only be copied from a %s source", virtualMachineKind),
+				Field:   field,
+			}}
+		}
+		return nil
+	default:
+		return []metav1.StatusCause{{
+			Type: metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("persistent state policy %q is not supported, must be one of %q or %q",
+				*policy, clone.PersistentStateCopy, clone.PersistentStateRegenerate),
+			Field: field,
+		}}
+	}
+}
+
 func doesSliceContainStr(slice []string, str string) (isFound bool) {
 	for _, curSliceStr := range slice {
 		if curSliceStr == str {
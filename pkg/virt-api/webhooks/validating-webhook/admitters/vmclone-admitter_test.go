@@ -415,6 +415,18 @@ var _ = Describe("Validating VirtualMachineClone Admitter", func() {
 		Entry("invalid mac address", "00:00:00:00:00", false),
 	)
 
+	DescribeTable("persistentState", func(sourceKind string, policy clone.PersistentStatePolicy, expectAllowed bool) {
+		vmClone.Spec.Source.Kind = sourceKind
+		vmClone.Spec.PersistentState = &policy
+		admitter.admitAndExpect(vmClone, expectAllowed)
+	},
+		Entry("Copy from VM", virtualMachineKind, clone.PersistentStateCopy, true),
+		Entry("Regenerate from VM", virtualMachineKind, clone.PersistentStateRegenerate, true),
+		Entry("Regenerate from snapshot", virtualMachineSnapshotKind, clone.PersistentStateRegenerate, true),
+		Entry("Copy from snapshot", virtualMachineSnapshotKind, clone.PersistentStateCopy, false),
+		Entry("unknown policy", virtualMachineKind, clone.PersistentStatePolicy("Discard"), false),
+	)
+
 })
 
 func createCloneAdmissionReview(vmClone *clone.VirtualMachineClone) *admissionv1.AdmissionReview {
@@ -47,6 +47,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/storage/reservation"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	hwutil "kubevirt.io/kubevirt/pkg/util/hardware"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
@@ -101,6 +102,8 @@ type VMICreateAdmitter struct {
 	ClusterConfig           *virtconfig.ClusterConfig
 	SpecValidators          []SpecValidator
 	KubeVirtServiceAccounts map[string]struct{}
+	// QuotaIndexers are used to enforce the VirtualMachineQuotas of the namespace, they are not enforced without them
+	QuotaIndexers *vmquota.Indexers
 }
 
 func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.AdmissionReview) *admissionv1.AdmissionResponse {
@@ -139,6 +142,10 @@ func (admitter *VMICreateAdmitter) Admit(_ context.Context, ar *admissionv1.Admi
 		return webhookutils.ToAdmissionResponse(causes)
 	}
 
+	if err := vmquota.Check(admitter.QuotaIndexers, ar.Request.Namespace, vmquota.VMIUsage(&vmi.Spec)); err != nil {
+		return webhookutils.ToAdmissionResponseError(err)
+	}
+
 	return &admissionv1.AdmissionResponse{
 		Allowed:  true,
 		Warnings: warnDeprecatedAPIs(&vmi.Spec, admitter.ClusterConfig),
@@ -194,10 +201,12 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateIOThreadsPolicy(field, spec)...)
 	causes = append(causes, validateProbe(field.Child("readinessProbe"), spec.ReadinessProbe)...)
 	causes = append(causes, validateProbe(field.Child("livenessProbe"), spec.LivenessProbe)...)
+	causes = append(causes, validateProbe(field.Child("startupProbe"), spec.StartupProbe)...)
 
 	if podNetwork := vmispec.LookupPodNetwork(spec.Networks); podNetwork == nil {
 		causes = appendStatusCauseForProbeNotAllowedWithNoPodNetworkPresent(field.Child("readinessProbe"), spec.ReadinessProbe, causes)
 		causes = appendStatusCauseForProbeNotAllowedWithNoPodNetworkPresent(field.Child("livenessProbe"), spec.LivenessProbe, causes)
+		causes = appendStatusCauseForProbeNotAllowedWithNoPodNetworkPresent(field.Child("startupProbe"), spec.StartupProbe, causes)
 	}
 
 	causes = append(causes, validateDomainSpec(field.Child("domain"), &spec.Domain)...)
@@ -214,6 +223,9 @@ func ValidateVirtualMachineInstanceSpec(field *k8sfield.Path, spec *v1.VirtualMa
 	causes = append(causes, validateMDEVRamFB(field, spec)...)
 	causes = append(causes, validateHostDevicesWithPassthroughEnabled(field, spec, config)...)
 	causes = append(causes, validateSoundDevices(field, spec)...)
+	causes = append(causes, validateFreezeHooks(field.Child("freezeHooks"), spec.FreezeHooks)...)
+	causes = append(causes, validatePanicPolicy(field.Child("panicPolicy"), spec.PanicPolicy)...)
+	causes = append(causes, validateGraphics(field, spec, config)...)
 	causes = append(causes, validateLaunchSecurity(field, spec, config)...)
 	causes = append(causes, validateVSOCK(field, spec, config)...)
 	causes = append(causes, validatePersistentReservation(field, spec, config)...)
@@ -401,6 +413,9 @@ func validateProbe(field *k8sfield.Path, probe *v1.Probe) []metav1.StatusCause {
 	if probe.GuestAgentPing != nil {
 		numHandlers++
 	}
+	if probe.GRPC != nil {
+		numHandlers++
+	}
 
 	if numHandlers > 1 {
 		causes = append(causes, metav1.StatusCause{
@@ -413,10 +428,12 @@ func validateProbe(field *k8sfield.Path, probe *v1.Probe) []metav1.StatusCause {
 	if numHandlers < 1 {
 		causes = append(causes, metav1.StatusCause{
 			Type: metav1.CauseTypeFieldValueRequired,
-			Message: fmt.Sprintf("either %s, %s or %s must be set if a %s is specified",
+			Message: fmt.Sprintf("either %s, %s, %s, %s or %s must be set if a %s is specified",
 				field.Child("tcpSocket").String(),
 				field.Child("exec").String(),
 				field.Child("httpGet").String(),
+				field.Child("grpc").String(),
+				field.Child("guestAgentPing").String(),
 				field,
 			),
 			Field: field.String(),
@@ -438,6 +455,7 @@ func appendStatusCauseForProbeNotAllowedWithNoPodNetworkPresent(field *k8sfield.
 	if probe.TCPSocket != nil {
 		causes = append(causes, podNetworkRequiredStatusCause(field.Child("tcpSocket")))
 	}
+
 	return causes
 }
 
@@ -536,6 +554,145 @@ func validateSoundDevices(field *k8sfield.Path, spec *v1.VirtualMachineInstanceS
 	return causes
 }
 
+func validateFreezeHooks(field *k8sfield.Path, freezeHooks *v1.FreezeHooks) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if freezeHooks == nil {
+		return causes
+	}
+
+	causes = append(causes, validateFreezeHookCommand(field.Child("preFreeze"), freezeHooks.PreFreeze)...)
+	causes = append(causes, validateFreezeHookCommand(field.Child("postThaw"), freezeHooks.PostThaw)...)
+
+	mountpoints := map[string]struct{}{}
+	for i, mountpoint := range freezeHooks.Mountpoints {
+		if mountpoint == "" {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Message: fmt.Sprintf("%s must not be empty", field.Child("mountpoints").Index(i).String()),
+				Field:   field.Child("mountpoints").Index(i).String(),
+			})
+			continue
+		}
+		if _, exists := mountpoints[mountpoint]; exists {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Message: fmt.Sprintf("%s is listed more than once", mountpoint),
+				Field:   field.Child("mountpoints").Index(i).String(),
+			})
+		}
+		mountpoints[mountpoint] = struct{}{}
+	}
+	return causes
+}
+
+func validatePanicPolicy(field *k8sfield.Path, panicPolicy *v1.PanicPolicy) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if panicPolicy == nil {
+		return causes
+	}
+
+	switch panicPolicy.Action {
+	case "", v1.PanicActionRestart, v1.PanicActionPause:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("%s must be one of %s, %s", field.Child("action").String(), v1.PanicActionRestart, v1.PanicActionPause),
+			Field:   field.Child("action").String(),
+		})
+	}
+	if panicPolicy.MemoryDumpClaimName != "" {
+		if errors := validation.IsDNS1123Subdomain(panicPolicy.MemoryDumpClaimName); len(errors) != 0 {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s is not a valid claim name: %s", field.Child("memoryDumpClaimName").String(), strings.Join(errors, ", ")),
+				Field:   field.Child("memoryDumpClaimName").String(),
+			})
+		}
+	}
+	return causes
+}
+
+func validateFreezeHookCommand(field *k8sfield.Path, hook *v1.FreezeHookCommand) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if hook == nil {
+		return causes
+	}
+
+	if hook.Command == "" {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Message: fmt.Sprintf("%s must not be empty", field.Child("command").String()),
+			Field:   field.Child("command").String(),
+		})
+	}
+	if hook.TimeoutSeconds != nil && (*hook.TimeoutSeconds < 1 || *hook.TimeoutSeconds > v1.GuestExecMaxTimeoutSeconds) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s must be between 1 and %d", field.Child("timeoutSeconds").String(), v1.GuestExecMaxTimeoutSeconds),
+			Field:   field.Child("timeoutSeconds").String(),
+		})
+	}
+	return causes
+}
+
+func validateGraphics(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	graphics := spec.Domain.Devices.Graphics
+	if graphics == nil {
+		return causes
+	}
+	graphicsField := field.Child("domain", "devices", "graphics")
+
+	if autoattach := spec.Domain.Devices.AutoattachGraphicsDevice; autoattach != nil && !*autoattach {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "graphics can't be configured when autoattachGraphicsDevice is disabled",
+			Field:   graphicsField.String(),
+		})
+	}
+
+	if graphics.VNC != nil && graphics.SPICE != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: "only one of vnc or spice may be specified",
+			Field:   graphicsField.String(),
+		})
+	}
+
+	spice := graphics.SPICE
+	if spice == nil {
+		return causes
+	}
+
+	if !config.SPICEGraphicsEnabled() {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s feature gate is not enabled in kubevirt-config", featuregate.SPICEGraphicsGate),
+			Field:   graphicsField.Child("spice").String(),
+		})
+	}
+
+	if spice.Heads != nil && (*spice.Heads < 1 || *spice.Heads > v1.SPICEGraphicsMaxHeads) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("spice heads must be between 1 and %d", v1.SPICEGraphicsMaxHeads),
+			Field:   graphicsField.Child("spice", "heads").String(),
+		})
+	}
+
+	switch spice.VideoModel {
+	case "", v1.SPICEVideoModelQXL, v1.SPICEVideoModelVirtIO:
+	default:
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Message: fmt.Sprintf("spice video model is not supported. Options: '%s' or '%s'", v1.SPICEVideoModelQXL, v1.SPICEVideoModelVirtIO),
+			Field:   graphicsField.Child("spice", "videoModel").String(),
+		})
+	}
+
+	return causes
+}
+
 func validateLaunchSecurity(field *k8sfield.Path, spec *v1.VirtualMachineInstanceSpec, config *virtconfig.ClusterConfig) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	launchSecurity := spec.Domain.LaunchSecurity
@@ -835,6 +992,15 @@ func validateStartStrategy(field *k8sfield.Path, spec *v1.VirtualMachineInstance
 			),
 			Field: field.Child("startStrategy").String(),
 		})
+	} else if spec.StartupProbe != nil {
+		causes = append(causes, metav1.StatusCause{
+			Type: metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("either %s or %s should be provided.Pausing VMI with StartupProbe is not supported",
+				field.Child("startStrategy").String(),
+				field.Child("startupProbe").String(),
+			),
+			Field: field.Child("startStrategy").String(),
+		})
 	}
 
 	return causes
@@ -1409,6 +1575,41 @@ func validateBootloader(field *k8sfield.Path, bootloader *v1.Bootloader) []metav
 		})
 	}
 
+	if bootloader != nil && bootloader.EFI != nil {
+		causes = append(causes, validateEFIVars(field.Child("efi"), bootloader.EFI)...)
+	}
+
+	return causes
+}
+
+func validateEFIVars(field *k8sfield.Path, efi *v1.EFI) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+
+	sources := []struct {
+		field  *k8sfield.Path
+		source *v1.EFIVarsSource
+	}{
+		{field.Child("secureBootKeys"), efi.SecureBootKeys},
+		{field.Child("varsTemplate"), efi.VarsTemplate},
+	}
+	for _, s := range sources {
+		if s.source != nil && (s.source.Secret == nil) == (s.source.ConfigMap == nil) {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must reference exactly one of a Secret or a ConfigMap.", s.field.String()),
+				Field:   s.field.String(),
+			})
+		}
+	}
+
+	if efi.SecureBootKeys != nil && efi.SecureBoot != nil && !*efi.SecureBoot {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s requires SecureBoot to be enabled.", field.Child("secureBootKeys").String()),
+			Field:   field.Child("secureBootKeys").String(),
+		})
+	}
+
 	return causes
 }
 
@@ -2129,6 +2330,52 @@ func validateErrorPolicy(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.S
 	return causes
 }
 
+func validateDiskIOTune(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
+	var causes []metav1.StatusCause
+	if disk.IOTune == nil {
+		return causes
+	}
+	ioTuneField := field.Index(idx).Child("ioTune")
+	ioTune := disk.IOTune
+
+	limits := []struct {
+		name     string
+		negative bool
+	}{
+		{"totalBytesPerSec", ioTune.TotalBytesPerSec != nil && ioTune.TotalBytesPerSec.Sign() < 0},
+		{"readBytesPerSec", ioTune.ReadBytesPerSec != nil && ioTune.ReadBytesPerSec.Sign() < 0},
+		{"writeBytesPerSec", ioTune.WriteBytesPerSec != nil && ioTune.WriteBytesPerSec.Sign() < 0},
+		{"totalIOPS", ioTune.TotalIOPS != nil && *ioTune.TotalIOPS < 0},
+		{"readIOPS", ioTune.ReadIOPS != nil && *ioTune.ReadIOPS < 0},
+		{"writeIOPS", ioTune.WriteIOPS != nil && *ioTune.WriteIOPS < 0},
+	}
+	for _, limit := range limits {
+		if limit.negative {
+			causes = append(causes, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Message: fmt.Sprintf("%s must not be negative", ioTuneField.Child(limit.name).String()),
+				Field:   ioTuneField.Child(limit.name).String(),
+			})
+		}
+	}
+
+	if ioTune.TotalBytesPerSec != nil && (ioTune.ReadBytesPerSec != nil || ioTune.WriteBytesPerSec != nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s cannot be combined with readBytesPerSec or writeBytesPerSec", ioTuneField.Child("totalBytesPerSec").String()),
+			Field:   ioTuneField.Child("totalBytesPerSec").String(),
+		})
+	}
+	if ioTune.TotalIOPS != nil && (ioTune.ReadIOPS != nil || ioTune.WriteIOPS != nil) {
+		causes = append(causes, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Message: fmt.Sprintf("%s cannot be combined with readIOPS or writeIOPS", ioTuneField.Child("totalIOPS").String()),
+			Field:   ioTuneField.Child("totalIOPS").String(),
+		})
+	}
+	return causes
+}
+
 func validateDiskNameAsContainerName(field *k8sfield.Path, idx int, disk v1.Disk) []metav1.StatusCause {
 	var causes []metav1.StatusCause
 	for _, err := range validation.IsDNS1123Label(disk.Name) {
@@ -2206,6 +2453,7 @@ func validateDisks(field *k8sfield.Path, disks []v1.Disk) []metav1.StatusCause {
 		causes = append(causes, validateCacheMode(field, idx, disk)...)
 		causes = append(causes, validateIOMode(field, idx, disk)...)
 		causes = append(causes, validateErrorPolicy(field, idx, disk)...)
+		causes = append(causes, validateDiskIOTune(field, idx, disk)...)
 		// Verify disk and volume name can be a valid container name since disk
 		// name can become a container name which will fail to schedule if invalid
 		causes = append(causes, validateDiskNameAsContainerName(field, idx, disk)...)
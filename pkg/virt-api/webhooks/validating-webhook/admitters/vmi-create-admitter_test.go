@@ -38,6 +38,7 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
 	k8sfield "k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/client-go/tools/cache"
 
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/api"
@@ -47,6 +48,7 @@ import (
 	libvmici "kubevirt.io/kubevirt/pkg/libvmi/cloudinit"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
@@ -124,6 +126,39 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		Expect(resp.Result.Details.Causes).To(Equal(expectedStatusCauses))
 	})
 
+	DescribeTable("should enforce the VirtualMachineQuota", func(vcpus int64, expectedAllowed bool) {
+		quota := &v1.VirtualMachineQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: metav1.NamespaceDefault},
+			Spec: v1.VirtualMachineQuotaSpec{
+				Hard: v1.VirtualMachineQuotaResources{VCPUs: pointer.P(vcpus)},
+			},
+		}
+		quotaIndexers := &vmquota.Indexers{
+			Quotas: cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+			VMIs:   cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc}),
+		}
+		Expect(quotaIndexers.Quotas.Add(quota)).To(Succeed())
+		admitter := &VMICreateAdmitter{
+			ClusterConfig:           config,
+			KubeVirtServiceAccounts: kubeVirtServiceAccounts,
+			QuotaIndexers:           quotaIndexers,
+		}
+		vmi := newBaseVmi()
+		vmi.Spec.Domain.CPU = &v1.CPU{Sockets: 2, Cores: 1, Threads: 1}
+		ar, err := newAdmissionReviewForVMICreation(vmi)
+		Expect(err).ToNot(HaveOccurred())
+		ar.Request.Namespace = metav1.NamespaceDefault
+
+		resp := admitter.Admit(context.Background(), ar)
+		Expect(resp.Allowed).To(Equal(expectedAllowed))
+		if !expectedAllowed {
+			Expect(resp.Result.Message).To(ContainSubstring("exceeded VirtualMachineQuota quota: vcpus: requested 2, used 0, limited to 1"))
+		}
+	},
+		Entry("and allow a VMI within the quota", int64(2), true),
+		Entry("and reject a VMI exceeding the quota", int64(1), false),
+	)
+
 	It("should reject invalid VirtualMachineInstance spec on create", func() {
 		vmi := newBaseVmi()
 		vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
@@ -256,7 +291,7 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 
 			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
 			Expect(resp.Allowed).To(BeFalse())
-			Expect(resp.Result.Message).To(Equal(`either spec.readinessProbe.tcpSocket, spec.readinessProbe.exec or spec.readinessProbe.httpGet must be set if a spec.readinessProbe is specified, either spec.livenessProbe.tcpSocket, spec.livenessProbe.exec or spec.livenessProbe.httpGet must be set if a spec.livenessProbe is specified`))
+			Expect(resp.Result.Message).To(Equal(`either spec.readinessProbe.tcpSocket, spec.readinessProbe.exec, spec.readinessProbe.httpGet, spec.readinessProbe.grpc or spec.readinessProbe.guestAgentPing must be set if a spec.readinessProbe is specified, either spec.livenessProbe.tcpSocket, spec.livenessProbe.exec, spec.livenessProbe.httpGet, spec.livenessProbe.grpc or spec.livenessProbe.guestAgentPing must be set if a spec.livenessProbe is specified`))
 		})
 		It("should reject probes with more than one action per probe configured", func() {
 			vmi := newBaseVmi(
@@ -336,6 +371,64 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(resp.Allowed).To(BeFalse())
 			Expect(resp.Result.Message).To(Equal(`spec.readinessProbe.tcpSocket is only allowed if the Pod Network is attached, spec.livenessProbe.httpGet is only allowed if the Pod Network is attached`))
 		})
+		It("should accept a guest agent ping startup probe together with a grpc liveness probe", func() {
+			vmi := newBaseVmi(
+				libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				withStartupProbe(&v1.Probe{
+					FailureThreshold: 60,
+					Handler: v1.Handler{
+						GuestAgentPing: &v1.GuestAgentPing{},
+					},
+				}),
+				withLivenessProbe(&v1.Probe{
+					Handler: v1.Handler{
+						GRPC: &k8sv1.GRPCAction{Port: 9090},
+					},
+				}),
+			)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
+		It("should reject a startup probe with multiple handlers", func() {
+			vmi := newBaseVmi(
+				libvmi.WithInterface(*v1.DefaultBridgeNetworkInterface()),
+				libvmi.WithNetwork(v1.DefaultPodNetwork()),
+				withStartupProbe(&v1.Probe{
+					Handler: v1.Handler{
+						GRPC:           &k8sv1.GRPCAction{Port: 9090},
+						GuestAgentPing: &v1.GuestAgentPing{},
+					},
+				}),
+			)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(resp.Result.Message).To(Equal(`spec.startupProbe must have exactly one probe type set`))
+		})
+		It("should accept a grpc startup probe if no Pod Network is present", func() {
+			vmi := newBaseVmi(
+				libvmi.WithAutoAttachPodInterface(false),
+				withStartupProbe(&v1.Probe{
+					Handler: v1.Handler{
+						GRPC: &k8sv1.GRPCAction{Port: 9090},
+					},
+				}),
+			)
+
+			ar, err := newAdmissionReviewForVMICreation(vmi)
+			Expect(err).ToNot(HaveOccurred())
+
+			resp := vmiCreateAdmitter.Admit(context.Background(), ar)
+			Expect(resp.Allowed).To(BeTrue())
+		})
 	})
 
 	It("should accept valid vmi spec on create", func() {
@@ -584,6 +677,54 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 			Expect(causes[0].Field).To(Equal("fake.Sound"))
 		})
+		It("should accept valid freeze hooks", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.FreezeHooks = &v1.FreezeHooks{
+				PreFreeze:   &v1.FreezeHookCommand{Command: "/usr/bin/flush-tables", TimeoutSeconds: pointer.P(int32(60))},
+				PostThaw:    &v1.FreezeHookCommand{Command: "/usr/bin/resume-writes"},
+				Mountpoints: []string{"/var/lib/db", "/data"},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+		DescribeTable("should reject invalid freeze hooks", func(freezeHooks *v1.FreezeHooks, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.FreezeHooks = freezeHooks
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("with an empty pre freeze command",
+				&v1.FreezeHooks{PreFreeze: &v1.FreezeHookCommand{}}, "fake.freezeHooks.preFreeze.command"),
+			Entry("with a post thaw timeout exceeding the maximum",
+				&v1.FreezeHooks{PostThaw: &v1.FreezeHookCommand{Command: "/bin/true", TimeoutSeconds: pointer.P(int32(v1.GuestExecMaxTimeoutSeconds + 1))}}, "fake.freezeHooks.postThaw.timeoutSeconds"),
+			Entry("with a zero timeout",
+				&v1.FreezeHooks{PreFreeze: &v1.FreezeHookCommand{Command: "/bin/true", TimeoutSeconds: pointer.P(int32(0))}}, "fake.freezeHooks.preFreeze.timeoutSeconds"),
+			Entry("with an empty mountpoint",
+				&v1.FreezeHooks{Mountpoints: []string{"/data", ""}}, "fake.freezeHooks.mountpoints[1]"),
+			Entry("with a duplicate mountpoint",
+				&v1.FreezeHooks{Mountpoints: []string{"/data", "/data"}}, "fake.freezeHooks.mountpoints[1]"),
+		)
+		It("should accept a valid panic policy", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.PanicPolicy = &v1.PanicPolicy{Action: v1.PanicActionPause, MemoryDumpClaimName: "dump-pvc"}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+		DescribeTable("should reject an invalid panic policy", func(panicPolicy *v1.PanicPolicy, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.PanicPolicy = panicPolicy
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("with an unknown action", &v1.PanicPolicy{Action: "Shutdown"}, "fake.panicPolicy.action"),
+			Entry("with an invalid claim name", &v1.PanicPolicy{MemoryDumpClaimName: "Dump_PVC"}, "fake.panicPolicy.memoryDumpClaimName"),
+		)
 		It("should reject volume with missing disk / file system", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
@@ -1365,6 +1506,21 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes[0].Field).To(Equal("fake.startStrategy"))
 			Expect(causes[0].Message).To(Equal("either fake.startStrategy or fake.livenessProbe should be provided.Pausing VMI with LivenessProbe is not supported"))
 		})
+		It("should reject spec with paused start strategy and StartupProbe", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			strategy := v1.StartStrategyPaused
+			vmi.Spec.StartStrategy = &strategy
+			vmi.Spec.StartupProbe = &v1.Probe{
+				Handler: v1.Handler{
+					GuestAgentPing: &v1.GuestAgentPing{},
+				},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.startStrategy"))
+			Expect(causes[0].Message).To(Equal("either fake.startStrategy or fake.startupProbe should be provided.Pausing VMI with StartupProbe is not supported"))
+		})
 		Context("with kernel boot defined", func() {
 
 			createKernelBoot := func(kernelArgs, initrdPath, kernelPath, image string) *v1.KernelBoot {
@@ -2257,6 +2413,43 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Entry("enospace", v1.DiskErrorPolicyEnospace),
 		)
 
+		DescribeTable("should reject disk with invalid ioTune", func(ioTune *v1.DiskIOTune, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", IOTune: ioTune, DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{}}})
+
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(HaveLen(1))
+			Expect(string(causes[0].Type)).To(Equal("FieldValueInvalid"))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("with negative bytes", &v1.DiskIOTune{ReadBytesPerSec: resource.NewQuantity(-1, resource.BinarySI)}, "fake[0].ioTune.readBytesPerSec"),
+			Entry("with negative IOPS", &v1.DiskIOTune{WriteIOPS: pointer.P(int64(-1))}, "fake[0].ioTune.writeIOPS"),
+			Entry("with total and read bytes", &v1.DiskIOTune{
+				TotalBytesPerSec: resource.NewQuantity(1024, resource.BinarySI),
+				ReadBytesPerSec:  resource.NewQuantity(1024, resource.BinarySI),
+			}, "fake[0].ioTune.totalBytesPerSec"),
+			Entry("with total and write IOPS", &v1.DiskIOTune{
+				TotalIOPS: pointer.P(int64(100)),
+				WriteIOPS: pointer.P(int64(100)),
+			}, "fake[0].ioTune.totalIOPS"),
+		)
+
+		It("should accept a disk with valid ioTune", func() {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.Devices.Disks = append(vmi.Spec.Domain.Devices.Disks, v1.Disk{
+				Name: "testdisk", DiskDevice: v1.DiskDevice{Disk: &v1.DiskTarget{}},
+				IOTune: &v1.DiskIOTune{
+					ReadBytesPerSec:  resource.NewQuantity(10485760, resource.BinarySI),
+					WriteBytesPerSec: resource.NewQuantity(5242880, resource.BinarySI),
+					TotalIOPS:        pointer.P(int64(500)),
+				}})
+
+			causes := validateDisks(k8sfield.NewPath("fake"), vmi.Spec.Domain.Devices.Disks)
+			Expect(causes).To(BeEmpty())
+		})
+
 		It("should reject invalid SN characters", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 			order := uint(1)
@@ -2799,6 +2992,50 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 			Expect(causes).To(HaveLen(1))
 		})
 
+		DescribeTable("should validate the EFI variable store sources", func(efi *v1.EFI, expectedField string) {
+			vmi := api.NewMinimalVMI("testvmi")
+			vmi.Spec.Subdomain = "testsubdomain"
+
+			vmi.Spec.Domain.Features = &v1.Features{
+				SMM: &v1.FeatureState{
+					Enabled: pointer.P(true),
+				},
+			}
+			vmi.Spec.Domain.Firmware = &v1.Firmware{
+				Bootloader: &v1.Bootloader{
+					EFI: efi,
+				},
+			}
+
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			if expectedField == "" {
+				Expect(causes).To(BeEmpty())
+				return
+			}
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("accept secure boot keys from a Secret", &v1.EFI{
+				SecureBootKeys: &v1.EFIVarsSource{Secret: &k8sv1.LocalObjectReference{Name: "keys"}},
+			}, ""),
+			Entry("accept a vars template from a ConfigMap", &v1.EFI{
+				VarsTemplate: &v1.EFIVarsSource{ConfigMap: &k8sv1.LocalObjectReference{Name: "vars"}},
+			}, ""),
+			Entry("reject a source without a reference", &v1.EFI{
+				VarsTemplate: &v1.EFIVarsSource{},
+			}, "fake.domain.firmware.bootloader.efi.varsTemplate"),
+			Entry("reject a source with both a Secret and a ConfigMap", &v1.EFI{
+				SecureBootKeys: &v1.EFIVarsSource{
+					Secret:    &k8sv1.LocalObjectReference{Name: "keys"},
+					ConfigMap: &k8sv1.LocalObjectReference{Name: "keys"},
+				},
+			}, "fake.domain.firmware.bootloader.efi.secureBootKeys"),
+			Entry("reject secure boot keys with SecureBoot disabled", &v1.EFI{
+				SecureBoot:     pointer.P(false),
+				SecureBootKeys: &v1.EFIVarsSource{Secret: &k8sv1.LocalObjectReference{Name: "keys"}},
+			}, "fake.domain.firmware.bootloader.efi.secureBootKeys"),
+		)
+
 		It("should reject disk without a valid DNS-1123 name", func() {
 			vmi := api.NewMinimalVMI("testvmi")
 
@@ -3079,6 +3316,58 @@ var _ = Describe("Validating VMICreate Admitter", func() {
 		})
 	})
 
+	Context("with graphics defined", func() {
+		var vmi *v1.VirtualMachineInstance
+		BeforeEach(func() {
+			vmi = api.NewMinimalVMI("testvmi")
+			enableFeatureGate(featuregate.SPICEGraphicsGate)
+		})
+
+		It("should accept vnc graphics", func() {
+			vmi.Spec.Domain.Devices.Graphics = &v1.GraphicsDevice{VNC: &v1.VNCGraphics{}}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		})
+
+		DescribeTable("should accept spice graphics", func(spice *v1.SPICEGraphics) {
+			vmi.Spec.Domain.Devices.Graphics = &v1.GraphicsDevice{SPICE: spice}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(BeEmpty())
+		},
+			Entry("with defaults", &v1.SPICEGraphics{}),
+			Entry("with multiple heads", &v1.SPICEGraphics{Heads: pointer.P(uint32(v1.SPICEGraphicsMaxHeads))}),
+			Entry("with virtio video model", &v1.SPICEGraphics{VideoModel: v1.SPICEVideoModelVirtIO}),
+		)
+
+		DescribeTable("should reject invalid graphics", func(graphics *v1.GraphicsDevice, expectedField string) {
+			vmi.Spec.Domain.Devices.Graphics = graphics
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(expectedField))
+		},
+			Entry("with vnc and spice", &v1.GraphicsDevice{VNC: &v1.VNCGraphics{}, SPICE: &v1.SPICEGraphics{}}, "fake.domain.devices.graphics"),
+			Entry("with zero heads", &v1.GraphicsDevice{SPICE: &v1.SPICEGraphics{Heads: pointer.P(uint32(0))}}, "fake.domain.devices.graphics.spice.heads"),
+			Entry("with too many heads", &v1.GraphicsDevice{SPICE: &v1.SPICEGraphics{Heads: pointer.P(uint32(v1.SPICEGraphicsMaxHeads + 1))}}, "fake.domain.devices.graphics.spice.heads"),
+			Entry("with unknown video model", &v1.GraphicsDevice{SPICE: &v1.SPICEGraphics{VideoModel: "cirrus"}}, "fake.domain.devices.graphics.spice.videoModel"),
+		)
+
+		It("should reject graphics when the graphics device is not attached", func() {
+			vmi.Spec.Domain.Devices.AutoattachGraphicsDevice = pointer.P(false)
+			vmi.Spec.Domain.Devices.Graphics = &v1.GraphicsDevice{VNC: &v1.VNCGraphics{}}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal("fake.domain.devices.graphics"))
+		})
+
+		It("should reject spice graphics when the feature gate is disabled", func() {
+			disableFeatureGates()
+			vmi.Spec.Domain.Devices.Graphics = &v1.GraphicsDevice{SPICE: &v1.SPICEGraphics{}}
+			causes := ValidateVirtualMachineInstanceSpec(k8sfield.NewPath("fake"), &vmi.Spec, config)
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Message).To(ContainSubstring(fmt.Sprintf("%s feature gate is not enabled", featuregate.SPICEGraphicsGate)))
+		})
+	})
+
 	Context("with affinity checks", func() {
 		var vmi *v1.VirtualMachineInstance
 		BeforeEach(func() {
@@ -4163,6 +4452,12 @@ func withLivenessProbe(probe *v1.Probe) libvmi.Option {
 	}
 }
 
+func withStartupProbe(probe *v1.Probe) libvmi.Option {
+	return func(vmi *v1.VirtualMachineInstance) {
+		vmi.Spec.StartupProbe = probe
+	}
+}
+
 func newValidateStub(statusCauses ...metav1.StatusCause) SpecValidator {
 	return func(_ *k8sfield.Path, _ *v1.VirtualMachineInstanceSpec, _ *virtconfig.ClusterConfig) []metav1.StatusCause {
 		return statusCauses
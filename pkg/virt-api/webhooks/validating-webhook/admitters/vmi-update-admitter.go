@@ -321,6 +321,8 @@ func getDiskMap(disks []v1.Disk) map[string]v1.Disk {
 	newDiskMap := make(map[string]v1.Disk, 0)
 	for _, disk := range disks {
 		if disk.Name != "" {
+			// I/O limits can be updated live and are not part of the comparison
+			disk.IOTune = nil
 			newDiskMap[disk.Name] = disk
 		}
 	}
@@ -27,6 +27,7 @@ import (
 	preferencewebhooks "kubevirt.io/kubevirt/pkg/instancetype/preference/webhooks"
 	instancetypewebhooks "kubevirt.io/kubevirt/pkg/instancetype/webhooks"
 	storageAdmitters "kubevirt.io/kubevirt/pkg/storage/admitters"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-api/webhooks/validating-webhook/admitters"
@@ -37,6 +38,7 @@ func ServeVMICreate(
 	resp http.ResponseWriter,
 	req *http.Request,
 	clusterConfig *virtconfig.ClusterConfig,
+	quotaIndexers *vmquota.Indexers,
 	kubeVirtServiceAccounts map[string]struct{},
 	specValidators ...admitters.SpecValidator,
 ) {
@@ -44,6 +46,7 @@ func ServeVMICreate(
 		ClusterConfig:           clusterConfig,
 		KubeVirtServiceAccounts: kubeVirtServiceAccounts,
 		SpecValidators:          specValidators,
+		QuotaIndexers:           quotaIndexers,
 	})
 }
 
@@ -74,8 +77,8 @@ func ServeVMIPreset(resp http.ResponseWriter, req *http.Request) {
 	validating_webhooks.Serve(resp, req, &admitters.VMIPresetAdmitter{})
 }
 
-func ServeMigrationCreate(resp http.ResponseWriter, req *http.Request, virtCli kubecli.KubevirtClient) {
-	validating_webhooks.Serve(resp, req, admitters.NewMigrationCreateAdmitter(virtCli.GeneratedKubeVirtClient()))
+func ServeMigrationCreate(resp http.ResponseWriter, req *http.Request, virtCli kubecli.KubevirtClient, quotaIndexers *vmquota.Indexers, kubeVirtServiceAccounts map[string]struct{}) {
+	validating_webhooks.Serve(resp, req, admitters.NewMigrationCreateAdmitter(virtCli.GeneratedKubeVirtClient(), quotaIndexers, kubeVirtServiceAccounts))
 }
 
 func ServeMigrationUpdate(resp http.ResponseWriter, req *http.Request) {
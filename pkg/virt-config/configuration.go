@@ -281,6 +281,11 @@ type ClusterConfig struct {
 	lastInvalidConfigResourceVersion string
 	lastValidConfigResourceVersion   string
 	configModifiedCallback           []ConfigModifiedFn
+	namespaceConfigIndexer           cache.Indexer
+	namespaceConfigCache             map[string]*namespaceConfigCacheEntry
+	// namespaceConfig is set on the configurations returned by ForNamespace, it holds the
+	// cluster-wide configuration merged with the overrides of the namespace
+	namespaceConfig *v1.KubeVirtConfiguration
 }
 
 func (c *ClusterConfig) SetConfigModifiedCallback(cb ConfigModifiedFn) {
@@ -318,6 +323,10 @@ func setConfigFromKubeVirt(config *v1.KubeVirtConfiguration, kv *v1.KubeVirt) er
 // XXX Rework this, to happen mostly in informer callbacks.
 // This will also allow us then to react to config changes and e.g. restart some controllers
 func (c *ClusterConfig) GetConfig() (config *v1.KubeVirtConfiguration) {
+	if c.namespaceConfig != nil {
+		return c.namespaceConfig
+	}
+
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
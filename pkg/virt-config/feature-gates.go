@@ -172,3 +172,19 @@ func (config *ClusterConfig) AlignCPUsEnabled() bool {
 func (config *ClusterConfig) NodeRestrictionEnabled() bool {
 	return config.isFeatureGateEnabled(featuregate.NodeRestrictionGate)
 }
+
+func (config *ClusterConfig) VNCTokenAccessEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.VNCTokenAccessGate)
+}
+
+func (config *ClusterConfig) SPICEGraphicsEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.SPICEGraphicsGate)
+}
+
+func (config *ClusterConfig) GuestAgentFileTransferEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestAgentFileTransferGate)
+}
+
+func (config *ClusterConfig) GuestExecEnabled() bool {
+	return config.isFeatureGateEnabled(featuregate.GuestExecGate)
+}
@@ -66,6 +66,21 @@ const (
 
 	VirtIOFSConfigVolumesGate = "EnableVirtioFsConfigVolumes"
 	VirtIOFSStorageVolumeGate = "EnableVirtioFsStorageVolumes"
+
+	// VNCTokenAccess allows requesting short-lived tokens which grant access to the VNC console of a
+	// VirtualMachineInstance through the unauthenticated, noVNC compatible vncproxy endpoint of virt-api.
+	VNCTokenAccessGate = "VNCTokenAccess"
+
+	// SPICEGraphics allows selecting SPICE instead of VNC as remote display protocol of a VirtualMachineInstance.
+	SPICEGraphicsGate = "SPICEGraphics"
+
+	// GuestAgentFileTransfer allows reading and writing files inside the guest through the guest agent
+	// using the guestfile subresources of a VirtualMachineInstance.
+	GuestAgentFileTransferGate = "GuestAgentFileTransfer"
+
+	// GuestExec allows executing commands inside the guest through the guest agent
+	// using the guestexec subresource of a VirtualMachineInstance.
+	GuestExecGate = "GuestExec"
 )
 
 func init() {
@@ -92,4 +107,8 @@ func init() {
 	RegisterFeatureGate(FeatureGate{Name: InstancetypeReferencePolicy, State: Beta})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSConfigVolumesGate, State: Alpha})
 	RegisterFeatureGate(FeatureGate{Name: VirtIOFSStorageVolumeGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: VNCTokenAccessGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: SPICEGraphicsGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestAgentFileTransferGate, State: Alpha})
+	RegisterFeatureGate(FeatureGate{Name: GuestExecGate, State: Alpha})
 }
@@ -0,0 +1,249 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virtconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"sync"
+
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+// NewNamespaceConfigInformer returns an informer for the KubeVirtNamespaceConfigs of all namespaces, indexed by namespace
+func NewNamespaceConfigInformer(restClient cache.Getter) cache.SharedIndexInformer {
+	lw := cache.NewListWatchFromClient(restClient, "kubevirtnamespaceconfigs", k8sv1.NamespaceAll, fields.Everything())
+	return cache.NewSharedIndexInformer(lw, &v1.KubeVirtNamespaceConfig{}, 0, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+}
+
+// SetNamespaceConfigInformer makes ForNamespace merge the KubeVirtNamespaceConfigs of the informer over the
+// cluster-wide configuration
+func (c *ClusterConfig) SetNamespaceConfigInformer(namespaceConfigInformer cache.SharedIndexInformer) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.namespaceConfigIndexer = namespaceConfigInformer.GetIndexer()
+	c.namespaceConfigCache = map[string]*namespaceConfigCacheEntry{}
+}
+
+// namespaceConfigCacheEntry holds the configuration ForNamespace returned for a namespace, it stays valid as long
+// as neither the cluster-wide configuration nor the KubeVirtNamespaceConfigs of the namespace change
+type namespaceConfigCacheEntry struct {
+	clusterConfig    *v1.KubeVirtConfiguration
+	resourceVersions []string
+	config           *ClusterConfig
+}
+
+func (e *namespaceConfigCacheEntry) matches(clusterConfig *v1.KubeVirtConfiguration, resourceVersions []string) bool {
+	if e.clusterConfig != clusterConfig || len(e.resourceVersions) != len(resourceVersions) {
+		return false
+	}
+	for i := range resourceVersions {
+		if e.resourceVersions[i] != resourceVersions[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// ForNamespace returns the configuration which applies to VMIs in the given namespace. The allowed overrides of
+// the KubeVirtNamespaceConfigs in the namespace are merged over the cluster-wide configuration in the order of
+// their names. Without applicable overrides the cluster-wide configuration itself is returned.
+// The result is cached until the cluster-wide configuration or the KubeVirtNamespaceConfigs of the namespace change.
+func (c *ClusterConfig) ForNamespace(namespace string) *ClusterConfig {
+	if c.namespaceConfig != nil {
+		return c
+	}
+
+	c.lock.Lock()
+	indexer := c.namespaceConfigIndexer
+	c.lock.Unlock()
+	if indexer == nil {
+		return c
+	}
+
+	objs, err := indexer.ByIndex(cache.NamespaceIndex, namespace)
+	if err != nil {
+		log.DefaultLogger().Reason(err).Errorf("Failed to look up the KubeVirtNamespaceConfigs of namespace %s, using the cluster-wide configuration", namespace)
+		return c
+	}
+	config := c.GetConfig()
+	if len(objs) == 0 || config.NamespaceConfiguration == nil || len(config.NamespaceConfiguration.AllowedOverrides) == 0 {
+		c.lock.Lock()
+		delete(c.namespaceConfigCache, namespace)
+		c.lock.Unlock()
+		return c
+	}
+
+	namespaceConfigs := make([]*v1.KubeVirtNamespaceConfig, 0, len(objs))
+	for _, obj := range objs {
+		namespaceConfigs = append(namespaceConfigs, obj.(*v1.KubeVirtNamespaceConfig))
+	}
+	sort.Slice(namespaceConfigs, func(i, j int) bool {
+		return namespaceConfigs[i].Name < namespaceConfigs[j].Name
+	})
+	resourceVersions := make([]string, 0, len(namespaceConfigs))
+	for _, namespaceConfig := range namespaceConfigs {
+		resourceVersions = append(resourceVersions, namespaceConfig.Name+"/"+namespaceConfig.ResourceVersion)
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if entry, exists := c.namespaceConfigCache[namespace]; exists && entry.matches(config, resourceVersions) {
+		return entry.config
+	}
+	namespaceClusterConfig := c.mergeNamespaceConfigs(config, namespaceConfigs)
+	c.namespaceConfigCache[namespace] = &namespaceConfigCacheEntry{
+		clusterConfig:    config,
+		resourceVersions: resourceVersions,
+		config:           namespaceClusterConfig,
+	}
+	return namespaceClusterConfig
+}
+
+func (c *ClusterConfig) mergeNamespaceConfigs(config *v1.KubeVirtConfiguration, namespaceConfigs []*v1.KubeVirtNamespaceConfig) *ClusterConfig {
+	var err error
+	merged := config
+	for _, namespaceConfig := range namespaceConfigs {
+		if merged, err = MergeNamespaceConfig(merged, &namespaceConfig.Spec); err != nil {
+			log.DefaultLogger().Object(namespaceConfig).Reason(err).Error("Ignoring invalid KubeVirtNamespaceConfig")
+			return c
+		}
+	}
+	if merged == config {
+		return c
+	}
+
+	return &ClusterConfig{
+		crdStore:        c.crdStore,
+		kubeVirtStore:   c.kubeVirtStore,
+		namespace:       c.namespace,
+		cpuArch:         c.cpuArch,
+		lock:            &sync.Mutex{},
+		lastValidConfig: merged,
+		defaultConfig:   c.defaultConfig,
+		namespaceConfig: merged,
+	}
+}
+
+// MergeNamespaceConfig merges the overrides of the spec which the configuration allows over a copy of the
+// configuration. The configuration itself is returned if no override applies. Settings which stay under the
+// control of the cluster admin are dropped from the overrides, see stripClusterOnlyOverrides.
+func MergeNamespaceConfig(config *v1.KubeVirtConfiguration, spec *v1.KubeVirtNamespaceConfigSpec) (*v1.KubeVirtConfiguration, error) {
+	overrides, err := namespaceOverrides(stripClusterOnlyOverrides(spec))
+	if err != nil {
+		return nil, err
+	}
+	for field := range overrides {
+		if !namespaceOverrideAllowed(config, field) {
+			delete(overrides, field)
+		}
+	}
+	if len(overrides) == 0 {
+		return config, nil
+	}
+
+	overrideBytes, err := json.Marshal(overrides)
+	if err != nil {
+		return nil, err
+	}
+	// like the cluster-wide configuration, the overrides are unmarshalled over the defaults, nested fields which
+	// are not set keep their value
+	merged := config.DeepCopy()
+	if err := json.Unmarshal(overrideBytes, merged); err != nil {
+		return nil, err
+	}
+	if err := validateConfig(merged); err != nil {
+		return nil, err
+	}
+	return merged, nil
+}
+
+// NamespaceOverrides returns the JSON names of the fields which are set in the spec
+func NamespaceOverrides(spec *v1.KubeVirtNamespaceConfigSpec) ([]string, error) {
+	overrides, err := namespaceOverrides(spec)
+	if err != nil {
+		return nil, err
+	}
+	fields := make([]string, 0, len(overrides))
+	for field := range overrides {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+	return fields, nil
+}
+
+// IgnoredNamespaceOverrides returns the overridden fields which the configuration does not allow to override
+func IgnoredNamespaceOverrides(config *v1.KubeVirtConfiguration, fields []string) []string {
+	var ignored []string
+	for _, field := range fields {
+		if !namespaceOverrideAllowed(config, field) {
+			ignored = append(ignored, field)
+		}
+	}
+	return ignored
+}
+
+func namespaceOverrideAllowed(config *v1.KubeVirtConfiguration, field string) bool {
+	if config.NamespaceConfiguration == nil {
+		return false
+	}
+	for _, allowed := range config.NamespaceConfiguration.AllowedOverrides {
+		if string(allowed) == field {
+			return true
+		}
+	}
+	return false
+}
+
+// stripClusterOnlyOverrides returns a copy of the spec without the settings which can't be overridden per
+// namespace even if their parent field can: they weaken the security of migrations, lift the cluster-wide
+// migration limits or, like the sidecar images of the binding plugins, make the nodes run arbitrary images.
+func stripClusterOnlyOverrides(spec *v1.KubeVirtNamespaceConfigSpec) *v1.KubeVirtNamespaceConfigSpec {
+	spec = spec.DeepCopy()
+	if migrations := spec.MigrationConfiguration; migrations != nil {
+		migrations.DisableTLS = nil
+		migrations.UnsafeMigrationOverride = nil
+		migrations.AllowPostCopy = nil
+		migrations.ParallelMigrationsPerCluster = nil
+		migrations.ParallelOutboundMigrationsPerNode = nil
+	}
+	if spec.NetworkConfiguration != nil {
+		spec.NetworkConfiguration.Binding = nil
+	}
+	return spec
+}
+
+func namespaceOverrides(spec *v1.KubeVirtNamespaceConfigSpec) (map[string]json.RawMessage, error) {
+	specBytes, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+	overrides := map[string]json.RawMessage{}
+	if err := json.Unmarshal(specBytes, &overrides); err != nil {
+		return nil, fmt.Errorf("failed to decode the namespace config overrides: %v", err)
+	}
+	return overrides, nil
+}
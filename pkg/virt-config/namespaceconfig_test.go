@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virtconfig_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+)
+
+var _ = Describe("Namespace configuration", func() {
+	const tenant = "tenant"
+
+	var (
+		clusterConfig           *virtconfig.ClusterConfig
+		namespaceConfigInformer cache.SharedIndexInformer
+	)
+
+	newClusterConfig := func(allowed ...v1.NamespaceConfigOverride) {
+		clusterConfig, _, _ = testutils.NewFakeClusterConfigUsingKVConfig(&v1.KubeVirtConfiguration{
+			CPUModel: "Skylake-Server",
+			MigrationConfiguration: &v1.MigrationConfiguration{
+				BandwidthPerMigration: pointer.P(resource.MustParse("64Mi")),
+			},
+			NamespaceConfiguration: &v1.NamespaceConfiguration{AllowedOverrides: allowed},
+		})
+		clusterConfig.SetNamespaceConfigInformer(namespaceConfigInformer)
+	}
+
+	addNamespaceConfig := func(namespace, name string, spec v1.KubeVirtNamespaceConfigSpec) {
+		Expect(namespaceConfigInformer.GetStore().Add(&v1.KubeVirtNamespaceConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec:       spec,
+		})).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		namespaceConfigInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.KubeVirtNamespaceConfig{},
+			cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	})
+
+	It("should merge the allowed overrides over the cluster-wide configuration", func() {
+		newClusterConfig(v1.NamespaceConfigOverrideMigrations, v1.NamespaceConfigOverrideCPUModel)
+		addNamespaceConfig(tenant, "defaults", v1.KubeVirtNamespaceConfigSpec{
+			CPUModel: "Haswell",
+			MigrationConfiguration: &v1.MigrationConfiguration{
+				BandwidthPerMigration: pointer.P(resource.MustParse("16Mi")),
+			},
+		})
+
+		config := clusterConfig.ForNamespace(tenant)
+		Expect(config.GetCPUModel()).To(Equal("Haswell"))
+		migrationConfig := config.GetMigrationConfiguration()
+		Expect(migrationConfig.BandwidthPerMigration.String()).To(Equal("16Mi"))
+		Expect(migrationConfig.ParallelMigrationsPerCluster).To(HaveValue(Equal(virtconfig.ParallelMigrationsPerClusterDefault)))
+
+		Expect(clusterConfig.GetCPUModel()).To(Equal("Skylake-Server"))
+		Expect(clusterConfig.GetMigrationConfiguration().BandwidthPerMigration.String()).To(Equal("64Mi"))
+	})
+
+	It("should ignore overrides which are not allowed", func() {
+		newClusterConfig(v1.NamespaceConfigOverrideCPUModel)
+		addNamespaceConfig(tenant, "defaults", v1.KubeVirtNamespaceConfigSpec{
+			CPUModel:         "Haswell",
+			EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate),
+		})
+
+		config := clusterConfig.ForNamespace(tenant)
+		Expect(config.GetCPUModel()).To(Equal("Haswell"))
+		Expect(config.GetConfig().EvictionStrategy).To(HaveValue(Equal(v1.EvictionStrategyNone)))
+	})
+
+	It("should apply the namespace configs in the order of their names", func() {
+		newClusterConfig(v1.NamespaceConfigOverrideCPUModel)
+		addNamespaceConfig(tenant, "b", v1.KubeVirtNamespaceConfigSpec{CPUModel: "Haswell"})
+		addNamespaceConfig(tenant, "a", v1.KubeVirtNamespaceConfigSpec{CPUModel: "Broadwell"})
+
+		Expect(clusterConfig.ForNamespace(tenant).GetCPUModel()).To(Equal("Haswell"))
+	})
+
+	It("should use the cluster-wide configuration without applicable overrides", func() {
+		newClusterConfig(v1.NamespaceConfigOverrideCPUModel)
+		addNamespaceConfig(tenant, "defaults", v1.KubeVirtNamespaceConfigSpec{
+			EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate),
+		})
+
+		Expect(clusterConfig.ForNamespace(tenant)).To(BeIdenticalTo(clusterConfig))
+		Expect(clusterConfig.ForNamespace("other")).To(BeIdenticalTo(clusterConfig))
+	})
+
+	It("should ignore invalid overrides", func() {
+		newClusterConfig(v1.NamespaceConfigOverrideNetwork)
+		addNamespaceConfig(tenant, "defaults", v1.KubeVirtNamespaceConfigSpec{
+			NetworkConfiguration: &v1.NetworkConfiguration{NetworkInterface: "invalid"},
+		})
+
+		Expect(clusterConfig.ForNamespace(tenant)).To(BeIdenticalTo(clusterConfig))
+	})
+
+	It("should not merge the settings which stay under the control of the cluster admin", func() {
+		newClusterConfig(v1.NamespaceConfigOverrideMigrations, v1.NamespaceConfigOverrideNetwork)
+		addNamespaceConfig(tenant, "defaults", v1.KubeVirtNamespaceConfigSpec{
+			MigrationConfiguration: &v1.MigrationConfiguration{
+				BandwidthPerMigration:             pointer.P(resource.MustParse("16Mi")),
+				DisableTLS:                        pointer.P(true),
+				UnsafeMigrationOverride:           pointer.P(true),
+				AllowPostCopy:                     pointer.P(true),
+				ParallelMigrationsPerCluster:      pointer.P(uint32(100)),
+				ParallelOutboundMigrationsPerNode: pointer.P(uint32(100)),
+			},
+			NetworkConfiguration: &v1.NetworkConfiguration{
+				Binding: map[string]v1.InterfaceBindingPlugin{"custom": {SidecarImage: "registry.tenant/sidecar"}},
+			},
+		})
+
+		config := clusterConfig.ForNamespace(tenant)
+		migrationConfig := config.GetMigrationConfiguration()
+		Expect(migrationConfig.BandwidthPerMigration.String()).To(Equal("16Mi"))
+		Expect(migrationConfig.DisableTLS).To(BeNil())
+		Expect(migrationConfig.UnsafeMigrationOverride).To(HaveValue(BeFalse()))
+		Expect(migrationConfig.AllowPostCopy).To(HaveValue(BeFalse()))
+		Expect(migrationConfig.ParallelMigrationsPerCluster).To(HaveValue(Equal(virtconfig.ParallelMigrationsPerClusterDefault)))
+		Expect(migrationConfig.ParallelOutboundMigrationsPerNode).To(HaveValue(Equal(virtconfig.ParallelOutboundMigrationsPerNodeDefault)))
+		Expect(config.GetNetworkBindings()).ToNot(HaveKey("custom"))
+	})
+
+	It("should cache the configuration until a namespace config changes", func() {
+		newClusterConfig(v1.NamespaceConfigOverrideCPUModel)
+		addNamespaceConfig(tenant, "defaults", v1.KubeVirtNamespaceConfigSpec{CPUModel: "Haswell"})
+
+		config := clusterConfig.ForNamespace(tenant)
+		Expect(clusterConfig.ForNamespace(tenant)).To(BeIdenticalTo(config))
+
+		Expect(namespaceConfigInformer.GetStore().Update(&v1.KubeVirtNamespaceConfig{
+			ObjectMeta: metav1.ObjectMeta{Name: "defaults", Namespace: tenant, ResourceVersion: "2"},
+			Spec:       v1.KubeVirtNamespaceConfigSpec{CPUModel: "Broadwell"},
+		})).To(Succeed())
+		Expect(clusterConfig.ForNamespace(tenant).GetCPUModel()).To(Equal("Broadwell"))
+	})
+
+	It("should list the overrides which are not allowed", func() {
+		config := &v1.KubeVirtConfiguration{
+			NamespaceConfiguration: &v1.NamespaceConfiguration{
+				AllowedOverrides: []v1.NamespaceConfigOverride{v1.NamespaceConfigOverrideCPUModel},
+			},
+		}
+		overrides, err := virtconfig.NamespaceOverrides(&v1.KubeVirtNamespaceConfigSpec{
+			CPUModel:         "Haswell",
+			EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate),
+			SMBIOSConfig:     &v1.SMBiosConfiguration{Family: "tenant"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(overrides).To(Equal([]string{"cpuModel", "evictionStrategy", "smbios"}))
+		Expect(virtconfig.IgnoredNamespaceOverrides(config, overrides)).To(Equal([]string{"evictionStrategy", "smbios"}))
+	})
+})
@@ -32,6 +32,7 @@ type ContainerSpecRenderer struct {
 	resources         k8sv1.ResourceRequirements
 	liveninessProbe   *k8sv1.Probe
 	readinessProbe    *k8sv1.Probe
+	startupProbe      *k8sv1.Probe
 	ports             []k8sv1.ContainerPort
 	capabilities      *k8sv1.Capabilities
 	args              []string
@@ -65,6 +66,7 @@ func (csr *ContainerSpecRenderer) Render(cmd []string) k8sv1.Container {
 		Env:             csr.envVars(),
 		LivenessProbe:   csr.liveninessProbe,
 		ReadinessProbe:  csr.readinessProbe,
+		StartupProbe:    csr.startupProbe,
 		Args:            csr.args,
 	}
 }
@@ -170,7 +172,7 @@ func WithLivelinessProbe(vmi *v1.VirtualMachineInstance) Option {
 	return func(renderer *ContainerSpecRenderer) {
 		v1.SetDefaults_Probe(vmi.Spec.LivenessProbe)
 		renderer.liveninessProbe = copyProbe(vmi.Spec.LivenessProbe)
-		updateLivenessProbe(vmi, renderer.liveninessProbe)
+		updateProbe(vmi, vmi.Spec.LivenessProbe, renderer.liveninessProbe)
 	}
 }
 
@@ -178,7 +180,15 @@ func WithReadinessProbe(vmi *v1.VirtualMachineInstance) Option {
 	return func(renderer *ContainerSpecRenderer) {
 		v1.SetDefaults_Probe(vmi.Spec.ReadinessProbe)
 		renderer.readinessProbe = copyProbe(vmi.Spec.ReadinessProbe)
-		updateReadinessProbe(vmi, renderer.readinessProbe)
+		updateProbe(vmi, vmi.Spec.ReadinessProbe, renderer.readinessProbe)
+	}
+}
+
+func WithStartupProbe(vmi *v1.VirtualMachineInstance) Option {
+	return func(renderer *ContainerSpecRenderer) {
+		v1.SetDefaults_Probe(vmi.Spec.StartupProbe)
+		renderer.startupProbe = copyProbe(vmi.Spec.StartupProbe)
+		updateProbe(vmi, vmi.Spec.StartupProbe, renderer.startupProbe)
 	}
 }
 
@@ -235,23 +245,15 @@ func containerPortsFromVMI(vmi *v1.VirtualMachineInstance) []k8sv1.ContainerPort
 	return ports
 }
 
-func updateReadinessProbe(vmi *v1.VirtualMachineInstance, computeProbe *k8sv1.Probe) {
-	if vmi.Spec.ReadinessProbe.GuestAgentPing != nil {
+func updateProbe(vmi *v1.VirtualMachineInstance, vmiProbe *v1.Probe, computeProbe *k8sv1.Probe) {
+	switch {
+	case vmiProbe.GuestAgentPing != nil:
 		wrapGuestAgentPingWithVirtProbe(vmi, computeProbe)
-		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
-		return
-	}
-	wrapExecProbeWithVirtProbe(vmi, computeProbe)
-	computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
-}
-
-func updateLivenessProbe(vmi *v1.VirtualMachineInstance, computeProbe *k8sv1.Probe) {
-	if vmi.Spec.LivenessProbe.GuestAgentPing != nil {
-		wrapGuestAgentPingWithVirtProbe(vmi, computeProbe)
-		computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
-		return
+	case vmiProbe.GRPC != nil:
+		wrapGRPCProbeWithVirtProbe(vmi, computeProbe)
+	default:
+		wrapExecProbeWithVirtProbe(vmi, computeProbe)
 	}
-	wrapExecProbeWithVirtProbe(vmi, computeProbe)
 	computeProbe.InitialDelaySeconds = computeProbe.InitialDelaySeconds + LibvirtStartupDelay
 }
 
@@ -12,6 +12,7 @@ import (
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/util"
 )
 
@@ -246,6 +247,63 @@ var _ = Describe("Container spec renderer", func() {
 			})
 		})
 
+		Context("startup probe", func() {
+			It("its pod should feature the same probe but with an additional 10 seconds initial delay", func() {
+				probe := dummyProbe()
+				specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithStartupProbe(
+					vmiWithStartupProbe(probe)))
+				Expect(specRenderer.Render(exampleCommand).StartupProbe).To(Equal(probeWithDelay(probe)))
+			})
+
+			It("should wrap the guest agent ping inside virt-probe", func() {
+				probe := dummyProbe()
+				probe.Handler = v1.Handler{
+					GuestAgentPing: &v1.GuestAgentPing{},
+				}
+				specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithStartupProbe(
+					vmiWithStartupProbe(probe)))
+				Expect(specRenderer.Render(exampleCommand).StartupProbe.Exec.Command).To(HaveExactElements(
+					"virt-probe",
+					"--domainName", "_",
+					"--timeoutSeconds", strconv.FormatInt(int64(dummyProbe().TimeoutSeconds), 10),
+					"--guestAgentPing"))
+			})
+		})
+
+		Context("grpc probe", func() {
+			It("should run the health check inside the guest via virt-probe", func() {
+				probe := dummyProbe()
+				probe.Handler = v1.Handler{
+					GRPC: &k8sv1.GRPCAction{Port: 9090, Service: pointer.P("health")},
+				}
+				specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithReadinessProbe(
+					vmiWithReadinessProbe(probe)))
+				readinessProbe := specRenderer.Render(exampleCommand).ReadinessProbe
+				Expect(readinessProbe.GRPC).To(BeNil())
+				Expect(readinessProbe.Exec.Command).To(HaveExactElements(
+					"virt-probe",
+					"--domainName", "_",
+					"--timeoutSeconds", strconv.FormatInt(int64(dummyProbe().TimeoutSeconds), 10),
+					"--grpcPort", "9090",
+					"--grpcService", "health"))
+				Expect(readinessProbe.TimeoutSeconds).To(Equal(dummyProbe().TimeoutSeconds + 1))
+			})
+
+			It("should omit the service when it is not set", func() {
+				probe := dummyProbe()
+				probe.Handler = v1.Handler{
+					GRPC: &k8sv1.GRPCAction{Port: 9090},
+				}
+				specRenderer = NewContainerSpecRenderer(containerName, img, pullPolicy, WithLivelinessProbe(
+					vmiWithLivenessProbe(probe)))
+				Expect(specRenderer.Render(exampleCommand).LivenessProbe.Exec.Command).To(HaveExactElements(
+					"virt-probe",
+					"--domainName", "_",
+					"--timeoutSeconds", strconv.FormatInt(int64(dummyProbe().TimeoutSeconds), 10),
+					"--grpcPort", "9090"))
+			})
+		})
+
 		Context("liveness exec probe", func() {
 			It("should wrap the liveness exec probe command inside virt-probe while preserving the original command", func() {
 				probe := dummyProbe()
@@ -394,6 +452,12 @@ func vmiWithLivenessProbe(probe *v1.Probe) *v1.VirtualMachineInstance {
 	return vmi
 }
 
+func vmiWithStartupProbe(probe *v1.Probe) *v1.VirtualMachineInstance {
+	vmi := simplestVMI()
+	vmi.Spec.StartupProbe = probe
+	return vmi
+}
+
 func probeWithDelay(probe *v1.Probe) *k8sv1.Probe {
 	if probe == nil {
 		return nil
@@ -408,6 +472,7 @@ func probeWithDelay(probe *v1.Probe) *k8sv1.Probe {
 			Exec:      probe.Exec,
 			HTTPGet:   probe.HTTPGet,
 			TCPSocket: probe.TCPSocket,
+			GRPC:      probe.GRPC,
 		},
 	}
 }
@@ -628,9 +628,10 @@ var _ = Describe("GetMemoryOverhead calculation", func() {
 	})
 
 	When("the vmi has probes fields", func() {
-		DescribeTable("should add probes overhead", func(livenessProbe, readinessProbe *v1.Probe, probeOverhead resource.Quantity) {
+		DescribeTable("should add probes overhead", func(livenessProbe, readinessProbe, startupProbe *v1.Probe, probeOverhead resource.Quantity) {
 			vmi.Spec.LivenessProbe = livenessProbe
 			vmi.Spec.ReadinessProbe = readinessProbe
+			vmi.Spec.StartupProbe = startupProbe
 			expected := resource.NewScaledQuantity(0, resource.Kilo)
 			expected.Add(*baseOverhead)
 			expected.Add(*staticOverhead)
@@ -641,9 +642,11 @@ var _ = Describe("GetMemoryOverhead calculation", func() {
 			overhead := GetMemoryOverhead(vmi, "amd64", nil)
 			Expect(overhead.Value()).To(BeEquivalentTo(expected.Value()))
 		},
-			Entry("with livenessProbe only", &v1.Probe{Handler: v1.Handler{Exec: &kubev1.ExecAction{}}}, nil, resource.MustParse("110Mi")),
-			Entry("with readinessProbe only", nil, &v1.Probe{Handler: v1.Handler{Exec: &kubev1.ExecAction{}}}, resource.MustParse("110Mi")),
-			Entry("with both readinessProbe adn livenessProbe", &v1.Probe{Handler: v1.Handler{Exec: &kubev1.ExecAction{}}}, &v1.Probe{Handler: v1.Handler{Exec: &kubev1.ExecAction{}}}, resource.MustParse("120Mi")),
+			Entry("with livenessProbe only", &v1.Probe{Handler: v1.Handler{Exec: &kubev1.ExecAction{}}}, nil, nil, resource.MustParse("110Mi")),
+			Entry("with readinessProbe only", nil, &v1.Probe{Handler: v1.Handler{Exec: &kubev1.ExecAction{}}}, nil, resource.MustParse("110Mi")),
+			Entry("with startupProbe only", nil, nil, &v1.Probe{Handler: v1.Handler{Exec: &kubev1.ExecAction{}}}, resource.MustParse("110Mi")),
+			Entry("with grpc startupProbe only", nil, nil, &v1.Probe{Handler: v1.Handler{GRPC: &kubev1.GRPCAction{Port: 9090}}}, resource.MustParse("110Mi")),
+			Entry("with both readinessProbe adn livenessProbe", &v1.Probe{Handler: v1.Handler{Exec: &kubev1.ExecAction{}}}, &v1.Probe{Handler: v1.Handler{Exec: &kubev1.ExecAction{}}}, nil, resource.MustParse("120Mi")),
 		)
 	})
 
@@ -19,6 +19,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/network/downwardapi"
 	"kubevirt.io/kubevirt/pkg/storage/types"
 	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/efi"
 	"kubevirt.io/kubevirt/pkg/virtiofs"
 )
 
@@ -439,6 +440,43 @@ func withBackendStorage(vmi *v1.VirtualMachineInstance, backendStoragePVCName st
 	}
 }
 
+func withEFIVars(vmi *v1.VirtualMachineInstance) VolumeRendererOption {
+	return func(renderer *VolumeRenderer) error {
+		if !vmi.IsBootloaderEFI() {
+			return nil
+		}
+		efiSpec := vmi.Spec.Domain.Firmware.Bootloader.EFI
+
+		sources := []struct {
+			name      string
+			source    *v1.EFIVarsSource
+			mountPath string
+		}{
+			{"efi-secureboot-keys", efiSpec.SecureBootKeys, efi.SecureBootKeysDir},
+			{"efi-vars-template", efiSpec.VarsTemplate, efi.VarsTemplateDir},
+		}
+		for _, s := range sources {
+			if s.source == nil {
+				continue
+			}
+			volumeSource, err := efiVarsVolumeSource(*s.source)
+			if err != nil {
+				return err
+			}
+			renderer.podVolumes = append(renderer.podVolumes, k8sv1.Volume{
+				Name:         s.name,
+				VolumeSource: volumeSource,
+			})
+			renderer.podVolumeMounts = append(renderer.podVolumeMounts, k8sv1.VolumeMount{
+				Name:      s.name,
+				MountPath: s.mountPath,
+				ReadOnly:  true,
+			})
+		}
+		return nil
+	}
+}
+
 func withSidecarVolumes(hookSidecars hooks.HookSidecarList) VolumeRendererOption {
 	return func(renderer *VolumeRenderer) error {
 		if len(hookSidecars) != 0 {
@@ -334,6 +334,65 @@ var _ = Describe("Container spec renderer", func() {
 			Expect(vsr.VolumeDevices()).To(BeEmpty())
 		})
 	})
+
+	Context("with EFI variable store option", func() {
+		BeforeEach(func() {
+			vmi := &v1.VirtualMachineInstance{
+				Spec: v1.VirtualMachineInstanceSpec{
+					Domain: v1.DomainSpec{
+						Firmware: &v1.Firmware{
+							Bootloader: &v1.Bootloader{
+								EFI: &v1.EFI{
+									SecureBootKeys: &v1.EFIVarsSource{Secret: &k8sv1.LocalObjectReference{Name: "keys"}},
+									VarsTemplate:   &v1.EFIVarsSource{ConfigMap: &k8sv1.LocalObjectReference{Name: "vars"}},
+								},
+							},
+						},
+					},
+				},
+			}
+
+			var err error
+			vsr, err = NewVolumeRenderer(namespace, ephemeralDisk, containerDisk, virtShareDir, withEFIVars(vmi))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should feature the default mount points plus the EFI variable store mounts", func() {
+			Expect(vsr.Mounts()).To(ConsistOf(
+				append(
+					defaultVolumeMounts(),
+					k8sv1.VolumeMount{
+						Name:      "efi-secureboot-keys",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/efi/secureboot-keys",
+					},
+					k8sv1.VolumeMount{
+						Name:      "efi-vars-template",
+						ReadOnly:  true,
+						MountPath: "/var/run/kubevirt-private/efi/vars-template",
+					})))
+		})
+
+		It("should feature the default volumes plus the Secret and ConfigMap volumes", func() {
+			Expect(vsr.Volumes()).To(ConsistOf(
+				append(
+					defaultVolumes(),
+					k8sv1.Volume{
+						Name: "efi-secureboot-keys",
+						VolumeSource: k8sv1.VolumeSource{
+							Secret: &k8sv1.SecretVolumeSource{SecretName: "keys"},
+						},
+					},
+					k8sv1.Volume{
+						Name: "efi-vars-template",
+						VolumeSource: k8sv1.VolumeSource{
+							ConfigMap: &k8sv1.ConfigMapVolumeSource{
+								LocalObjectReference: k8sv1.LocalObjectReference{Name: "vars"},
+							},
+						},
+					})))
+		})
+	})
 })
 
 func vmiDiskPath(volumeName string) string {
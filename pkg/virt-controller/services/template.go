@@ -27,6 +27,7 @@ import (
 	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/openshift/library-go/pkg/build/naming"
 	k8sv1 "k8s.io/api/core/v1"
@@ -257,6 +258,25 @@ func sysprepVolumeSource(sysprepVolume v1.SysprepSource) (k8sv1.VolumeSource, er
 	return k8sv1.VolumeSource{}, fmt.Errorf(errorStr)
 }
 
+func efiVarsVolumeSource(source v1.EFIVarsSource) (k8sv1.VolumeSource, error) {
+	if source.Secret != nil {
+		return k8sv1.VolumeSource{
+			Secret: &k8sv1.SecretVolumeSource{
+				SecretName: source.Secret.Name,
+			},
+		}, nil
+	} else if source.ConfigMap != nil {
+		return k8sv1.VolumeSource{
+			ConfigMap: &k8sv1.ConfigMapVolumeSource{
+				LocalObjectReference: k8sv1.LocalObjectReference{
+					Name: source.ConfigMap.Name,
+				},
+			},
+		}, nil
+	}
+	return k8sv1.VolumeSource{}, fmt.Errorf("EFI variable store data must have Secret or ConfigMap reference set %v", source)
+}
+
 func (t *templateService) GetLauncherImage() string {
 	return t.launcherImage
 }
@@ -315,6 +335,17 @@ func (t *templateService) RenderLaunchManifest(vmi *v1.VirtualMachineInstance) (
 	return t.renderLaunchManifest(vmi, nil, backendStoragePVCName, false)
 }
 
+// forNamespace returns a copy of the template service which renders with the configuration of the namespace
+func (t *templateService) forNamespace(namespace string) *templateService {
+	clusterConfig := t.clusterConfig.ForNamespace(namespace)
+	if clusterConfig == t.clusterConfig {
+		return t
+	}
+	namespaceTemplateService := *t
+	namespaceTemplateService.clusterConfig = clusterConfig
+	return &namespaceTemplateService
+}
+
 func (t *templateService) IsPPC64() bool {
 	return t.clusterConfig.GetClusterCPUArch() == "ppc64le"
 }
@@ -358,6 +389,7 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 	precond.MustNotBeNil(vmi)
 	domain := precond.MustNotBeEmpty(vmi.GetObjectMeta().GetName())
 	namespace := precond.MustNotBeEmpty(vmi.GetObjectMeta().GetNamespace())
+	t = t.forNamespace(namespace)
 
 	var userId int64 = util.RootUser
 
@@ -433,6 +465,13 @@ func (t *templateService) renderLaunchManifest(vmi *v1.VirtualMachineInstance, i
 			log.Log.Object(vmi).Infof("Applying custom debug filters for vmi %s: %s", vmi.Name, customDebugFilters)
 			command = append(command, "--libvirt-log-filters", customDebugFilters)
 		}
+		if interval, exists := vmi.Annotations[v1.GuestPatchComplianceIntervalAnnotation]; exists {
+			if d, err := time.ParseDuration(interval); err == nil && d > 0 {
+				command = append(command, "--qemu-agent-patch-compliance-interval", d.String())
+			} else {
+				log.Log.Object(vmi).Warningf("Ignoring invalid guest patch compliance interval %q", interval)
+			}
+		}
 	}
 
 	if t.clusterConfig.AllowEmulation() {
@@ -814,6 +853,10 @@ func (t *templateService) newContainerSpecRenderer(vmi *v1.VirtualMachineInstanc
 		computeContainerOpts = append(computeContainerOpts, WithLivelinessProbe(vmi))
 	}
 
+	if vmi.Spec.StartupProbe != nil {
+		computeContainerOpts = append(computeContainerOpts, WithStartupProbe(vmi))
+	}
+
 	const computeContainerName = "compute"
 	containerRenderer := NewContainerSpecRenderer(
 		computeContainerName, t.launcherImage, t.clusterConfig.GetImagePullPolicy(), computeContainerOpts...)
@@ -826,6 +869,7 @@ func (t *templateService) newVolumeRenderer(vmi *v1.VirtualMachineInstance, name
 		withVMIVolumes(t.persistentVolumeClaimStore, vmi.Spec.Volumes, vmi.Status.VolumeStatus),
 		withAccessCredentials(vmi.Spec.AccessCredentials),
 		withBackendStorage(vmi, backendStoragePVCName),
+		withEFIVars(vmi),
 	}
 	if len(requestedHookSidecarList) != 0 {
 		volumeOpts = append(volumeOpts, withSidecarVolumes(requestedHookSidecarList))
@@ -914,6 +958,7 @@ func sidecarContainerName(i int) string {
 }
 
 func (t *templateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, claimMap map[string]*k8sv1.PersistentVolumeClaim) (*k8sv1.Pod, error) {
+	t = t.forNamespace(vmi.Namespace)
 	zero := int64(0)
 	runUser := int64(util.NonRootUID)
 	sharedMount := k8sv1.MountPropagationHostToContainer
@@ -1043,6 +1088,7 @@ func (t *templateService) RenderHotplugAttachmentPodTemplate(volumes []*v1.Volum
 }
 
 func (t *templateService) RenderHotplugAttachmentTriggerPodTemplate(volume *v1.Volume, ownerPod *k8sv1.Pod, vmi *v1.VirtualMachineInstance, pvcName string, isBlock bool, tempPod bool) (*k8sv1.Pod, error) {
+	t = t.forNamespace(vmi.Namespace)
 	zero := int64(0)
 	runUser := int64(util.NonRootUID)
 	sharedMount := k8sv1.MountPropagationHostToContainer
@@ -1231,13 +1277,14 @@ var virtProbeOverhead = resource.MustParse("10Mi")
 func addProbeOverheads(vmi *v1.VirtualMachineInstance, to *resource.Quantity) {
 	hasLiveness := addProbeOverhead(vmi.Spec.LivenessProbe, to)
 	hasReadiness := addProbeOverhead(vmi.Spec.ReadinessProbe, to)
-	if hasLiveness || hasReadiness {
+	hasStartup := addProbeOverhead(vmi.Spec.StartupProbe, to)
+	if hasLiveness || hasReadiness || hasStartup {
 		to.Add(virtProbeTotalAdditionalOverhead)
 	}
 }
 
 func addProbeOverhead(probe *v1.Probe, to *resource.Quantity) bool {
-	if probe != nil && probe.Exec != nil {
+	if probe != nil && (probe.Exec != nil || probe.GRPC != nil) {
 		to.Add(virtProbeOverhead)
 		return true
 	}
@@ -1312,6 +1359,7 @@ func copyProbe(probe *v1.Probe) *k8sv1.Probe {
 			Exec:      probe.Exec,
 			HTTPGet:   probe.HTTPGet,
 			TCPSocket: probe.TCPSocket,
+			GRPC:      probe.GRPC,
 		},
 	}
 }
@@ -1329,6 +1377,25 @@ func wrapGuestAgentPingWithVirtProbe(vmi *v1.VirtualMachineInstance, probe *k8sv
 	return
 }
 
+// wrapGRPCProbeWithVirtProbe replaces the grpc handler with virt-probe, which runs the
+// health check inside the guest through the guest agent. The kubelet would otherwise
+// probe the pod address, which does not reach the guest with every network binding.
+func wrapGRPCProbeWithVirtProbe(vmi *v1.VirtualMachineInstance, probe *k8sv1.Probe) {
+	grpcCommand := []string{
+		"virt-probe",
+		"--domainName", api.VMINamespaceKeyFunc(vmi),
+		"--timeoutSeconds", strconv.FormatInt(int64(probe.TimeoutSeconds), 10),
+		"--grpcPort", strconv.FormatInt(int64(probe.ProbeHandler.GRPC.Port), 10),
+	}
+	if probe.ProbeHandler.GRPC.Service != nil {
+		grpcCommand = append(grpcCommand, "--grpcService", *probe.ProbeHandler.GRPC.Service)
+	}
+	probe.ProbeHandler.GRPC = nil
+	probe.ProbeHandler.Exec = &k8sv1.ExecAction{Command: grpcCommand}
+	// we add 1s to the pod probe to compensate for the additional steps in probing
+	probe.TimeoutSeconds += 1
+}
+
 func alignPodMultiCategorySecurity(pod *k8sv1.Pod, selinuxType string, dockerSELinuxMCSWorkaround bool) {
 	if selinuxType == "" && !dockerSELinuxMCSWorkaround {
 		// No SELinux type and no docker workaround, nothing to do
@@ -251,6 +251,24 @@ var _ = Describe("Template", func() {
 			})
 		})
 
+		DescribeTable("should pass the guest patch compliance interval to virt-launcher", func(interval string, expectedArgs []string) {
+			_, kvStore, svc = configFactory(defaultArch)
+			vmi := libvmi.New(libvmi.WithNamespace("default"), libvmi.WithAnnotation(v1.GuestPatchComplianceIntervalAnnotation, interval))
+
+			pod, err := svc.RenderLaunchManifest(vmi)
+			Expect(err).NotTo(HaveOccurred())
+
+			if expectedArgs == nil {
+				Expect(pod.Spec.Containers[0].Command).NotTo(ContainElement("--qemu-agent-patch-compliance-interval"))
+			} else {
+				Expect(pod.Spec.Containers[0].Command).To(ContainElements(expectedArgs))
+			}
+		},
+			Entry("with a valid interval", "10m", []string{"--qemu-agent-patch-compliance-interval", "10m0s"}),
+			Entry("not with an invalid interval", "often", nil),
+			Entry("not with a zero interval", "0s", nil),
+		)
+
 		It("should not set seccomp profile by default", func() {
 			_, kvStore, svc = configFactory(defaultArch)
 			pod, err := svc.RenderLaunchManifest(newMinimalWithContainerDisk("random"))
@@ -3255,6 +3273,26 @@ var _ = Describe("Template", func() {
 				Expect(err).ToNot(HaveOccurred())
 				Expect(pod.Spec.Containers[0].ReadinessProbe).To(BeNil())
 			})
+
+			It("should copy the startup probe", func() {
+				config, kvStore, svc = configFactory(defaultArch)
+				vmi.Spec.StartupProbe = &v1.Probe{
+					InitialDelaySeconds: 5,
+					PeriodSeconds:       10,
+					FailureThreshold:    60,
+					Handler: v1.Handler{
+						GRPC: &k8sv1.GRPCAction{Port: 9090},
+					},
+				}
+				pod, err := svc.RenderLaunchManifest(vmi)
+				Expect(err).ToNot(HaveOccurred())
+				startupProbe := pod.Spec.Containers[0].StartupProbe
+				Expect(startupProbe).ToNot(BeNil())
+				Expect(startupProbe.ProbeHandler.GRPC).To(BeNil())
+				Expect(startupProbe.ProbeHandler.Exec.Command).To(ContainElements("virt-probe", "--grpcPort", "9090"))
+				Expect(startupProbe.InitialDelaySeconds).To(Equal(vmi.Spec.StartupProbe.InitialDelaySeconds + LibvirtStartupDelay))
+				Expect(startupProbe.FailureThreshold).To(Equal(vmi.Spec.StartupProbe.FailureThreshold))
+			})
 		})
 
 		Context("with GPU device interface", func() {
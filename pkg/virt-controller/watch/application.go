@@ -41,6 +41,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/pool"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/quota"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vmi"
@@ -63,6 +64,7 @@ import (
 	"k8s.io/client-go/util/flowcontrol"
 
 	"kubevirt.io/kubevirt/pkg/util/ratelimiter"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 
@@ -186,6 +188,9 @@ type VirtControllerApp struct {
 	migrationController *migration.Controller
 	migrationInformer   cache.SharedIndexInformer
 
+	vmQuotaController *quota.Controller
+	vmQuotaInformer   cache.SharedIndexInformer
+
 	workloadUpdateController *workloadupdater.WorkloadUpdateController
 
 	caExportConfigMapInformer    cache.SharedIndexInformer
@@ -255,6 +260,7 @@ type VirtControllerApp struct {
 	restoreControllerThreads          int
 	snapshotControllerResyncPeriod    time.Duration
 	cloneControllerThreads            int
+	vmQuotaControllerThreads          int
 
 	caConfigMapName          string
 	promCertFilePath         string
@@ -337,6 +343,10 @@ func Execute() {
 	if err != nil {
 		panic(err)
 	}
+	namespaceConfigInformer := virtconfig.NewNamespaceConfigInformer(app.restClient)
+	go namespaceConfigInformer.Run(stopChan)
+	cache.WaitForCacheSync(stopChan, namespaceConfigInformer.HasSynced)
+	app.clusterConfig.SetNamespaceConfigInformer(namespaceConfigInformer)
 
 	app.reInitChan = make(chan string, 10)
 	app.hasCDI = app.clusterConfig.HasDataVolumeAPI()
@@ -375,6 +385,7 @@ func Execute() {
 	app.vmInformer = app.informerFactory.VirtualMachine()
 
 	app.migrationInformer = app.informerFactory.VirtualMachineInstanceMigration()
+	app.vmQuotaInformer = vmquota.NewInformer(app.restClient)
 
 	app.controllerRevisionInformer = app.informerFactory.ControllerRevision()
 
@@ -468,6 +479,7 @@ func Execute() {
 	app.initExportController()
 	app.initWorkloadUpdaterController()
 	app.initCloneController()
+	app.initVMQuotaController()
 	go app.Run()
 
 	<-app.reInitChan
@@ -578,6 +590,8 @@ func (vca *VirtControllerApp) onStartedLeading() func(ctx context.Context) {
 		go vca.poolController.Run(vca.poolControllerThreads, stop)
 		go vca.vmController.Run(vca.vmControllerThreads, stop)
 		go vca.migrationController.Run(vca.migrationControllerThreads, stop)
+		go vca.vmQuotaInformer.Run(stop)
+		go vca.vmQuotaController.Run(vca.vmQuotaControllerThreads, stop)
 		go func() {
 			if err := vca.snapshotController.Run(vca.snapshotControllerThreads, stop); err != nil {
 				log.Log.Warningf("error running the snapshot controller: %v", err)
@@ -746,6 +760,8 @@ func (vca *VirtControllerApp) initVirtualMachines() {
 		vca.persistentVolumeClaimInformer,
 		vca.controllerRevisionInformer,
 		vca.kvPodInformer,
+		vca.vmQuotaInformer,
+		vca.migrationInformer,
 		recorder,
 		vca.clientSet,
 		vca.clusterConfig,
@@ -903,13 +919,21 @@ func (vca *VirtControllerApp) initCloneController() {
 	var err error
 	recorder := vca.newRecorder(k8sv1.NamespaceAll, "clone-controller")
 	vca.vmCloneController, err = clonecontroller.NewVmCloneController(
-		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, recorder,
+		vca.clientSet, vca.vmCloneInformer, vca.vmSnapshotInformer, vca.vmRestoreInformer, vca.vmInformer, vca.vmSnapshotContentInformer, vca.persistentVolumeClaimInformer, recorder, vca.clusterConfig,
 	)
 	if err != nil {
 		panic(err)
 	}
 }
 
+func (vca *VirtControllerApp) initVMQuotaController() {
+	var err error
+	vca.vmQuotaController, err = quota.NewController(vca.clientSet, vca.vmQuotaInformer, vca.vmiInformer, vca.migrationInformer)
+	if err != nil {
+		panic(err)
+	}
+}
+
 func (vca *VirtControllerApp) leaderProbe(_ *restful.Request, response *restful.Response) {
 	res := map[string]interface{}{}
 
@@ -1015,6 +1039,9 @@ func (vca *VirtControllerApp) AddFlags() {
 
 	flag.IntVar(&vca.cloneControllerThreads, "clone-controller-threads", defaultControllerThreads,
 		"Number of goroutines to run for clone controller")
+
+	flag.IntVar(&vca.vmQuotaControllerThreads, "vm-quota-controller-threads", defaultControllerThreads,
+		"Number of goroutines to run for virtual machine quota controller")
 }
 
 func (vca *VirtControllerApp) setupLeaderElector() (err error) {
@@ -64,6 +64,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/drain/evacuation"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/migration"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/node"
+	"kubevirt.io/kubevirt/pkg/virt-controller/watch/quota"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/replicaset"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/topology"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/vm"
@@ -126,6 +127,7 @@ var _ = Describe("Application", func() {
 		preferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachinePreference{})
 		clusterPreferenceInformer, _ := testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterPreference{})
 		controllerRevisionInformer, _ := testutils.NewFakeInformerFor(&appsv1.ControllerRevision{})
+		vmQuotaInformer, _ := testutils.NewFakeInformerFor(&v1.VirtualMachineQuota{})
 
 		var qemuGid int64 = 107
 
@@ -165,6 +167,8 @@ var _ = Describe("Application", func() {
 			pvcInformer,
 			crInformer,
 			podInformer,
+			vmQuotaInformer,
+			migrationInformer,
 			recorder,
 			virtClient,
 			config,
@@ -253,7 +257,10 @@ var _ = Describe("Application", func() {
 			vmSnapshotContentInformer,
 			pvcInformer,
 			recorder,
+			config,
 		)
+		app.vmQuotaInformer = vmQuotaInformer
+		app.vmQuotaController, _ = quota.NewController(virtClient, vmQuotaInformer, vmiInformer, migrationInformer)
 
 		app.readyChan = make(chan bool)
 
@@ -28,6 +28,7 @@ import (
 	"k8s.io/apimachinery/pkg/api/equality"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 
 	clone "kubevirt.io/api/clone/v1beta1"
@@ -35,6 +36,7 @@ import (
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
 	"kubevirt.io/client-go/log"
 
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 	virtsnapshot "kubevirt.io/kubevirt/pkg/storage/snapshot"
@@ -173,7 +175,7 @@ func (ctrl *VMCloneController) retrieveCloneInfo(vmClone *clone.VirtualMachineCl
 		}
 
 		sourceVM := sourceVMObj.(*k6tv1.VirtualMachine)
-		if backendstorage.IsBackendStorageNeededForVM(sourceVM) {
+		if backendstorage.IsBackendStorageNeededForVM(sourceVM) && !isPersistentStateCloneSupported(vmClone) {
 			return nil, fmt.Errorf("%w: VM %s/%s", ErrSourceWithBackendStorage, vmClone.Namespace, sourceInfo.Name)
 		}
 		cloneInfo.sourceVm = sourceVM
@@ -236,6 +238,13 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 				return syncInfo
 			}
 
+			if isPersistentStatePolicy(vmClone, clone.PersistentStateCopy) {
+				syncInfo = ctrl.copyPersistentState(vmClone, vm, syncInfo)
+				if syncInfo.isFailingOrError() {
+					return syncInfo
+				}
+			}
+
 			syncInfo = ctrl.createRestoreFromVm(vmClone, vm, vmCloneInfo.snapshotName, syncInfo)
 			return syncInfo
 		}
@@ -258,6 +267,13 @@ func (ctrl *VMCloneController) syncTargetVM(vmCloneInfo *vmCloneInfo) syncInfoTy
 
 	case clone.Succeeded:
 
+		if isPersistentStatePolicy(vmClone, clone.PersistentStateCopy) {
+			syncInfo = ctrl.handOverPersistentState(vmClone, syncInfo)
+			if syncInfo.isFailingOrError() {
+				return syncInfo
+			}
+		}
+
 		if vmClone.Status.RestoreName != nil {
 			syncInfo = ctrl.verifyPVCBound(vmClone, syncInfo)
 			if syncInfo.isFailingOrError() || !syncInfo.pvcBound {
@@ -442,7 +458,7 @@ func (ctrl *VMCloneController) verifySnapshotReady(vmClone *clone.VirtualMachine
 		return snapshot, syncInfo
 	}
 
-	if err := ctrl.verifySnapshotContent(snapshot); err != nil {
+	if err := ctrl.verifySnapshotContent(vmClone, snapshot); err != nil {
 		// At this point the snapshot is already succeded and ready.
 		// If there is an issue with the snapshot content something is not right
 		// and the clone should fail
@@ -472,7 +488,7 @@ func (ctrl *VMCloneController) getSnapshotContent(snapshot *snapshotv1.VirtualMa
 	return contentObj.(*snapshotv1.VirtualMachineSnapshotContent), nil
 }
 
-func (ctrl *VMCloneController) verifySnapshotContent(snapshot *snapshotv1.VirtualMachineSnapshot) error {
+func (ctrl *VMCloneController) verifySnapshotContent(vmClone *clone.VirtualMachineClone, snapshot *snapshotv1.VirtualMachineSnapshot) error {
 	content, err := ctrl.getSnapshotContent(snapshot)
 	if err != nil {
 		return err
@@ -489,7 +505,7 @@ func (ctrl *VMCloneController) verifySnapshotContent(snapshot *snapshotv1.Virtua
 		return nil
 	}
 
-	if backendstorage.IsBackendStorageNeededForVMI(&vm.Spec.Template.Spec) {
+	if backendstorage.IsBackendStorageNeededForVMI(&vm.Spec.Template.Spec) && !isPersistentStateCloneSupported(vmClone) {
 		return fmt.Errorf("%w: snapshot %s/%s", ErrSourceWithBackendStorage, snapshot.Namespace, snapshot.Name)
 	}
 
@@ -642,6 +658,115 @@ func (ctrl *VMCloneController) verifyPVCBound(vmClone *clone.VirtualMachineClone
 
 }
 
+// copyPersistentState creates the backend storage PVC of the target VM from the one of the source VM,
+// in the VM state storage class if one is configured.
+// It has to happen before the restore creates the target VM, otherwise a started target would get
+// a fresh backend storage PVC. The PVC is owned by the clone until the target VM exists.
+func (ctrl *VMCloneController) copyPersistentState(vmClone *clone.VirtualMachineClone, sourceVM *k6tv1.VirtualMachine, syncInfo syncInfoType) syncInfoType {
+	if !backendstorage.IsBackendStorageNeededForVM(sourceVM) {
+		return syncInfo
+	}
+
+	sourcePVC := ctrl.getPersistentStatePVC(sourceVM.Name, vmClone.Namespace)
+	if sourcePVC == nil {
+		// The source VM never ran, so there is no state to copy. The target will start with empty state.
+		log.Log.Object(vmClone).V(defaultVerbosityLevel).Infof("source VM %s has no persistent state, nothing to copy", sourceVM.Name)
+		return syncInfo
+	}
+
+	storageClass := ctrl.clusterConfig.GetVMStateStorageClass()
+	if isCrossStorageClassCopy(sourcePVC, storageClass) {
+		volumeCloneSource := generatePersistentStateVolumeCloneSource(sourcePVC, vmClone.Spec.Target.Name, vmClone.Name, vmClone.UID)
+		_, err := ctrl.client.CdiClient().CdiV1beta1().VolumeCloneSources(vmClone.Namespace).Create(context.Background(), volumeCloneSource, v1.CreateOptions{})
+		if err != nil && !k8serrors.IsAlreadyExists(err) {
+			retErr := fmt.Errorf("failed creating volume clone source for persistent state PVC %s for clone %s: %v", sourcePVC.Name, vmClone.Name, err)
+			ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(PersistentStateCopyFailed), retErr.Error())
+			syncInfo.setError(retErr)
+			return syncInfo
+		}
+	}
+
+	pvc := generatePersistentStatePVC(sourcePVC, storageClass, vmClone.Spec.Target.Name, vmClone.Name, vmClone.UID)
+	_, err := ctrl.client.CoreV1().PersistentVolumeClaims(vmClone.Namespace).Create(context.Background(), pvc, v1.CreateOptions{})
+	if err != nil {
+		if k8serrors.IsAlreadyExists(err) {
+			return syncInfo
+		}
+		retErr := fmt.Errorf("failed copying persistent state PVC %s to %s for clone %s: %v", sourcePVC.Name, pvc.Name, vmClone.Name, err)
+		ctrl.recorder.Event(vmClone, corev1.EventTypeWarning, string(PersistentStateCopyFailed), retErr.Error())
+		syncInfo.setError(retErr)
+		return syncInfo
+	}
+
+	ctrl.logAndRecord(vmClone, PersistentStateCopied, fmt.Sprintf("copied persistent state PVC %s to %s for clone %s", sourcePVC.Name, pvc.Name, vmClone.Name))
+	return syncInfo
+}
+
+// handOverPersistentState moves the ownership of the copied persistent state PVC from the clone to the target VM,
+// so that the state outlives the clone and is removed together with the target VM.
+func (ctrl *VMCloneController) handOverPersistentState(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
+	pvcName := generatePersistentStatePVCName(vmClone.Spec.Target.Name, vmClone.UID)
+	obj, exists, err := ctrl.pvcStore.GetByKey(getKey(pvcName, vmClone.Namespace))
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting PVC %s from cache for clone %s: %v", pvcName, vmClone.Name, err))
+		return syncInfo
+	}
+	if !exists {
+		return syncInfo
+	}
+
+	pvc := obj.(*corev1.PersistentVolumeClaim)
+	if owned, _ := isOwnedByClone(pvc); !owned {
+		return syncInfo
+	}
+
+	targetVMObj, exists, err := ctrl.vmStore.GetByKey(getKey(vmClone.Spec.Target.Name, vmClone.Namespace))
+	if !exists {
+		syncInfo.setError(fmt.Errorf("target VM %s is not created yet for clone %s", vmClone.Spec.Target.Name, vmClone.Name))
+		return syncInfo
+	} else if err != nil {
+		syncInfo.setError(fmt.Errorf("error getting VM %s from cache for clone %s: %v", vmClone.Spec.Target.Name, vmClone.Name, err))
+		return syncInfo
+	}
+	targetVM := targetVMObj.(*k6tv1.VirtualMachine)
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/metadata/ownerReferences", pvc.OwnerReferences),
+		patch.WithReplace("/metadata/ownerReferences", []v1.OwnerReference{*v1.NewControllerRef(targetVM, k6tv1.VirtualMachineGroupVersionKind)}),
+	).GeneratePayload()
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("error generating patch for PVC %s for clone %s: %v", pvcName, vmClone.Name, err))
+		return syncInfo
+	}
+
+	_, err = ctrl.client.CoreV1().PersistentVolumeClaims(vmClone.Namespace).Patch(context.Background(), pvcName, types.JSONPatchType, patchBytes, v1.PatchOptions{})
+	if err != nil {
+		syncInfo.setError(fmt.Errorf("cannot hand over PVC %s to target VM %s for clone %s: %v", pvcName, targetVM.Name, vmClone.Name, err))
+		return syncInfo
+	}
+
+	return syncInfo
+}
+
+// getPersistentStatePVC returns the backend storage PVC of the given VM, or nil if the VM has none.
+func (ctrl *VMCloneController) getPersistentStatePVC(vmName, namespace string) *corev1.PersistentVolumeClaim {
+	var legacyPVC *corev1.PersistentVolumeClaim
+	for _, obj := range ctrl.pvcStore.List() {
+		pvc := obj.(*corev1.PersistentVolumeClaim)
+		if pvc.Namespace != namespace || pvc.DeletionTimestamp != nil {
+			continue
+		}
+		if pvc.Labels[backendstorage.PVCPrefix] == vmName {
+			return pvc
+		}
+		if pvc.Name == backendstorage.PVCPrefix+"-"+vmName {
+			legacyPVC = pvc
+		}
+	}
+
+	return legacyPVC
+}
+
 func (ctrl *VMCloneController) cleanupSnapshot(vmClone *clone.VirtualMachineClone, syncInfo syncInfoType) syncInfoType {
 	err := ctrl.client.VirtualMachineSnapshot(vmClone.Namespace).Delete(context.Background(), *vmClone.Status.SnapshotName, v1.DeleteOptions{})
 	if err != nil && !k8serrors.IsNotFound(err) {
@@ -705,6 +830,23 @@ func (ctrl *VMCloneController) getVmFromSnapshot(snapshot *snapshotv1.VirtualMac
 	return vm, nil
 }
 
+func isPersistentStatePolicy(vmClone *clone.VirtualMachineClone, policy clone.PersistentStatePolicy) bool {
+	return vmClone.Spec.PersistentState != nil && *vmClone.Spec.PersistentState == policy
+}
+
+// isPersistentStateCloneSupported reports whether a source with backend storage can be cloned.
+// The state can only be copied from a live VM, a snapshot does not hold it.
+func isPersistentStateCloneSupported(vmClone *clone.VirtualMachineClone) bool {
+	switch {
+	case isPersistentStatePolicy(vmClone, clone.PersistentStateRegenerate):
+		return true
+	case isPersistentStatePolicy(vmClone, clone.PersistentStateCopy):
+		return cloneSourceType(vmClone.Spec.Source.Kind) == sourceTypeVM
+	default:
+		return false
+	}
+}
+
 func (s *syncInfoType) setError(err error) {
 	s.err = err
 }
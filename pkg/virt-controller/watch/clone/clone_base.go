@@ -21,6 +21,7 @@ import (
 	"kubevirt.io/client-go/log"
 
 	"kubevirt.io/kubevirt/pkg/storage/snapshot"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 type Event string
@@ -29,13 +30,15 @@ const (
 	defaultVerbosityLevel = 2
 	unknownTypeErrFmt     = "clone controller expected object of type %s but found object of unknown type"
 
-	SnapshotCreated       Event = "SnapshotCreated"
-	SnapshotReady         Event = "SnapshotReady"
-	RestoreCreated        Event = "RestoreCreated"
-	RestoreCreationFailed Event = "RestoreCreationFailed"
-	RestoreReady          Event = "RestoreReady"
-	TargetVMCreated       Event = "TargetVMCreated"
-	PVCBound              Event = "PVCBound"
+	SnapshotCreated           Event = "SnapshotCreated"
+	SnapshotReady             Event = "SnapshotReady"
+	RestoreCreated            Event = "RestoreCreated"
+	RestoreCreationFailed     Event = "RestoreCreationFailed"
+	RestoreReady              Event = "RestoreReady"
+	TargetVMCreated           Event = "TargetVMCreated"
+	PVCBound                  Event = "PVCBound"
+	PersistentStateCopied     Event = "PersistentStateCopied"
+	PersistentStateCopyFailed Event = "PersistentStateCopyFailed"
 
 	SnapshotDeleted                 Event = "SnapshotDeleted"
 	SnapshotContentInvalid          Event = "SnapshotContentInvalid"
@@ -62,12 +65,13 @@ type VMCloneController struct {
 	snapshotContentStore cache.Store
 	pvcStore             cache.Store
 	recorder             record.EventRecorder
+	clusterConfig        *virtconfig.ClusterConfig
 
 	vmCloneQueue workqueue.TypedRateLimitingInterface[string]
 	hasSynced    func() bool
 }
 
-func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer cache.SharedIndexInformer, recorder record.EventRecorder) (*VMCloneController, error) {
+func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapshotInformer, restoreInformer, vmInformer, snapshotContentInformer, pvcInformer cache.SharedIndexInformer, recorder record.EventRecorder, clusterConfig *virtconfig.ClusterConfig) (*VMCloneController, error) {
 	ctrl := VMCloneController{
 		client:               client,
 		vmCloneIndexer:       vmCloneInformer.GetIndexer(),
@@ -77,6 +81,7 @@ func NewVmCloneController(client kubecli.KubevirtClient, vmCloneInformer, snapsh
 		snapshotContentStore: snapshotContentInformer.GetStore(),
 		pvcStore:             pvcInformer.GetStore(),
 		recorder:             recorder,
+		clusterConfig:        clusterConfig,
 		vmCloneQueue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
 			workqueue.DefaultTypedControllerRateLimiter[string](),
 			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmclone"},
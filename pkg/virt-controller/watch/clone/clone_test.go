@@ -40,6 +40,7 @@ import (
 	clone "kubevirt.io/api/clone/v1beta1"
 	virtv1 "kubevirt.io/api/core/v1"
 	snapshotv1 "kubevirt.io/api/snapshot/v1beta1"
+	cdifake "kubevirt.io/client-go/containerizeddataimporter/fake"
 	"kubevirt.io/client-go/kubecli"
 	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
 
@@ -49,6 +50,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/libvmi"
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
+	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 )
 
 const (
@@ -69,6 +71,8 @@ var _ = Describe("Clone", func() {
 
 		client    *kubevirtfake.Clientset
 		k8sClient *k8sfake.Clientset
+		cdiClient *cdifake.Clientset
+		kvStore   cache.Store
 		sourceVM  *virtv1.VirtualMachine
 		vmClone   *clone.VirtualMachineClone
 	)
@@ -218,6 +222,8 @@ var _ = Describe("Clone", func() {
 		recorder = record.NewFakeRecorder(100)
 		recorder.IncludeObject = true
 		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		var config *virtconfig.ClusterConfig
+		config, _, kvStore = testutils.NewFakeClusterConfigUsingKVConfig(&virtv1.KubeVirtConfiguration{})
 		controller, _ = NewVmCloneController(
 			virtClient,
 			cloneInformer,
@@ -226,7 +232,8 @@ var _ = Describe("Clone", func() {
 			vmInformer,
 			snapshotContentInformer,
 			pvcInformer,
-			recorder)
+			recorder,
+			config)
 		mockQueue = testutils.NewMockWorkQueue(controller.vmCloneQueue)
 		controller.vmCloneQueue = mockQueue
 
@@ -245,6 +252,10 @@ var _ = Describe("Clone", func() {
 			return true, nil, nil
 		})
 		virtClient.EXPECT().AppsV1().Return(k8sClient.AppsV1()).AnyTimes()
+		virtClient.EXPECT().CoreV1().Return(k8sClient.CoreV1()).AnyTimes()
+
+		cdiClient = cdifake.NewSimpleClientset()
+		virtClient.EXPECT().CdiClient().Return(cdiClient).AnyTimes()
 	})
 
 	sanityExecute := func() {
@@ -617,6 +628,168 @@ var _ = Describe("Clone", func() {
 				})
 			})
 
+			When("source VM has persistent state", func() {
+				BeforeEach(func() {
+					sourceVM.Spec.Template.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{
+						Persistent: pointer.P(true),
+					}
+				})
+
+				It("should create snapshot if the state is regenerated", func() {
+					vmClone.Spec.PersistentState = pointer.P(clone.PersistentStateRegenerate)
+					addVM(sourceVM)
+					addClone(vmClone)
+
+					sanityExecute()
+					expectEvent(SnapshotCreated)
+					expectSnapshotExists()
+					expectCloneBeInPhase(clone.SnapshotInProgress)
+				})
+
+				It("should copy the state PVC before creating the restore", func() {
+					vmClone.Spec.PersistentState = pointer.P(clone.PersistentStateCopy)
+					snapshot := createVirtualMachineSnapshot(sourceVM)
+					snapshot.Status.ReadyToUse = pointer.P(true)
+					snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+					vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+					vmClone.Status.Phase = clone.SnapshotInProgress
+
+					sourcePVC := createPVC(metav1.NamespaceDefault, k8sv1.ClaimBound)
+					sourcePVC.Name = "persistent-state-for-" + sourceVM.Name + "-abcde"
+					sourcePVC.Labels = map[string]string{"persistent-state-for": sourceVM.Name}
+					sourcePVC.Spec.AccessModes = []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteMany}
+					sourcePVC.Spec.VolumeName = "pv-1"
+
+					pvcCreated := false
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						pvc := action.(testing.CreateAction).GetObject().(*k8sv1.PersistentVolumeClaim)
+						Expect(pvc.Name).To(Equal("persistent-state-for-" + vmClone.Spec.Target.Name + "-" + testCloneUID))
+						Expect(pvc.Labels).To(HaveKeyWithValue("persistent-state-for", vmClone.Spec.Target.Name))
+						Expect(pvc.Spec.AccessModes).To(Equal(sourcePVC.Spec.AccessModes))
+						Expect(pvc.Spec.VolumeName).To(BeEmpty())
+						Expect(pvc.Spec.DataSource).To(Equal(&k8sv1.TypedLocalObjectReference{
+							Kind: "PersistentVolumeClaim",
+							Name: sourcePVC.Name,
+						}))
+						Expect(pvc.OwnerReferences).To(HaveLen(1))
+						validateOwnerReference(pvc.OwnerReferences[0], vmClone)
+						pvcCreated = true
+						return true, pvc, nil
+					})
+
+					addVM(sourceVM)
+					addPVC(sourcePVC)
+					addClone(vmClone)
+					addSnapshot(snapshot)
+					addSnapshotContent(snapshotContent)
+
+					sanityExecute()
+					Expect(pvcCreated).To(BeTrue())
+					expectEvent(SnapshotReady)
+					expectEvent(PersistentStateCopied)
+					expectEvent(RestoreCreated)
+					expectCloneBeInPhase(clone.RestoreInProgress)
+					expectRestoreExists()
+				})
+
+				It("should copy the state PVC into the VM state storage class through CDI", func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &virtv1.KubeVirt{
+						Spec: virtv1.KubeVirtSpec{
+							Configuration: virtv1.KubeVirtConfiguration{
+								VMStateStorageClass: "vm-state",
+							},
+						},
+					})
+					vmClone.Spec.PersistentState = pointer.P(clone.PersistentStateCopy)
+					snapshot := createVirtualMachineSnapshot(sourceVM)
+					snapshot.Status.ReadyToUse = pointer.P(true)
+					snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+					vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+					vmClone.Status.Phase = clone.SnapshotInProgress
+
+					sourcePVC := createPVC(metav1.NamespaceDefault, k8sv1.ClaimBound)
+					sourcePVC.Name = "persistent-state-for-" + sourceVM.Name + "-abcde"
+					sourcePVC.Labels = map[string]string{"persistent-state-for": sourceVM.Name}
+					sourcePVC.Spec.StorageClassName = pointer.P("legacy")
+					copiedPVCName := "persistent-state-for-" + vmClone.Spec.Target.Name + "-" + testCloneUID
+
+					pvcCreated := false
+					k8sClient.Fake.PrependReactor("create", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						pvc := action.(testing.CreateAction).GetObject().(*k8sv1.PersistentVolumeClaim)
+						Expect(pvc.Name).To(Equal(copiedPVCName))
+						Expect(pvc.Spec.StorageClassName).To(HaveValue(Equal("vm-state")))
+						Expect(pvc.Spec.DataSource).To(BeNil())
+						Expect(pvc.Spec.DataSourceRef).To(Equal(&k8sv1.TypedObjectReference{
+							APIGroup: pointer.P("cdi.kubevirt.io"),
+							Kind:     "VolumeCloneSource",
+							Name:     copiedPVCName,
+						}))
+						pvcCreated = true
+						return true, pvc, nil
+					})
+
+					addVM(sourceVM)
+					addPVC(sourcePVC)
+					addClone(vmClone)
+					addSnapshot(snapshot)
+					addSnapshotContent(snapshotContent)
+
+					sanityExecute()
+					Expect(pvcCreated).To(BeTrue())
+					volumeCloneSource, err := cdiClient.CdiV1beta1().VolumeCloneSources(metav1.NamespaceDefault).Get(context.TODO(), copiedPVCName, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(volumeCloneSource.Spec.Source).To(Equal(k8sv1.TypedLocalObjectReference{
+						Kind: "PersistentVolumeClaim",
+						Name: sourcePVC.Name,
+					}))
+					Expect(volumeCloneSource.OwnerReferences).To(HaveLen(1))
+					validateOwnerReference(volumeCloneSource.OwnerReferences[0], vmClone)
+					expectEvent(SnapshotReady)
+					expectEvent(PersistentStateCopied)
+					expectEvent(RestoreCreated)
+				})
+
+				It("should hand the copied state PVC over to the target VM", func() {
+					vmClone.Spec.PersistentState = pointer.P(clone.PersistentStateCopy)
+					snapshot := createVirtualMachineSnapshot(sourceVM)
+					snapshot.Status.ReadyToUse = pointer.P(true)
+					restore := createVirtualMachineRestore(sourceVM, snapshot.Name)
+					restore.Status.Complete = pointer.P(true)
+					vmClone.Status.SnapshotName = pointer.P(snapshot.Name)
+					vmClone.Status.RestoreName = pointer.P(restore.Name)
+					vmClone.Status.Phase = clone.CreatingTargetVM
+
+					targetVM := sourceVM.DeepCopy()
+					targetVM.Name = vmClone.Spec.Target.Name
+					targetVM.UID = "target-vm-uid"
+
+					copiedPVC := createPVC(metav1.NamespaceDefault, k8sv1.ClaimBound)
+					copiedPVC.Name = "persistent-state-for-" + targetVM.Name + "-" + testCloneUID
+					copiedPVC.OwnerReferences = []metav1.OwnerReference{createOwnerReference(vmClone)}
+
+					pvcPatched := false
+					k8sClient.Fake.PrependReactor("patch", "persistentvolumeclaims", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						patchAction := action.(testing.PatchAction)
+						Expect(patchAction.GetName()).To(Equal(copiedPVC.Name))
+						Expect(string(patchAction.GetPatch())).To(ContainSubstring(string(targetVM.UID)))
+						pvcPatched = true
+						return true, copiedPVC, nil
+					})
+
+					addVM(sourceVM)
+					addVM(targetVM)
+					addPVC(copiedPVC)
+					addClone(vmClone)
+					addSnapshot(snapshot)
+					addRestore(restore)
+
+					sanityExecute()
+					Expect(pvcPatched).To(BeTrue())
+					expectEvent(TargetVMCreated)
+					expectCloneBeInPhase(clone.Succeeded)
+				})
+			})
+
 		})
 
 		Context("with source snapshot", func() {
@@ -684,6 +857,27 @@ var _ = Describe("Clone", func() {
 				expectCloneBeInPhase(clone.Failed)
 			})
 
+			It("should create restore if source VMSnapshot has backendstorage and the state is regenerated", func() {
+				snapshot := createVirtualMachineSnapshot(sourceVM)
+				snapshot.Status.ReadyToUse = pointer.P(true)
+				snapshotContent := createVirtualMachineSnapshotContent(sourceVM)
+				snapshotContent.Spec.Source.VirtualMachine.Spec.Template.Spec.Domain.Devices.TPM = &virtv1.TPMDevice{
+					Persistent: pointer.P(true),
+				}
+
+				setSnapshotSource(vmClone, snapshot.Name)
+				vmClone.Spec.PersistentState = pointer.P(clone.PersistentStateRegenerate)
+
+				addClone(vmClone)
+				addSnapshot(snapshot)
+				addSnapshotContent(snapshotContent)
+
+				sanityExecute()
+				expectEvent(SnapshotReady)
+				expectEvent(RestoreCreated)
+				expectCloneBeInPhase(clone.RestoreInProgress)
+			})
+
 			It("should fail clone if snaphshot ready - but not all volumes were snapshoted", func() {
 				snapshot := createVirtualMachineSnapshot(sourceVM)
 				snapshot.Status.ReadyToUse = pointer.P(true)
@@ -6,6 +6,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 
 	"kubevirt.io/kubevirt/pkg/pointer"
+	backendstorage "kubevirt.io/kubevirt/pkg/storage/backend-storage"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -17,6 +18,7 @@ import (
 
 	clone "kubevirt.io/api/clone/v1beta1"
 	v1 "kubevirt.io/api/core/v1"
+	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
 
 const (
@@ -64,6 +66,10 @@ func generateRestoreName(vmCloneUID types.UID) string {
 	return fmt.Sprintf("tmp-restore-%s", string(vmCloneUID))
 }
 
+func generatePersistentStatePVCName(targetVMName string, vmCloneUID types.UID) string {
+	return fmt.Sprintf("%s-%s-%s", backendstorage.PVCPrefix, targetVMName, string(vmCloneUID))
+}
+
 func generateVMName(oldVMName string) string {
 	return generateNameWithRandomSuffix(oldVMName, "clone")
 }
@@ -113,6 +119,67 @@ func generateRestore(targetInfo *corev1.TypedLocalObjectReference, sourceVMName,
 	}
 }
 
+// generatePersistentStatePVC clones the source PVC into storageClass. The PVC is cloned by the CSI driver
+// if it stays in the storage class of the source, otherwise by CDI from a VolumeCloneSource of the same name.
+func generatePersistentStatePVC(sourcePVC *corev1.PersistentVolumeClaim, storageClass, targetVMName, cloneName string, cloneUID types.UID) *corev1.PersistentVolumeClaim {
+	pvcName := generatePersistentStatePVCName(targetVMName, cloneUID)
+	spec := sourcePVC.Spec.DeepCopy()
+	spec.VolumeName = ""
+	spec.Selector = nil
+	spec.DataSourceRef = nil
+	spec.DataSource = &corev1.TypedLocalObjectReference{
+		Kind: "PersistentVolumeClaim",
+		Name: sourcePVC.Name,
+	}
+	if isCrossStorageClassCopy(sourcePVC, storageClass) {
+		spec.StorageClassName = pointer.P(storageClass)
+		spec.DataSource = nil
+		spec.DataSourceRef = &corev1.TypedObjectReference{
+			APIGroup: pointer.P(cdiv1.SchemeGroupVersion.Group),
+			Kind:     "VolumeCloneSource",
+			Name:     pvcName,
+		}
+	}
+
+	return &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      pvcName,
+			Namespace: sourcePVC.Namespace,
+			Labels: map[string]string{
+				backendstorage.PVCPrefix: targetVMName,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				getCloneOwnerReference(cloneName, cloneUID),
+			},
+		},
+		Spec: *spec,
+	}
+}
+
+func generatePersistentStateVolumeCloneSource(sourcePVC *corev1.PersistentVolumeClaim, targetVMName, cloneName string, cloneUID types.UID) *cdiv1.VolumeCloneSource {
+	return &cdiv1.VolumeCloneSource{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      generatePersistentStatePVCName(targetVMName, cloneUID),
+			Namespace: sourcePVC.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				getCloneOwnerReference(cloneName, cloneUID),
+			},
+		},
+		Spec: cdiv1.VolumeCloneSourceSpec{
+			Source: corev1.TypedLocalObjectReference{
+				Kind: "PersistentVolumeClaim",
+				Name: sourcePVC.Name,
+			},
+		},
+	}
+}
+
+// isCrossStorageClassCopy reports whether the state has to be copied into another storage class than the
+// one of the source PVC, which a CSI clone can't do. An empty storageClass keeps the one of the source.
+func isCrossStorageClassCopy(sourcePVC *corev1.PersistentVolumeClaim, storageClass string) bool {
+	return storageClass != "" && (sourcePVC.Spec.StorageClassName == nil || *sourcePVC.Spec.StorageClassName != storageClass)
+}
+
 func getCloneOwnerReference(cloneName string, cloneUID types.UID) metav1.OwnerReference {
 	return metav1.OwnerReference{
 		APIVersion:         clone.VirtualMachineCloneKind.GroupVersion().String(),
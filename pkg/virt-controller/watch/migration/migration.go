@@ -985,7 +985,7 @@ func (c *Controller) handleTargetPodHandoff(migration *virtv1.VirtualMachineInst
 		}
 	}
 
-	clusterMigrationConfigs := c.clusterConfig.GetMigrationConfiguration().DeepCopy()
+	clusterMigrationConfigs := c.clusterConfig.ForNamespace(vmiCopy.Namespace).GetMigrationConfiguration().DeepCopy()
 	err := c.matchMigrationPolicy(vmiCopy, clusterMigrationConfigs)
 	if err != nil {
 		return fmt.Errorf("failed to match migration policy: %v", err)
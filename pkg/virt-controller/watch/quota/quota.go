@@ -0,0 +1,198 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package quota
+
+import (
+	"context"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
+)
+
+// Controller keeps the status of the VirtualMachineQuotas up to date with the
+// usage of the VirtualMachineInstances and migrations in their namespace.
+// Enforcement happens in virt-api, the status is informational only.
+type Controller struct {
+	clientset        kubecli.KubevirtClient
+	Queue            workqueue.TypedRateLimitingInterface[string]
+	quotaIndexer     cache.Indexer
+	vmiIndexer       cache.Indexer
+	migrationIndexer cache.Indexer
+	hasSynced        func() bool
+}
+
+// NewController creates a new instance of the VirtualMachineQuota controller.
+func NewController(clientset kubecli.KubevirtClient, quotaInformer, vmiInformer, migrationInformer cache.SharedIndexInformer) (*Controller, error) {
+	c := &Controller{
+		clientset: clientset,
+		Queue: workqueue.NewTypedRateLimitingQueueWithConfig[string](
+			workqueue.DefaultTypedControllerRateLimiter[string](),
+			workqueue.TypedRateLimitingQueueConfig[string]{Name: "virt-controller-vmquota"},
+		),
+		quotaIndexer:     quotaInformer.GetIndexer(),
+		vmiIndexer:       vmiInformer.GetIndexer(),
+		migrationIndexer: migrationInformer.GetIndexer(),
+	}
+
+	c.hasSynced = func() bool {
+		return quotaInformer.HasSynced() && vmiInformer.HasSynced() && migrationInformer.HasSynced()
+	}
+
+	_, err := quotaInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc:    c.enqueueQuota,
+		DeleteFunc: func(_ interface{}) { /* nothing to do */ },
+		UpdateFunc: func(_, curr interface{}) { c.enqueueQuota(curr) },
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, informer := range []cache.SharedIndexInformer{vmiInformer, migrationInformer} {
+		_, err = informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+			AddFunc:    c.enqueueNamespaceQuotas,
+			DeleteFunc: c.enqueueNamespaceQuotas,
+			UpdateFunc: func(_, curr interface{}) { c.enqueueNamespaceQuotas(curr) },
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return c, nil
+}
+
+func (c *Controller) enqueueQuota(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from VirtualMachineQuota.")
+		return
+	}
+	c.Queue.Add(key)
+}
+
+// enqueueNamespaceQuotas enqueues all VirtualMachineQuotas in the namespace of the
+// changed VirtualMachineInstance or migration
+func (c *Controller) enqueueNamespaceQuotas(obj interface{}) {
+	key, err := controller.KeyFunc(obj)
+	if err != nil {
+		log.Log.Reason(err).Error("Failed to extract key from object.")
+		return
+	}
+	namespace, _, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to split key %s.", key)
+		return
+	}
+	err = cache.ListAllByNamespace(c.quotaIndexer, namespace, labels.Everything(), func(obj interface{}) {
+		c.enqueueQuota(obj)
+	})
+	if err != nil {
+		log.Log.Reason(err).Errorf("Failed to list VirtualMachineQuotas in namespace %s.", namespace)
+	}
+}
+
+// Run runs the passed in VirtualMachineQuota controller.
+func (c *Controller) Run(threadiness int, stopCh <-chan struct{}) {
+	defer controller.HandlePanic()
+	defer c.Queue.ShutDown()
+	log.Log.Info("Starting VirtualMachineQuota controller.")
+
+	cache.WaitForCacheSync(stopCh, c.hasSynced)
+
+	for i := 0; i < threadiness; i++ {
+		go wait.Until(c.runWorker, time.Second, stopCh)
+	}
+
+	<-stopCh
+	log.Log.Info("Stopping VirtualMachineQuota controller.")
+}
+
+func (c *Controller) runWorker() {
+	for c.Execute() {
+	}
+}
+
+// Execute runs commands from the controller queue, if there is
+// an error it requeues the command. Returns false if the queue
+// is empty.
+func (c *Controller) Execute() bool {
+	key, quit := c.Queue.Get()
+	if quit {
+		return false
+	}
+	defer c.Queue.Done(key)
+
+	if err := c.execute(key); err != nil {
+		log.Log.Reason(err).Infof("reenqueuing VirtualMachineQuota %v", key)
+		c.Queue.AddRateLimited(key)
+	} else {
+		log.Log.V(4).Infof("processed VirtualMachineQuota %v", key)
+		c.Queue.Forget(key)
+	}
+	return true
+}
+
+func (c *Controller) execute(key string) error {
+	obj, exists, err := c.quotaIndexer.GetByKey(key)
+	if err != nil || !exists {
+		return err
+	}
+	quota := obj.(*v1.VirtualMachineQuota)
+
+	var vmis []*v1.VirtualMachineInstance
+	err = cache.ListAllByNamespace(c.vmiIndexer, quota.Namespace, labels.Everything(), func(obj interface{}) {
+		vmis = append(vmis, obj.(*v1.VirtualMachineInstance))
+	})
+	if err != nil {
+		return err
+	}
+	var migrations []*v1.VirtualMachineInstanceMigration
+	err = cache.ListAllByNamespace(c.migrationIndexer, quota.Namespace, labels.Everything(), func(obj interface{}) {
+		migrations = append(migrations, obj.(*v1.VirtualMachineInstanceMigration))
+	})
+	if err != nil {
+		return err
+	}
+
+	status := v1.VirtualMachineQuotaStatus{
+		Hard: *quota.Spec.Hard.DeepCopy(),
+		Used: vmquota.Mask(vmquota.Usage(vmis, migrations), quota.Spec.Hard),
+	}
+	if equality.Semantic.DeepEqual(quota.Status, status) {
+		return nil
+	}
+
+	quotaCopy := quota.DeepCopy()
+	quotaCopy.Status = status
+	_, err = c.clientset.GeneratedKubeVirtClient().KubevirtV1().VirtualMachineQuotas(quota.Namespace).UpdateStatus(context.Background(), quotaCopy, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,142 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package quota
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/testutils"
+)
+
+var _ = Describe("VirtualMachineQuota controller", func() {
+	var quotaInformer cache.SharedIndexInformer
+	var vmiInformer cache.SharedIndexInformer
+	var migrationInformer cache.SharedIndexInformer
+	var fakeVirtClient *kubevirtfake.Clientset
+	var controller *Controller
+
+	newVMI := func(name, namespace string, phase v1.VirtualMachineInstancePhase) *v1.VirtualMachineInstance {
+		return &v1.VirtualMachineInstance{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: v1.VirtualMachineInstanceSpec{
+				Domain: v1.DomainSpec{
+					CPU:    &v1.CPU{Sockets: 1, Cores: 2, Threads: 1},
+					Memory: &v1.Memory{Guest: pointer.P(resource.MustParse("1Gi"))},
+				},
+			},
+			Status: v1.VirtualMachineInstanceStatus{Phase: phase},
+		}
+	}
+
+	addQuota := func(quota *v1.VirtualMachineQuota) {
+		Expect(quotaInformer.GetStore().Add(quota)).To(Succeed())
+		_, err := fakeVirtClient.KubevirtV1().VirtualMachineQuotas(quota.Namespace).Create(context.Background(), quota, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		virtClient := kubecli.NewMockKubevirtClient(ctrl)
+		fakeVirtClient = kubevirtfake.NewSimpleClientset()
+		virtClient.EXPECT().GeneratedKubeVirtClient().Return(fakeVirtClient).AnyTimes()
+
+		quotaInformer, _ = testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineQuota{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+		vmiInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
+		migrationInformer, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstanceMigration{})
+
+		var err error
+		controller, err = NewController(virtClient, quotaInformer, vmiInformer, migrationInformer)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should report the usage of the limited resources in the namespace", func() {
+		quota := &v1.VirtualMachineQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: metav1.NamespaceDefault},
+			Spec: v1.VirtualMachineQuotaSpec{
+				Hard: v1.VirtualMachineQuotaResources{
+					RunningVMIs: pointer.P(int64(10)),
+					GuestMemory: pointer.P(resource.MustParse("16Gi")),
+					Migrations:  pointer.P(int64(2)),
+				},
+			},
+		}
+		addQuota(quota)
+		Expect(vmiInformer.GetStore().Add(newVMI("running", metav1.NamespaceDefault, v1.Running))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newVMI("scheduling", metav1.NamespaceDefault, v1.Scheduling))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newVMI("succeeded", metav1.NamespaceDefault, v1.Succeeded))).To(Succeed())
+		Expect(vmiInformer.GetStore().Add(newVMI("other", "other", v1.Running))).To(Succeed())
+		Expect(migrationInformer.GetStore().Add(&v1.VirtualMachineInstanceMigration{
+			ObjectMeta: metav1.ObjectMeta{Name: "migration", Namespace: metav1.NamespaceDefault},
+			Status:     v1.VirtualMachineInstanceMigrationStatus{Phase: v1.MigrationRunning},
+		})).To(Succeed())
+
+		Expect(controller.execute("default/quota")).To(Succeed())
+
+		updatedQuota, err := fakeVirtClient.KubevirtV1().VirtualMachineQuotas(metav1.NamespaceDefault).Get(context.Background(), quota.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updatedQuota.Status.Hard).To(Equal(quota.Spec.Hard))
+		Expect(updatedQuota.Status.Used.RunningVMIs).To(HaveValue(BeEquivalentTo(2)))
+		Expect(updatedQuota.Status.Used.GuestMemory.Cmp(resource.MustParse("2Gi"))).To(BeZero())
+		Expect(updatedQuota.Status.Used.VCPUs).To(BeNil())
+		Expect(updatedQuota.Status.Used.Migrations).To(HaveValue(BeEquivalentTo(1)))
+	})
+
+	It("should not update an up to date status", func() {
+		quota := &v1.VirtualMachineQuota{
+			ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: metav1.NamespaceDefault},
+			Spec: v1.VirtualMachineQuotaSpec{
+				Hard: v1.VirtualMachineQuotaResources{RunningVMIs: pointer.P(int64(10))},
+			},
+			Status: v1.VirtualMachineQuotaStatus{
+				Hard: v1.VirtualMachineQuotaResources{RunningVMIs: pointer.P(int64(10))},
+				Used: v1.VirtualMachineQuotaResources{RunningVMIs: pointer.P(int64(1))},
+			},
+		}
+		addQuota(quota)
+		Expect(vmiInformer.GetStore().Add(newVMI("running", metav1.NamespaceDefault, v1.Running))).To(Succeed())
+		fakeVirtClient.ClearActions()
+
+		Expect(controller.execute("default/quota")).To(Succeed())
+		Expect(fakeVirtClient.Actions()).To(BeEmpty())
+	})
+
+	It("should enqueue the quotas of the namespace when a VMI changes", func() {
+		addQuota(&v1.VirtualMachineQuota{ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: metav1.NamespaceDefault}})
+		addQuota(&v1.VirtualMachineQuota{ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: "other"}})
+
+		controller.enqueueNamespaceQuotas(newVMI("running", metav1.NamespaceDefault, v1.Running))
+		Expect(controller.Queue.Len()).To(Equal(1))
+		key, _ := controller.Queue.Get()
+		Expect(key).To(Equal("default/quota"))
+	})
+})
@@ -70,6 +70,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/util/hardware"
 	"kubevirt.io/kubevirt/pkg/util/migrations"
 	traceUtils "kubevirt.io/kubevirt/pkg/util/trace"
+	"kubevirt.io/kubevirt/pkg/util/vmquota"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	volumemig "kubevirt.io/kubevirt/pkg/virt-controller/watch/volume-migration"
 )
@@ -117,6 +118,9 @@ const (
 	hotplugMemoryErrorReason     = "HotPlugMemoryError"
 	volumesUpdateErrorReason     = "VolumesUpdateError"
 	tolerationsChangeErrorReason = "TolerationsChangeError"
+	deviceTuningErrorReason      = "DeviceTuningError"
+
+	guestPanicMemoryDumpErrorReason = "GuestPanicMemoryDumpError"
 )
 
 const defaultMaxCrashLoopBackoffDelaySeconds = 300
@@ -129,6 +133,8 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 	pvcInformer cache.SharedIndexInformer,
 	crInformer cache.SharedIndexInformer,
 	podInformer cache.SharedIndexInformer,
+	vmQuotaInformer cache.SharedIndexInformer,
+	migrationInformer cache.SharedIndexInformer,
 	recorder record.EventRecorder,
 	clientset kubecli.KubevirtClient,
 	clusterConfig *virtconfig.ClusterConfig,
@@ -159,12 +165,18 @@ func NewController(vmiInformer cache.SharedIndexInformer,
 		},
 		clusterConfig:   clusterConfig,
 		netSynchronizer: netSynchronizer,
+		quotaIndexers: &vmquota.Indexers{
+			Quotas:     vmQuotaInformer.GetIndexer(),
+			VMIs:       vmiInformer.GetIndexer(),
+			Migrations: migrationInformer.GetIndexer(),
+		},
 	}
 
 	c.hasSynced = func() bool {
 		return vmiInformer.HasSynced() && vmInformer.HasSynced() &&
 			dataVolumeInformer.HasSynced() && dataSourceInformer.HasSynced() &&
-			pvcInformer.HasSynced() && crInformer.HasSynced() && podInformer.HasSynced()
+			pvcInformer.HasSynced() && crInformer.HasSynced() && podInformer.HasSynced() &&
+			vmQuotaInformer.HasSynced() && migrationInformer.HasSynced()
 	}
 
 	_, err := vmInformer.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -253,6 +265,7 @@ type Controller struct {
 	namespaceStore         cache.Store
 	pvcStore               cache.Store
 	crIndexer              cache.Indexer
+	quotaIndexers          *vmquota.Indexers
 	instancetypeController instancetypeHandler
 	recorder               record.EventRecorder
 	expectations           *controller.UIDTrackingControllerExpectations
@@ -605,6 +618,10 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return fmt.Errorf("CPU hotplug is not allowed while VMI is migrating")
 	}
 
+	if vmiConditions.HasConditionWithStatus(vmi, virtv1.VirtualMachineInstanceCPUUnplug, k8score.ConditionUnknown) {
+		return fmt.Errorf("the guest is still releasing vCPUs from a previous unplug")
+	}
+
 	// If the following is true, MaxSockets was calculated, not manually specified (or the validation webhook would have rejected the change).
 	// Since we're here, we can also assume MaxSockets was not changed in the VM spec since last boot.
 	// Therefore, bumping Sockets to a value higher than MaxSockets is fine, it just requires a reboot.
@@ -613,8 +630,9 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return nil
 	}
 
-	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets < vmi.Spec.Domain.CPU.Sockets {
-		setRestartRequired(vm, "Reduction of CPU socket count requires a restart")
+	// Unplugging vCPUs only succeeds if the guest offlines them, which can't be expected from a guest that isn't up and running.
+	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU.Sockets < vmi.Spec.Domain.CPU.Sockets && !isGuestAgentConnected(vmi) {
+		setRestartRequired(vm, "Reduction of CPU socket count requires a connected guest agent or a restart")
 		return nil
 	}
 
@@ -624,6 +642,13 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 		return nil
 	}
 
+	if vcpusDelta := hardware.GetNumberOfVCPUs(vmCopyWithInstancetype.Spec.Template.Spec.Domain.CPU) - hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU); vcpusDelta > 0 {
+		exceeded, err := c.hotplugExceedsQuota(vm, vmi, virtv1.VirtualMachineQuotaResources{VCPUs: &vcpusDelta}, "CPU")
+		if err != nil || exceeded {
+			return err
+		}
+	}
+
 	if err := c.VMICPUsPatch(vmCopyWithInstancetype, vmi); err != nil {
 		log.Log.Object(vmi).Errorf("unable to patch vmi to add cpu topology status: %v", err)
 		return err
@@ -632,6 +657,24 @@ func (c *Controller) handleCPUChangeRequest(vm *virtv1.VirtualMachine, vmi *virt
 	return nil
 }
 
+// hotplugExceedsQuota checks the resources hotplugged into a running VMI against the VirtualMachineQuotas of its
+// namespace. If they would exceed a quota, the change is left for the next start of the VM, which is admitted
+// against the quota again.
+func (c *Controller) hotplugExceedsQuota(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, requested virtv1.VirtualMachineQuotaResources, kind string) (bool, error) {
+	err := vmquota.Check(c.quotaIndexers, vmi.Namespace, requested)
+	var exceededErr *vmquota.ExceededError
+	if errors.As(err, &exceededErr) {
+		setRestartRequired(vm, fmt.Sprintf("%s hotplug not allowed, %s", kind, exceededErr.Error()))
+		return true, nil
+	}
+	return false, err
+}
+
+func isGuestAgentConnected(vmi *virtv1.VirtualMachineInstance) bool {
+	return controller.NewVirtualMachineInstanceConditionManager().HasConditionWithStatus(vmi,
+		virtv1.VirtualMachineInstanceAgentConnected, k8score.ConditionTrue)
+}
+
 func (c *Controller) VMNodeSelectorPatch(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	patchset := patch.New()
 	if vm.Spec.Template.Spec.NodeSelector != nil {
@@ -732,6 +775,103 @@ func (c *Controller) handleTolerationsChangeRequest(vm *virtv1.VirtualMachine, v
 	return nil
 }
 
+// handleDeviceTuningChangeRequest applies the live-updatable disk and interface fields of the VM template to the VMI.
+// Without liveUpdate only the interface link state is applied, it is live-updatable regardless of the rollout strategy.
+func (c *Controller) handleDeviceTuningChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance, liveUpdate bool) error {
+	if vmi == nil || vmi.DeletionTimestamp != nil {
+		return nil
+	}
+
+	vmCopyWithInstancetype := vm.DeepCopy()
+	if err := c.instancetypeController.ApplyToVM(vmCopyWithInstancetype); err != nil {
+		return err
+	}
+
+	disks := vmi.Spec.Domain.Devices.Disks
+	if liveUpdate {
+		disks = copyLiveUpdatableDiskFields(disks, vmCopyWithInstancetype.Spec.Template.Spec.Domain.Devices.Disks)
+	}
+	interfaces := copyLiveUpdatableInterfaceFields(vmi.Spec.Domain.Devices.Interfaces, vmCopyWithInstancetype.Spec.Template.Spec.Domain.Devices.Interfaces, liveUpdate)
+	hasDisksChanged := !equality.Semantic.DeepEqual(disks, vmi.Spec.Domain.Devices.Disks)
+	hasInterfacesChanged := !equality.Semantic.DeepEqual(interfaces, vmi.Spec.Domain.Devices.Interfaces)
+	if !hasDisksChanged && !hasInterfacesChanged {
+		return nil
+	}
+
+	if migrations.IsMigrating(vmi) {
+		return fmt.Errorf("disk I/O limits, interface link state and bandwidth should not be changed during VMI migration")
+	}
+
+	patchset := patch.New()
+	if hasDisksChanged {
+		patchset.AddOption(
+			patch.WithTest("/spec/domain/devices/disks", vmi.Spec.Domain.Devices.Disks),
+			patch.WithReplace("/spec/domain/devices/disks", disks))
+	}
+	if hasInterfacesChanged {
+		patchset.AddOption(
+			patch.WithTest("/spec/domain/devices/interfaces", vmi.Spec.Domain.Devices.Interfaces),
+			patch.WithReplace("/spec/domain/devices/interfaces", interfaces))
+	}
+	generatedPatch, err := patchset.GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	if _, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, generatedPatch, metav1.PatchOptions{}); err != nil {
+		log.Log.Object(vmi).Errorf("unable to patch vmi to update device tuning: %v", err)
+		return err
+	}
+	return nil
+}
+
+// copyLiveUpdatableDiskFields returns a copy of the disks with the I/O limits of the desired disks of the same name
+func copyLiveUpdatableDiskFields(disks, desiredDisks []virtv1.Disk) []virtv1.Disk {
+	if disks == nil {
+		return nil
+	}
+	desiredByName := make(map[string]virtv1.Disk, len(desiredDisks))
+	for _, disk := range desiredDisks {
+		desiredByName[disk.Name] = disk
+	}
+	updated := make([]virtv1.Disk, 0, len(disks))
+	for _, disk := range disks {
+		disk = *disk.DeepCopy()
+		if desired, ok := desiredByName[disk.Name]; ok {
+			disk.IOTune = desired.IOTune.DeepCopy()
+		}
+		updated = append(updated, disk)
+	}
+	return updated
+}
+
+// copyLiveUpdatableInterfaceFields returns a copy of the interfaces with the link state of the desired interfaces of the
+// same name, and with liveUpdate their bandwidth as well. Transitions from or to the absent state are hot(un)plug
+// requests and are left alone.
+func copyLiveUpdatableInterfaceFields(interfaces, desiredInterfaces []virtv1.Interface, liveUpdate bool) []virtv1.Interface {
+	if interfaces == nil {
+		return nil
+	}
+	desiredByName := make(map[string]virtv1.Interface, len(desiredInterfaces))
+	for _, iface := range desiredInterfaces {
+		desiredByName[iface.Name] = iface
+	}
+	updated := make([]virtv1.Interface, 0, len(interfaces))
+	for _, iface := range interfaces {
+		iface = *iface.DeepCopy()
+		if desired, ok := desiredByName[iface.Name]; ok {
+			if liveUpdate {
+				iface.Bandwidth = desired.Bandwidth.DeepCopy()
+			}
+			if iface.State != virtv1.InterfaceStateAbsent && desired.State != virtv1.InterfaceStateAbsent {
+				iface.State = desired.State
+			}
+		}
+		updated = append(updated, iface)
+	}
+	return updated
+}
+
 func (c *Controller) handleAffinityChangeRequest(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
 	if vmi == nil || vmi.DeletionTimestamp != nil {
 		return nil
@@ -2393,6 +2533,9 @@ func (c *Controller) updateStatus(vm, vmOrig *virtv1.VirtualMachine, vmi *virtv1
 	}
 
 	c.trimDoneVolumeRequests(vm)
+	if err := c.requestGuestPanicMemoryDump(vm, vmi); err != nil {
+		return err
+	}
 	memorydump.UpdateRequest(vm, vmi)
 
 	if c.isTrimFirstChangeRequestNeeded(vm, vmi) {
@@ -2753,6 +2896,96 @@ func (c *Controller) isTrimFirstChangeRequestNeeded(vm *virtv1.VirtualMachine, v
 	return false
 }
 
+// requestGuestPanicMemoryDump requests a memory dump to the claim of the panic policy, once the guest of the VMI panicked.
+// The request is validated like memory dumps requested through the API. If the dump can't be taken, the VMI moves on to
+// the action of the panic policy.
+func (c *Controller) requestGuestPanicMemoryDump(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineInstance) error {
+	if vmi == nil || vmi.Status.GuestPanic == nil || vmi.Status.GuestPanic.Phase != virtv1.GuestPanicMemoryDumpInProgress {
+		return nil
+	}
+
+	guestPanic := vmi.Status.GuestPanic
+	if request := vm.Status.MemoryDumpRequest; request != nil {
+		if request.Phase != virtv1.MemoryDumpCompleted && request.Phase != virtv1.MemoryDumpFailed {
+			// Either the dump of the panic is in progress, or the panic has to wait for a requested dump to finish
+			return nil
+		}
+		if request.ClaimName == guestPanic.MemoryDumpClaimName && (request.EndTimestamp == nil || !request.EndTimestamp.Before(guestPanic.Timestamp)) {
+			// The memory of the panicked guest was already dumped
+			return nil
+		}
+	}
+
+	reason, err := c.validateGuestPanicMemoryDump(vmi, guestPanic.MemoryDumpClaimName)
+	if err != nil {
+		return err
+	}
+	if reason != "" {
+		return c.skipGuestPanicMemoryDump(vmi, reason)
+	}
+
+	log.Log.Object(vm).Infof("Requesting a memory dump of the panicked guest to %s", guestPanic.MemoryDumpClaimName)
+	vm.Status.MemoryDumpRequest = &virtv1.VirtualMachineMemoryDumpRequest{
+		ClaimName: guestPanic.MemoryDumpClaimName,
+		Phase:     virtv1.MemoryDumpAssociating,
+	}
+	return nil
+}
+
+// validateGuestPanicMemoryDump returns why the memory of the VMI can't be dumped to the claim, or an error if that can't be determined yet
+func (c *Controller) validateGuestPanicMemoryDump(vmi *virtv1.VirtualMachineInstance, claimName string) (string, error) {
+	if !c.clusterConfig.HotplugVolumesEnabled() {
+		return "HotplugVolumes feature gate is not enabled", nil
+	}
+
+	pvc, err := storagetypes.GetPersistentVolumeClaimFromCache(vmi.Namespace, claimName, c.pvcStore)
+	if err != nil {
+		return "", err
+	}
+	if pvc == nil {
+		return fmt.Sprintf("pvc %s does not exist", claimName), nil
+	}
+
+	cdiConfig, err := c.clientset.CdiClient().CdiV1beta1().CDIConfigs().Get(context.Background(), storagetypes.ConfigName, metav1.GetOptions{})
+	if apiErrors.IsNotFound(err) {
+		cdiConfig = nil
+	} else if err != nil {
+		return "", fmt.Errorf("unable to retrieve cdi config: %v", err)
+	}
+
+	err = memorydump.ValidateClaim(vmi, pvc, cdiConfig)
+	var invalidClaimErr *memorydump.InvalidClaimError
+	if errors.As(err, &invalidClaimErr) {
+		return invalidClaimErr.Error(), nil
+	}
+	return "", err
+}
+
+// skipGuestPanicMemoryDump moves the panic of the VMI on to the action of the panic policy without a memory dump
+func (c *Controller) skipGuestPanicMemoryDump(vmi *virtv1.VirtualMachineInstance, reason string) error {
+	guestPanic := vmi.Status.GuestPanic.DeepCopy()
+	guestPanic.Phase = virtv1.GuestPanicRestarting
+	if guestPanic.Action == virtv1.PanicActionPause {
+		guestPanic.Phase = virtv1.GuestPanicPaused
+	}
+	guestPanic.Message = fmt.Sprintf("Memory of the panicked guest was not dumped: %s", reason)
+
+	patchBytes, err := patch.New(
+		patch.WithTest("/status/guestPanic", vmi.Status.GuestPanic),
+		patch.WithReplace("/status/guestPanic", guestPanic),
+	).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err = c.clientset.VirtualMachineInstance(vmi.Namespace).Patch(context.Background(), vmi.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+		return err
+	}
+
+	log.Log.Object(vmi).Warningf("Skipping the memory dump of the panicked guest: %s", reason)
+	c.recorder.Eventf(vmi, k8score.EventTypeWarning, guestPanicMemoryDumpErrorReason, guestPanic.Message)
+	return nil
+}
+
 func (c *Controller) trimDoneVolumeRequests(vm *virtv1.VirtualMachine) {
 	if len(vm.Status.VolumeRequests) == 0 {
 		return
@@ -2905,6 +3138,10 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 		if validLiveUpdateVolumes(lastSeenVMSpec, currentVM) {
 			lastSeenVMSpec.Template.Spec.Volumes = currentVM.Spec.Template.Spec.Volumes
 		}
+		lastSeenVMSpec.Template.Spec.Domain.Devices.Disks = copyLiveUpdatableDiskFields(
+			lastSeenVMSpec.Template.Spec.Domain.Devices.Disks, currentVM.Spec.Template.Spec.Domain.Devices.Disks)
+		lastSeenVMSpec.Template.Spec.Domain.Devices.Interfaces = copyLiveUpdatableInterfaceFields(
+			lastSeenVMSpec.Template.Spec.Domain.Devices.Interfaces, currentVM.Spec.Template.Spec.Domain.Devices.Interfaces, true)
 		if validLiveUpdateDisks(lastSeenVMSpec, currentVM) {
 			lastSeenVMSpec.Template.Spec.Domain.Devices.Disks = currentVM.Spec.Template.Spec.Domain.Devices.Disks
 		}
@@ -2935,6 +3172,9 @@ func (c *Controller) addRestartRequiredIfNeeded(lastSeenVMSpec *virtv1.VirtualMa
 			lastSeenVMSpec.Template.Spec.Volumes = currentVM.Spec.Template.Spec.Volumes
 			lastSeenVMSpec.Template.Spec.Domain.Devices.Disks = currentVM.Spec.Template.Spec.Domain.Devices.Disks
 		}
+		// The interface link state is live-updatable regardless of the rollout strategy
+		lastSeenVMSpec.Template.Spec.Domain.Devices.Interfaces = copyLiveUpdatableInterfaceFields(
+			lastSeenVMSpec.Template.Spec.Domain.Devices.Interfaces, currentVM.Spec.Template.Spec.Domain.Devices.Interfaces, false)
 	}
 
 	if !equality.Semantic.DeepEqual(lastSeenVM.Spec.Template.Spec, currentVM.Spec.Template.Spec) {
@@ -3078,6 +3318,12 @@ func (c *Controller) sync(vm *virtv1.VirtualMachine, vmi *virtv1.VirtualMachineI
 		if err := c.handleVolumeUpdateRequest(vmCopy, vmi); err != nil {
 			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling volumes update requests: %v", err), volumesUpdateErrorReason), nil
 		}
+
+		if err := c.handleDeviceTuningChangeRequest(vmCopy, vmi, true); err != nil {
+			return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling device tuning change request: %v", err), deviceTuningErrorReason), nil
+		}
+	} else if err := c.handleDeviceTuningChangeRequest(vmCopy, vmi, false); err != nil {
+		return vm, vmi, common.NewSyncError(fmt.Errorf("error encountered while handling device tuning change request: %v", err), deviceTuningErrorReason), nil
 	}
 
 	if !equality.Semantic.DeepEqual(vm.Spec, vmCopy.Spec) || !equality.Semantic.DeepEqual(vm.ObjectMeta, vmCopy.ObjectMeta) {
@@ -3205,6 +3451,17 @@ func (c *Controller) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *
 		return nil
 	}
 
+	if conditionManager.HasConditionWithStatus(vmi,
+		virtv1.VirtualMachineInstanceMemoryUnplug, k8score.ConditionUnknown) {
+		return fmt.Errorf("the guest is still releasing memory from a previous unplug")
+	}
+
+	// virtio-mem memory is only released once the guest driver offlines it, which can't be expected from a guest that isn't up and running.
+	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest.Cmp(*vmi.Spec.Domain.Memory.Guest) == -1 && !isGuestAgentConnected(vmi) {
+		setRestartRequired(vm, "memory updated in template spec to a lower value. Memory hot-unplug requires a connected guest agent")
+		return nil
+	}
+
 	// If the following is true, MaxGuest was calculated, not manually specified (or the validation webhook would have rejected the change).
 	// Since we're here, we can also assume MaxGuest was not changed in the VM spec since last boot.
 	// Therefore, bumping Guest to a value higher than MaxGuest is fine, it just requires a reboot.
@@ -3214,6 +3471,15 @@ func (c *Controller) handleMemoryHotplugRequest(vm *virtv1.VirtualMachine, vmi *
 		return nil
 	}
 
+	if vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest.Cmp(*vmi.Spec.Domain.Memory.Guest) == 1 {
+		guestMemoryDelta := vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest.DeepCopy()
+		guestMemoryDelta.Sub(*vmi.Spec.Domain.Memory.Guest)
+		exceeded, err := c.hotplugExceedsQuota(vm, vmi, virtv1.VirtualMachineQuotaResources{GuestMemory: &guestMemoryDelta}, "memory")
+		if err != nil || exceeded {
+			return err
+		}
+	}
+
 	memoryDelta := resource.NewQuantity(vmCopyWithInstancetype.Spec.Template.Spec.Domain.Memory.Guest.Value()-vmi.Status.Memory.GuestCurrent.Value(), resource.BinarySI)
 
 	newMemoryReq := vmi.Spec.Domain.Resources.Requests.Memory().DeepCopy()
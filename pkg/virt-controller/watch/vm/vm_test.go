@@ -47,6 +47,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
+	"kubevirt.io/kubevirt/pkg/virt-config/featuregate"
 	"kubevirt.io/kubevirt/pkg/virt-controller/watch/common"
 	watchtesting "kubevirt.io/kubevirt/pkg/virt-controller/watch/testing"
 	watchutil "kubevirt.io/kubevirt/pkg/virt-controller/watch/util"
@@ -126,6 +127,8 @@ var _ = Describe("VirtualMachine", func() {
 				},
 			})
 			podInformer, _ := testutils.NewFakeInformerFor(&k8sv1.Pod{})
+			vmQuotaInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineQuota{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+			migrationInformer, _ := testutils.NewFakeInformerWithIndexersFor(&v1.VirtualMachineInstanceMigration{}, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
 
 			recorder = record.NewFakeRecorder(100)
 			recorder.IncludeObject = true
@@ -140,6 +143,8 @@ var _ = Describe("VirtualMachine", func() {
 				pvcInformer,
 				crInformer,
 				podInformer,
+				vmQuotaInformer,
+				migrationInformer,
 				recorder,
 				virtClient,
 				config,
@@ -3232,6 +3237,41 @@ var _ = Describe("VirtualMachine", func() {
 				Expect(vmi.Spec.Volumes[0].Name).To(Equal(testPVCName))
 			})
 
+			It("should add memory dump volume with the requested format to the vm and the vmi", func() {
+				vm, vmi := watchtesting.DefaultVirtualMachine(true)
+				vm.Status.Created = true
+				vm.Status.Ready = true
+				vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+					ClaimName: testPVCName,
+					Phase:     v1.MemoryDumpAssociating,
+					Format:    v1.MemoryDumpFormatKdumpZlib,
+				}
+
+				vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+				Expect(err).To(Succeed())
+				addVirtualMachine(vm)
+
+				watchtesting.MarkAsReady(vmi)
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				controller.vmiIndexer.Add(vmi)
+
+				sanityExecute(vm)
+
+				vm, err = virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+				Expect(err).To(Succeed())
+				Expect(vm.Spec.Template.Spec.Volumes).To(HaveLen(1))
+				Expect(vm.Spec.Template.Spec.Volumes[0].MemoryDump).ToNot(BeNil())
+				Expect(vm.Spec.Template.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatKdumpZlib))
+
+				vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(vmi.Spec.Volumes).To(HaveLen(1))
+				Expect(vmi.Spec.Volumes[0].MemoryDump).ToNot(BeNil())
+				Expect(vmi.Spec.Volumes[0].MemoryDump.Format).To(Equal(v1.MemoryDumpFormatKdumpZlib))
+				Expect(vmi.Spec.Volumes[0].MemoryDump.Hotpluggable).To(BeTrue())
+			})
+
 			It("should remove memory dump volume from vm volumes list", func() {
 				// No need to add vmi - can do this action even if vm not running
 				vm, _ := watchtesting.DefaultVirtualMachine(false)
@@ -3282,6 +3322,134 @@ var _ = Describe("VirtualMachine", func() {
 				Entry("in phase Dissociating", v1.MemoryDumpDissociating),
 			)
 
+			Context("after a guest panic", func() {
+				var panicTimestamp metav1.Time
+
+				newPanickedVMI := func(phase v1.GuestPanicPhase) *v1.VirtualMachineInstance {
+					_, vmi := watchtesting.DefaultVirtualMachine(true)
+					vmi.Status.GuestPanic = &v1.GuestPanicStatus{
+						Phase:               phase,
+						Count:               1,
+						Timestamp:           &panicTimestamp,
+						Action:              v1.PanicActionPause,
+						MemoryDumpClaimName: testPVCName,
+					}
+					return vmi
+				}
+
+				newMemoryDumpPVC := func(volumeMode k8sv1.PersistentVolumeMode) *k8sv1.PersistentVolumeClaim {
+					return &k8sv1.PersistentVolumeClaim{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      testPVCName,
+							Namespace: metav1.NamespaceDefault,
+						},
+						Spec: k8sv1.PersistentVolumeClaimSpec{
+							AccessModes: []k8sv1.PersistentVolumeAccessMode{k8sv1.ReadWriteOnce},
+							VolumeMode:  &volumeMode,
+							Resources: k8sv1.VolumeResourceRequirements{
+								Requests: k8sv1.ResourceList{
+									k8sv1.ResourceStorage: resource.MustParse("10Gi"),
+								},
+							},
+						},
+					}
+				}
+
+				expectMemoryDumpSkipped := func(vmi *v1.VirtualMachineInstance, reason string) {
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vmi.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(updatedVMI.Status.GuestPanic.Phase).To(Equal(v1.GuestPanicPaused))
+					Expect(updatedVMI.Status.GuestPanic.Message).To(ContainSubstring(reason))
+					testutils.ExpectEvent(recorder, guestPanicMemoryDumpErrorReason)
+				}
+
+				BeforeEach(func() {
+					panicTimestamp = metav1.Now()
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								DeveloperConfiguration: &v1.DeveloperConfiguration{
+									FeatureGates: []string{featuregate.HotplugVolumesGate},
+								},
+							},
+						},
+					})
+					Expect(controller.pvcStore.Add(newMemoryDumpPVC(k8sv1.PersistentVolumeFilesystem))).To(Succeed())
+					cdiClient.Fake.PrependReactor("get", "cdiconfigs", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+						return true, nil, k8serrors.NewNotFound(cdiv1.Resource("cdiconfigs"), action.(testing.GetAction).GetName())
+					})
+				})
+
+				It("should request a memory dump to the claim of the panic policy", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					Expect(controller.requestGuestPanicMemoryDump(vm, newPanickedVMI(v1.GuestPanicMemoryDumpInProgress))).To(Succeed())
+					Expect(vm.Status.MemoryDumpRequest).To(Equal(&v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: testPVCName,
+						Phase:     v1.MemoryDumpAssociating,
+					}))
+				})
+
+				It("should request a memory dump when a previous dump completed before the panic", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					endTimestamp := metav1.NewTime(panicTimestamp.Add(-time.Minute))
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName:    testPVCName,
+						Phase:        v1.MemoryDumpCompleted,
+						EndTimestamp: &endTimestamp,
+					}
+					Expect(controller.requestGuestPanicMemoryDump(vm, newPanickedVMI(v1.GuestPanicMemoryDumpInProgress))).To(Succeed())
+					Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpAssociating))
+				})
+
+				It("should not request a memory dump when the panic was already dumped", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					endTimestamp := metav1.NewTime(panicTimestamp.Add(time.Minute))
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName:    testPVCName,
+						Phase:        v1.MemoryDumpCompleted,
+						EndTimestamp: &endTimestamp,
+					}
+					Expect(controller.requestGuestPanicMemoryDump(vm, newPanickedVMI(v1.GuestPanicMemoryDumpInProgress))).To(Succeed())
+					Expect(vm.Status.MemoryDumpRequest.Phase).To(Equal(v1.MemoryDumpCompleted))
+				})
+
+				It("should not request a memory dump while another dump is in progress", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Status.MemoryDumpRequest = &v1.VirtualMachineMemoryDumpRequest{
+						ClaimName: "other",
+						Phase:     v1.MemoryDumpInProgress,
+					}
+					Expect(controller.requestGuestPanicMemoryDump(vm, newPanickedVMI(v1.GuestPanicMemoryDumpInProgress))).To(Succeed())
+					Expect(vm.Status.MemoryDumpRequest.ClaimName).To(Equal("other"))
+				})
+
+				It("should not request a memory dump once the panic policy action is applied", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					Expect(controller.requestGuestPanicMemoryDump(vm, newPanickedVMI(v1.GuestPanicRestarting))).To(Succeed())
+					Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+				})
+
+				DescribeTable("should skip the memory dump and apply the panic policy action", func(prepare func(), reason string) {
+					prepare()
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Create(context.Background(), newPanickedVMI(v1.GuestPanicMemoryDumpInProgress), metav1.CreateOptions{})
+					Expect(err).ToNot(HaveOccurred())
+
+					Expect(controller.requestGuestPanicMemoryDump(vm, vmi)).To(Succeed())
+					Expect(vm.Status.MemoryDumpRequest).To(BeNil())
+					expectMemoryDumpSkipped(vmi, reason)
+				},
+					Entry("if the HotplugVolumes feature gate is disabled", func() {
+						testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{})
+					}, "HotplugVolumes feature gate is not enabled"),
+					Entry("if the claim does not exist", func() {
+						Expect(controller.pvcStore.Delete(newMemoryDumpPVC(k8sv1.PersistentVolumeFilesystem))).To(Succeed())
+					}, "does not exist"),
+					Entry("if the claim is a block pvc", func() {
+						Expect(controller.pvcStore.Update(newMemoryDumpPVC(k8sv1.PersistentVolumeBlock))).To(Succeed())
+					}, "pvc should be filesystem pvc"),
+				)
+			})
 		})
 
 		Context("VM printableStatus", func() {
@@ -4790,6 +4958,43 @@ var _ = Describe("VirtualMachine", func() {
 					Expect(vmi.Spec.Domain.Resources.Limits.Cpu().String()).To(Equal(expectedCpuLim.String()))
 				})
 
+				It("should set a restartRequired condition if the CPU hotplug exceeds the VirtualMachineQuota", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 2,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    1,
+						MaxSockets: 4,
+					}
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+					Expect(controller.quotaIndexers.Quotas.Add(&v1.VirtualMachineQuota{
+						ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: vmi.Namespace},
+						Spec: v1.VirtualMachineQuotaSpec{
+							Hard: v1.VirtualMachineQuotaResources{VCPUs: pointer.P(int64(1))},
+						},
+					})).To(Succeed())
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.CPU.Sockets).To(Equal(uint32(1)))
+
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineRestartRequired)
+					Expect(cond).To(Not(BeNil()))
+					Expect(*cond).To(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+						"Type":    Equal(v1.VirtualMachineRestartRequired),
+						"Message": ContainSubstring("CPU hotplug not allowed, exceeded VirtualMachineQuota quota"),
+						"Status":  Equal(k8sv1.ConditionTrue),
+					}))
+				})
+
 				It("should set a restartRequired condition if NetworkInterfaceMultiQueue is enabled", func() {
 					vm, _ := watchtesting.DefaultVirtualMachine(true)
 					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
@@ -4814,6 +5019,96 @@ var _ = Describe("VirtualMachine", func() {
 						"Status":  Equal(k8sv1.ConditionTrue),
 					}))
 				})
+
+				It("should patch VMI when CPU hot-unplug is requested and the guest agent is connected", func() {
+					resources := v1.ResourceRequirements{
+						Requests: k8sv1.ResourceList{
+							k8sv1.ResourceCPU: resource.MustParse("300m"),
+						},
+					}
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.Resources = resources
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    3,
+						MaxSockets: 4,
+					}
+					vmi.Spec.Domain.Resources = resources
+					virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceAgentConnected,
+						Status: k8sv1.ConditionTrue,
+					})
+
+					vcpusDelta := int64(vm.Spec.Template.Spec.Domain.CPU.Sockets) - int64(vmi.Spec.Domain.CPU.Sockets)
+					resourcesDelta := resource.NewMilliQuantity(vcpusDelta*int64(1000*(1.0/float32(config.GetCPUAllocationRatio()))), resource.DecimalSI)
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.CPU.Sockets).To(Equal(vm.Spec.Template.Spec.Domain.CPU.Sockets))
+
+					expectedCpuReq := vmi.Spec.Domain.Resources.Requests.Cpu().DeepCopy()
+					expectedCpuReq.Add(*resourcesDelta)
+					Expect(updatedVMI.Spec.Domain.Resources.Requests.Cpu().String()).To(Equal(expectedCpuReq.String()))
+
+					Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(vm, v1.VirtualMachineRestartRequired)).To(BeFalse())
+				})
+
+				It("should set a restartRequired condition if CPU hot-unplug is requested and the guest agent is not connected", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    2,
+						MaxSockets: 4,
+					}
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).To(Succeed())
+
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineRestartRequired)
+					Expect(cond).To(Not(BeNil()))
+					Expect(*cond).To(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+						"Type":    Equal(v1.VirtualMachineRestartRequired),
+						"Message": ContainSubstring("requires a connected guest agent"),
+						"Status":  Equal(k8sv1.ConditionTrue),
+					}))
+				})
+
+				It("should not patch VMI while the guest is still releasing vCPUs", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					vm.Spec.Template.Spec.Domain.CPU = &v1.CPU{
+						Sockets: 1,
+					}
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					vmi.Spec.Domain.CPU = &v1.CPU{
+						Sockets:    2,
+						MaxSockets: 4,
+					}
+					vmiCondManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+					vmiCondManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceAgentConnected,
+						Status: k8sv1.ConditionTrue,
+					})
+					vmiCondManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceCPUUnplug,
+						Status: k8sv1.ConditionUnknown,
+						Reason: v1.VirtualMachineInstanceReasonCPUUnplugInProgress,
+					})
+
+					Expect(controller.handleCPUChangeRequest(vm, vmi)).ToNot(Succeed())
+				})
 			})
 
 			Context("Memory", func() {
@@ -4907,6 +5202,51 @@ var _ = Describe("VirtualMachine", func() {
 					}),
 				)
 
+				It("should set a restartRequired condition if the memory hotplug exceeds the VirtualMachineQuota", func() {
+					vm, _ := watchtesting.DefaultVirtualMachine(true)
+					newMemory := resource.MustParse("2Gi")
+					vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{Guest: &newMemory}
+					vm.Spec.Template.Spec.Architecture = "amd64"
+
+					vmi := api.NewMinimalVMI(vm.Name)
+					guestMemory := resource.MustParse("1Gi")
+					vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, MaxGuest: &maxGuestFromSpec}
+					vmi.Spec.Domain.Resources.Requests = k8sv1.ResourceList{k8sv1.ResourceMemory: guestMemory}
+					vmi.Status.Memory = &v1.MemoryStatus{
+						GuestAtBoot:    &guestMemory,
+						GuestCurrent:   &guestMemory,
+						GuestRequested: &guestMemory,
+					}
+					virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+						Type:   v1.VirtualMachineInstanceIsMigratable,
+						Status: k8sv1.ConditionTrue,
+					})
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+					Expect(controller.quotaIndexers.Quotas.Add(&v1.VirtualMachineQuota{
+						ObjectMeta: metav1.ObjectMeta{Name: "quota", Namespace: vmi.Namespace},
+						Spec: v1.VirtualMachineQuotaSpec{
+							Hard: v1.VirtualMachineQuotaResources{GuestMemory: pointer.P(resource.MustParse("1536Mi"))},
+						},
+					})).To(Succeed())
+
+					Expect(controller.handleMemoryHotplugRequest(vm, vmi)).To(Succeed())
+
+					updatedVMI, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(updatedVMI.Spec.Domain.Memory.Guest.Cmp(guestMemory)).To(BeZero())
+
+					cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineRestartRequired)
+					Expect(cond).To(Not(BeNil()))
+					Expect(*cond).To(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+						"Type":    Equal(v1.VirtualMachineRestartRequired),
+						"Message": ContainSubstring("memory hotplug not allowed, exceeded VirtualMachineQuota quota"),
+						"Status":  Equal(k8sv1.ConditionTrue),
+					}))
+				})
+
 				It("should not patch VMI if memory hotplug is already in progress", func() {
 					vm, _ := watchtesting.DefaultVirtualMachine(true)
 					newMemory := resource.MustParse("128Mi")
@@ -5198,6 +5538,80 @@ var _ = Describe("VirtualMachine", func() {
 						"Status":  Equal(k8sv1.ConditionTrue),
 					}))
 				})
+
+				Context("hot-unplug", func() {
+					var (
+						vm  *v1.VirtualMachine
+						vmi *v1.VirtualMachineInstance
+					)
+
+					BeforeEach(func() {
+						bootMemory := resource.MustParse("1Gi")
+						guestMemory := resource.MustParse("3Gi")
+						newMemory := resource.MustParse("2Gi")
+
+						vm, _ = watchtesting.DefaultVirtualMachine(true)
+						vm.Spec.Template.Spec.Domain.Memory = &v1.Memory{Guest: &newMemory}
+						vm.Spec.Template.Spec.Architecture = "amd64"
+
+						vmi = api.NewMinimalVMI(vm.Name)
+						vmi.Spec.Domain.Memory = &v1.Memory{Guest: &guestMemory, MaxGuest: &maxGuestFromSpec}
+						vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory] = guestMemory
+						vmi.Status.Memory = &v1.MemoryStatus{
+							GuestAtBoot:    &bootMemory,
+							GuestCurrent:   &guestMemory,
+							GuestRequested: &guestMemory,
+						}
+						virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+							Type:   v1.VirtualMachineInstanceIsMigratable,
+							Status: k8sv1.ConditionTrue,
+						})
+					})
+
+					It("should patch VMI if the guest agent is connected", func() {
+						virtcontroller.NewVirtualMachineInstanceConditionManager().UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+							Type:   v1.VirtualMachineInstanceAgentConnected,
+							Status: k8sv1.ConditionTrue,
+						})
+						vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(controller.handleMemoryHotplugRequest(vm, vmi)).To(Succeed())
+
+						vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.Background(), vmi.Name, metav1.GetOptions{})
+						Expect(err).NotTo(HaveOccurred())
+						Expect(vmi.Spec.Domain.Memory.Guest.Cmp(*vm.Spec.Template.Spec.Domain.Memory.Guest)).To(Equal(0), "The VMI Guest should match VM's")
+						Expect(vmi.Spec.Domain.Resources.Requests.Memory().Cmp(*vm.Spec.Template.Spec.Domain.Memory.Guest)).To(Equal(0))
+						Expect(virtcontroller.NewVirtualMachineConditionManager().HasCondition(vm, v1.VirtualMachineRestartRequired)).To(BeFalse())
+					})
+
+					It("should set a restartRequired condition if the guest agent is not connected", func() {
+						Expect(controller.handleMemoryHotplugRequest(vm, vmi)).To(Succeed())
+
+						cond := virtcontroller.NewVirtualMachineConditionManager().GetCondition(vm, v1.VirtualMachineRestartRequired)
+						Expect(cond).To(Not(BeNil()))
+						Expect(*cond).To(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+							"Type":    Equal(v1.VirtualMachineRestartRequired),
+							"Message": ContainSubstring("Memory hot-unplug requires a connected guest agent"),
+							"Status":  Equal(k8sv1.ConditionTrue),
+						}))
+					})
+
+					It("should not patch VMI while the guest is still releasing memory", func() {
+						vmiCondManager := virtcontroller.NewVirtualMachineInstanceConditionManager()
+						vmiCondManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+							Type:   v1.VirtualMachineInstanceAgentConnected,
+							Status: k8sv1.ConditionTrue,
+						})
+						vmiCondManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+							Type:   v1.VirtualMachineInstanceMemoryUnplug,
+							Status: k8sv1.ConditionUnknown,
+							Reason: v1.VirtualMachineInstanceReasonMemoryUnplugInProgress,
+						})
+
+						Expect(controller.handleMemoryHotplugRequest(vm, vmi)).ToNot(Succeed())
+					})
+				})
 			})
 
 			Context("Tolerations", func() {
@@ -5292,6 +5706,91 @@ var _ = Describe("VirtualMachine", func() {
 				)
 			})
 
+			Context("Interface link state", func() {
+				It("should apply the VM template link state to the VMI regardless of the rollout strategy", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default"}}
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default", State: v1.InterfaceStateLinkDown}}
+
+					vmi, err := virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(controller.handleDeviceTuningChangeRequest(vm, vmi, false)).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.Interfaces).To(Equal(vm.Spec.Template.Spec.Domain.Devices.Interfaces))
+				})
+			})
+
+			Context("Device tuning", func() {
+				BeforeEach(func() {
+					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
+						Spec: v1.KubeVirtSpec{
+							Configuration: v1.KubeVirtConfiguration{
+								VMRolloutStrategy: &liveUpdate,
+							},
+						},
+					})
+				})
+
+				It("should live-update disk I/O limits, interface link state and bandwidth", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0"}}
+					vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{{Name: "default"}}
+					vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{
+						Name:   "disk0",
+						IOTune: &v1.DiskIOTune{ReadIOPS: pointer.P(int64(100))},
+					}}
+					vm.Spec.Template.Spec.Domain.Devices.Interfaces = []v1.Interface{{
+						Name:  "default",
+						State: v1.InterfaceStateLinkDown,
+						Bandwidth: &v1.InterfaceBandwidth{
+							Outbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi")},
+						},
+					}}
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(HaveLen(1))
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Get(context.TODO(), vm.Name, metav1.GetOptions{})
+					Expect(err).ToNot(HaveOccurred())
+					Expect(vmi.Spec.Domain.Devices.Disks).To(Equal(vm.Spec.Template.Spec.Domain.Devices.Disks))
+					Expect(vmi.Spec.Domain.Devices.Interfaces).To(HaveLen(1))
+					Expect(vmi.Spec.Domain.Devices.Interfaces[0].State).To(Equal(v1.InterfaceStateLinkDown))
+					Expect(vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth.Outbound.Average.Value()).To(Equal(int64(1048576)))
+				})
+
+				It("should not patch the VMI when nothing changed", func() {
+					vm, vmi := watchtesting.DefaultVirtualMachine(true)
+					vmi.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0"}}
+					vm.Spec.Template.Spec.Domain.Devices.Disks = []v1.Disk{{Name: "disk0"}}
+
+					vm, err := virtFakeClient.KubevirtV1().VirtualMachines(vm.Namespace).Create(context.TODO(), vm, metav1.CreateOptions{})
+					Expect(err).To(Succeed())
+
+					vmi, err = virtFakeClient.KubevirtV1().VirtualMachineInstances(vm.Namespace).Create(context.Background(), vmi, metav1.CreateOptions{})
+					Expect(err).NotTo(HaveOccurred())
+					Expect(controller.vmiIndexer.Add(vmi)).To(Succeed())
+
+					addVirtualMachine(vm)
+
+					sanityExecute(vm)
+
+					Expect(kvtesting.FilterActions(&virtFakeClient.Fake, "patch", "virtualmachineinstances")).To(BeEmpty())
+				})
+			})
+
 			Context("Affinity", func() {
 				It("should be live-updated", func() {
 					testutils.UpdateFakeKubeVirtClusterConfig(kvStore, &v1.KubeVirt{
@@ -6252,6 +6751,26 @@ var _ = Describe("VirtualMachine", func() {
 			Entry("for a removed hotpluggable pvc", []v1.Volume{createPVCVol("vol1", "test1", true)}, []v1.Volume{},
 				[]v1.Disk{createDisk("vol1")}, []v1.Disk{}, true),
 		)
+
+		DescribeTable("should copy only the live-updatable interface state", func(current, desired, expected v1.InterfaceState) {
+			ifaces := copyLiveUpdatableInterfaceFields(
+				[]v1.Interface{{Name: "default", State: current}},
+				[]v1.Interface{{Name: "default", State: desired}}, true)
+			Expect(ifaces).To(Equal([]v1.Interface{{Name: "default", State: expected}}))
+		},
+			Entry("from up to down", v1.InterfaceStateLinkUp, v1.InterfaceStateLinkDown, v1.InterfaceStateLinkDown),
+			Entry("from unset to down", v1.InterfaceState(""), v1.InterfaceStateLinkDown, v1.InterfaceStateLinkDown),
+			Entry("but not to absent", v1.InterfaceStateLinkUp, v1.InterfaceStateAbsent, v1.InterfaceStateLinkUp),
+			Entry("but not from absent", v1.InterfaceStateAbsent, v1.InterfaceStateLinkUp, v1.InterfaceStateAbsent),
+		)
+
+		It("should copy only the interface link state without live update", func() {
+			bandwidth := &v1.InterfaceBandwidth{Outbound: &v1.BandwidthLimit{Average: resource.MustParse("1Mi")}}
+			ifaces := copyLiveUpdatableInterfaceFields(
+				[]v1.Interface{{Name: "default", State: v1.InterfaceStateLinkUp}},
+				[]v1.Interface{{Name: "default", State: v1.InterfaceStateLinkDown, Bandwidth: bandwidth}}, false)
+			Expect(ifaces).To(Equal([]v1.Interface{{Name: "default", State: v1.InterfaceStateLinkDown}}))
+		})
 	})
 
 	Context("syncVolumeMigration", func() {
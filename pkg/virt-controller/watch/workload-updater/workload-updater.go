@@ -423,9 +423,65 @@ func (c *WorkloadUpdateController) getUpdateData(kv *virtv1.KubeVirt) *updateDat
 		}
 	}
 
+	inWave := c.handlerUpdateWaveFilter(kv, data.migratableOutdatedVMIs)
+	data.migratableOutdatedVMIs = filterVMIs(data.migratableOutdatedVMIs, inWave)
+	data.evictOutdatedVMIs = filterVMIs(data.evictOutdatedVMIs, inWave)
+
 	return data
 }
 
+func handlerUpdateInProgress(kv *virtv1.KubeVirt) bool {
+	status := kv.Status.HandlerUpdate
+	return status != nil && status.TargetDeploymentID == kv.Status.TargetDeploymentID
+}
+
+// handlerUpdateWaveFilter follows a staged virt-handler rollout: outdated VMIs are only updated
+// on the nodes of the first wave which still hosts migratable outdated VMIs, or of the waves before it.
+// VMIs which can't be migrated therefore don't hold back later waves. Nodes which are not part of
+// any wave come last. VMIs which require a migration for other reasons are not held back.
+func (c *WorkloadUpdateController) handlerUpdateWaveFilter(kv *virtv1.KubeVirt, migratableVMIs []*virtv1.VirtualMachineInstance) func(*virtv1.VirtualMachineInstance) bool {
+	if !handlerUpdateInProgress(kv) {
+		return func(*virtv1.VirtualMachineInstance) bool { return true }
+	}
+	status := kv.Status.HandlerUpdate
+
+	waveOfNode := map[string]int{}
+	for i, wave := range status.Waves {
+		for _, node := range wave.Nodes {
+			if _, exists := waveOfNode[node]; !exists {
+				waveOfNode[node] = i
+			}
+		}
+	}
+	getWave := func(vmi *virtv1.VirtualMachineInstance) int {
+		if wave, exists := waveOfNode[vmi.Status.NodeName]; exists {
+			return wave
+		}
+		return len(status.Waves)
+	}
+
+	currentWave := len(status.Waves)
+	for _, vmi := range migratableVMIs {
+		if wave := getWave(vmi); c.isOutdated(vmi) && wave < currentWave {
+			currentWave = wave
+		}
+	}
+
+	return func(vmi *virtv1.VirtualMachineInstance) bool {
+		return !c.isOutdated(vmi) || getWave(vmi) <= currentWave
+	}
+}
+
+func filterVMIs(vmis []*virtv1.VirtualMachineInstance, filter func(*virtv1.VirtualMachineInstance) bool) []*virtv1.VirtualMachineInstance {
+	var filtered []*virtv1.VirtualMachineInstance
+	for _, vmi := range vmis {
+		if filter(vmi) {
+			filtered = append(filtered, vmi)
+		}
+	}
+	return filtered
+}
+
 func (c *WorkloadUpdateController) execute(key string) error {
 	obj, exists, err := c.kubeVirtStore.GetByKey(key)
 
@@ -445,11 +501,14 @@ func (c *WorkloadUpdateController) execute(key string) error {
 
 	kv := obj.(*virtv1.KubeVirt)
 
-	// don't update workloads unless the infra is completely deployed and not updating
-	if kv.Status.Phase != virtv1.KubeVirtPhaseDeployed {
-		return nil
-	} else if kv.Status.ObservedDeploymentID != kv.Status.TargetDeploymentID {
-		return nil
+	// don't update workloads unless the infra is completely deployed and not updating,
+	// a staged virt-handler rollout however relies on the workloads following its waves
+	if !handlerUpdateInProgress(kv) {
+		if kv.Status.Phase != virtv1.KubeVirtPhaseDeployed {
+			return nil
+		} else if kv.Status.ObservedDeploymentID != kv.Status.TargetDeploymentID {
+			return nil
+		}
 	}
 
 	return c.sync(kv)
@@ -343,6 +343,73 @@ var _ = Describe("Workload Updater", func() {
 			Expect(migrations.Items).To(HaveLen(1))
 		})
 
+		It("should only update outdated VMIs on the nodes of the current virt-handler update wave", func() {
+			for _, node := range []string{"node01", "node02", "node03"} {
+				vmi := newVirtualMachineInstance("testvm-"+node, true, "madeup")
+				vmi.Status.NodeName = node
+				controller.vmiStore.Add(vmi)
+				controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			}
+			vmi := newVirtualMachineInstance("testvm-up-to-date", true, expectedImage)
+			vmi.Status.NodeName = "node01"
+			controller.vmiStore.Add(vmi)
+			controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 4)
+
+			kv := newKubeVirt(3)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			kv.Status.Phase = v1.KubeVirtPhaseDeploying
+			kv.Status.TargetDeploymentID = "new"
+			kv.Status.HandlerUpdate = &v1.HandlerUpdateStatus{
+				TargetDeploymentID: "new",
+				CurrentWave:        1,
+				Waves: []v1.HandlerUpdateWaveStatus{
+					{Name: "canary", Nodes: []string{"node02"}},
+					{Name: "main", Nodes: []string{"node01"}},
+				},
+			}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(HaveLen(1))
+			Expect(migrations.Items[0].Spec.VMIName).To(Equal("testvm-node02"))
+		})
+
+		It("should not let outdated VMIs which can't be migrated hold back the next virt-handler update wave", func() {
+			vmi := newVirtualMachineInstance("testvm-node02", false, "madeup")
+			vmi.Status.NodeName = "node02"
+			controller.vmiStore.Add(vmi)
+			controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			for _, node := range []string{"node01", "node03"} {
+				vmi := newVirtualMachineInstance("testvm-"+node, true, "madeup")
+				vmi.Status.NodeName = node
+				controller.vmiStore.Add(vmi)
+				controller.podIndexer.Add(newLauncherPodForVMI(vmi))
+			}
+			waitForNumberOfInstancesOnVMIInformerCache(controller, 3)
+
+			kv := newKubeVirt(3)
+			kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods = []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodLiveMigrate}
+			kv.Status.HandlerUpdate = &v1.HandlerUpdateStatus{
+				CurrentWave: 2,
+				Waves: []v1.HandlerUpdateWaveStatus{
+					{Name: "canary", Nodes: []string{"node02"}},
+					{Name: "main", Nodes: []string{"node01"}},
+				},
+			}
+			addKubeVirt(kv)
+
+			sanityExecute()
+			testutils.ExpectEvent(recorder, SuccessfulCreateVirtualMachineInstanceMigrationReason)
+			migrations, err := fakeVirtClient.KubevirtV1().VirtualMachineInstanceMigrations(k8sv1.NamespaceDefault).List(context.Background(), metav1.ListOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(migrations.Items).To(HaveLen(1))
+			Expect(migrations.Items[0].Spec.VMIName).To(Equal("testvm-node01"))
+		})
+
 		It("should do nothing if no method is set", func() {
 			totalVMs := 0
 			for i := 0; i < 50; i++ {
@@ -36,6 +36,7 @@ import (
 	"path/filepath"
 	"syscall"
 	"time"
+	"unicode/utf8"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
@@ -111,12 +112,18 @@ type LauncherClient interface {
 	GetSEVInfo() (*v1.SEVPlatformInfo, error)
 	GetLaunchMeasurement(*v1.VirtualMachineInstance) (*v1.SEVMeasurementInfo, error)
 	InjectLaunchSecret(*v1.VirtualMachineInstance, *v1.SEVSecretOptions) error
+	GuestFileRead(vmi *v1.VirtualMachineInstance, path string, maxBytes int64) ([]byte, error)
+	GuestFileWrite(vmi *v1.VirtualMachineInstance, path string, contents []byte) error
+	GuestExec(vmi *v1.VirtualMachineInstance, command string, args []string, timeoutSeconds int32, output func(*v1.GuestExecResult) error) error
+	GetEFIVars(vmi *v1.VirtualMachineInstance, maxBytes int64) ([]byte, error)
 	SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
 }
 
 type VirtLauncherClient struct {
-	v1client cmdv1.CmdClient
-	conn     *grpc.ClientConn
+	v1client    cmdv1.CmdClient
+	guestClient cmdv1.GuestClient
+	efiClient   cmdv1.EFIClient
+	conn        *grpc.ClientConn
 }
 
 const (
@@ -287,8 +294,10 @@ func NewClientWithInfoClient(infoClient info.CmdInfoClient, conn *grpc.ClientCon
 
 func newV1Client(client cmdv1.CmdClient, conn *grpc.ClientConn) LauncherClient {
 	return &VirtLauncherClient{
-		v1client: client,
-		conn:     conn,
+		v1client:    client,
+		guestClient: cmdv1.NewGuestClient(conn),
+		efiClient:   cmdv1.NewEFIClient(conn),
+		conn:        conn,
 	}
 }
 
@@ -398,7 +407,7 @@ func (c *VirtLauncherClient) FreezeVirtualMachine(vmi *v1.VirtualMachineInstance
 		UnfreezeTimeoutSeconds: unfreezeTimeoutSeconds,
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout+freezeHooksTimeout(vmi))
 	defer cancel()
 	response, err := c.v1client.FreezeVirtualMachine(ctx, request)
 
@@ -407,7 +416,29 @@ func (c *VirtLauncherClient) FreezeVirtualMachine(vmi *v1.VirtualMachineInstance
 }
 
 func (c *VirtLauncherClient) UnfreezeVirtualMachine(vmi *v1.VirtualMachineInstance) error {
-	return c.genericSendVMICmd("Unfreeze", c.v1client.UnfreezeVirtualMachine, vmi, &cmdv1.VirtualMachineOptions{})
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.VMIRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Options: &cmdv1.VirtualMachineOptions{},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout+freezeHooksTimeout(vmi))
+	defer cancel()
+	response, err := c.v1client.UnfreezeVirtualMachine(ctx, request)
+
+	err = handleError(err, "Unfreeze", response)
+	return err
+}
+
+// freezeHooksTimeout is the additional time freeze and unfreeze calls may take to run the freeze hooks in the guest
+func freezeHooksTimeout(vmi *v1.VirtualMachineInstance) time.Duration {
+	return time.Duration(vmi.Spec.FreezeHooks.GetHooksTimeoutSeconds()) * time.Second
 }
 
 func (c *VirtLauncherClient) VirtualMachineMemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
@@ -803,6 +834,154 @@ func (c *VirtLauncherClient) InjectLaunchSecret(vmi *v1.VirtualMachineInstance,
 	return handleError(err, "InjectLaunchSecret", response)
 }
 
+func (c *VirtLauncherClient) GuestFileRead(vmi *v1.VirtualMachineInstance, path string, maxBytes int64) ([]byte, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.GuestFileReadRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Path:     path,
+		MaxBytes: maxBytes,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	response, err := c.guestClient.GuestFileRead(ctx, request)
+	if err = handleError(err, "GuestFileRead", response.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	return response.GetContents(), nil
+}
+
+func (c *VirtLauncherClient) GuestFileWrite(vmi *v1.VirtualMachineInstance, path string, contents []byte) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.GuestFileWriteRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Path:     path,
+		Contents: contents,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), longTimeout)
+	defer cancel()
+
+	response, err := c.guestClient.GuestFileWrite(ctx, request)
+
+	return handleError(err, "GuestFileWrite", response)
+}
+
+// GuestExec passes the output of the command to output while it runs, the last result passed holds its exit code
+func (c *VirtLauncherClient) GuestExec(vmi *v1.VirtualMachineInstance, command string, args []string, timeoutSeconds int32, output func(*v1.GuestExecResult) error) error {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return err
+	}
+
+	request := &cmdv1.GuestExecRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		Command:        command,
+		Args:           args,
+		TimeoutSeconds: timeoutSeconds,
+	}
+
+	ctx, cancel := context.WithTimeout(
+		context.Background(),
+		// we give the context a bit more time as the timeout should kick
+		// on the actual execution
+		time.Duration(timeoutSeconds)*time.Second+shortTimeout,
+	)
+	defer cancel()
+
+	stream, err := c.guestClient.GuestExec(ctx, request)
+	if err = handleError(err, "GuestExec", nil); err != nil {
+		return err
+	}
+
+	var pendingStdout, pendingStderr []byte
+	for {
+		response, err := stream.Recv()
+		if err == io.EOF {
+			return fmt.Errorf("the output of guest command %s ended before it exited", command)
+		}
+		if err = handleError(err, "GuestExec", response.GetResponse()); err != nil {
+			return err
+		}
+
+		result := &v1.GuestExecResult{
+			Stdout:   completeRunes(&pendingStdout, response.GetStdOut(), response.GetExited()),
+			Stderr:   completeRunes(&pendingStderr, response.GetStdErr(), response.GetExited()),
+			Exited:   response.GetExited(),
+			ExitCode: response.GetExitCode(),
+		}
+		if result.Stdout != "" || result.Stderr != "" || result.Exited {
+			if err := output(result); err != nil {
+				return err
+			}
+		}
+		if result.Exited {
+			return nil
+		}
+	}
+}
+
+// completeRunes returns the pending and the new output up to its last complete UTF-8 character and keeps the rest
+// pending, so that characters split across two chunks of output are not lost when converting it to a string
+func completeRunes(pending *[]byte, chunk []byte, flush bool) string {
+	data := append(*pending, chunk...)
+	*pending = nil
+	if flush {
+		return string(data)
+	}
+	// only the last utf8.UTFMax bytes can belong to an incomplete character
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				*pending = append([]byte{}, data[i:]...)
+				data = data[:i]
+			}
+			break
+		}
+	}
+	return string(data)
+}
+
+func (c *VirtLauncherClient) GetEFIVars(vmi *v1.VirtualMachineInstance, maxBytes int64) ([]byte, error) {
+	vmiJson, err := json.Marshal(vmi)
+	if err != nil {
+		return nil, err
+	}
+
+	request := &cmdv1.EFIVarsRequest{
+		Vmi: &cmdv1.VMI{
+			VmiJson: vmiJson,
+		},
+		MaxBytes: maxBytes,
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), shortTimeout)
+	defer cancel()
+
+	response, err := c.efiClient.GetEFIVars(ctx, request)
+	if err = handleError(err, "GetEFIVars", response.GetResponse()); err != nil {
+		return nil, err
+	}
+
+	return response.GetContents(), nil
+}
+
 func (c *VirtLauncherClient) SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error {
 	return c.genericSendVMICmd("SyncVirtualMachineMemory", c.v1client.SyncVirtualMachineMemory, vmi, options)
 }
@@ -353,6 +353,48 @@ func (_mr *_MockLauncherClientRecorder) InjectLaunchSecret(arg0, arg1 interface{
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "InjectLaunchSecret", arg0, arg1)
 }
 
+func (_m *MockLauncherClient) GuestFileRead(vmi *v1.VirtualMachineInstance, path string, maxBytes int64) ([]byte, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileRead", vmi, path, maxBytes)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestFileRead(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", arg0, arg1, arg2)
+}
+
+func (_m *MockLauncherClient) GuestFileWrite(vmi *v1.VirtualMachineInstance, path string, contents []byte) error {
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", vmi, path, contents)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestFileWrite(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", arg0, arg1, arg2)
+}
+
+func (_m *MockLauncherClient) GetEFIVars(vmi *v1.VirtualMachineInstance, maxBytes int64) ([]byte, error) {
+	ret := _m.ctrl.Call(_m, "GetEFIVars", vmi, maxBytes)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockLauncherClientRecorder) GetEFIVars(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetEFIVars", arg0, arg1)
+}
+
+func (_m *MockLauncherClient) GuestExec(vmi *v1.VirtualMachineInstance, command string, args []string, timeoutSeconds int32, output func(*v1.GuestExecResult) error) error {
+	ret := _m.ctrl.Call(_m, "GuestExec", vmi, command, args, timeoutSeconds, output)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockLauncherClientRecorder) GuestExec(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1, arg2, arg3, arg4)
+}
+
 func (_m *MockLauncherClient) SyncVirtualMachineMemory(vmi *v1.VirtualMachineInstance, options *v10.VirtualMachineOptions) error {
 	ret := _m.ctrl.Call(_m, "SyncVirtualMachineMemory", vmi, options)
 	ret0, _ := ret[0].(error)
@@ -157,6 +157,24 @@ func (t *ConsoleHandler) VNCHandler(request *restful.Request, response *restful.
 	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), stopChn)
 }
 
+func (t *ConsoleHandler) SPICEHandler(request *restful.Request, response *restful.Response) {
+	vmi, code, err := getVMI(request, t.vmiStore)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error(failedRetrieveVMI)
+		response.WriteError(code, err)
+		return
+	}
+	unixSocketPath, err := t.getUnixSocketPath(vmi, "virt-spice")
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed finding unix socket for SPICE console")
+		response.WriteError(http.StatusBadRequest, err)
+		return
+	}
+	// A SPICE client opens a separate connection for each of its channels, so unlike
+	// VNC, a new connection must not terminate the existing ones.
+	t.stream(vmi, request, response, unixSocketDialer(vmi, unixSocketPath), make(chan struct{}))
+}
+
 func (t *ConsoleHandler) SerialHandler(request *restful.Request, response *restful.Response) {
 	vmi, code, err := getVMI(request, t.vmiStore)
 	if err != nil {
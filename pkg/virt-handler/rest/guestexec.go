@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+func (lh *LifecycleHandler) GuestExecHandler(request *restful.Request, response *restful.Response) {
+	if request.Request.Body == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("request body is required"))
+		return
+	}
+	defer request.Request.Body.Close()
+
+	options := &v1.GuestExecOptions{}
+	if err := json.NewDecoder(request.Request.Body).Decode(options); err != nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to decode guest exec options: %v", err))
+		return
+	}
+	if options.Command == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("command is required"))
+		return
+	}
+
+	timeoutSeconds := int32(v1.GuestExecDefaultTimeoutSeconds)
+	if options.TimeoutSeconds != nil {
+		timeoutSeconds = *options.TimeoutSeconds
+	}
+	if timeoutSeconds <= 0 || timeoutSeconds > v1.GuestExecMaxTimeoutSeconds {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("timeoutSeconds must be between 1 and %d", v1.GuestExecMaxTimeoutSeconds))
+		return
+	}
+
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	// the output is streamed as a sequence of results, an error can only be reported until the first one was sent
+	streaming := false
+	encoder := json.NewEncoder(response)
+	err = client.GuestExec(vmi, options.Command, options.Args, timeoutSeconds, func(result *v1.GuestExecResult) error {
+		if !streaming {
+			response.Header().Set("Content-Type", restful.MIME_JSON)
+			response.WriteHeader(http.StatusOK)
+			streaming = true
+		}
+		if err := encoder.Encode(result); err != nil {
+			return err
+		}
+		response.Flush()
+		return nil
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to execute guest command %s", options.Command)
+		if !streaming {
+			response.WriteError(http.StatusInternalServerError, err)
+		}
+	}
+}
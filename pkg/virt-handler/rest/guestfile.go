@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/emicklei/go-restful/v3"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+)
+
+const guestFilePathParamName = "path"
+
+// maxGuestFileRequestSize leaves room for the base64 encoding of the contents and the remaining fields
+const maxGuestFileRequestSize = v1.GuestFileMaxSize/3*4 + 64*1024
+
+func (lh *LifecycleHandler) GuestFileReadHandler(request *restful.Request, response *restful.Response) {
+	path := request.QueryParameter(guestFilePathParamName)
+	if path == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("path is required"))
+		return
+	}
+
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	contents, err := client.GuestFileRead(vmi, path, v1.GuestFileMaxSize)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to read guest file %s", path)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteEntity(v1.GuestFile{
+		Path:     path,
+		Contents: contents,
+	})
+}
+
+func (lh *LifecycleHandler) GuestFileWriteHandler(request *restful.Request, response *restful.Response) {
+	if request.Request.Body == nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("request body is required"))
+		return
+	}
+	defer request.Request.Body.Close()
+
+	guestFile := &v1.GuestFile{}
+	if err := json.NewDecoder(http.MaxBytesReader(response.ResponseWriter, request.Request.Body, maxGuestFileRequestSize)).Decode(guestFile); err != nil {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("failed to decode guest file: %v", err))
+		return
+	}
+	if guestFile.Path == "" {
+		response.WriteError(http.StatusBadRequest, fmt.Errorf("path is required"))
+		return
+	}
+	if len(guestFile.Contents) > v1.GuestFileMaxSize {
+		response.WriteError(http.StatusRequestEntityTooLarge, fmt.Errorf("contents exceed the maximum size of %d bytes", v1.GuestFileMaxSize))
+		return
+	}
+
+	vmi, client, err := lh.getVMILauncherClient(request, response)
+	if err != nil {
+		return
+	}
+
+	if err := client.GuestFileWrite(vmi, guestFile.Path, guestFile.Contents); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to write guest file %s", guestFile.Path)
+		response.WriteError(http.StatusInternalServerError, err)
+		return
+	}
+
+	response.WriteHeader(http.StatusAccepted)
+}
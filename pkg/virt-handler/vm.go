@@ -117,6 +117,10 @@ const (
 	unableCreateVirtLauncherConnectionFmt = "unable to create virt-launcher client connection: %v"
 	// This value was determined after consulting with libvirt developers and performing extensive testing.
 	parallelMultifdMigrationThreads = uint(8)
+	// memoryUnplugTimeout is how long the guest is given to release hot-unplugged memory
+	memoryUnplugTimeout = 5 * time.Minute
+	// cpuUnplugTimeout is how long the guest is given to release hot-unplugged vCPUs
+	cpuUnplugTimeout = 5 * time.Minute
 )
 
 const (
@@ -1173,6 +1177,10 @@ func (c *VirtualMachineController) updatePausedConditions(vmi *v1.VirtualMachine
 			}
 			calculatePausedCondition(vmi, reason)
 		}
+	} else if isDomainPanicked(domain) && vmi.Status.GuestPanic != nil && vmi.Status.GuestPanic.Phase == v1.GuestPanicPaused {
+		if !condManager.HasCondition(vmi, v1.VirtualMachineInstancePaused) {
+			calculatePausedCondition(vmi, api.ReasonPanicked)
+		}
 	} else if condManager.HasCondition(vmi, v1.VirtualMachineInstancePaused) {
 		log.Log.Object(vmi).V(3).Info("Removing paused condition")
 		condManager.RemoveCondition(vmi, v1.VirtualMachineInstancePaused)
@@ -1235,6 +1243,112 @@ func (c *VirtualMachineController) updateFSFreezeStatus(vmi *v1.VirtualMachineIn
 
 }
 
+func (c *VirtualMachineController) updateFreezeHooksStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if domain == nil || domain.Spec.Metadata.KubeVirt.FreezeHooks == nil {
+		return
+	}
+
+	freezeHooksMetadata := domain.Spec.Metadata.KubeVirt.FreezeHooks
+	freezeHooksStatus := &v1.FreezeHooksStatus{}
+	for _, step := range []struct {
+		name     v1.FreezeHookStepName
+		metadata *api.FreezeHookStepMetadata
+	}{
+		{v1.FreezeHookStepPreFreeze, freezeHooksMetadata.PreFreeze},
+		{v1.FreezeHookStepFreeze, freezeHooksMetadata.Freeze},
+		{v1.FreezeHookStepThaw, freezeHooksMetadata.Thaw},
+		{v1.FreezeHookStepPostThaw, freezeHooksMetadata.PostThaw},
+	} {
+		if step.metadata == nil {
+			continue
+		}
+		stepStatus := v1.FreezeHookStepStatus{
+			Name:      step.name,
+			Phase:     v1.FreezeHookStepFailed,
+			ExitCode:  step.metadata.ExitCode,
+			Message:   step.metadata.Message,
+			Timestamp: step.metadata.Timestamp,
+		}
+		if step.metadata.Succeeded {
+			stepStatus.Phase = v1.FreezeHookStepSucceeded
+		}
+		freezeHooksStatus.Steps = append(freezeHooksStatus.Steps, stepStatus)
+	}
+	vmi.Status.FreezeHooks = freezeHooksStatus
+}
+
+func isDomainPanicked(domain *api.Domain) bool {
+	return domain != nil && domain.Status.Status == api.Crashed && domain.Status.Reason == api.ReasonPanicked
+}
+
+func guestPanicActionPhase(action v1.PanicAction) v1.GuestPanicPhase {
+	if action == v1.PanicActionPause {
+		return v1.GuestPanicPaused
+	}
+	return v1.GuestPanicRestarting
+}
+
+func isControlledByVirtualMachine(vmi *v1.VirtualMachineInstance) bool {
+	owner := metav1.GetControllerOf(vmi)
+	return owner != nil && owner.Kind == v1.VirtualMachineGroupVersionKind.Kind
+}
+
+func memoryDumpVolumeStatus(vmi *v1.VirtualMachineInstance, claimName string) *v1.VolumeStatus {
+	for i := range vmi.Status.VolumeStatus {
+		if vmi.Status.VolumeStatus[i].Name == claimName && vmi.Status.VolumeStatus[i].MemoryDumpVolume != nil {
+			return &vmi.Status.VolumeStatus[i]
+		}
+	}
+	return nil
+}
+
+// updateGuestPanicStatus tracks the handling of the guest panics counted by virt-launcher.
+// A new panic waits for the memory dump to the claim of the panic policy, before the guest is restarted or kept paused.
+func (c *VirtualMachineController) updateGuestPanicStatus(vmi *v1.VirtualMachineInstance, domain *api.Domain) {
+	if vmi.Spec.PanicPolicy == nil || domain == nil || domain.Spec.Metadata.KubeVirt.GuestPanic == nil {
+		return
+	}
+
+	guestPanicMetadata := domain.Spec.Metadata.KubeVirt.GuestPanic
+	guestPanic := vmi.Status.GuestPanic
+	if guestPanic == nil || guestPanic.Count < guestPanicMetadata.Count {
+		guestPanic = &v1.GuestPanicStatus{
+			Phase:               v1.GuestPanicMemoryDumpInProgress,
+			Count:               guestPanicMetadata.Count,
+			Timestamp:           guestPanicMetadata.Timestamp,
+			Action:              vmi.Spec.PanicPolicy.GetAction(),
+			MemoryDumpClaimName: vmi.Spec.PanicPolicy.MemoryDumpClaimName,
+		}
+		if guestPanic.MemoryDumpClaimName != "" && !isControlledByVirtualMachine(vmi) {
+			guestPanic.MemoryDumpClaimName = ""
+			guestPanic.Message = "Memory dumps are only taken for VMIs owned by a VirtualMachine"
+		}
+		if guestPanic.MemoryDumpClaimName == "" {
+			guestPanic.Phase = guestPanicActionPhase(guestPanic.Action)
+		}
+		c.recorder.Eventf(vmi, k8sv1.EventTypeWarning, "GuestPanicked", "The guest panicked, panic policy action is %s", guestPanic.Action)
+		vmi.Status.GuestPanic = guestPanic
+	}
+
+	switch guestPanic.Phase {
+	case v1.GuestPanicMemoryDumpInProgress:
+		volumeStatus := memoryDumpVolumeStatus(vmi, guestPanic.MemoryDumpClaimName)
+		if volumeStatus == nil || volumeStatus.MemoryDumpVolume.EndTimestamp == nil || volumeStatus.MemoryDumpVolume.EndTimestamp.Before(guestPanic.Timestamp) {
+			return
+		}
+		if volumeStatus.Phase == v1.MemoryDumpVolumeFailed {
+			guestPanic.Message = volumeStatus.Message
+		} else {
+			guestPanic.Message = fmt.Sprintf("Memory of the panicked guest was dumped to %s", volumeStatus.MemoryDumpVolume.TargetFileName)
+		}
+		guestPanic.Phase = guestPanicActionPhase(guestPanic.Action)
+	case v1.GuestPanicRestarting, v1.GuestPanicPaused:
+		if !isDomainPanicked(domain) {
+			guestPanic.Phase = v1.GuestPanicRecovered
+		}
+	}
+}
+
 func IsoGuestVolumePath(namespace, name string, volume *v1.Volume) string {
 	const basepath = "/var/run"
 	switch {
@@ -1350,7 +1464,9 @@ func (c *VirtualMachineController) updateVMIStatusFromDomain(vmi *v1.VirtualMach
 	c.setMigrationProgressStatus(vmi, domain)
 	c.updateGuestInfoFromDomain(vmi, domain)
 	c.updateVolumeStatusesFromDomain(vmi, domain)
+	c.updateGuestPanicStatus(vmi, domain)
 	c.updateFSFreezeStatus(vmi, domain)
+	c.updateFreezeHooksStatus(vmi, domain)
 	c.updateMachineType(vmi, domain)
 	if err = c.updateMemoryInfo(vmi, domain); err != nil {
 		return err
@@ -1367,6 +1483,8 @@ func (c *VirtualMachineController) updateVMIConditions(vmi *v1.VirtualMachineIns
 		return err
 	}
 	c.updatePausedConditions(vmi, domain, condManager)
+	c.updateMemoryUnplugCondition(vmi, condManager)
+	c.updateCPUUnplugCondition(vmi, domain, condManager)
 
 	return nil
 }
@@ -1497,6 +1615,16 @@ func calculatePausedCondition(vmi *v1.VirtualMachineInstance, reason api.StateCh
 			Reason:             "PausedIOError",
 			Message:            "VMI was paused, low-level IO error detected",
 		})
+	case api.ReasonPanicked:
+		log.Log.Object(vmi).V(3).Info("Adding paused condition")
+		vmi.Status.Conditions = append(vmi.Status.Conditions, v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstancePaused,
+			Status:             k8sv1.ConditionTrue,
+			LastProbeTime:      now,
+			LastTransitionTime: now,
+			Reason:             "PausedByGuestPanic",
+			Message:            "VMI was paused after a guest panic",
+		})
 	default:
 		log.Log.Object(vmi).V(3).Infof("Domain is paused for unknown reason, %s", reason)
 	}
@@ -3009,6 +3137,10 @@ func (c *VirtualMachineController) handleRunningVMI(vmi *v1.VirtualMachineInstan
 		return err
 	}
 
+	if err := c.restartPanickedGuest(vmi); err != nil {
+		return err
+	}
+
 	isolationRes, err := c.podIsolationDetector.Detect(vmi)
 	if err != nil {
 		return fmt.Errorf(failedDetectIsolationFmt, err)
@@ -3169,11 +3301,12 @@ func (c *VirtualMachineController) configureVirtioFS(vmi *v1.VirtualMachineInsta
 }
 
 func (c *VirtualMachineController) syncVirtualMachine(client cmdclient.LauncherClient, vmi *v1.VirtualMachineInstance, preallocatedVolumes []string) error {
-	smbios := c.clusterConfig.GetSMBIOS()
-	period := c.clusterConfig.GetMemBalloonStatsPeriod()
+	clusterConfig := c.clusterConfig.ForNamespace(vmi.Namespace)
+	smbios := clusterConfig.GetSMBIOS()
+	period := clusterConfig.GetMemBalloonStatsPeriod()
 
-	options := virtualMachineOptions(smbios, period, preallocatedVolumes, c.capabilities, c.clusterConfig)
-	options.InterfaceDomainAttachment = domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, c.clusterConfig.GetNetworkBindings())
+	options := virtualMachineOptions(smbios, period, preallocatedVolumes, c.capabilities, clusterConfig)
+	options.InterfaceDomainAttachment = domainspec.DomainAttachmentByInterfaceName(vmi.Spec.Domain.Devices.Interfaces, clusterConfig.GetNetworkBindings())
 
 	err := client.SyncVirtualMachine(vmi, options)
 	if err != nil {
@@ -3305,6 +3438,24 @@ func (c *VirtualMachineController) getMemoryDump(vmi *v1.VirtualMachineInstance)
 	return nil
 }
 
+// restartPanickedGuest resets the guest, once a panic was handled and the panic policy asks for a restart
+func (c *VirtualMachineController) restartPanickedGuest(vmi *v1.VirtualMachineInstance) error {
+	if vmi.Status.GuestPanic == nil || vmi.Status.GuestPanic.Phase != v1.GuestPanicRestarting {
+		return nil
+	}
+	client, err := c.getVerifiedLauncherClient(vmi)
+	if err != nil {
+		return fmt.Errorf("failed to restart the panicked guest: %v", err)
+	}
+
+	// Unpausing resets and resumes a domain preserved after a panic, it does nothing once the guest runs again
+	log.Log.V(3).Object(vmi).Info("restarting the panicked guest")
+	if err = client.UnpauseVirtualMachine(vmi); err != nil {
+		return fmt.Errorf("failed to restart the panicked guest: %v", err)
+	}
+	return nil
+}
+
 func (c *VirtualMachineController) processVmUpdate(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
 
 	isUnresponsive, isInitialized, err := c.isLauncherClientUnresponsive(vmi)
@@ -3369,6 +3520,10 @@ func (c *VirtualMachineController) calculateVmPhaseForStatusReason(domain *api.D
 		case api.Shutoff, api.Crashed:
 			switch domain.Status.Reason {
 			case api.ReasonCrashed, api.ReasonPanicked:
+				if isDomainPanicked(domain) && vmi.Spec.PanicPolicy != nil {
+					// The panicked domain is preserved and handled according to the panic policy
+					return v1.Running, nil
+				}
 				return v1.Failed, nil
 			case api.ReasonDestroyed:
 				// When ACPI is available, the domain was tried to be shutdown,
@@ -3635,6 +3790,20 @@ func (c *VirtualMachineController) hotplugCPU(vmi *v1.VirtualMachineInstance, cl
 		vmi.Status.CurrentCPUTopology = &v1.CPUTopology{}
 	}
 
+	// The guest offlines unplugged vCPUs at its own pace, track it until the domain converges
+	if vmi.Status.CurrentCPUTopology.Sockets > vmi.Spec.Domain.CPU.Sockets {
+		vmiConditions.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceCPUUnplug,
+			Status:             k8sv1.ConditionUnknown,
+			Reason:             v1.VirtualMachineInstanceReasonCPUUnplugInProgress,
+			Message:            fmt.Sprintf("waiting for the guest to release vCPUs down to %d sockets", vmi.Spec.Domain.CPU.Sockets),
+			LastProbeTime:      metav1.Now(),
+			LastTransitionTime: metav1.Now(),
+		})
+	} else {
+		vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceCPUUnplug)
+	}
+
 	vmi.Status.CurrentCPUTopology.Sockets = vmi.Spec.Domain.CPU.Sockets
 	vmi.Status.CurrentCPUTopology.Cores = vmi.Spec.Domain.CPU.Cores
 	vmi.Status.CurrentCPUTopology.Threads = vmi.Spec.Domain.CPU.Threads
@@ -3690,9 +3859,114 @@ func (c *VirtualMachineController) hotplugMemory(vmi *v1.VirtualMachineInstance,
 
 	vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceMemoryChange)
 	vmi.Status.Memory.GuestRequested = vmi.Spec.Domain.Memory.Guest
+
+	// The guest releases unplugged memory at its own pace, track it until GuestCurrent converges
+	if vmi.Status.Memory.GuestCurrent != nil && vmi.Spec.Domain.Memory.Guest.Cmp(*vmi.Status.Memory.GuestCurrent) < 0 {
+		vmiConditions.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceMemoryUnplug,
+			Status:             k8sv1.ConditionUnknown,
+			Reason:             v1.VirtualMachineInstanceReasonMemoryUnplugInProgress,
+			Message:            fmt.Sprintf("waiting for the guest to release memory down to %s", vmi.Spec.Domain.Memory.Guest.String()),
+			LastProbeTime:      metav1.Now(),
+			LastTransitionTime: metav1.Now(),
+		})
+	} else {
+		vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceMemoryUnplug)
+	}
 	return nil
 }
 
+func (c *VirtualMachineController) updateMemoryUnplugCondition(vmi *v1.VirtualMachineInstance, condManager *controller.VirtualMachineInstanceConditionManager) {
+	cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceMemoryUnplug)
+	if cond == nil || cond.Status != k8sv1.ConditionUnknown ||
+		vmi.Status.Memory == nil || vmi.Status.Memory.GuestCurrent == nil || vmi.Status.Memory.GuestRequested == nil {
+		return
+	}
+
+	guestCurrent := vmi.Status.Memory.GuestCurrent
+	guestRequested := vmi.Status.Memory.GuestRequested
+	now := metav1.Now()
+
+	if guestCurrent.Cmp(*guestRequested) <= 0 {
+		log.Log.Object(vmi).Infof("guest memory converged to %s after unplug", guestCurrent.String())
+		condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceMemoryUnplug,
+			Status:             k8sv1.ConditionTrue,
+			Reason:             v1.VirtualMachineInstanceReasonMemoryUnplugConverged,
+			Message:            fmt.Sprintf("guest memory converged to %s", guestCurrent.String()),
+			LastProbeTime:      now,
+			LastTransitionTime: now,
+		})
+		return
+	}
+
+	elapsed := now.Sub(cond.LastTransitionTime.Time)
+	if elapsed < memoryUnplugTimeout {
+		c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), memoryUnplugTimeout-elapsed)
+		return
+	}
+
+	message := fmt.Sprintf("guest memory is %s, the guest did not release memory down to %s within %s", guestCurrent.String(), guestRequested.String(), memoryUnplugTimeout)
+	log.Log.Object(vmi).Warning(message)
+	c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonMemoryUnplugTimedOut, message)
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceMemoryUnplug,
+		Status:             k8sv1.ConditionFalse,
+		Reason:             v1.VirtualMachineInstanceReasonMemoryUnplugTimedOut,
+		Message:            message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	})
+}
+
+func (c *VirtualMachineController) updateCPUUnplugCondition(vmi *v1.VirtualMachineInstance, domain *api.Domain, condManager *controller.VirtualMachineInstanceConditionManager) {
+	cond := condManager.GetCondition(vmi, v1.VirtualMachineInstanceCPUUnplug)
+	if cond == nil || cond.Status != k8sv1.ConditionUnknown ||
+		domain == nil || domain.Spec.VCPUs == nil || vmi.Spec.Domain.CPU == nil {
+		return
+	}
+
+	enabledVCPUs := int64(0)
+	for _, vcpu := range domain.Spec.VCPUs.VCPU {
+		if vcpu.Enabled == "yes" {
+			enabledVCPUs++
+		}
+	}
+	requestedVCPUs := hardware.GetNumberOfVCPUs(vmi.Spec.Domain.CPU)
+	now := metav1.Now()
+
+	if enabledVCPUs <= requestedVCPUs {
+		log.Log.Object(vmi).Infof("guest vCPUs converged to %d after unplug", enabledVCPUs)
+		condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+			Type:               v1.VirtualMachineInstanceCPUUnplug,
+			Status:             k8sv1.ConditionTrue,
+			Reason:             v1.VirtualMachineInstanceReasonCPUUnplugConverged,
+			Message:            fmt.Sprintf("guest vCPUs converged to %d", enabledVCPUs),
+			LastProbeTime:      now,
+			LastTransitionTime: now,
+		})
+		return
+	}
+
+	elapsed := now.Sub(cond.LastTransitionTime.Time)
+	if elapsed < cpuUnplugTimeout {
+		c.queue.AddAfter(controller.VirtualMachineInstanceKey(vmi), cpuUnplugTimeout-elapsed)
+		return
+	}
+
+	message := fmt.Sprintf("guest has %d vCPUs enabled, the guest did not release vCPUs down to %d within %s", enabledVCPUs, requestedVCPUs, cpuUnplugTimeout)
+	log.Log.Object(vmi).Warning(message)
+	c.recorder.Event(vmi, k8sv1.EventTypeWarning, v1.VirtualMachineInstanceReasonCPUUnplugTimedOut, message)
+	condManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+		Type:               v1.VirtualMachineInstanceCPUUnplug,
+		Status:             k8sv1.ConditionFalse,
+		Reason:             v1.VirtualMachineInstanceReasonCPUUnplugTimedOut,
+		Message:            message,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+	})
+}
+
 func removeMigratedVolumes(vmi *v1.VirtualMachineInstance) {
 	vmiConditions := controller.NewVirtualMachineInstanceConditionManager()
 	vmiConditions.RemoveCondition(vmi, v1.VirtualMachineInstanceVolumesChange)
@@ -2137,6 +2137,179 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(v1.VirtualMachinePodMemoryRequestsLabel).ToNot(BeKeyOf(vmi.Labels))
 			Expect(vmi.Status.Memory.GuestRequested).ToNot(Equal(vmi.Spec.Domain.Memory.Guest))
 		})
+
+		Context("memory hot-unplug", func() {
+			var (
+				conditionManager *virtcontroller.VirtualMachineInstanceConditionManager
+				vmi              *v1.VirtualMachineInstance
+			)
+
+			BeforeEach(func() {
+				conditionManager = virtcontroller.NewVirtualMachineInstanceConditionManager()
+
+				initialMemory := resource.MustParse("512Mi")
+				currentMemory := resource.MustParse("2Gi")
+				requestedMemory := resource.MustParse("1Gi")
+
+				vmi = api2.NewMinimalVMI("testvmi")
+				vmi.Spec.Domain.Memory = &v1.Memory{
+					Guest: &requestedMemory,
+				}
+				vmi.Spec.Domain.Resources.Requests[k8sv1.ResourceMemory] = requestedMemory
+				vmi.Status.Memory = &v1.MemoryStatus{
+					GuestAtBoot:    &initialMemory,
+					GuestCurrent:   &currentMemory,
+					GuestRequested: &currentMemory,
+				}
+			})
+
+			setUnplugInProgress := func(since time.Time) {
+				conditionManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+					Type:               v1.VirtualMachineInstanceMemoryUnplug,
+					Status:             k8sv1.ConditionUnknown,
+					Reason:             v1.VirtualMachineInstanceReasonMemoryUnplugInProgress,
+					LastTransitionTime: metav1.NewTime(since),
+				})
+				vmi.Status.Memory.GuestRequested = vmi.Spec.Domain.Memory.Guest
+			}
+
+			It("should track the unplug once the memory device is updated", func() {
+				targetPodMemory := services.GetMemoryOverhead(vmi, runtime.GOARCH, nil)
+				targetPodMemory.Add(*vmi.Spec.Domain.Memory.Guest)
+				vmi.Labels = map[string]string{
+					v1.VirtualMachinePodMemoryRequestsLabel: targetPodMemory.String(),
+				}
+				conditionManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+					Type:   v1.VirtualMachineInstanceMemoryChange,
+					Status: k8sv1.ConditionTrue,
+				})
+
+				client.EXPECT().SyncVirtualMachineMemory(vmi, gomock.Any())
+
+				Expect(controller.hotplugMemory(vmi, client)).To(Succeed())
+
+				Expect(conditionManager.HasCondition(vmi, v1.VirtualMachineInstanceMemoryChange)).To(BeFalse())
+				Expect(conditionManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceMemoryUnplug,
+					k8sv1.ConditionUnknown, v1.VirtualMachineInstanceReasonMemoryUnplugInProgress)).To(BeTrue())
+			})
+
+			It("should report convergence once the guest released the memory", func() {
+				setUnplugInProgress(time.Now())
+				vmi.Status.Memory.GuestCurrent = vmi.Spec.Domain.Memory.Guest
+
+				controller.updateMemoryUnplugCondition(vmi, conditionManager)
+
+				Expect(conditionManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceMemoryUnplug,
+					k8sv1.ConditionTrue, v1.VirtualMachineInstanceReasonMemoryUnplugConverged)).To(BeTrue())
+			})
+
+			It("should wait for the guest to release the memory", func() {
+				setUnplugInProgress(time.Now())
+
+				controller.updateMemoryUnplugCondition(vmi, conditionManager)
+
+				Expect(conditionManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceMemoryUnplug,
+					k8sv1.ConditionUnknown, v1.VirtualMachineInstanceReasonMemoryUnplugInProgress)).To(BeTrue())
+				Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+			})
+
+			It("should report a failure if the guest did not release the memory in time", func() {
+				setUnplugInProgress(time.Now().Add(-memoryUnplugTimeout))
+
+				controller.updateMemoryUnplugCondition(vmi, conditionManager)
+
+				Expect(conditionManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceMemoryUnplug,
+					k8sv1.ConditionFalse, v1.VirtualMachineInstanceReasonMemoryUnplugTimedOut)).To(BeTrue())
+				testutils.ExpectEvent(recorder, v1.VirtualMachineInstanceReasonMemoryUnplugTimedOut)
+			})
+		})
+	})
+
+	Context("CPU hot-unplug", func() {
+		var (
+			conditionManager *virtcontroller.VirtualMachineInstanceConditionManager
+			vmi              *v1.VirtualMachineInstance
+			domain           *api.Domain
+		)
+
+		BeforeEach(func() {
+			conditionManager = virtcontroller.NewVirtualMachineInstanceConditionManager()
+
+			vmi = api2.NewMinimalVMI("testvmi")
+			vmi.Spec.Domain.CPU = &v1.CPU{
+				Sockets: 1,
+				Cores:   1,
+				Threads: 1,
+			}
+			vmi.Status.CurrentCPUTopology = &v1.CPUTopology{
+				Sockets: 2,
+				Cores:   1,
+				Threads: 1,
+			}
+
+			domain = api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Spec.VCPUs = &api.VCPUs{
+				VCPU: []api.VCPUsVCPU{
+					{ID: 0, Enabled: "yes"},
+					{ID: 1, Enabled: "yes"},
+				},
+			}
+		})
+
+		setUnplugInProgress := func(since time.Time) {
+			conditionManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+				Type:               v1.VirtualMachineInstanceCPUUnplug,
+				Status:             k8sv1.ConditionUnknown,
+				Reason:             v1.VirtualMachineInstanceReasonCPUUnplugInProgress,
+				LastTransitionTime: metav1.NewTime(since),
+			})
+		}
+
+		It("should track the unplug once the vCPUs are updated", func() {
+			conditionManager.UpdateCondition(vmi, &v1.VirtualMachineInstanceCondition{
+				Type:   v1.VirtualMachineInstanceVCPUChange,
+				Status: k8sv1.ConditionTrue,
+			})
+
+			client.EXPECT().SyncVirtualMachineCPUs(vmi, gomock.Any())
+
+			Expect(controller.hotplugCPU(vmi, client)).To(Succeed())
+
+			Expect(conditionManager.HasCondition(vmi, v1.VirtualMachineInstanceVCPUChange)).To(BeFalse())
+			Expect(conditionManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceCPUUnplug,
+				k8sv1.ConditionUnknown, v1.VirtualMachineInstanceReasonCPUUnplugInProgress)).To(BeTrue())
+			Expect(vmi.Status.CurrentCPUTopology.Sockets).To(Equal(vmi.Spec.Domain.CPU.Sockets))
+		})
+
+		It("should report convergence once the guest released the vCPUs", func() {
+			setUnplugInProgress(time.Now())
+			domain.Spec.VCPUs.VCPU[1].Enabled = "no"
+
+			controller.updateCPUUnplugCondition(vmi, domain, conditionManager)
+
+			Expect(conditionManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceCPUUnplug,
+				k8sv1.ConditionTrue, v1.VirtualMachineInstanceReasonCPUUnplugConverged)).To(BeTrue())
+		})
+
+		It("should wait for the guest to release the vCPUs", func() {
+			setUnplugInProgress(time.Now())
+
+			controller.updateCPUUnplugCondition(vmi, domain, conditionManager)
+
+			Expect(conditionManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceCPUUnplug,
+				k8sv1.ConditionUnknown, v1.VirtualMachineInstanceReasonCPUUnplugInProgress)).To(BeTrue())
+			Expect(mockQueue.GetAddAfterEnqueueCount()).To(Equal(1))
+		})
+
+		It("should report a failure if the guest did not release the vCPUs in time", func() {
+			setUnplugInProgress(time.Now().Add(-cpuUnplugTimeout))
+
+			controller.updateCPUUnplugCondition(vmi, domain, conditionManager)
+
+			Expect(conditionManager.HasConditionWithStatusAndReason(vmi, v1.VirtualMachineInstanceCPUUnplug,
+				k8sv1.ConditionFalse, v1.VirtualMachineInstanceReasonCPUUnplugTimedOut)).To(BeTrue())
+			testutils.ExpectEvent(recorder, v1.VirtualMachineInstanceReasonCPUUnplugTimedOut)
+		})
 	})
 
 	It("should always remove the VirtualMachineInstanceVCPUChange condition even if hotplug CPU has failed", func() {
@@ -3021,6 +3194,175 @@ var _ = Describe("VirtualMachineInstance", func() {
 			Expect(updatedVMI.Status.FSFreezeStatus).To(BeEmpty())
 		})
 
+		It("should update the freeze hook steps in VMI status", func() {
+			vmi := api2.NewMinimalVMI("testvmi")
+			vmi.UID = vmiTestUUID
+			vmi.ObjectMeta.ResourceVersion = "1"
+			vmi.Status.Phase = v1.Scheduled
+
+			domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+			domain.Status.Status = api.Running
+			domain.Spec.Metadata.KubeVirt.FreezeHooks = &api.FreezeHooksMetadata{
+				PreFreeze: &api.FreezeHookStepMetadata{Succeeded: true, ExitCode: pointer.P(int32(0))},
+				Freeze:    &api.FreezeHookStepMetadata{Succeeded: true},
+				Thaw:      &api.FreezeHookStepMetadata{Succeeded: true},
+				PostThaw:  &api.FreezeHookStepMetadata{ExitCode: pointer.P(int32(1)), Message: "PostThaw hook exited with code 1"},
+			}
+
+			addVMI(vmi)
+			addDomain(domain)
+			createVMI(vmi)
+
+			sanityExecute()
+
+			testutils.ExpectEvent(recorder, VMIStarted)
+			updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(updatedVMI.Status.FreezeHooks).To(Equal(&v1.FreezeHooksStatus{
+				Steps: []v1.FreezeHookStepStatus{
+					{Name: v1.FreezeHookStepPreFreeze, Phase: v1.FreezeHookStepSucceeded, ExitCode: pointer.P(int32(0))},
+					{Name: v1.FreezeHookStepFreeze, Phase: v1.FreezeHookStepSucceeded},
+					{Name: v1.FreezeHookStepThaw, Phase: v1.FreezeHookStepSucceeded},
+					{Name: v1.FreezeHookStepPostThaw, Phase: v1.FreezeHookStepFailed, ExitCode: pointer.P(int32(1)), Message: "PostThaw hook exited with code 1"},
+				},
+			}))
+		})
+
+		Context("guest panic", func() {
+			var panicTimestamp metav1.Time
+
+			newPanickedDomain := func(count int32) *api.Domain {
+				domain := api.NewMinimalDomainWithUUID("testvmi", vmiTestUUID)
+				domain.Status.Status = api.Crashed
+				domain.Status.Reason = api.ReasonPanicked
+				domain.Spec.Metadata.KubeVirt.GuestPanic = &api.GuestPanicMetadata{Count: count, Timestamp: &panicTimestamp}
+				return domain
+			}
+
+			newOwnedVMI := func() *v1.VirtualMachineInstance {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.OwnerReferences = []metav1.OwnerReference{*metav1.NewControllerRef(&v1.VirtualMachine{ObjectMeta: metav1.ObjectMeta{Name: "testvmi"}}, v1.VirtualMachineGroupVersionKind)}
+				return vmi
+			}
+
+			BeforeEach(func() {
+				panicTimestamp = metav1.Now()
+			})
+
+			It("should keep a panicked VMI with a panic policy running and record the panic", func() {
+				vmi := newOwnedVMI()
+				vmi.UID = vmiTestUUID
+				vmi.ObjectMeta.ResourceVersion = "1"
+				vmi.Status.Phase = v1.Scheduled
+				vmi.Spec.PanicPolicy = &v1.PanicPolicy{MemoryDumpClaimName: "dump-pvc"}
+
+				addVMI(vmi)
+				addDomain(newPanickedDomain(1))
+				createVMI(vmi)
+
+				sanityExecute()
+
+				testutils.ExpectEvent(recorder, "GuestPanicked")
+				testutils.ExpectEvent(recorder, VMIStarted)
+				updatedVMI, err := virtfakeClient.KubevirtV1().VirtualMachineInstances(metav1.NamespaceDefault).Get(context.TODO(), vmi.Name, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updatedVMI.Status.Phase).To(Equal(v1.Running))
+				Expect(updatedVMI.Status.GuestPanic).ToNot(BeNil())
+				Expect(updatedVMI.Status.GuestPanic.Phase).To(Equal(v1.GuestPanicMemoryDumpInProgress))
+				Expect(updatedVMI.Status.GuestPanic.Count).To(Equal(int32(1)))
+				Expect(updatedVMI.Status.GuestPanic.Action).To(Equal(v1.PanicActionRestart))
+				Expect(updatedVMI.Status.GuestPanic.MemoryDumpClaimName).To(Equal("dump-pvc"))
+			})
+
+			It("should fail a panicked VMI without a panic policy", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				phase, err := controller.calculateVmPhaseForStatusReason(newPanickedDomain(1), vmi)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(phase).To(Equal(v1.Failed))
+			})
+
+			DescribeTable("should apply the panic policy action", func(policy *v1.PanicPolicy, expectedPhase v1.GuestPanicPhase) {
+				vmi := newOwnedVMI()
+				vmi.Spec.PanicPolicy = policy
+				controller.updateGuestPanicStatus(vmi, newPanickedDomain(1))
+				testutils.ExpectEvent(recorder, "GuestPanicked")
+				Expect(vmi.Status.GuestPanic.Phase).To(Equal(expectedPhase))
+			},
+				Entry("restart without memory dump", &v1.PanicPolicy{}, v1.GuestPanicRestarting),
+				Entry("pause without memory dump", &v1.PanicPolicy{Action: v1.PanicActionPause}, v1.GuestPanicPaused),
+				Entry("wait for the memory dump", &v1.PanicPolicy{MemoryDumpClaimName: "dump-pvc"}, v1.GuestPanicMemoryDumpInProgress),
+			)
+
+			It("should not wait for a memory dump of a VMI without VirtualMachine", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Spec.PanicPolicy = &v1.PanicPolicy{Action: v1.PanicActionPause, MemoryDumpClaimName: "dump-pvc"}
+				controller.updateGuestPanicStatus(vmi, newPanickedDomain(1))
+				testutils.ExpectEvent(recorder, "GuestPanicked")
+				Expect(vmi.Status.GuestPanic.Phase).To(Equal(v1.GuestPanicPaused))
+				Expect(vmi.Status.GuestPanic.MemoryDumpClaimName).To(BeEmpty())
+			})
+
+			DescribeTable("should apply the panic policy action once the memory dump finished", func(volumePhase v1.VolumePhase) {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Spec.PanicPolicy = &v1.PanicPolicy{MemoryDumpClaimName: "dump-pvc"}
+				vmi.Status.GuestPanic = &v1.GuestPanicStatus{
+					Phase:               v1.GuestPanicMemoryDumpInProgress,
+					Count:               1,
+					Timestamp:           &panicTimestamp,
+					Action:              v1.PanicActionRestart,
+					MemoryDumpClaimName: "dump-pvc",
+				}
+				endTimestamp := metav1.NewTime(panicTimestamp.Add(time.Minute))
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+					Name:             "dump-pvc",
+					Phase:            volumePhase,
+					MemoryDumpVolume: &v1.DomainMemoryDumpInfo{EndTimestamp: &endTimestamp},
+				}}
+
+				controller.updateGuestPanicStatus(vmi, newPanickedDomain(1))
+				Expect(vmi.Status.GuestPanic.Phase).To(Equal(v1.GuestPanicRestarting))
+			},
+				Entry("completed", v1.MemoryDumpVolumeCompleted),
+				Entry("failed", v1.MemoryDumpVolumeFailed),
+			)
+
+			It("should wait for a memory dump of the current panic", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Spec.PanicPolicy = &v1.PanicPolicy{MemoryDumpClaimName: "dump-pvc"}
+				vmi.Status.GuestPanic = &v1.GuestPanicStatus{
+					Phase:               v1.GuestPanicMemoryDumpInProgress,
+					Count:               1,
+					Timestamp:           &panicTimestamp,
+					MemoryDumpClaimName: "dump-pvc",
+				}
+				endTimestamp := metav1.NewTime(panicTimestamp.Add(-time.Minute))
+				vmi.Status.VolumeStatus = []v1.VolumeStatus{{
+					Name:             "dump-pvc",
+					Phase:            v1.MemoryDumpVolumeCompleted,
+					MemoryDumpVolume: &v1.DomainMemoryDumpInfo{EndTimestamp: &endTimestamp},
+				}}
+
+				controller.updateGuestPanicStatus(vmi, newPanickedDomain(1))
+				Expect(vmi.Status.GuestPanic.Phase).To(Equal(v1.GuestPanicMemoryDumpInProgress))
+			})
+
+			It("should mark the panic recovered once the guest runs again", func() {
+				vmi := api2.NewMinimalVMI("testvmi")
+				vmi.Spec.PanicPolicy = &v1.PanicPolicy{}
+				vmi.Status.GuestPanic = &v1.GuestPanicStatus{
+					Phase:     v1.GuestPanicRestarting,
+					Count:     1,
+					Timestamp: &panicTimestamp,
+				}
+				domain := newPanickedDomain(1)
+				domain.Status.Status = api.Running
+				domain.Status.Reason = api.ReasonUnknown
+
+				controller.updateGuestPanicStatus(vmi, domain)
+				Expect(vmi.Status.GuestPanic.Phase).To(Equal(v1.GuestPanicRecovered))
+			})
+		})
+
 		It("should update Memory information in VMI status", func() {
 			initialMemory := resource.MustParse("128Ki")
 			vmi := api2.NewMinimalVMI("testvmi")
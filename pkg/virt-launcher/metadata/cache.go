@@ -31,6 +31,8 @@ type Cache struct {
 	GracePeriod      SafeData[api.GracePeriodMetadata]
 	AccessCredential SafeData[api.AccessCredentialMetadata]
 	MemoryDump       SafeData[api.MemoryDumpMetadata]
+	FreezeHooks      SafeData[api.FreezeHooksMetadata]
+	GuestPanic       SafeData[api.GuestPanicMetadata]
 
 	notificationSignal chan struct{}
 }
@@ -44,6 +46,8 @@ func NewCache() *Cache {
 	cache.GracePeriod.dirtyChanel = cache.notificationSignal
 	cache.AccessCredential.dirtyChanel = cache.notificationSignal
 	cache.MemoryDump.dirtyChanel = cache.notificationSignal
+	cache.FreezeHooks.dirtyChanel = cache.notificationSignal
+	cache.GuestPanic.dirtyChanel = cache.notificationSignal
 	return cache
 }
 
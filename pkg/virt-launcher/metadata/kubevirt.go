@@ -44,5 +44,11 @@ func LoadKubevirtMetadata(metadataCache *Cache) api.KubeVirtMetadata {
 	if value, exists := metadataCache.MemoryDump.Load(); exists {
 		kubevirtMetadata.MemoryDump = &value
 	}
+	if value, exists := metadataCache.FreezeHooks.Load(); exists {
+		kubevirtMetadata.FreezeHooks = &value
+	}
+	if value, exists := metadataCache.GuestPanic.Load(); exists {
+		kubevirtMetadata.GuestPanic = &value
+	}
 	return kubevirtMetadata
 }
@@ -347,6 +347,8 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 				event := watch.Event{Type: watch.Added, Object: domain}
 				client.SendDomainEvent(event)
 				updateEvents(event, domain, events)
+			} else if libvirtEvent.Event.Event == libvirt.DOMAIN_EVENT_CRASHED && libvirt.DomainEventCrashedDetailType(libvirtEvent.Event.Detail) == libvirt.DOMAIN_EVENT_CRASHED_PANICKED {
+				recordGuestPanic(metadataCache)
 			}
 		}
 		if interfaceStatus != nil {
@@ -367,6 +369,16 @@ func (e *eventCaller) eventCallback(c cli.Connection, domain *api.Domain, libvir
 	}
 }
 
+// recordGuestPanic counts the panics of the guest, virt-handler handles them according to the panic policy of the VMI
+func recordGuestPanic(metadataCache *metadata.Cache) {
+	now := metav1.Now()
+	metadataCache.GuestPanic.WithSafeBlock(func(guestPanicMetadata *api.GuestPanicMetadata, _ bool) {
+		guestPanicMetadata.Count++
+		guestPanicMetadata.Timestamp = &now
+	})
+	log.Log.Warning("The guest panicked")
+}
+
 var updateEvents = updateEventsClosure()
 
 func updateEventsClosure() func(event watch.Event, domain *api.Domain, events chan watch.Event) {
@@ -395,6 +407,8 @@ func (n *Notifier) StartDomainNotifier(
 	qemuAgentUserInterval time.Duration,
 	qemuAgentVersionInterval time.Duration,
 	qemuAgentFSFreezeStatusInterval time.Duration,
+	qemuAgentInventoryInterval time.Duration,
+	qemuAgentPatchComplianceInterval time.Duration,
 	metadataCache *metadata.Cache,
 ) error {
 
@@ -416,6 +430,8 @@ func (n *Notifier) StartDomainNotifier(
 		qemuAgentUserInterval,
 		qemuAgentVersionInterval,
 		qemuAgentFSFreezeStatusInterval,
+		qemuAgentInventoryInterval,
+		qemuAgentPatchComplianceInterval,
 	)
 
 	// Run the event process logic in a separate go-routine to not block libvirt
@@ -255,6 +255,26 @@ var _ = Describe("Notify", func() {
 				}
 				Expect(timedOut).To(BeFalse())
 			})
+
+		It("should record a guest panic in the metadata",
+			func() {
+				domain := api.NewMinimalDomain("test")
+				x, err := xml.Marshal(domain.Spec)
+				Expect(err).ToNot(HaveOccurred())
+				mockDomain.EXPECT().Free().Times(2)
+				mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_CRASHED, int(libvirt.DOMAIN_CRASHED_PANICKED), nil).Times(2)
+				mockDomain.EXPECT().GetName().Return("test", nil).AnyTimes()
+				mockDomain.EXPECT().GetXMLDesc(gomock.Eq(libvirt.DomainXMLFlags(0))).Return(string(x), nil).Times(2)
+
+				panicEvent := libvirtEvent{Event: &libvirt.DomainEventLifecycle{Event: libvirt.DOMAIN_EVENT_CRASHED, Detail: int(libvirt.DOMAIN_EVENT_CRASHED_PANICKED)}}
+				e.eventCallback(mockCon, util.NewDomainFromName("test", "1234"), panicEvent, client, deleteNotificationSent, nil, nil, nil, nil, metadataCache)
+				e.eventCallback(mockCon, util.NewDomainFromName("test", "1234"), panicEvent, client, deleteNotificationSent, nil, nil, nil, nil, metadataCache)
+
+				guestPanic, exists := metadataCache.GuestPanic.Load()
+				Expect(exists).To(BeTrue())
+				Expect(guestPanic.Count).To(Equal(int32(2)))
+				Expect(guestPanic.Timestamp).ToNot(BeNil())
+			})
 	})
 
 	Describe("K8s Events", func() {
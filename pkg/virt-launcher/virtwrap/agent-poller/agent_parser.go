@@ -4,11 +4,17 @@ import (
 	"encoding/json"
 	"fmt"
 	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"kubevirt.io/client-go/log"
 
 	v1 "kubevirt.io/api/core/v1"
 
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -95,6 +101,48 @@ type Filesystem struct {
 	Disk       []FSDisk `json:"disk,omitempty"`
 }
 
+// Load is the response from 'guest-get-load'
+type Load struct {
+	Load1m  float64 `json:"load1m"`
+	Load5m  float64 `json:"load5m"`
+	Load15m float64 `json:"load15m"`
+}
+
+// VCPU is a single vCPU from the 'guest-get-vcpus' response
+type VCPU struct {
+	LogicalID int  `json:"logical-id"`
+	Online    bool `json:"online"`
+}
+
+// MemoryBlock is a single memory block from the 'guest-get-memory-blocks' response
+type MemoryBlock struct {
+	PhysIndex int  `json:"phys-index"`
+	Online    bool `json:"online"`
+}
+
+// MemoryBlockInfo is the response from 'guest-get-memory-block-info'
+type MemoryBlockInfo struct {
+	Size int64 `json:"size"`
+}
+
+// DiskStats is a single block device from the 'guest-get-diskstats' response
+type DiskStats struct {
+	Name  string `json:"name"`
+	Stats struct {
+		ReadSectors  int64 `json:"read-sectors,omitempty"`
+		ReadIOs      int64 `json:"read-ios,omitempty"`
+		WriteSectors int64 `json:"write-sectors,omitempty"`
+		WriteIOs     int64 `json:"write-ios,omitempty"`
+	} `json:"stats"`
+}
+
+// Hotfix is a single hotfix listed by Get-HotFix in a Windows guest
+type Hotfix struct {
+	HotFixID    string  `json:"HotFixID"`
+	Description string  `json:"Description"`
+	InstalledOn *string `json:"InstalledOn"`
+}
+
 // AgentInfo from the guest VM serves the purpose
 // of checking the GA presence and version compatibility
 type AgentInfo struct {
@@ -245,6 +293,159 @@ func parseUsers(agentReply string) ([]api.User, error) {
 	return convertedResult, nil
 }
 
+// parseLoad from the agent response
+func parseLoad(agentReply string) (api.GuestLoad, error) {
+	result := Load{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return api.GuestLoad{}, err
+	}
+
+	return api.GuestLoad{
+		Load1m:  result.Load1m,
+		Load5m:  result.Load5m,
+		Load15m: result.Load15m,
+	}, nil
+}
+
+// parseVCPUs from the agent response
+func parseVCPUs(agentReply string) (api.GuestVCPUs, error) {
+	result := []VCPU{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return api.GuestVCPUs{}, err
+	}
+
+	vcpus := api.GuestVCPUs{Total: len(result)}
+	for _, vcpu := range result {
+		if vcpu.Online {
+			vcpus.Online++
+		}
+	}
+
+	return vcpus, nil
+}
+
+// parseMemoryBlocks from the agent response
+func parseMemoryBlocks(agentReply string) (api.GuestMemoryBlocks, error) {
+	result := []MemoryBlock{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return api.GuestMemoryBlocks{}, err
+	}
+
+	blocks := api.GuestMemoryBlocks{Total: len(result)}
+	for _, block := range result {
+		if block.Online {
+			blocks.Online++
+		}
+	}
+
+	return blocks, nil
+}
+
+// parseMemoryBlockSize from the agent response
+func parseMemoryBlockSize(agentReply string) (int64, error) {
+	result := MemoryBlockInfo{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.Size, nil
+}
+
+// parseDiskStats from the agent response
+func parseDiskStats(agentReply string) ([]api.DiskStats, error) {
+	result := []DiskStats{}
+	response := stripAgentResponse(agentReply)
+
+	err := json.Unmarshal([]byte(response), &result)
+	if err != nil {
+		return []api.DiskStats{}, err
+	}
+
+	convertedResult := []api.DiskStats{}
+
+	for _, disk := range result {
+		convertedResult = append(convertedResult, api.DiskStats{
+			Name:         disk.Name,
+			ReadSectors:  disk.Stats.ReadSectors,
+			ReadIOs:      disk.Stats.ReadIOs,
+			WriteSectors: disk.Stats.WriteSectors,
+			WriteIOs:     disk.Stats.WriteIOs,
+		})
+	}
+
+	return convertedResult, nil
+}
+
+// parseProcStatBootTime gets the boot time from the btime line of the guest /proc/stat
+func parseProcStatBootTime(procStat string) (metav1.Time, error) {
+	for _, line := range strings.Split(procStat, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) != 2 || fields[0] != "btime" {
+			continue
+		}
+		seconds, err := strconv.ParseInt(fields[1], 10, 64)
+		if err != nil {
+			return metav1.Time{}, fmt.Errorf("invalid btime %s: %v", fields[1], err)
+		}
+		return metav1.NewTime(time.Unix(seconds, 0).UTC()), nil
+	}
+	return metav1.Time{}, fmt.Errorf("no btime in /proc/stat")
+}
+
+// parseWindowsBootTime gets the boot time from the output of the boot time script of a Windows guest
+func parseWindowsBootTime(result *agent.GuestExecResult) (metav1.Time, error) {
+	if result.ExitCode != 0 {
+		return metav1.Time{}, fmt.Errorf("exit code %d: %s", result.ExitCode, result.Stderr)
+	}
+	bootTime, err := time.Parse(time.RFC3339, strings.TrimSpace(result.Stdout))
+	if err != nil {
+		return metav1.Time{}, err
+	}
+	return metav1.NewTime(bootTime.UTC()), nil
+}
+
+// parseHotfixes gets the hotfixes from the output of the hotfixes script of a Windows guest
+func parseHotfixes(result *agent.GuestExecResult) ([]api.Hotfix, error) {
+	if result.ExitCode != 0 {
+		return nil, fmt.Errorf("exit code %d: %s", result.ExitCode, result.Stderr)
+	}
+
+	hotfixes := []Hotfix{}
+	if err := json.Unmarshal([]byte(strings.TrimSpace(result.Stdout)), &hotfixes); err != nil {
+		return nil, err
+	}
+
+	convertedResult := []api.Hotfix{}
+	for _, hotfix := range hotfixes {
+		convertedHotfix := api.Hotfix{
+			ID:          hotfix.HotFixID,
+			Description: hotfix.Description,
+		}
+		if hotfix.InstalledOn != nil {
+			installedOn, err := time.Parse(time.RFC3339, *hotfix.InstalledOn)
+			if err != nil {
+				return nil, err
+			}
+			convertedHotfix.InstalledOn = &metav1.Time{Time: installedOn.UTC()}
+		}
+		convertedResult = append(convertedResult, convertedHotfix)
+	}
+
+	return convertedResult, nil
+}
+
 // parseAgent gets the agent version from response
 func parseAgent(agentReply string) (AgentInfo, error) {
 	gaInfo := AgentInfo{}
@@ -20,9 +20,14 @@
 package agentpoller
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
 
@@ -318,5 +323,125 @@ var _ = Describe("Qemu agent poller", func() {
 			}
 			Expect(parseUsers(jsonInput)).To(Equal(expectedUsers))
 		})
+
+		It("should parse Load", func() {
+
+			jsonInput := `{
+                "return":{
+                    "load1m":0.5,
+                    "load5m":0.25,
+                    "load15m":0.1
+                }
+            }`
+
+			expectedLoad := api.GuestLoad{
+				Load1m:  0.5,
+				Load5m:  0.25,
+				Load15m: 0.1,
+			}
+			Expect(parseLoad(jsonInput)).To(Equal(expectedLoad))
+		})
+
+		It("should parse VCPUs", func() {
+
+			jsonInput := `{
+                "return":[
+                    {"logical-id":0, "online":true, "can-offline":false},
+                    {"logical-id":1, "online":false, "can-offline":true}
+                ]
+            }`
+
+			Expect(parseVCPUs(jsonInput)).To(Equal(api.GuestVCPUs{Online: 1, Total: 2}))
+		})
+
+		It("should parse memory blocks", func() {
+
+			jsonInput := `{
+                "return":[
+                    {"phys-index":0, "online":true, "can-offline":false},
+                    {"phys-index":1, "online":true, "can-offline":true},
+                    {"phys-index":2, "online":false, "can-offline":true}
+                ]
+            }`
+
+			Expect(parseMemoryBlocks(jsonInput)).To(Equal(api.GuestMemoryBlocks{Online: 2, Total: 3}))
+		})
+
+		It("should parse memory block info", func() {
+
+			jsonInput := `{
+                "return":{
+                    "size":134217728
+                }
+            }`
+
+			Expect(parseMemoryBlockSize(jsonInput)).To(Equal(int64(134217728)))
+		})
+
+		It("should parse DiskStats", func() {
+
+			jsonInput := `{
+                "return":[
+                    {
+                        "name":"vda",
+                        "major":252,
+                        "minor":0,
+                        "stats":{
+                            "read-sectors":2048,
+                            "read-ios":100,
+                            "write-sectors":4096,
+                            "write-ios":200,
+                            "ios-pgr":0
+                        }
+                    }
+                ]
+            }`
+
+			expectedDiskStats := []api.DiskStats{
+				{
+					Name:         "vda",
+					ReadSectors:  2048,
+					ReadIOs:      100,
+					WriteSectors: 4096,
+					WriteIOs:     200,
+				},
+			}
+			Expect(parseDiskStats(jsonInput)).To(Equal(expectedDiskStats))
+		})
+
+		It("should parse the boot time from /proc/stat", func() {
+			procStat := "cpu  10 0 20 300 0 0 0 0 0 0\nintr 1234\nctxt 5678\nbtime 1700000000\nprocesses 42\n"
+
+			Expect(parseProcStatBootTime(procStat)).To(Equal(metav1.NewTime(time.Unix(1700000000, 0).UTC())))
+		})
+
+		It("should fail to parse the boot time without btime in /proc/stat", func() {
+			_, err := parseProcStatBootTime("cpu  10 0 20 300 0 0 0 0 0 0\n")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should parse the boot time of a Windows guest", func() {
+			result := &agent.GuestExecResult{Stdout: "2023-11-14T22:13:20.0000000Z\r\n"}
+
+			Expect(parseWindowsBootTime(result)).To(Equal(metav1.NewTime(time.Unix(1700000000, 0).UTC())))
+		})
+
+		It("should parse the hotfixes of a Windows guest", func() {
+			result := &agent.GuestExecResult{
+				Stdout: `[{"HotFixID":"KB5034441","Description":"Security Update","InstalledOn":"2023-11-14T22:13:20.0000000Z"},` +
+					`{"HotFixID":"KB5011048","Description":"Update","InstalledOn":null}]`,
+			}
+			installedOn := metav1.NewTime(time.Unix(1700000000, 0).UTC())
+
+			Expect(parseHotfixes(result)).To(Equal([]api.Hotfix{
+				{ID: "KB5034441", Description: "Security Update", InstalledOn: &installedOn},
+				{ID: "KB5011048", Description: "Update"},
+			}))
+		})
+
+		It("should not parse the hotfixes of a Windows guest if the script failed", func() {
+			_, err := parseHotfixes(&agent.GuestExecResult{ExitCode: 1, Stderr: "Get-HotFix failed"})
+			Expect(err).To(HaveOccurred())
+		})
 	})
 })
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 
 	"kubevirt.io/client-go/log"
@@ -47,6 +48,16 @@ const (
 	GET_FILESYSTEM      AgentCommand = "guest-get-fsinfo"
 	GET_AGENT           AgentCommand = "guest-info"
 	GET_FSFREEZE_STATUS AgentCommand = "guest-fsfreeze-status"
+	GET_LOAD            AgentCommand = "guest-get-load"
+	GET_VCPUS           AgentCommand = "guest-get-vcpus"
+	GET_MEMORY_BLOCKS   AgentCommand = "guest-get-memory-blocks"
+	GET_MEMORY_BLOCK    AgentCommand = "guest-get-memory-block-info"
+	GET_DISKSTATS       AgentCommand = "guest-get-diskstats"
+
+	// The following are no guest agent commands, the data is collected by guestDataCollectors
+	GET_BOOT_TIME      AgentCommand = "boot-time"
+	GET_REBOOT_PENDING AgentCommand = "reboot-pending"
+	GET_HOTFIXES       AgentCommand = "hotfixes"
 
 	pollInitialInterval = 10 * time.Second
 )
@@ -209,6 +220,88 @@ func (s *AsyncAgentStore) GetUsers(limit int) []api.User {
 	return limitedUsers
 }
 
+// GetLoad returns the guest load average, nil if not reported
+func (s *AsyncAgentStore) GetLoad() *api.GuestLoad {
+	data, ok := s.store.Load(GET_LOAD)
+	if !ok {
+		return nil
+	}
+
+	load := data.(api.GuestLoad)
+	return &load
+}
+
+// GetVCPUs returns the vCPUs known to the guest, nil if not reported
+func (s *AsyncAgentStore) GetVCPUs() *api.GuestVCPUs {
+	data, ok := s.store.Load(GET_VCPUS)
+	if !ok {
+		return nil
+	}
+
+	vcpus := data.(api.GuestVCPUs)
+	return &vcpus
+}
+
+// GetMemory returns the online and total memory in bytes known to the guest.
+// ok is false when the guest did not report its memory blocks.
+func (s *AsyncAgentStore) GetMemory() (onlineBytes int64, totalBytes int64, ok bool) {
+	data, ok := s.store.Load(GET_MEMORY_BLOCKS)
+	if !ok {
+		return 0, 0, false
+	}
+	blocks := data.(api.GuestMemoryBlocks)
+
+	data, ok = s.store.Load(GET_MEMORY_BLOCK)
+	if !ok {
+		return 0, 0, false
+	}
+	blockSize := data.(int64)
+
+	return int64(blocks.Online) * blockSize, int64(blocks.Total) * blockSize, true
+}
+
+// GetDiskStats returns the I/O statistics of the guest block devices
+func (s *AsyncAgentStore) GetDiskStats() []api.DiskStats {
+	data, ok := s.store.Load(GET_DISKSTATS)
+	if ok {
+		return data.([]api.DiskStats)
+	}
+
+	return nil
+}
+
+// GetBootTime returns the time the guest booted, nil if not reported
+func (s *AsyncAgentStore) GetBootTime() *metav1.Time {
+	data, ok := s.store.Load(GET_BOOT_TIME)
+	if !ok {
+		return nil
+	}
+
+	bootTime := data.(metav1.Time)
+	return &bootTime
+}
+
+// GetRebootPending returns whether the guest has to be rebooted to apply updates, nil if unknown
+func (s *AsyncAgentStore) GetRebootPending() *bool {
+	data, ok := s.store.Load(GET_REBOOT_PENDING)
+	if !ok {
+		return nil
+	}
+
+	rebootPending := data.(bool)
+	return &rebootPending
+}
+
+// GetHotfixes returns the hotfixes installed in a Windows guest
+func (s *AsyncAgentStore) GetHotfixes() []api.Hotfix {
+	data, ok := s.store.Load(GET_HOTFIXES)
+	if ok {
+		return data.([]api.Hotfix)
+	}
+
+	return nil
+}
+
 // PollerWorker collects the data from the guest agent
 // only unique items are stored as configuration
 type PollerWorker struct {
@@ -277,6 +370,8 @@ func CreatePoller(
 	qemuAgentUserInterval time.Duration,
 	qemuAgentVersionInterval time.Duration,
 	qemuAgentFSFreezeStatusInterval time.Duration,
+	qemuAgentInventoryInterval time.Duration,
+	qemuAgentPatchComplianceInterval time.Duration,
 ) *AgentPoller {
 	p := &AgentPoller{
 		Connection: connecton,
@@ -311,6 +406,18 @@ func CreatePoller(
 		CallTick:      qemuAgentFSFreezeStatusInterval,
 		AgentCommands: []AgentCommand{GET_FSFREEZE_STATUS},
 	})
+	// inventory command group
+	p.workers = append(p.workers, PollerWorker{
+		CallTick:      qemuAgentInventoryInterval,
+		AgentCommands: []AgentCommand{GET_LOAD, GET_VCPUS, GET_MEMORY_BLOCK, GET_MEMORY_BLOCKS, GET_DISKSTATS},
+	})
+	// patch compliance command group, it runs commands in the guest and is only polled on request
+	if qemuAgentPatchComplianceInterval > 0 {
+		p.workers = append(p.workers, PollerWorker{
+			CallTick:      qemuAgentPatchComplianceInterval,
+			AgentCommands: []AgentCommand{GET_BOOT_TIME, GET_REBOOT_PENDING, GET_HOTFIXES},
+		})
+	}
 
 	return p
 }
@@ -341,6 +448,11 @@ func (p *AgentPoller) Stop() {
 // With libvirt 5.6.0 direct call to agent can be replaced with call to libvirt Domain.GetGuestInfo
 func executeAgentCommands(commands []AgentCommand, con cli.Connection, agentStore *AsyncAgentStore, domainName string) {
 	for _, command := range commands {
+		if collect, exists := guestDataCollectors[command]; exists {
+			collect(con, agentStore, domainName)
+			continue
+		}
+
 		// replace with direct call to libvirt function when 5.6.0 is available
 		cmdResult, err := con.QemuAgentCommand(`{"execute":"`+string(command)+`"}`, domainName)
 		if err != nil {
@@ -407,6 +519,41 @@ func executeAgentCommands(commands []AgentCommand, con cli.Connection, agentStor
 				continue
 			}
 			agentStore.Store(GET_AGENT, agent)
+		case GET_LOAD:
+			load, err := parseLoad(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent load %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_LOAD, load)
+		case GET_VCPUS:
+			vcpus, err := parseVCPUs(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent vcpus %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_VCPUS, vcpus)
+		case GET_MEMORY_BLOCKS:
+			blocks, err := parseMemoryBlocks(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent memory blocks %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_MEMORY_BLOCKS, blocks)
+		case GET_MEMORY_BLOCK:
+			blockSize, err := parseMemoryBlockSize(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent memory block info %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_MEMORY_BLOCK, blockSize)
+		case GET_DISKSTATS:
+			diskStats, err := parseDiskStats(cmdResult)
+			if err != nil {
+				log.Log.Errorf("Cannot parse guest agent diskstats %s", err.Error())
+				continue
+			}
+			agentStore.Store(GET_DISKSTATS, diskStats)
 		}
 	}
 }
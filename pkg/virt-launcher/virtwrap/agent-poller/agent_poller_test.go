@@ -24,6 +24,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	v1 "kubevirt.io/api/core/v1"
 
 	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 )
@@ -170,6 +171,70 @@ var _ = Describe("Qemu agent poller", func() {
 
 			Expect(*osInfo).To(Equal(fakeInfo))
 		})
+
+		It("should not report memory until both memory block commands were stored", func() {
+			var agentStore = NewAsyncAgentStore()
+			agentStore.Store(GET_MEMORY_BLOCKS, api.GuestMemoryBlocks{Online: 3, Total: 4})
+			_, _, ok := agentStore.GetMemory()
+			Expect(ok).To(BeFalse())
+
+			agentStore.Store(GET_MEMORY_BLOCK, int64(1024))
+			onlineBytes, totalBytes, ok := agentStore.GetMemory()
+			Expect(ok).To(BeTrue())
+			Expect(onlineBytes).To(Equal(int64(3072)))
+			Expect(totalBytes).To(Equal(int64(4096)))
+		})
+	})
+
+	Context("CreatePoller", func() {
+		hasPatchComplianceWorker := func(p *AgentPoller) bool {
+			for _, worker := range p.workers {
+				for _, command := range worker.AgentCommands {
+					if command == GET_REBOOT_PENDING {
+						return true
+					}
+				}
+			}
+			return false
+		}
+
+		It("should not poll the patch compliance without an interval", func() {
+			p := CreatePoller(nil, "", "", nil, time.Second, time.Second, time.Second, time.Second, time.Second, time.Second, 0)
+			Expect(hasPatchComplianceWorker(p)).To(BeFalse())
+		})
+
+		It("should poll the patch compliance with an interval", func() {
+			p := CreatePoller(nil, "", "", nil, time.Second, time.Second, time.Second, time.Second, time.Second, time.Second, time.Minute)
+			Expect(hasPatchComplianceWorker(p)).To(BeTrue())
+		})
+	})
+
+	Context("guestCommandsEnabled", func() {
+		var agentStore AsyncAgentStore
+
+		BeforeEach(func() {
+			agentStore = NewAsyncAgentStore()
+		})
+
+		It("should be false as long as the supported commands are unknown", func() {
+			Expect(guestCommandsEnabled(&agentStore, guestExecCommands)).To(BeFalse())
+		})
+
+		It("should be false if the guest agent blocks a command", func() {
+			agentStore.Store(GET_AGENT, AgentInfo{SupportedCommands: []v1.GuestAgentCommandInfo{
+				{Name: "guest-exec", Enabled: false},
+				{Name: "guest-exec-status", Enabled: true},
+			}})
+			Expect(guestCommandsEnabled(&agentStore, guestExecCommands)).To(BeFalse())
+		})
+
+		It("should be true if the guest agent has all commands enabled", func() {
+			agentStore.Store(GET_AGENT, AgentInfo{SupportedCommands: []v1.GuestAgentCommandInfo{
+				{Name: "guest-exec", Enabled: true},
+				{Name: "guest-exec-status", Enabled: true},
+			}})
+			Expect(guestCommandsEnabled(&agentStore, guestExecCommands)).To(BeTrue())
+		})
 	})
 
 	Context("PollerWorker", func() {
@@ -0,0 +1,181 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package agentpoller
+
+import (
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+// The guest agent has no commands reporting the patch compliance of the guest, so it is
+// collected by reading files and running commands in the guest through the guest agent.
+const (
+	guestCommandTimeoutSeconds = 30
+	procStatMaxBytes           = 1024 * 1024
+	windowsOSID                = "mswindows"
+	powershell                 = "powershell.exe"
+	posixShell                 = "/bin/sh"
+
+	// rebootPendingExitCode is returned by the reboot pending scripts if a reboot is pending,
+	// 0 if not, and any other exit code if it is unknown.
+	rebootPendingExitCode = 1
+
+	linuxRebootPendingScript = `if [ -e /run/reboot-required ]; then exit 1; fi
+if command -v needs-restarting >/dev/null 2>&1; then needs-restarting -r >/dev/null 2>&1; exit $?; fi
+exit 2`
+	windowsRebootPendingScript = `$cbs = Test-Path 'HKLM:\SOFTWARE\Microsoft\Windows\CurrentVersion\Component Based Servicing\RebootPending'
+$wu = Test-Path 'HKLM:\SOFTWARE\Microsoft\Windows\CurrentVersion\WindowsUpdate\Auto Update\RebootRequired'
+$rename = $null -ne (Get-ItemProperty -Path 'HKLM:\SYSTEM\CurrentControlSet\Control\Session Manager' -Name PendingFileRenameOperations -ErrorAction SilentlyContinue)
+if ($cbs -or $wu -or $rename) { exit 1 } else { exit 0 }`
+	windowsBootTimeScript = `(Get-CimInstance -ClassName Win32_OperatingSystem).LastBootUpTime.ToUniversalTime().ToString('o')`
+	windowsHotfixesScript = `ConvertTo-Json -Compress -InputObject @(Get-HotFix | Select-Object HotFixID, Description, @{Name='InstalledOn'; Expression={if ($_.InstalledOn) {$_.InstalledOn.ToUniversalTime().ToString('o')}}})`
+)
+
+// guestExecCommands and guestFileReadCommands are needed to collect the data, guest agents may
+// block them, in which case the data is not collected.
+var (
+	guestExecCommands     = []string{"guest-exec", "guest-exec-status"}
+	guestFileReadCommands = []string{"guest-file-open", "guest-file-read", "guest-file-close"}
+)
+
+type guestDataCollector func(con cli.Connection, agentStore *AsyncAgentStore, domainName string)
+
+// guestDataCollectors collect the data of the commands which are not executed by the guest agent itself
+var guestDataCollectors = map[AgentCommand]guestDataCollector{
+	GET_BOOT_TIME:      collectBootTime,
+	GET_REBOOT_PENDING: collectRebootPending,
+	GET_HOTFIXES:       collectHotfixes,
+}
+
+// isWindowsGuest reports whether the guest runs Windows, ok is false as long as the guest os is unknown
+func isWindowsGuest(agentStore *AsyncAgentStore) (isWindows bool, ok bool) {
+	osInfo := agentStore.GetGuestOSInfo()
+	if osInfo == nil {
+		return false, false
+	}
+	return osInfo.Id == windowsOSID, true
+}
+
+// guestCommandsEnabled reports whether the guest agent has all the commands enabled.
+// It is false as long as the supported commands of the guest agent are unknown.
+func guestCommandsEnabled(agentStore *AsyncAgentStore, commands []string) bool {
+	enabled := map[string]bool{}
+	for _, command := range agentStore.GetGA().SupportedCommands {
+		enabled[command.Name] = command.Enabled
+	}
+	for _, command := range commands {
+		if !enabled[command] {
+			return false
+		}
+	}
+	return true
+}
+
+func runPowershell(con cli.Connection, domainName string, script string) (*agent.GuestExecResult, error) {
+	return agent.GuestExecCommand(con, domainName, powershell, []string{"-NoProfile", "-NonInteractive", "-Command", script}, guestCommandTimeoutSeconds)
+}
+
+func collectBootTime(con cli.Connection, agentStore *AsyncAgentStore, domainName string) {
+	isWindows, ok := isWindowsGuest(agentStore)
+	if !ok {
+		return
+	}
+
+	if isWindows {
+		if !guestCommandsEnabled(agentStore, guestExecCommands) {
+			return
+		}
+		result, err := runPowershell(con, domainName, windowsBootTimeScript)
+		if err != nil {
+			log.Log.V(3).Reason(err).Info("Cannot read the boot time of the guest")
+			return
+		}
+		bootTime, err := parseWindowsBootTime(result)
+		if err != nil {
+			log.Log.V(3).Reason(err).Info("Cannot parse the boot time of the guest")
+			return
+		}
+		agentStore.Store(GET_BOOT_TIME, bootTime)
+		return
+	}
+
+	if !guestCommandsEnabled(agentStore, guestFileReadCommands) {
+		return
+	}
+	procStat, err := agent.GuestFileRead(con, domainName, "/proc/stat", procStatMaxBytes)
+	if err != nil {
+		log.Log.V(3).Reason(err).Info("Cannot read the boot time of the guest")
+		return
+	}
+	bootTime, err := parseProcStatBootTime(string(procStat))
+	if err != nil {
+		log.Log.V(3).Reason(err).Info("Cannot parse the boot time of the guest")
+		return
+	}
+	agentStore.Store(GET_BOOT_TIME, bootTime)
+}
+
+func collectRebootPending(con cli.Connection, agentStore *AsyncAgentStore, domainName string) {
+	isWindows, ok := isWindowsGuest(agentStore)
+	if !ok || !guestCommandsEnabled(agentStore, guestExecCommands) {
+		return
+	}
+
+	var result *agent.GuestExecResult
+	var err error
+	if isWindows {
+		result, err = runPowershell(con, domainName, windowsRebootPendingScript)
+	} else {
+		result, err = agent.GuestExecCommand(con, domainName, posixShell, []string{"-c", linuxRebootPendingScript}, guestCommandTimeoutSeconds)
+	}
+	if err != nil {
+		log.Log.V(3).Reason(err).Info("Cannot check whether a reboot of the guest is pending")
+		return
+	}
+
+	switch result.ExitCode {
+	case 0:
+		agentStore.Store(GET_REBOOT_PENDING, false)
+	case rebootPendingExitCode:
+		agentStore.Store(GET_REBOOT_PENDING, true)
+	default:
+		log.Log.V(3).Infof("Cannot tell whether a reboot of the guest is pending, exit code %d", result.ExitCode)
+	}
+}
+
+func collectHotfixes(con cli.Connection, agentStore *AsyncAgentStore, domainName string) {
+	if isWindows, ok := isWindowsGuest(agentStore); !ok || !isWindows || !guestCommandsEnabled(agentStore, guestExecCommands) {
+		return
+	}
+
+	result, err := runPowershell(con, domainName, windowsHotfixesScript)
+	if err != nil {
+		log.Log.V(3).Reason(err).Info("Cannot list the hotfixes of the guest")
+		return
+	}
+	hotfixes, err := parseHotfixes(result)
+	if err != nil {
+		log.Log.V(3).Reason(err).Info("Cannot parse the hotfixes of the guest")
+		return
+	}
+	agentStore.Store(GET_HOTFIXES, hotfixes)
+}
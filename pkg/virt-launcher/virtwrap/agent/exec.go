@@ -1,7 +1,9 @@
 package agent
 
 import (
+	"crypto/rand"
 	"encoding/base64"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -23,6 +25,37 @@ type execStatusReturnData struct {
 	Exited   bool   `json:"exited"`
 	ExitCode int    `json:"exitcode"`
 	OutData  string `json:"out-data"`
+	ErrData  string `json:"err-data"`
+}
+
+// guestExecStatusInterval is the interval in which GuestExecCommand polls for the exit of the command
+const guestExecStatusInterval = 250 * time.Millisecond
+
+const (
+	// guestExecShell runs the commands whose output is streamed by GuestExecStream
+	guestExecShell = "/bin/sh"
+	// guestExecStreamScript runs the command with its stdout and stderr redirected to the files passed as first and
+	// second argument. noclobber makes the redirection fail instead of writing to a file which already exists.
+	guestExecStreamScript = `set -C; out=$1; err=$2; shift 2; exec "$@" >"$out" 2>"$err"`
+	// guestExecOutputDir holds the files the output of streamed commands is written to
+	guestExecOutputDir = "/tmp"
+)
+
+type guestExecArguments struct {
+	Path          string   `json:"path"`
+	Arg           []string `json:"arg"`
+	CaptureOutput bool     `json:"capture-output"`
+}
+
+type guestExecStatusArguments struct {
+	Pid int `json:"pid"`
+}
+
+// GuestExecResult holds the exit code and the captured output of a command executed by GuestExecCommand
+type GuestExecResult struct {
+	ExitCode int
+	Stdout   string
+	Stderr   string
 }
 
 // ExecExitCode returned at non-zero return codes
@@ -104,3 +137,219 @@ func GuestExec(virConn cli.Connection, domName string, command string, args []st
 
 	return stdOut, nil
 }
+
+// GuestExecCommand executes the command with args in the guest and waits up to timeoutSeconds for it to exit.
+// In contrast to GuestExec a non-zero exit code is not treated as error and stderr is captured as well.
+func GuestExecCommand(virConn cli.Connection, domName string, command string, args []string, timeoutSeconds int32) (*GuestExecResult, error) {
+	pid, err := guestExecStart(virConn, domName, command, args)
+	if err != nil {
+		return nil, err
+	}
+
+	statusCheck := time.NewTicker(guestExecStatusInterval)
+	defer statusCheck.Stop()
+	checkUntil := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+
+	for {
+		status, err := guestExecStatus(virConn, domName, pid)
+		if err != nil {
+			return nil, err
+		}
+
+		if status.Exited {
+			stdout, stderr, err := status.output()
+			if err != nil {
+				return nil, err
+			}
+			return &GuestExecResult{
+				ExitCode: status.ExitCode,
+				Stdout:   string(stdout),
+				Stderr:   string(stderr),
+			}, nil
+		}
+
+		if checkUntil.Before(<-statusCheck.C) {
+			return nil, fmt.Errorf("Timed out waiting for guest pid [%d] for command [%s] to exit", pid, command)
+		}
+	}
+}
+
+// GuestExecOutput receives the output a command executed by GuestExecStream wrote since its previous call
+type GuestExecOutput func(stdout, stderr []byte) error
+
+// GuestExecStream executes the command with args in the guest and passes its output to output while it runs,
+// until it exits or timeoutSeconds passed. It returns the exit code of the command.
+// The guest agent only returns the captured output of a command once it exited, so the command is run by a shell
+// which redirects its output to files in the guest, and these files are read while it runs. Guests without a POSIX
+// shell, like Windows, fall back to GuestExecCommand and receive the whole output once the command exited.
+func GuestExecStream(virConn cli.Connection, domName string, command string, args []string, timeoutSeconds int32, output GuestExecOutput) (int, error) {
+	id, err := newGuestExecID()
+	if err != nil {
+		return 0, err
+	}
+	stdout := &guestFileTail{path: fmt.Sprintf("%s/kubevirt-guest-exec-%s.out", guestExecOutputDir, id)}
+	stderr := &guestFileTail{path: fmt.Sprintf("%s/kubevirt-guest-exec-%s.err", guestExecOutputDir, id)}
+
+	pid, err := guestExecStart(virConn, domName, guestExecShell,
+		append([]string{"-c", guestExecStreamScript, guestExecShell, stdout.path, stderr.path, command}, args...))
+	if err != nil {
+		return guestExecWithoutShell(virConn, domName, command, args, timeoutSeconds, output)
+	}
+	defer func() {
+		stdout.close(virConn, domName)
+		stderr.close(virConn, domName)
+		// a command which timed out keeps its output files open, they are freed once it exits
+		_, _ = guestExecStart(virConn, domName, "/bin/rm", []string{"-f", stdout.path, stderr.path})
+	}()
+
+	statusCheck := time.NewTicker(guestExecStatusInterval)
+	defer statusCheck.Stop()
+	checkUntil := time.Now().Add(time.Duration(timeoutSeconds) * time.Second)
+
+	for {
+		// the status is queried before reading the output, so that all of it is read once the command exited
+		status, err := guestExecStatus(virConn, domName, pid)
+		if err != nil {
+			return 0, err
+		}
+		stdoutChunk, err := stdout.read(virConn, domName)
+		if err != nil {
+			return 0, err
+		}
+		stderrChunk, err := stderr.read(virConn, domName)
+		if err != nil {
+			return 0, err
+		}
+
+		if status.Exited {
+			// the shell only writes to its own output if it failed to run the command
+			shellStdout, shellStderr, err := status.output()
+			if err != nil {
+				return 0, err
+			}
+			stdoutChunk = append(stdoutChunk, shellStdout...)
+			stderrChunk = append(stderrChunk, shellStderr...)
+		}
+		if len(stdoutChunk) > 0 || len(stderrChunk) > 0 {
+			if err := output(stdoutChunk, stderrChunk); err != nil {
+				return 0, err
+			}
+		}
+		if status.Exited {
+			return status.ExitCode, nil
+		}
+
+		if checkUntil.Before(<-statusCheck.C) {
+			return 0, fmt.Errorf("Timed out waiting for guest pid [%d] for command [%s] to exit", pid, command)
+		}
+	}
+}
+
+func guestExecWithoutShell(virConn cli.Connection, domName string, command string, args []string, timeoutSeconds int32, output GuestExecOutput) (int, error) {
+	result, err := GuestExecCommand(virConn, domName, command, args, timeoutSeconds)
+	if err != nil {
+		return 0, err
+	}
+	if result.Stdout != "" || result.Stderr != "" {
+		if err := output([]byte(result.Stdout), []byte(result.Stderr)); err != nil {
+			return 0, err
+		}
+	}
+	return result.ExitCode, nil
+}
+
+func guestExecStart(virConn cli.Connection, domName string, command string, args []string) (int, error) {
+	if args == nil {
+		args = []string{}
+	}
+	output, err := agentCommandWithArguments(virConn, domName, "guest-exec", guestExecArguments{
+		Path:          command,
+		Arg:           args,
+		CaptureOutput: true,
+	})
+	if err != nil {
+		return 0, err
+	}
+	execRes := &execReturn{}
+	if err := json.Unmarshal([]byte(output), execRes); err != nil {
+		return 0, err
+	}
+	if execRes.Return.Pid <= 0 {
+		return 0, fmt.Errorf("Invalid pid [%d] returned from qemu agent: %s", execRes.Return.Pid, output)
+	}
+	return execRes.Return.Pid, nil
+}
+
+func guestExecStatus(virConn cli.Connection, domName string, pid int) (*execStatusReturnData, error) {
+	output, err := agentCommandWithArguments(virConn, domName, "guest-exec-status", guestExecStatusArguments{Pid: pid})
+	if err != nil {
+		return nil, err
+	}
+	execStatusRes := &execStatusReturn{}
+	if err := json.Unmarshal([]byte(output), execStatusRes); err != nil {
+		return nil, err
+	}
+	return &execStatusRes.Return, nil
+}
+
+// output decodes the output the guest agent captured, it is only returned once the command exited
+func (s *execStatusReturnData) output() (stdout []byte, stderr []byte, err error) {
+	if stdout, err = base64.StdEncoding.DecodeString(s.OutData); err != nil {
+		return nil, nil, err
+	}
+	if stderr, err = base64.StdEncoding.DecodeString(s.ErrData); err != nil {
+		return nil, nil, err
+	}
+	return stdout, stderr, nil
+}
+
+func newGuestExecID() (string, error) {
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+// guestFileTail reads the data appended to a file in the guest since the previous read
+type guestFileTail struct {
+	path   string
+	handle int
+	opened bool
+	eof    bool
+}
+
+func (t *guestFileTail) read(virConn cli.Connection, domName string) ([]byte, error) {
+	if !t.opened {
+		handle, err := guestFileOpen(virConn, domName, t.path, "r")
+		if err != nil {
+			// the shell did not create the file yet
+			return nil, nil
+		}
+		t.handle, t.opened = handle, true
+	}
+	if t.eof {
+		if err := guestFileClearEOF(virConn, domName, t.handle); err != nil {
+			return nil, err
+		}
+	}
+
+	var data []byte
+	for {
+		chunk, eof, err := guestFileReadChunk(virConn, domName, t.handle)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, chunk...)
+		t.eof = eof
+		if eof || len(chunk) == 0 {
+			return data, nil
+		}
+	}
+}
+
+func (t *guestFileTail) close(virConn cli.Connection, domName string) {
+	if t.opened {
+		_ = guestFileClose(virConn, domName, t.handle)
+	}
+}
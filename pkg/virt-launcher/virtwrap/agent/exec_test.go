@@ -0,0 +1,170 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package agent_test
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Guest exec", func() {
+	const (
+		execStatusCommand = `{"execute":"guest-exec-status","arguments":{"pid":789}}`
+		execReturn        = `{"return":{"pid":789}}`
+	)
+
+	var mockConn *cli.MockConnection
+
+	BeforeEach(func() {
+		mockConn = cli.NewMockConnection(gomock.NewController(GinkgoT()))
+	})
+
+	exitedReturn := func(exitCode int, stdout, stderr string) string {
+		return fmt.Sprintf(`{"return":{"exited":true,"exitcode":%d,"out-data":%q,"err-data":%q}}`, exitCode,
+			base64.StdEncoding.EncodeToString([]byte(stdout)), base64.StdEncoding.EncodeToString([]byte(stderr)))
+	}
+
+	It("should return the output and exit code of the command", func() {
+		gomock.InOrder(
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec","arguments":{"path":"cmd.exe","arg":["/c","echo \"hello\""],"capture-output":true}}`, domainName).Return(execReturn, nil),
+			mockConn.EXPECT().QemuAgentCommand(execStatusCommand, domainName).Return(`{"return":{"exited":false}}`, nil),
+			mockConn.EXPECT().QemuAgentCommand(execStatusCommand, domainName).Return(exitedReturn(2, "hello\n", "failure\n"), nil),
+		)
+
+		result, err := agent.GuestExecCommand(mockConn, domainName, "cmd.exe", []string{"/c", `echo "hello"`}, 5)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(&agent.GuestExecResult{
+			ExitCode: 2,
+			Stdout:   "hello\n",
+			Stderr:   "failure\n",
+		}))
+	})
+
+	It("should pass an empty argument list", func() {
+		gomock.InOrder(
+			mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec","arguments":{"path":"/usr/bin/true","arg":[],"capture-output":true}}`, domainName).Return(execReturn, nil),
+			mockConn.EXPECT().QemuAgentCommand(execStatusCommand, domainName).Return(exitedReturn(0, "", ""), nil),
+		)
+
+		result, err := agent.GuestExecCommand(mockConn, domainName, "/usr/bin/true", nil, 5)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.ExitCode).To(BeZero())
+	})
+
+	It("should fail if the command does not exit in time", func() {
+		mockConn.EXPECT().QemuAgentCommand(gomock.Any(), domainName).Return(execReturn, nil)
+		mockConn.EXPECT().QemuAgentCommand(execStatusCommand, domainName).Return(`{"return":{"exited":false}}`, nil)
+
+		_, err := agent.GuestExecCommand(mockConn, domainName, "/usr/bin/sleep", []string{"60"}, 0)
+		Expect(err).To(MatchError(ContainSubstring("Timed out waiting for guest pid [789]")))
+	})
+
+	Context("with streamed output", func() {
+		const (
+			stdoutHandle = 1000
+			stdoutRead   = `{"execute":"guest-file-read","arguments":{"handle":1000,"count":65536}}`
+		)
+
+		var (
+			chunks []string
+			output agent.GuestExecOutput
+		)
+
+		BeforeEach(func() {
+			chunks = nil
+			output = func(stdout, stderr []byte) error {
+				chunks = append(chunks, string(stdout)+"|"+string(stderr))
+				return nil
+			}
+		})
+
+		It("should pass the output to the caller while the command runs", func() {
+			writes := []string{"hello\n", "world\n"}
+			written := ""
+			var seeks int
+			var closed, removed bool
+
+			mockConn.EXPECT().QemuAgentCommand(gomock.Any(), domainName).DoAndReturn(func(cmd, _ string) (string, error) {
+				switch {
+				case strings.HasPrefix(cmd, `{"execute":"guest-exec","arguments":{"path":"/bin/sh"`):
+					Expect(cmd).To(ContainSubstring(`"/usr/bin/ping","-c","2","localhost"]`))
+					return execReturn, nil
+				case strings.HasPrefix(cmd, `{"execute":"guest-exec","arguments":{"path":"/bin/rm"`):
+					removed = true
+					return `{"return":{"pid":790}}`, nil
+				case cmd == execStatusCommand:
+					if len(writes) > 0 {
+						written += writes[0]
+						writes = writes[1:]
+						return `{"return":{"exited":false}}`, nil
+					}
+					return exitedReturn(0, "", ""), nil
+				case strings.HasPrefix(cmd, `{"execute":"guest-file-open"`):
+					if strings.Contains(cmd, ".out") {
+						return fmt.Sprintf(`{"return":%d}`, stdoutHandle), nil
+					}
+					return "", errors.New("No such file or directory")
+				case cmd == stdoutRead:
+					data := written
+					written = ""
+					return fmt.Sprintf(`{"return":{"count":%d,"buf-b64":%q,"eof":true}}`, len(data), base64.StdEncoding.EncodeToString([]byte(data))), nil
+				case cmd == `{"execute":"guest-file-seek","arguments":{"handle":1000,"offset":0,"whence":"cur"}}`:
+					seeks++
+					return `{"return":{"position":0,"eof":false}}`, nil
+				case cmd == `{"execute":"guest-file-close","arguments":{"handle":1000}}`:
+					closed = true
+					return `{"return":{}}`, nil
+				}
+				Fail("unexpected guest agent command " + cmd)
+				return "", nil
+			}).AnyTimes()
+
+			exitCode, err := agent.GuestExecStream(mockConn, domainName, "/usr/bin/ping", []string{"-c", "2", "localhost"}, 5, output)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(BeZero())
+			Expect(chunks).To(Equal([]string{"hello\n|", "world\n|"}))
+			Expect(seeks).To(Equal(2))
+			Expect(closed).To(BeTrue())
+			Expect(removed).To(BeTrue())
+		})
+
+		It("should pass the output once the command exited if the guest has no shell", func() {
+			gomock.InOrder(
+				mockConn.EXPECT().QemuAgentCommand(gomock.Any(), domainName).Return("", errors.New("Failed to execute child process /bin/sh")),
+				mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-exec","arguments":{"path":"ipconfig","arg":[],"capture-output":true}}`, domainName).Return(execReturn, nil),
+				mockConn.EXPECT().QemuAgentCommand(execStatusCommand, domainName).Return(exitedReturn(1, "out", "err"), nil),
+			)
+
+			exitCode, err := agent.GuestExecStream(mockConn, domainName, "ipconfig", nil, 5, output)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exitCode).To(Equal(1))
+			Expect(chunks).To(Equal([]string{"out|err"}))
+		})
+	})
+})
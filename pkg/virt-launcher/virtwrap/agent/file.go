@@ -0,0 +1,195 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package agent
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+// guestFileChunkSize limits the amount of data transferred by a single guest agent command
+const guestFileChunkSize = 64 * 1024
+
+type agentCommand struct {
+	Execute   string      `json:"execute"`
+	Arguments interface{} `json:"arguments,omitempty"`
+}
+
+type fileOpenArguments struct {
+	Path string `json:"path"`
+	Mode string `json:"mode"`
+}
+
+type fileHandleArguments struct {
+	Handle int `json:"handle"`
+}
+
+type fileReadArguments struct {
+	Handle int `json:"handle"`
+	Count  int `json:"count"`
+}
+
+type fileSeekArguments struct {
+	Handle int    `json:"handle"`
+	Offset int    `json:"offset"`
+	Whence string `json:"whence"`
+}
+
+type fileWriteArguments struct {
+	Handle int    `json:"handle"`
+	BufB64 string `json:"buf-b64"`
+}
+
+type fileOpenReturn struct {
+	Return int `json:"return"`
+}
+
+type fileReadReturn struct {
+	Return fileReadReturnData `json:"return"`
+}
+type fileReadReturnData struct {
+	Count  int    `json:"count"`
+	BufB64 string `json:"buf-b64"`
+	EOF    bool   `json:"eof"`
+}
+
+type fileWriteReturn struct {
+	Return fileWriteReturnData `json:"return"`
+}
+type fileWriteReturnData struct {
+	Count int `json:"count"`
+}
+
+// GuestFileRead reads the file at the given path in the guest. It fails if the file is larger than maxBytes.
+func GuestFileRead(virConn cli.Connection, domName string, path string, maxBytes int64) (contents []byte, err error) {
+	handle, err := guestFileOpen(virConn, domName, path, "r")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if closeErr := guestFileClose(virConn, domName, handle); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	contents = []byte{}
+	for {
+		chunk, eof, err := guestFileReadChunk(virConn, domName, handle)
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(contents)+len(chunk)) > maxBytes {
+			return nil, fmt.Errorf("file %s exceeds the maximum size of %d bytes", path, maxBytes)
+		}
+		contents = append(contents, chunk...)
+
+		if eof || len(chunk) == 0 {
+			return contents, nil
+		}
+	}
+}
+
+// GuestFileWrite creates or truncates the file at the given path in the guest and writes contents to it.
+func GuestFileWrite(virConn cli.Connection, domName string, path string, contents []byte) (err error) {
+	handle, err := guestFileOpen(virConn, domName, path, "w")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := guestFileClose(virConn, domName, handle); closeErr != nil && err == nil {
+			err = closeErr
+		}
+	}()
+
+	for len(contents) > 0 {
+		chunk := contents[:min(len(contents), guestFileChunkSize)]
+		output, err := agentCommandWithArguments(virConn, domName, "guest-file-write", fileWriteArguments{
+			Handle: handle,
+			BufB64: base64.StdEncoding.EncodeToString(chunk),
+		})
+		if err != nil {
+			return err
+		}
+		writeRes := &fileWriteReturn{}
+		if err := json.Unmarshal([]byte(output), writeRes); err != nil {
+			return err
+		}
+		if writeRes.Return.Count <= 0 {
+			return fmt.Errorf("failed to write to file %s: no data written", path)
+		}
+		contents = contents[writeRes.Return.Count:]
+	}
+
+	return nil
+}
+
+func guestFileOpen(virConn cli.Connection, domName string, path string, mode string) (int, error) {
+	output, err := agentCommandWithArguments(virConn, domName, "guest-file-open", fileOpenArguments{Path: path, Mode: mode})
+	if err != nil {
+		return 0, err
+	}
+	openRes := &fileOpenReturn{}
+	if err := json.Unmarshal([]byte(output), openRes); err != nil {
+		return 0, err
+	}
+	return openRes.Return, nil
+}
+
+// guestFileReadChunk reads up to guestFileChunkSize bytes from the current position of the handle
+func guestFileReadChunk(virConn cli.Connection, domName string, handle int) (chunk []byte, eof bool, err error) {
+	output, err := agentCommandWithArguments(virConn, domName, "guest-file-read", fileReadArguments{Handle: handle, Count: guestFileChunkSize})
+	if err != nil {
+		return nil, false, err
+	}
+	readRes := &fileReadReturn{}
+	if err := json.Unmarshal([]byte(output), readRes); err != nil {
+		return nil, false, err
+	}
+	if readRes.Return.Count > 0 {
+		if chunk, err = base64.StdEncoding.DecodeString(readRes.Return.BufB64); err != nil {
+			return nil, false, err
+		}
+	}
+	return chunk, readRes.Return.EOF, nil
+}
+
+// guestFileClearEOF seeks by zero bytes, which clears the end of file indicator the guest agent keeps for a handle,
+// so that data appended to the file after reaching its end can be read as well
+func guestFileClearEOF(virConn cli.Connection, domName string, handle int) error {
+	_, err := agentCommandWithArguments(virConn, domName, "guest-file-seek", fileSeekArguments{Handle: handle, Offset: 0, Whence: "cur"})
+	return err
+}
+
+func guestFileClose(virConn cli.Connection, domName string, handle int) error {
+	_, err := agentCommandWithArguments(virConn, domName, "guest-file-close", fileHandleArguments{Handle: handle})
+	return err
+}
+
+// agentCommandWithArguments marshals the command, so that user provided arguments like paths are properly escaped
+func agentCommandWithArguments(virConn cli.Connection, domName string, command string, arguments interface{}) (string, error) {
+	cmd, err := json.Marshal(agentCommand{Execute: command, Arguments: arguments})
+	if err != nil {
+		return "", err
+	}
+	return virConn.QemuAgentCommand(string(cmd), domName)
+}
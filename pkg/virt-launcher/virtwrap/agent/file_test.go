@@ -0,0 +1,144 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package agent_test
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+const domainName = "default_testvmi"
+
+var _ = Describe("Guest file", func() {
+	var mockConn *cli.MockConnection
+
+	BeforeEach(func() {
+		mockConn = cli.NewMockConnection(gomock.NewController(GinkgoT()))
+	})
+
+	expectOpen := func(path, mode string) *gomock.Call {
+		return mockConn.EXPECT().QemuAgentCommand(
+			fmt.Sprintf(`{"execute":"guest-file-open","arguments":{"path":%q,"mode":%q}}`, path, mode), domainName,
+		).Return(`{"return": 1000}`, nil)
+	}
+
+	expectClose := func() *gomock.Call {
+		return mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-file-close","arguments":{"handle":1000}}`, domainName).Return(`{"return": {}}`, nil)
+	}
+
+	readCommand := `{"execute":"guest-file-read","arguments":{"handle":1000,"count":65536}}`
+
+	readReturn := func(data []byte, eof bool) string {
+		return fmt.Sprintf(`{"return": {"count": %d, "buf-b64": %q, "eof": %t}}`, len(data), base64.StdEncoding.EncodeToString(data), eof)
+	}
+
+	Context("read", func() {
+		It("should read a file in multiple chunks", func() {
+			gomock.InOrder(
+				expectOpen("/etc/hostname", "r"),
+				mockConn.EXPECT().QemuAgentCommand(readCommand, domainName).Return(readReturn([]byte("test"), false), nil),
+				mockConn.EXPECT().QemuAgentCommand(readCommand, domainName).Return(readReturn([]byte("vmi\n"), true), nil),
+				expectClose(),
+			)
+
+			contents, err := agent.GuestFileRead(mockConn, domainName, "/etc/hostname", 1024)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(Equal([]byte("testvmi\n")))
+		})
+
+		It("should fail and close the file if it exceeds the size limit", func() {
+			gomock.InOrder(
+				expectOpen("/etc/hostname", "r"),
+				mockConn.EXPECT().QemuAgentCommand(readCommand, domainName).Return(readReturn([]byte("testvmi\n"), true), nil),
+				expectClose(),
+			)
+
+			_, err := agent.GuestFileRead(mockConn, domainName, "/etc/hostname", 4)
+			Expect(err).To(MatchError(ContainSubstring("exceeds the maximum size of 4 bytes")))
+		})
+
+		It("should escape the path", func() {
+			path := `/tmp/a"b`
+			gomock.InOrder(
+				expectOpen(path, "r"),
+				mockConn.EXPECT().QemuAgentCommand(readCommand, domainName).Return(readReturn(nil, true), nil),
+				expectClose(),
+			)
+
+			contents, err := agent.GuestFileRead(mockConn, domainName, path, 1024)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(BeEmpty())
+		})
+
+		It("should fail if the file can't be opened", func() {
+			mockConn.EXPECT().QemuAgentCommand(gomock.Any(), domainName).Return("", fmt.Errorf("No such file or directory"))
+
+			_, err := agent.GuestFileRead(mockConn, domainName, "/missing", 1024)
+			Expect(err).To(MatchError(ContainSubstring("No such file or directory")))
+		})
+	})
+
+	Context("write", func() {
+		writeCommand := func(data []byte) string {
+			return fmt.Sprintf(`{"execute":"guest-file-write","arguments":{"handle":1000,"buf-b64":%q}}`, base64.StdEncoding.EncodeToString(data))
+		}
+
+		It("should write a file in multiple chunks", func() {
+			first := bytes.Repeat([]byte("a"), 64*1024)
+			second := []byte("b")
+			gomock.InOrder(
+				expectOpen("/tmp/file", "w"),
+				mockConn.EXPECT().QemuAgentCommand(writeCommand(first), domainName).Return(`{"return": {"count": 65536, "eof": false}}`, nil),
+				mockConn.EXPECT().QemuAgentCommand(writeCommand(second), domainName).Return(`{"return": {"count": 1, "eof": false}}`, nil),
+				expectClose(),
+			)
+
+			Expect(agent.GuestFileWrite(mockConn, domainName, "/tmp/file", append(first, second...))).To(Succeed())
+		})
+
+		It("should continue a partial write", func() {
+			gomock.InOrder(
+				expectOpen("/tmp/file", "w"),
+				mockConn.EXPECT().QemuAgentCommand(writeCommand([]byte("abcd")), domainName).Return(`{"return": {"count": 2, "eof": false}}`, nil),
+				mockConn.EXPECT().QemuAgentCommand(writeCommand([]byte("cd")), domainName).Return(`{"return": {"count": 2, "eof": false}}`, nil),
+				expectClose(),
+			)
+
+			Expect(agent.GuestFileWrite(mockConn, domainName, "/tmp/file", []byte("abcd"))).To(Succeed())
+		})
+
+		It("should create an empty file", func() {
+			gomock.InOrder(
+				expectOpen("/tmp/file", "w"),
+				expectClose(),
+			)
+
+			Expect(agent.GuestFileWrite(mockConn, domainName, "/tmp/file", nil)).To(Succeed())
+		})
+	})
+})
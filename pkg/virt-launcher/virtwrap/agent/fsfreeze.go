@@ -0,0 +1,36 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package agent
+
+import (
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+type fsFreezeListArguments struct {
+	Mountpoints []string `json:"mountpoints"`
+}
+
+// FSFreezeList freezes only the guest filesystems mounted at the given mountpoints
+func FSFreezeList(virConn cli.Connection, domName string, mountpoints []string) error {
+	_, err := agentCommandWithArguments(virConn, domName, "guest-fsfreeze-freeze-list", fsFreezeListArguments{
+		Mountpoints: mountpoints,
+	})
+	return err
+}
@@ -0,0 +1,51 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package agent_test
+
+import (
+	"fmt"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/cli"
+)
+
+var _ = Describe("Guest fsfreeze", func() {
+	var mockConn *cli.MockConnection
+
+	BeforeEach(func() {
+		mockConn = cli.NewMockConnection(gomock.NewController(GinkgoT()))
+	})
+
+	It("should freeze the listed mountpoints", func() {
+		mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-freeze-list","arguments":{"mountpoints":["/var/lib/pgsql","/data"]}}`, domainName).Return(`{"return":2}`, nil)
+
+		Expect(agent.FSFreezeList(mockConn, domainName, []string{"/var/lib/pgsql", "/data"})).To(Succeed())
+	})
+
+	It("should fail if the agent command fails", func() {
+		mockConn.EXPECT().QemuAgentCommand(gomock.Any(), domainName).Return("", fmt.Errorf("agent not connected"))
+
+		Expect(agent.FSFreezeList(mockConn, domainName, []string{"/data"})).To(MatchError("agent not connected"))
+	})
+})
@@ -183,6 +183,16 @@ func (in *BackingStoreFormat) DeepCopy() *BackingStoreFormat {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BandWidth) DeepCopyInto(out *BandWidth) {
 	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandWidthLimit)
+		**out = **in
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandWidthLimit)
+		**out = **in
+	}
 	return
 }
 
@@ -196,6 +206,22 @@ func (in *BandWidth) DeepCopy() *BandWidth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandWidthLimit) DeepCopyInto(out *BandWidthLimit) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandWidthLimit.
+func (in *BandWidthLimit) DeepCopy() *BandWidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandWidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockIO) DeepCopyInto(out *BlockIO) {
 	*out = *in
@@ -798,6 +824,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(MemoryDevice)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Panics != nil {
+		in, out := &in.Panics, &out.Panics
+		*out = make([]PanicDevice, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -871,6 +902,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(Shareable)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		**out = **in
+	}
 	return
 }
 
@@ -973,6 +1009,22 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskSecret) DeepCopyInto(out *DiskSecret) {
 	*out = *in
@@ -1036,6 +1088,22 @@ func (in *DiskSourceHost) DeepCopy() *DiskSourceHost {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskStats) DeepCopyInto(out *DiskStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskStats.
+func (in *DiskStats) DeepCopy() *DiskStats {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1777,6 +1845,67 @@ func (in *FilterRef) DeepCopy() *FilterRef {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHookStepMetadata) DeepCopyInto(out *FreezeHookStepMetadata) {
+	*out = *in
+	if in.ExitCode != nil {
+		in, out := &in.ExitCode, &out.ExitCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHookStepMetadata.
+func (in *FreezeHookStepMetadata) DeepCopy() *FreezeHookStepMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHookStepMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHooksMetadata) DeepCopyInto(out *FreezeHooksMetadata) {
+	*out = *in
+	if in.PreFreeze != nil {
+		in, out := &in.PreFreeze, &out.PreFreeze
+		*out = new(FreezeHookStepMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Freeze != nil {
+		in, out := &in.Freeze, &out.Freeze
+		*out = new(FreezeHookStepMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Thaw != nil {
+		in, out := &in.Thaw, &out.Thaw
+		*out = new(FreezeHookStepMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostThaw != nil {
+		in, out := &in.PostThaw, &out.PostThaw
+		*out = new(FreezeHookStepMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHooksMetadata.
+func (in *FreezeHooksMetadata) DeepCopy() *FreezeHooksMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHooksMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GracePeriodMetadata) DeepCopyInto(out *GracePeriodMetadata) {
 	*out = *in
@@ -1839,6 +1968,38 @@ func (in *GraphicsListen) DeepCopy() *GraphicsListen {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestLoad) DeepCopyInto(out *GuestLoad) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestLoad.
+func (in *GuestLoad) DeepCopy() *GuestLoad {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestLoad)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestMemoryBlocks) DeepCopyInto(out *GuestMemoryBlocks) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestMemoryBlocks.
+func (in *GuestMemoryBlocks) DeepCopy() *GuestMemoryBlocks {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestMemoryBlocks)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestOSInfo) DeepCopyInto(out *GuestOSInfo) {
 	*out = *in
@@ -1855,6 +2016,42 @@ func (in *GuestOSInfo) DeepCopy() *GuestOSInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestPanicMetadata) DeepCopyInto(out *GuestPanicMetadata) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestPanicMetadata.
+func (in *GuestPanicMetadata) DeepCopy() *GuestPanicMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestPanicMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestVCPUs) DeepCopyInto(out *GuestVCPUs) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestVCPUs.
+func (in *GuestVCPUs) DeepCopy() *GuestVCPUs {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestVCPUs)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 	*out = *in
@@ -1909,6 +2106,26 @@ func (in *HostDeviceSource) DeepCopy() *HostDeviceSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Hotfix) DeepCopyInto(out *Hotfix) {
+	*out = *in
+	if in.InstalledOn != nil {
+		in, out := &in.InstalledOn, &out.InstalledOn
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Hotfix.
+func (in *Hotfix) DeepCopy() *Hotfix {
+	if in == nil {
+		return nil
+	}
+	out := new(Hotfix)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HugePage) DeepCopyInto(out *HugePage) {
 	*out = *in
@@ -2021,7 +2238,7 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 	if in.BandWidth != nil {
 		in, out := &in.BandWidth, &out.BandWidth
 		*out = new(BandWidth)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.BootOrder != nil {
 		in, out := &in.BootOrder, &out.BootOrder
@@ -2238,6 +2455,16 @@ func (in *KubeVirtMetadata) DeepCopyInto(out *KubeVirtMetadata) {
 		*out = new(MemoryDumpMetadata)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FreezeHooks != nil {
+		in, out := &in.FreezeHooks, &out.FreezeHooks
+		*out = new(FreezeHooksMetadata)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestPanic != nil {
+		in, out := &in.GuestPanic, &out.GuestPanic
+		*out = new(GuestPanicMetadata)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2861,6 +3088,22 @@ func (in *OSType) DeepCopy() *OSType {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PanicDevice) DeepCopyInto(out *PanicDevice) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PanicDevice.
+func (in *PanicDevice) DeepCopy() *PanicDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(PanicDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReadOnly) DeepCopyInto(out *ReadOnly) {
 	*out = *in
@@ -177,6 +177,36 @@ type User struct {
 	LoginTime float64
 }
 
+type GuestLoad struct {
+	Load1m  float64
+	Load5m  float64
+	Load15m float64
+}
+
+type GuestVCPUs struct {
+	Online int
+	Total  int
+}
+
+type GuestMemoryBlocks struct {
+	Online int
+	Total  int
+}
+
+type DiskStats struct {
+	Name         string
+	ReadSectors  int64
+	ReadIOs      int64
+	WriteSectors int64
+	WriteIOs     int64
+}
+
+type Hotfix struct {
+	ID          string
+	Description string
+	InstalledOn *metav1.Time
+}
+
 // DomainGuestInfo represent guest agent info for specific domain
 type DomainGuestInfo struct {
 	Interfaces     []InterfaceStatus
@@ -219,6 +249,7 @@ type DomainSpec struct {
 	NUMATune       *NUMATune       `xml:"numatune"`
 	IOThreads      *IOThreads      `xml:"iothreads,omitempty"`
 	LaunchSecurity *LaunchSecurity `xml:"launchSecurity,omitempty"`
+	OnCrash        string          `xml:"on_crash,omitempty"`
 }
 
 type CPUTune struct {
@@ -390,6 +421,8 @@ type KubeVirtMetadata struct {
 	Migration        *MigrationMetadata        `xml:"migration,omitempty"`
 	AccessCredential *AccessCredentialMetadata `xml:"accessCredential,omitempty"`
 	MemoryDump       *MemoryDumpMetadata       `xml:"memoryDump,omitempty"`
+	FreezeHooks      *FreezeHooksMetadata      `xml:"freezeHooks,omitempty"`
+	GuestPanic       *GuestPanicMetadata       `xml:"guestPanic,omitempty"`
 }
 
 type AccessCredentialMetadata struct {
@@ -406,6 +439,25 @@ type MemoryDumpMetadata struct {
 	FailureReason  string       `xml:"failureReason,omitempty"`
 }
 
+type FreezeHooksMetadata struct {
+	PreFreeze *FreezeHookStepMetadata `xml:"preFreeze,omitempty"`
+	Freeze    *FreezeHookStepMetadata `xml:"freeze,omitempty"`
+	Thaw      *FreezeHookStepMetadata `xml:"thaw,omitempty"`
+	PostThaw  *FreezeHookStepMetadata `xml:"postThaw,omitempty"`
+}
+
+type FreezeHookStepMetadata struct {
+	Succeeded bool         `xml:"succeeded,omitempty"`
+	ExitCode  *int32       `xml:"exitCode,omitempty"`
+	Message   string       `xml:"message,omitempty"`
+	Timestamp *metav1.Time `xml:"timestamp,omitempty"`
+}
+
+type GuestPanicMetadata struct {
+	Count     int32        `xml:"count,omitempty"`
+	Timestamp *metav1.Time `xml:"timestamp,omitempty"`
+}
+
 type MigrationMetadata struct {
 	UID            types.UID        `xml:"uid,omitempty"`
 	StartTimestamp *metav1.Time     `xml:"startTimestamp,omitempty"`
@@ -536,6 +588,7 @@ type Devices struct {
 	TPMs        []TPM              `xml:"tpm,omitempty"`
 	VSOCK       *VSOCK             `xml:"vsock,omitempty"`
 	Memory      *MemoryDevice      `xml:"memory,omitempty"`
+	Panics      []PanicDevice      `xml:"panic,omitempty"`
 }
 
 type TPM struct {
@@ -676,6 +729,7 @@ type Disk struct {
 	Capacity           *int64        `xml:"capacity,omitempty"`
 	ExpandDisksEnabled bool          `xml:"expandDisksEnabled,omitempty"`
 	Shareable          *Shareable    `xml:"shareable,omitempty"`
+	IOTune             *DiskIOTune   `xml:"iotune,omitempty"`
 }
 
 type DiskAuth struct {
@@ -708,6 +762,15 @@ type DiskTarget struct {
 	Tray   string     `xml:"tray,attr,omitempty"`
 }
 
+type DiskIOTune struct {
+	TotalBytesSec uint64 `xml:"total_bytes_sec,omitempty"`
+	ReadBytesSec  uint64 `xml:"read_bytes_sec,omitempty"`
+	WriteBytesSec uint64 `xml:"write_bytes_sec,omitempty"`
+	TotalIopsSec  uint64 `xml:"total_iops_sec,omitempty"`
+	ReadIopsSec   uint64 `xml:"read_iops_sec,omitempty"`
+	WriteIopsSec  uint64 `xml:"write_iops_sec,omitempty"`
+}
+
 type DiskDriver struct {
 	Cache       string             `xml:"cache,attr,omitempty"`
 	ErrorPolicy v1.DiskErrorPolicy `xml:"error_policy,attr,omitempty"`
@@ -867,6 +930,15 @@ type LinkState struct {
 }
 
 type BandWidth struct {
+	Inbound  *BandWidthLimit `xml:"inbound,omitempty"`
+	Outbound *BandWidthLimit `xml:"outbound,omitempty"`
+}
+
+// BandWidthLimit rates are in KiB per second and the burst in KiB
+type BandWidthLimit struct {
+	Average uint64 `xml:"average,attr"`
+	Peak    uint64 `xml:"peak,attr,omitempty"`
+	Burst   uint64 `xml:"burst,attr,omitempty"`
 }
 
 type BootOrder struct {
@@ -1183,6 +1255,12 @@ type MemBalloonDriver struct {
 	IOMMU string `xml:"iommu,attr,omitempty"`
 }
 
+// PanicDevice describes a device the guest reports a panic to
+// See: https://libvirt.org/formatdomain.html#panic-device
+type PanicDevice struct {
+	Model string `xml:"model,attr,omitempty"`
+}
+
 type Watchdog struct {
 	Model   string   `xml:"model,attr"`
 	Action  string   `xml:"action,attr"`
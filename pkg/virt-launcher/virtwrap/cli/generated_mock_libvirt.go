@@ -357,6 +357,16 @@ func (_mr *_MockVirDomainRecorder) GetBlockInfo(arg0, arg1 interface{}) *gomock.
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetBlockInfo", arg0, arg1)
 }
 
+func (_m *MockVirDomain) SetBlockIoTune(disk string, params *libvirt.DomainBlockIoTuneParameters, flags libvirt.DomainModificationImpact) error {
+	ret := _m.ctrl.Call(_m, "SetBlockIoTune", disk, params, flags)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirDomainRecorder) SetBlockIoTune(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetBlockIoTune", arg0, arg1, arg2)
+}
+
 func (_m *MockVirDomain) AttachDeviceFlags(xml string, flags libvirt.DomainDeviceModifyFlags) error {
 	ret := _m.ctrl.Call(_m, "AttachDeviceFlags", xml, flags)
 	ret0, _ := ret[0].(error)
@@ -591,6 +591,96 @@ func (l *Launcher) GuestPing(ctx context.Context, request *cmdv1.GuestPingReques
 	return resp, nil
 }
 
+// GuestFileRead reads a file from the guest through the guest agent
+func (l *Launcher) GuestFileRead(_ context.Context, request *cmdv1.GuestFileReadRequest) (*cmdv1.GuestFileReadResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	resp := &cmdv1.GuestFileReadResponse{
+		Response: response,
+	}
+	if !response.Success {
+		return resp, nil
+	}
+
+	contents, err := l.domainManager.GuestFileRead(vmi, request.Path, request.MaxBytes)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to read guest file %s", request.Path)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+	resp.Contents = contents
+
+	return resp, nil
+}
+
+// GuestFileWrite writes a file to the guest through the guest agent
+func (l *Launcher) GuestFileWrite(_ context.Context, request *cmdv1.GuestFileWriteRequest) (*cmdv1.Response, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return response, nil
+	}
+
+	if err := l.domainManager.GuestFileWrite(vmi, request.Path, request.Contents); err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to write guest file %s", request.Path)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return response, nil
+	}
+
+	log.Log.Object(vmi).Infof("Wrote %d bytes to guest file %s", len(request.Contents), request.Path)
+	return response, nil
+}
+
+// GuestExec executes a command in the guest through the guest agent and streams its output until it exits
+func (l *Launcher) GuestExec(request *cmdv1.GuestExecRequest, stream cmdv1.Guest_GuestExecServer) error {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	if !response.Success {
+		return stream.Send(&cmdv1.GuestExecResponse{Response: response})
+	}
+
+	exitCode, err := l.domainManager.GuestExec(vmi, request.Command, request.Args, request.TimeoutSeconds, func(stdout, stderr []byte) error {
+		return stream.Send(&cmdv1.GuestExecResponse{
+			Response: &cmdv1.Response{Success: true},
+			StdOut:   stdout,
+			StdErr:   stderr,
+		})
+	})
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Errorf("Failed to execute guest command %s", request.Command)
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return stream.Send(&cmdv1.GuestExecResponse{Response: response})
+	}
+
+	return stream.Send(&cmdv1.GuestExecResponse{
+		Response: response,
+		Exited:   true,
+		ExitCode: int32(exitCode),
+	})
+}
+
+// GetEFIVars returns the EFI variable store of the VMI
+func (l *Launcher) GetEFIVars(_ context.Context, request *cmdv1.EFIVarsRequest) (*cmdv1.EFIVarsResponse, error) {
+	vmi, response := getVMIFromRequest(request.Vmi)
+	resp := &cmdv1.EFIVarsResponse{
+		Response: response,
+	}
+	if !response.Success {
+		return resp, nil
+	}
+
+	contents, err := l.domainManager.GetEFIVars(vmi, request.MaxBytes)
+	if err != nil {
+		log.Log.Object(vmi).Reason(err).Error("Failed to read the EFI variable store")
+		response.Success = false
+		response.Message = getErrorMessage(err)
+		return resp, nil
+	}
+	resp.Contents = contents
+
+	return resp, nil
+}
+
 func RunServer(socketPath string,
 	domainManager virtwrap.DomainManager,
 	stopChan chan struct{},
@@ -611,6 +701,8 @@ func RunServer(socketPath string,
 	// register more versions as soon as needed
 	// and add them to info.go
 	cmdv1.RegisterCmdServer(grpcServer, server)
+	cmdv1.RegisterGuestServer(grpcServer, server)
+	cmdv1.RegisterEFIServer(grpcServer, server)
 
 	sock, err := grpcutil.CreateSocket(socketPath)
 	if err != nil {
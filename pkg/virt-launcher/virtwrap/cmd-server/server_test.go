@@ -331,6 +331,80 @@ var _ = Describe("Virt remote commands", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		It("should read a guest file", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GuestFileRead(vmi, "/etc/hostname", int64(1024)).Return([]byte("testvmi\n"), nil)
+			contents, err := client.GuestFileRead(vmi, "/etc/hostname", 1024)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(Equal([]byte("testvmi\n")))
+		})
+
+		It("should fail to read a guest file", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GuestFileRead(vmi, "/missing", int64(1024)).Return(nil, errors.New("No such file or directory"))
+			_, err := client.GuestFileRead(vmi, "/missing", 1024)
+			Expect(err).To(MatchError(ContainSubstring("No such file or directory")))
+		})
+
+		It("should write a guest file", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GuestFileWrite(vmi, "/tmp/file", []byte("data")).Return(nil)
+			Expect(client.GuestFileWrite(vmi, "/tmp/file", []byte("data"))).To(Succeed())
+		})
+
+		It("should stream the output of a guest command", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GuestExec(vmi, "/usr/bin/ls", []string{"/tmp"}, int32(10), gomock.Any()).DoAndReturn(
+				func(_ *v1.VirtualMachineInstance, _ string, _ []string, _ int32, output agent.GuestExecOutput) (int, error) {
+					Expect(output([]byte("out"), nil)).To(Succeed())
+					// the euro sign is split across two chunks
+					Expect(output([]byte("\xe2\x82"), []byte("err"))).To(Succeed())
+					Expect(output([]byte("\xac"), nil)).To(Succeed())
+					return 2, nil
+				})
+
+			var results []v1.GuestExecResult
+			err := client.GuestExec(vmi, "/usr/bin/ls", []string{"/tmp"}, 10, func(result *v1.GuestExecResult) error {
+				results = append(results, *result)
+				return nil
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(results).To(Equal([]v1.GuestExecResult{
+				{Stdout: "out"},
+				{Stderr: "err"},
+				{Stdout: "€"},
+				{Exited: true, ExitCode: 2},
+			}))
+		})
+
+		It("should fail to execute a guest command if the guest agent fails", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GuestExec(vmi, "/usr/bin/ls", gomock.Any(), gomock.Any(), gomock.Any()).Return(0, errors.New("agent not connected"))
+
+			err := client.GuestExec(vmi, "/usr/bin/ls", nil, 10, func(*v1.GuestExecResult) error {
+				Fail("no output expected")
+				return nil
+			})
+			Expect(err).To(MatchError(ContainSubstring("agent not connected")))
+		})
+
+		It("should return the EFI variable store", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetEFIVars(vmi, int64(v1.EFIVarsMaxSize)).Return([]byte("vars"), nil)
+
+			contents, err := client.GetEFIVars(vmi, v1.EFIVarsMaxSize)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(contents).To(Equal([]byte("vars")))
+		})
+
+		It("should fail to return the EFI variable store if it cannot be read", func() {
+			vmi := v1.NewVMIReferenceFromName("testvmi")
+			domainManager.EXPECT().GetEFIVars(vmi, gomock.Any()).Return(nil, errors.New("no such file"))
+
+			_, err := client.GetEFIVars(vmi, v1.EFIVarsMaxSize)
+			Expect(err).To(MatchError(ContainSubstring("no such file")))
+		})
+
 		It("should call UpdateGuestMemory", func() {
 			vmi := v1.NewVMIReferenceFromName("testvmi")
 			domainManager.EXPECT().UpdateGuestMemory(vmi).Return(nil)
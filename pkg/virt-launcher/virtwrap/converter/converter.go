@@ -208,6 +208,9 @@ func Convert_v1_Disk_To_api_Disk(c *ConverterContext, diskDevice *v1.Disk, disk
 	if diskDevice.BootOrder != nil {
 		disk.BootOrder = &api.BootOrder{Order: *diskDevice.BootOrder}
 	}
+	if diskDevice.Disk != nil || diskDevice.LUN != nil {
+		disk.IOTune = convertDiskIOTune(diskDevice.IOTune)
+	}
 	if c.UseLaunchSecurity && disk.Target.Bus == v1.DiskBusVirtio {
 		disk.Driver.IOMMU = "on"
 	}
@@ -1641,6 +1644,13 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 		domain.Spec.Devices.Watchdogs = append(domain.Spec.Devices.Watchdogs, *newWatchdog)
 	}
 
+	if vmi.Spec.PanicPolicy != nil {
+		// libvirt picks the panic device model of the architecture.
+		// The panicked domain is preserved, so that it can be dumped before it is restarted or paused
+		domain.Spec.Devices.Panics = append(domain.Spec.Devices.Panics, api.PanicDevice{})
+		domain.Spec.OnCrash = "preserve"
+	}
+
 	if vmi.Spec.Domain.Devices.Rng != nil {
 		newRng := &api.Rng{}
 		err := Convert_v1_Rng_To_api_Rng(vmi.Spec.Domain.Devices.Rng, newRng, c)
@@ -1831,14 +1841,12 @@ func Convert_v1_VirtualMachineInstance_To_api_Domain(vmi *v1.VirtualMachineInsta
 
 	if vmi.Spec.Domain.Devices.AutoattachGraphicsDevice == nil || *vmi.Spec.Domain.Devices.AutoattachGraphicsDevice {
 		c.Architecture.AddGraphicsDevice(vmi, domain, c.BochsForEFIGuests && isEFIVMI(vmi))
-		domain.Spec.Devices.Graphics = []api.Graphics{
-			{
-				Listen: &api.GraphicsListen{
-					Type:   "socket",
-					Socket: fmt.Sprintf("/var/run/kubevirt-private/%s/virt-vnc", vmi.ObjectMeta.UID),
-				},
-				Type: "vnc",
-			},
+		if spice := getSPICEGraphics(vmi); spice != nil {
+			domain.Spec.Devices.Video = []api.Video{convertSPICEVideo(spice)}
+			domain.Spec.Devices.Graphics = []api.Graphics{convertSPICEGraphics(vmi)}
+			domain.Spec.Devices.Channels = append(domain.Spec.Devices.Channels, convertSPICEAgentChannel())
+		} else {
+			domain.Spec.Devices.Graphics = []api.Graphics{convertVNCGraphics(vmi)}
 		}
 	}
 
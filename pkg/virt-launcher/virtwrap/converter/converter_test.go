@@ -224,6 +224,30 @@ var _ = Describe("Converter", func() {
 			}),
 		)
 
+		It("Should convert disk I/O tuning", func() {
+			totalIOPS := int64(500)
+			v1Disk := v1.Disk{
+				Name: "myvolume",
+				DiskDevice: v1.DiskDevice{
+					Disk: &v1.DiskTarget{Bus: v1.VirtIO},
+				},
+				IOTune: &v1.DiskIOTune{
+					ReadBytesPerSec:  resource.NewQuantity(10485760, resource.BinarySI),
+					WriteBytesPerSec: resource.NewQuantity(5242880, resource.BinarySI),
+					TotalIOPS:        &totalIOPS,
+				},
+			}
+			apiDisk := api.Disk{}
+			devicePerBus := map[string]deviceNamer{}
+			numQueues := uint(2)
+			Expect(Convert_v1_Disk_To_api_Disk(&ConverterContext{}, &v1Disk, &apiDisk, devicePerBus, &numQueues, map[string]v1.VolumeStatus{})).To(Succeed())
+			Expect(apiDisk.IOTune).To(Equal(&api.DiskIOTune{
+				ReadBytesSec:  10485760,
+				WriteBytesSec: 5242880,
+				TotalIopsSec:  500,
+			}))
+		})
+
 		DescribeTable("Should add boot order when provided", func(arch, expectedModel string) {
 			order := uint(1)
 			kubevirtDisk := &v1.Disk{
@@ -1276,6 +1300,21 @@ var _ = Describe("Converter", func() {
 			}))
 		})
 
+		It("should not add a panic device by default", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Panics).To(BeEmpty())
+			Expect(domain.Spec.OnCrash).To(BeEmpty())
+		})
+
+		It("should add a panic device and preserve the crashed domain with a panic policy", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.PanicPolicy = &v1.PanicPolicy{Action: v1.PanicActionPause}
+			domain := vmiToDomain(vmi, c)
+			Expect(domain.Spec.Devices.Panics).To(ConsistOf(api.PanicDevice{}))
+			Expect(domain.Spec.OnCrash).To(Equal("preserve"))
+		})
+
 		DescribeTable("usb redirection", func(arch string, expectedModel string) {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.ClientPassthrough = &v1.ClientPassthroughDevices{}
@@ -1570,6 +1609,26 @@ var _ = Describe("Converter", func() {
 			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
 			Expect(domain.Spec.Devices.Interfaces[0].LinkState.State).To(Equal("down"))
 		})
+		It("Should set domain interface bandwidth in KiB", func() {
+			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
+			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
+				*v1.DefaultBridgeNetworkInterface(),
+			}
+			vmi.Spec.Domain.Devices.Interfaces[0].Bandwidth = &v1.InterfaceBandwidth{
+				Inbound: &v1.BandwidthLimit{
+					Average: resource.MustParse("1Mi"),
+					Burst:   resource.NewQuantity(100, resource.DecimalSI),
+				},
+			}
+			vmi.Spec.Networks = []v1.Network{*v1.DefaultPodNetwork()}
+
+			domain := vmiToDomain(vmi, c)
+			Expect(domain).ToNot(BeNil())
+			Expect(domain.Spec.Devices.Interfaces).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Interfaces[0].BandWidth).To(Equal(&api.BandWidth{
+				Inbound: &api.BandWidthLimit{Average: 1024, Burst: 1},
+			}))
+		})
 		It("Should set domain interface source correctly for multus", func() {
 			v1.SetObjectDefaults_VirtualMachineInstance(vmi)
 			vmi.Spec.Domain.Devices.Interfaces = []v1.Interface{
@@ -1812,6 +1871,46 @@ var _ = Describe("Converter", func() {
 		},
 			MultiArchEntry(""),
 		)
+
+		DescribeTable("should configure spice", func(spice *v1.SPICEGraphics, videoType string, heads uint) {
+			vmi := v1.VirtualMachineInstance{
+				ObjectMeta: k8smeta.ObjectMeta{
+					Name:      "testvmi",
+					Namespace: "default",
+					UID:       "1234",
+				},
+				Spec: v1.VirtualMachineInstanceSpec{
+					Domain: v1.DomainSpec{
+						Devices: v1.Devices{
+							Graphics: &v1.GraphicsDevice{SPICE: spice},
+						},
+					},
+				},
+			}
+
+			domain := vmiToDomain(&vmi, &ConverterContext{Architecture: archconverter.NewConverter(amd64), AllowEmulation: true})
+			Expect(domain.Spec.Devices.Graphics).To(HaveExactElements(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+				"Type": Equal("spice"),
+				"Listen": gstruct.PointTo(gstruct.MatchFields(gstruct.IgnoreExtras, gstruct.Fields{
+					"Type":   Equal("socket"),
+					"Socket": Equal("/var/run/kubevirt-private/1234/virt-spice"),
+				})),
+			})))
+			Expect(domain.Spec.Devices.Video).To(HaveLen(1))
+			Expect(domain.Spec.Devices.Video[0].Model.Type).To(Equal(videoType))
+			Expect(domain.Spec.Devices.Video[0].Model.Heads).To(HaveValue(Equal(heads)))
+			Expect(domain.Spec.Devices.Channels).To(ContainElement(api.Channel{
+				Type: "spicevmc",
+				Target: &api.ChannelTarget{
+					Type: v1.VirtIO,
+					Name: "com.redhat.spice.0",
+				},
+			}))
+		},
+			Entry("with qxl by default", &v1.SPICEGraphics{}, "qxl", uint(1)),
+			Entry("with multiple qxl heads", &v1.SPICEGraphics{Heads: pointer.P(uint32(2))}, "qxl", uint(2)),
+			Entry("with virtio", &v1.SPICEGraphics{VideoModel: v1.SPICEVideoModelVirtIO, Heads: pointer.P(uint32(4))}, v1.VirtIO, uint(4)),
+		)
 	})
 
 	Context("HyperV", func() {
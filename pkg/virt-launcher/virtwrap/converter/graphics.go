@@ -0,0 +1,106 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package converter
+
+import (
+	"fmt"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/util"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+const (
+	spiceAgentChannelName = "com.redhat.spice.0"
+
+	// QXL memory defaults in KiB, matching the libvirt defaults
+	qxlDefaultRAM    = 65536
+	qxlDefaultVRAM   = 65536
+	qxlDefaultVGAMem = 16384
+)
+
+func convertVNCGraphics(vmi *v1.VirtualMachineInstance) api.Graphics {
+	return api.Graphics{
+		Listen: &api.GraphicsListen{
+			Type:   "socket",
+			Socket: fmt.Sprintf("%s/%s/virt-vnc", util.VirtPrivateDir, vmi.ObjectMeta.UID),
+		},
+		Type: "vnc",
+	}
+}
+
+func convertSPICEGraphics(vmi *v1.VirtualMachineInstance) api.Graphics {
+	return api.Graphics{
+		Listen: &api.GraphicsListen{
+			Type:   "socket",
+			Socket: fmt.Sprintf("%s/%s/virt-spice", util.VirtPrivateDir, vmi.ObjectMeta.UID),
+		},
+		Type: "spice",
+	}
+}
+
+// convertSPICEVideo replaces the default video device of the architecture with
+// a SPICE capable one, providing one head per requested monitor.
+func convertSPICEVideo(spice *v1.SPICEGraphics) api.Video {
+	heads := uint(1)
+	if spice.Heads != nil {
+		heads = uint(*spice.Heads)
+	}
+
+	if spice.VideoModel == v1.SPICEVideoModelVirtIO {
+		return api.Video{
+			Model: api.VideoModel{
+				Type:  v1.VirtIO,
+				Heads: pointer.P(heads),
+			},
+		}
+	}
+
+	return api.Video{
+		Model: api.VideoModel{
+			Type:   string(v1.SPICEVideoModelQXL),
+			Heads:  pointer.P(heads),
+			Ram:    pointer.P(uint(qxlDefaultRAM)),
+			VRam:   pointer.P(uint(qxlDefaultVRAM)),
+			VGAMem: pointer.P(uint(qxlDefaultVGAMem)),
+		},
+	}
+}
+
+// convertSPICEAgentChannel creates the channel used by the SPICE guest agent
+// for clipboard sharing and monitor configuration.
+func convertSPICEAgentChannel() api.Channel {
+	return api.Channel{
+		Type: "spicevmc",
+		Target: &api.ChannelTarget{
+			Type: v1.VirtIO,
+			Name: spiceAgentChannelName,
+		},
+	}
+}
+
+func getSPICEGraphics(vmi *v1.VirtualMachineInstance) *v1.SPICEGraphics {
+	if vmi.Spec.Domain.Devices.Graphics == nil {
+		return nil
+	}
+	return vmi.Spec.Domain.Devices.Graphics.SPICE
+}
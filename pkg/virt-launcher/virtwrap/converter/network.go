@@ -104,6 +104,7 @@ func CreateDomainInterfaces(vmi *v1.VirtualMachineInstance, c *ConverterContext)
 		if iface.State == v1.InterfaceStateLinkDown {
 			domainIface.LinkState = &api.LinkState{State: "down"}
 		}
+		domainIface.BandWidth = convertInterfaceBandwidth(iface.Bandwidth)
 		domainInterfaces = append(domainInterfaces, domainIface)
 	}
 
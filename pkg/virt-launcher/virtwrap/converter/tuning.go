@@ -0,0 +1,82 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package converter
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+func convertDiskIOTune(ioTune *v1.DiskIOTune) *api.DiskIOTune {
+	if ioTune == nil {
+		return nil
+	}
+	return &api.DiskIOTune{
+		TotalBytesSec: quantityToUint64(ioTune.TotalBytesPerSec),
+		ReadBytesSec:  quantityToUint64(ioTune.ReadBytesPerSec),
+		WriteBytesSec: quantityToUint64(ioTune.WriteBytesPerSec),
+		TotalIopsSec:  int64ToUint64(ioTune.TotalIOPS),
+		ReadIopsSec:   int64ToUint64(ioTune.ReadIOPS),
+		WriteIopsSec:  int64ToUint64(ioTune.WriteIOPS),
+	}
+}
+
+func convertInterfaceBandwidth(bandwidth *v1.InterfaceBandwidth) *api.BandWidth {
+	if bandwidth == nil {
+		return nil
+	}
+	return &api.BandWidth{
+		Inbound:  convertBandwidthLimit(bandwidth.Inbound),
+		Outbound: convertBandwidthLimit(bandwidth.Outbound),
+	}
+}
+
+// convertBandwidthLimit converts a limit from bytes to the KiB libvirt expects, rounding up so that small limits are kept.
+func convertBandwidthLimit(limit *v1.BandwidthLimit) *api.BandWidthLimit {
+	if limit == nil {
+		return nil
+	}
+	return &api.BandWidthLimit{
+		Average: toKiB(quantityToUint64(&limit.Average)),
+		Peak:    toKiB(quantityToUint64(limit.Peak)),
+		Burst:   toKiB(quantityToUint64(limit.Burst)),
+	}
+}
+
+func toKiB(bytes uint64) uint64 {
+	return (bytes + 1023) / 1024
+}
+
+func quantityToUint64(quantity *resource.Quantity) uint64 {
+	if quantity == nil || quantity.Sign() < 0 {
+		return 0
+	}
+	return uint64(quantity.Value())
+}
+
+func int64ToUint64(value *int64) uint64 {
+	if value == nil || *value < 0 {
+		return 0
+	}
+	return uint64(*value)
+}
@@ -0,0 +1,189 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package efi
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// VarsDir holds the user provided EFI variable store data in the compute container
+	VarsDir = "/var/run/kubevirt-private/efi"
+	// SecureBootKeysDir is where the Secure Boot certificates are mounted
+	SecureBootKeysDir = VarsDir + "/secureboot-keys"
+	// VarsTemplateDir is where the prepared EFI variable store is mounted
+	VarsTemplateDir = VarsDir + "/vars-template"
+	// CustomVarsTemplate is the template the EFI variable store is initialized from
+	// when user provided data is used
+	CustomVarsTemplate = VarsDir + "/VARS.template.fd"
+
+	// the owner of the enrolled certificates
+	secureBootKeysOwner = "c69b0bc3-3c7c-4bcc-9f63-ccd00a5bb7a2"
+
+	templateChecksumSuffix = ".template.sha256"
+)
+
+// enrollSecureBootKeys enrolls the certificates into a copy of the input variable store
+var enrollSecureBootKeys = func(input, output string, pk string, keks, dbs []string) error {
+	args := []string{"--input", input, "--output", output, "--secure-boot", "--set-pk", secureBootKeysOwner, pk}
+	for _, kek := range keks {
+		args = append(args, "--add-kek", secureBootKeysOwner, kek)
+	}
+	for _, db := range dbs {
+		args = append(args, "--add-db", secureBootKeysOwner, db)
+	}
+
+	out, err := exec.Command("virt-fw-vars", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("failed to enroll the secure boot keys: %v: %s", err, string(out))
+	}
+	return nil
+}
+
+// PrepareVarsTemplate writes the template of the EFI variable store to output.
+// The template is the prepared variable store in templateDir, or baseTemplate
+// if templateDir is empty. The Secure Boot certificates in keysDir are
+// enrolled into it unless keysDir is empty.
+// It returns a checksum of the inputs the template was built from.
+func PrepareVarsTemplate(baseTemplate, templateDir, keysDir, output string) (string, error) {
+	template := baseTemplate
+	if templateDir != "" {
+		template = filepath.Join(templateDir, v1.EFIVarsTemplateKey)
+	}
+
+	inputs := []string{template}
+	var pk string
+	var keks, dbs []string
+	if keysDir != "" {
+		pk = filepath.Join(keysDir, v1.EFISecureBootPKKey)
+		if _, err := os.Stat(pk); err != nil {
+			return "", fmt.Errorf("the secure boot platform key is missing: %v", err)
+		}
+		inputs = append(inputs, pk)
+		for _, key := range []string{v1.EFISecureBootKEKKey, v1.EFISecureBootDBKey} {
+			cert := filepath.Join(keysDir, key)
+			if _, err := os.Stat(cert); errors.Is(err, os.ErrNotExist) {
+				continue
+			} else if err != nil {
+				return "", err
+			}
+			inputs = append(inputs, cert)
+			if key == v1.EFISecureBootKEKKey {
+				keks = append(keks, cert)
+			} else {
+				dbs = append(dbs, cert)
+			}
+		}
+	}
+
+	checksum, err := checksumFiles(inputs...)
+	if err != nil {
+		return "", err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(output), 0755); err != nil {
+		return "", err
+	}
+	if keysDir == "" {
+		return checksum, copyFile(template, output)
+	}
+	return checksum, enrollSecureBootKeys(template, output, pk, keks, dbs)
+}
+
+// ResetVarsOnTemplateChange removes the EFI variable store at nvram when it was
+// initialized from a template with a different checksum, so that it gets
+// initialized again from the current template.
+func ResetVarsOnTemplateChange(nvram, checksum string) error {
+	checksumFile := nvram + templateChecksumSuffix
+
+	previous, err := os.ReadFile(checksumFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if string(previous) != checksum {
+		if err := os.Remove(nvram); err != nil && !errors.Is(err, os.ErrNotExist) {
+			return err
+		}
+	}
+
+	return os.WriteFile(checksumFile, []byte(checksum), 0644)
+}
+
+// ReadVars returns the contents of the EFI variable store at nvram.
+// It fails if the store is larger than maxBytes.
+func ReadVars(nvram string, maxBytes int64) ([]byte, error) {
+	f, err := os.Open(nvram)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	contents, err := io.ReadAll(io.LimitReader(f, maxBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(contents)) > maxBytes {
+		return nil, fmt.Errorf("the EFI variable store exceeds the maximum size of %d bytes", maxBytes)
+	}
+	return contents, nil
+}
+
+func checksumFiles(paths ...string) (string, error) {
+	hash := sha256.New()
+	for _, path := range paths {
+		f, err := os.Open(path)
+		if err != nil {
+			return "", err
+		}
+		_, err = io.Copy(hash, f)
+		f.Close()
+		if err != nil {
+			return "", err
+		}
+	}
+	return hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	out, err := os.Create(dst)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
@@ -0,0 +1,139 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package efi
+
+import (
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+var _ = Describe("EFI variable store", func() {
+	var tmpDir string
+
+	writeFile := func(path, contents string) string {
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(contents), 0644)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		tmpDir = GinkgoT().TempDir()
+	})
+
+	Context("PrepareVarsTemplate", func() {
+		var baseTemplate, output string
+
+		BeforeEach(func() {
+			baseTemplate = writeFile(filepath.Join(tmpDir, "OVMF_VARS.fd"), "stock")
+			output = filepath.Join(tmpDir, "out", "VARS.template.fd")
+		})
+
+		It("should copy the prepared template", func() {
+			templateDir := filepath.Join(tmpDir, "template")
+			writeFile(filepath.Join(templateDir, v1.EFIVarsTemplateKey), "prepared")
+
+			checksum, err := PrepareVarsTemplate(baseTemplate, templateDir, "", output)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(checksum).ToNot(BeEmpty())
+			Expect(os.ReadFile(output)).To(Equal([]byte("prepared")))
+		})
+
+		It("should enroll the secure boot keys into the stock template", func() {
+			keysDir := filepath.Join(tmpDir, "keys")
+			pk := writeFile(filepath.Join(keysDir, v1.EFISecureBootPKKey), "pk")
+			db := writeFile(filepath.Join(keysDir, v1.EFISecureBootDBKey), "db")
+
+			origEnroll := enrollSecureBootKeys
+			DeferCleanup(func() { enrollSecureBootKeys = origEnroll })
+			enrollSecureBootKeys = func(input, out string, enrolledPK string, keks, dbs []string) error {
+				Expect(input).To(Equal(baseTemplate))
+				Expect(out).To(Equal(output))
+				Expect(enrolledPK).To(Equal(pk))
+				Expect(keks).To(BeEmpty())
+				Expect(dbs).To(ConsistOf(db))
+				return nil
+			}
+
+			_, err := PrepareVarsTemplate(baseTemplate, "", keysDir, output)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should fail without a platform key", func() {
+			keysDir := filepath.Join(tmpDir, "keys")
+			writeFile(filepath.Join(keysDir, v1.EFISecureBootDBKey), "db")
+
+			_, err := PrepareVarsTemplate(baseTemplate, "", keysDir, output)
+			Expect(err).To(MatchError(ContainSubstring("platform key is missing")))
+		})
+
+		It("should return a different checksum when the inputs change", func() {
+			templateDir := filepath.Join(tmpDir, "template")
+			template := writeFile(filepath.Join(templateDir, v1.EFIVarsTemplateKey), "prepared")
+
+			checksum, err := PrepareVarsTemplate(baseTemplate, templateDir, "", output)
+			Expect(err).ToNot(HaveOccurred())
+
+			writeFile(template, "edited")
+			newChecksum, err := PrepareVarsTemplate(baseTemplate, templateDir, "", output)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(newChecksum).ToNot(Equal(checksum))
+		})
+	})
+
+	Context("ResetVarsOnTemplateChange", func() {
+		var nvram string
+
+		BeforeEach(func() {
+			nvram = writeFile(filepath.Join(tmpDir, "vmi_VARS.fd"), "vars")
+		})
+
+		It("should keep the variable store when the template did not change", func() {
+			Expect(ResetVarsOnTemplateChange(nvram, "abc")).To(Succeed())
+			writeFile(nvram, "vars")
+			Expect(ResetVarsOnTemplateChange(nvram, "abc")).To(Succeed())
+			Expect(nvram).To(BeARegularFile())
+		})
+
+		It("should remove the variable store when the template changed", func() {
+			Expect(ResetVarsOnTemplateChange(nvram, "abc")).To(Succeed())
+			writeFile(nvram, "vars")
+			Expect(ResetVarsOnTemplateChange(nvram, "def")).To(Succeed())
+			Expect(nvram).ToNot(BeAnExistingFile())
+		})
+	})
+
+	Context("ReadVars", func() {
+		It("should return the contents of the variable store", func() {
+			nvram := writeFile(filepath.Join(tmpDir, "vmi_VARS.fd"), "vars")
+			Expect(ReadVars(nvram, 4)).To(Equal([]byte("vars")))
+		})
+
+		It("should fail when the variable store is too large", func() {
+			nvram := writeFile(filepath.Join(tmpDir, "vmi_VARS.fd"), "vars")
+			_, err := ReadVars(nvram, 3)
+			Expect(err).To(MatchError(ContainSubstring("exceeds the maximum size")))
+		})
+	})
+})
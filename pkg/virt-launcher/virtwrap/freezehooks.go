@@ -0,0 +1,104 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package virtwrap
+
+import (
+	"fmt"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
+	"kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
+)
+
+func freezeHookCommand(hooks *v1.FreezeHooks, step v1.FreezeHookStepName) *v1.FreezeHookCommand {
+	if hooks == nil {
+		return nil
+	}
+	switch step {
+	case v1.FreezeHookStepPreFreeze:
+		return hooks.PreFreeze
+	case v1.FreezeHookStepPostThaw:
+		return hooks.PostThaw
+	}
+	return nil
+}
+
+// runFreezeHook executes the hook command of the given step in the guest and records the outcome.
+// A non-zero exit code is treated as a failure.
+func (l *LibvirtDomainManager) runFreezeHook(vmi *v1.VirtualMachineInstance, step v1.FreezeHookStepName) error {
+	hook := freezeHookCommand(vmi.Spec.FreezeHooks, step)
+	if hook == nil {
+		return nil
+	}
+	result, err := agent.GuestExecCommand(l.virConn, api.VMINamespaceKeyFunc(vmi), hook.Command, hook.Args, hook.GetTimeoutSeconds())
+	if err != nil {
+		err = fmt.Errorf("%s hook failed: %v", step, err)
+		l.recordFreezeHookStep(vmi, step, nil, err)
+		return err
+	}
+	exitCode := int32(result.ExitCode)
+	if result.ExitCode != 0 {
+		err = fmt.Errorf("%s hook exited with code %d: %s", step, result.ExitCode, strings.TrimSpace(result.Stderr))
+	}
+	l.recordFreezeHookStep(vmi, step, &exitCode, err)
+	return err
+}
+
+// resetFreezeHookSteps clears the steps of a previous freeze, so that only the current freeze is reported
+func (l *LibvirtDomainManager) resetFreezeHookSteps(vmi *v1.VirtualMachineInstance) {
+	if vmi.Spec.FreezeHooks == nil {
+		return
+	}
+	l.metadataCache.FreezeHooks.Store(api.FreezeHooksMetadata{})
+}
+
+func (l *LibvirtDomainManager) recordFreezeHookStep(vmi *v1.VirtualMachineInstance, step v1.FreezeHookStepName, exitCode *int32, err error) {
+	if vmi.Spec.FreezeHooks == nil {
+		return
+	}
+	now := metav1.Now()
+	stepMetadata := &api.FreezeHookStepMetadata{
+		Succeeded: err == nil,
+		ExitCode:  exitCode,
+		Timestamp: &now,
+	}
+	if err != nil {
+		stepMetadata.Message = err.Error()
+		log.Log.Object(vmi).Reason(err).Errorf("Freeze step %s failed", step)
+	}
+
+	l.metadataCache.FreezeHooks.WithSafeBlock(func(freezeHooksMetadata *api.FreezeHooksMetadata, _ bool) {
+		switch step {
+		case v1.FreezeHookStepPreFreeze:
+			freezeHooksMetadata.PreFreeze = stepMetadata
+		case v1.FreezeHookStepFreeze:
+			freezeHooksMetadata.Freeze = stepMetadata
+		case v1.FreezeHookStepThaw:
+			freezeHooksMetadata.Thaw = stepMetadata
+		case v1.FreezeHookStepPostThaw:
+			freezeHooksMetadata.PostThaw = stepMetadata
+		}
+	})
+}
@@ -9,6 +9,7 @@ import (
 
 	v10 "kubevirt.io/kubevirt/pkg/handler-launcher-com/cmd/v1"
 	cmd_client "kubevirt.io/kubevirt/pkg/virt-handler/cmd-client"
+	agent "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/agent"
 	api "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/api"
 	stats "kubevirt.io/kubevirt/pkg/virt-launcher/virtwrap/stats"
 )
@@ -286,6 +287,49 @@ func (_mr *_MockDomainManagerRecorder) GuestPing(arg0 interface{}) *gomock.Call
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestPing", arg0)
 }
 
+func (_m *MockDomainManager) GuestFileRead(vmi *v1.VirtualMachineInstance, path string, maxBytes int64) ([]byte, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileRead", vmi, path, maxBytes)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestFileRead(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", arg0, arg1, arg2)
+}
+
+func (_m *MockDomainManager) GuestFileWrite(vmi *v1.VirtualMachineInstance, path string, contents []byte) error {
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", vmi, path, contents)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestFileWrite(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", arg0, arg1, arg2)
+}
+
+func (_m *MockDomainManager) GuestExec(vmi *v1.VirtualMachineInstance, command string, args []string, timeoutSeconds int32, output agent.GuestExecOutput) (int, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", vmi, command, args, timeoutSeconds, output)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockDomainManagerRecorder) GuestExec(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1, arg2, arg3, arg4)
+}
+
+func (_m *MockDomainManager) GetEFIVars(vmi *v1.VirtualMachineInstance, maxBytes int64) ([]byte, error) {
+	ret := _m.ctrl.Call(_m, "GetEFIVars", vmi, maxBytes)
+	ret0, _ := ret[0].([]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockDomainManagerRecorder) GetEFIVars(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GetEFIVars", arg0, arg1)
+}
+
 func (_m *MockDomainManager) MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error {
 	ret := _m.ctrl.Call(_m, "MemoryDump", vmi, dumpPath)
 	ret0, _ := ret[0].(error)
@@ -163,6 +163,12 @@ func (l *LibvirtDomainManager) prepareMigrationTarget(
 		return fmt.Errorf("pre-start pod-setup failed: %v", err)
 	}
 
+	// The variable store itself is migrated, or shared with the source if it is persistent,
+	// so only the template is prepared
+	if _, err := prepareEFIVarsTemplate(vmi, domain); err != nil {
+		return fmt.Errorf("preparing the EFI variable store template failed: %v", err)
+	}
+
 	l.metadataCache.UID.Set(vmi.UID)
 	l.metadataCache.GracePeriod.Set(
 		api.GracePeriodMetadata{DeletionGracePeriodSeconds: converter.GracePeriodSeconds(vmi)},
@@ -140,6 +140,10 @@ type DomainManager interface {
 	GetGuestOSInfo() *api.GuestOSInfo
 	Exec(string, string, []string, int32) (string, error)
 	GuestPing(string) error
+	GuestFileRead(vmi *v1.VirtualMachineInstance, path string, maxBytes int64) ([]byte, error)
+	GuestFileWrite(vmi *v1.VirtualMachineInstance, path string, contents []byte) error
+	GuestExec(vmi *v1.VirtualMachineInstance, command string, args []string, timeoutSeconds int32, output agent.GuestExecOutput) (int, error)
+	GetEFIVars(vmi *v1.VirtualMachineInstance, maxBytes int64) ([]byte, error)
 	MemoryDump(vmi *v1.VirtualMachineInstance, dumpPath string) error
 	GetQemuVersion() (string, error)
 	UpdateVCPUs(vmi *v1.VirtualMachineInstance, options *cmdv1.VirtualMachineOptions) error
@@ -609,6 +613,25 @@ func (l *LibvirtDomainManager) Exec(domainName, command string, args []string, t
 	return agent.GuestExec(l.virConn, domainName, command, args, timeoutSeconds)
 }
 
+func (l *LibvirtDomainManager) GuestFileRead(vmi *v1.VirtualMachineInstance, path string, maxBytes int64) ([]byte, error) {
+	return agent.GuestFileRead(l.virConn, api.VMINamespaceKeyFunc(vmi), path, maxBytes)
+}
+
+func (l *LibvirtDomainManager) GuestFileWrite(vmi *v1.VirtualMachineInstance, path string, contents []byte) error {
+	return agent.GuestFileWrite(l.virConn, api.VMINamespaceKeyFunc(vmi), path, contents)
+}
+
+func (l *LibvirtDomainManager) GuestExec(vmi *v1.VirtualMachineInstance, command string, args []string, timeoutSeconds int32, output agent.GuestExecOutput) (int, error) {
+	return agent.GuestExecStream(l.virConn, api.VMINamespaceKeyFunc(vmi), command, args, timeoutSeconds, output)
+}
+
+func (l *LibvirtDomainManager) GetEFIVars(vmi *v1.VirtualMachineInstance, maxBytes int64) ([]byte, error) {
+	if !vmi.IsBootloaderEFI() {
+		return nil, fmt.Errorf("VMI does not boot with EFI")
+	}
+	return efi.ReadVars(filepath.Join(services.PathForNVram(vmi), vmi.Name+"_VARS.fd"), maxBytes)
+}
+
 func (l *LibvirtDomainManager) GuestPing(domainName string) error {
 	pingCmd := `{"execute":"guest-ping"}`
 	_, err := l.virConn.QemuAgentCommand(pingCmd, domainName)
@@ -1167,6 +1190,10 @@ func (l *LibvirtDomainManager) SyncVMI(vmi *v1.VirtualMachineInstance, allowEmul
 		return nil, err
 	}
 
+	if err := syncDiskIOTune(domain, oldSpec, dom, vmi); err != nil {
+		return nil, err
+	}
+
 	if err := l.syncNetwork(domain, oldSpec, dom, vmi, options); err != nil {
 		return nil, err
 	}
@@ -1247,6 +1274,54 @@ func (l *LibvirtDomainManager) syncDiskHotplug(
 	return nil
 }
 
+// syncDiskIOTune applies changed disk I/O limits to the running domain. Removed limits are
+// cleared by setting them to zero.
+func syncDiskIOTune(domain *api.Domain, spec *api.DomainSpec, dom cli.VirDomain, vmi *v1.VirtualMachineInstance) error {
+	if !vmi.IsRunning() {
+		return nil
+	}
+	logger := log.Log.Object(vmi)
+
+	currentDisks := make(map[string]api.Disk)
+	for _, disk := range spec.Devices.Disks {
+		currentDisks[disk.Alias.GetName()] = disk
+	}
+	for _, disk := range domain.Spec.Devices.Disks {
+		currentDisk, ok := currentDisks[disk.Alias.GetName()]
+		if !ok || normalizedIOTune(currentDisk.IOTune) == normalizedIOTune(disk.IOTune) {
+			continue
+		}
+		ioTune := normalizedIOTune(disk.IOTune)
+		logger.V(1).Infof("Updating I/O limits of disk %s, target %s", disk.Alias.GetName(), disk.Target.Device)
+		err := dom.SetBlockIoTune(disk.Target.Device, &libvirt.DomainBlockIoTuneParameters{
+			TotalBytesSecSet: true,
+			TotalBytesSec:    ioTune.TotalBytesSec,
+			ReadBytesSecSet:  true,
+			ReadBytesSec:     ioTune.ReadBytesSec,
+			WriteBytesSecSet: true,
+			WriteBytesSec:    ioTune.WriteBytesSec,
+			TotalIopsSecSet:  true,
+			TotalIopsSec:     ioTune.TotalIopsSec,
+			ReadIopsSecSet:   true,
+			ReadIopsSec:      ioTune.ReadIopsSec,
+			WriteIopsSecSet:  true,
+			WriteIopsSec:     ioTune.WriteIopsSec,
+		}, libvirt.DOMAIN_AFFECT_LIVE|libvirt.DOMAIN_AFFECT_CONFIG)
+		if err != nil {
+			logger.Reason(err).Errorf("libvirt failed to update I/O limits of disk %s", disk.Alias.GetName())
+			return err
+		}
+	}
+	return nil
+}
+
+func normalizedIOTune(ioTune *api.DiskIOTune) api.DiskIOTune {
+	if ioTune == nil {
+		return api.DiskIOTune{}
+	}
+	return *ioTune
+}
+
 func (l *LibvirtDomainManager) syncNetwork(
 	domain *api.Domain,
 	oldSpec *api.DomainSpec,
@@ -1270,7 +1345,7 @@ func (l *LibvirtDomainManager) syncNetwork(
 	if err := networkInterfaceManager.hotUnplugVirtioInterface(vmi, &api.Domain{Spec: *oldSpec}); err != nil {
 		return err
 	}
-	if err := networkInterfaceManager.updateDomainLinkState(&api.Domain{Spec: *oldSpec}, domain); err != nil {
+	if err := networkInterfaceManager.updateDomainInterfaces(&api.Domain{Spec: *oldSpec}, domain); err != nil {
 		return err
 	}
 
@@ -1323,6 +1398,11 @@ func (l *LibvirtDomainManager) lookupOrCreateVirDomain(
 		return nil, err
 	}
 
+	if err := prepareEFIVars(vmi, domain); err != nil {
+		logger.Reason(err).Error("preparing the EFI variable store failed.")
+		return nil, err
+	}
+
 	setDomainFn := func(v *v1.VirtualMachineInstance, s *api.DomainSpec) (cli.VirDomain, error) {
 		return l.setDomainSpecWithHooks(v, s)
 	}
@@ -1339,6 +1419,47 @@ func (l *LibvirtDomainManager) lookupOrCreateVirDomain(
 	return dom, err
 }
 
+// prepareEFIVars builds the EFI variable store template from the user provided
+// Secure Boot keys or prepared variable store and lets the domain use it
+func prepareEFIVars(vmi *v1.VirtualMachineInstance, domain *api.Domain) error {
+	checksum, err := prepareEFIVarsTemplate(vmi, domain)
+	if err != nil || checksum == "" {
+		return err
+	}
+
+	return efi.ResetVarsOnTemplateChange(domain.Spec.OS.NVRam.NVRam, checksum)
+}
+
+// prepareEFIVarsTemplate builds the EFI variable store template from the user provided
+// Secure Boot keys or prepared variable store and returns the checksum of its inputs,
+// or an empty checksum if the VMI uses the stock template.
+// The template is referenced by the domain, so it has to exist on migration targets too.
+func prepareEFIVarsTemplate(vmi *v1.VirtualMachineInstance, domain *api.Domain) (string, error) {
+	if !vmi.IsBootloaderEFI() || domain.Spec.OS.NVRam == nil {
+		return "", nil
+	}
+	efiSpec := vmi.Spec.Domain.Firmware.Bootloader.EFI
+	if efiSpec.SecureBootKeys == nil && efiSpec.VarsTemplate == nil {
+		return "", nil
+	}
+
+	var templateDir, keysDir string
+	if efiSpec.VarsTemplate != nil {
+		templateDir = efi.VarsTemplateDir
+	}
+	if efiSpec.SecureBootKeys != nil {
+		keysDir = efi.SecureBootKeysDir
+	}
+
+	checksum, err := efi.PrepareVarsTemplate(domain.Spec.OS.NVRam.Template, templateDir, keysDir, efi.CustomVarsTemplate)
+	if err != nil {
+		return "", err
+	}
+	domain.Spec.OS.NVRam.Template = efi.CustomVarsTemplate
+
+	return checksum, nil
+}
+
 func getSourceFile(disk api.Disk) string {
 	file := disk.Source.File
 	if disk.Source.File == "" {
@@ -1555,7 +1676,7 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 	logger.Infof("Starting memory dump")
 	failed := false
 	reason := ""
-	err = dom.CoreDumpWithFormat(dumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY)
+	err = dom.CoreDumpWithFormat(dumpPath, memoryDumpFormat(vmi, dumpPath), libvirt.DUMP_MEMORY_ONLY)
 	if err != nil {
 		failed = true
 		reason = fmt.Sprintf("%s: %s", failedDomainMemoryDump, err)
@@ -1567,6 +1688,27 @@ func (l *LibvirtDomainManager) memoryDump(vmi *v1.VirtualMachineInstance, dumpPa
 	return err
 }
 
+var coreDumpFormats = map[v1.MemoryDumpFormat]libvirt.DomainCoreDumpFormat{
+	v1.MemoryDumpFormatKdumpZlib:   libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_ZLIB,
+	v1.MemoryDumpFormatKdumpLzo:    libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_LZO,
+	v1.MemoryDumpFormatKdumpSnappy: libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_SNAPPY,
+	v1.MemoryDumpFormatWinDmp:      libvirt.DOMAIN_CORE_DUMP_FORMAT_WIN_DMP,
+}
+
+// memoryDumpFormat returns the format requested by the memory dump volume the dump is written to
+func memoryDumpFormat(vmi *v1.VirtualMachineInstance, dumpPath string) libvirt.DomainCoreDumpFormat {
+	volumeName := filepath.Base(filepath.Dir(dumpPath))
+	for _, volume := range vmi.Spec.Volumes {
+		if volume.Name != volumeName || volume.MemoryDump == nil {
+			continue
+		}
+		if format, exists := coreDumpFormats[volume.MemoryDump.Format]; exists {
+			return format
+		}
+	}
+	return libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW
+}
+
 func (l *LibvirtDomainManager) shouldSkipMemoryDump(dumpPath string) bool {
 	memoryDumpMetadata, _ := l.metadataCache.MemoryDump.Load()
 	if memoryDumpMetadata.FileName == filepath.Base(dumpPath) {
@@ -1664,7 +1806,7 @@ func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error
 	}
 	defer dom.Free()
 
-	domState, _, err := dom.GetState()
+	domState, reason, err := dom.GetState()
 	if err != nil {
 		logger.Reason(err).Error(failedGetDomainState)
 		return err
@@ -1684,6 +1826,17 @@ func (l *LibvirtDomainManager) UnpauseVMI(vmi *v1.VirtualMachineInstance) error
 			return err
 		}
 
+	} else if domState == libvirt.DOMAIN_CRASHED && libvirt.DomainCrashedReason(reason) == libvirt.DOMAIN_CRASHED_PANICKED {
+		// The domain was preserved after a guest panic, the panicked guest can only continue after a reset
+		if err = dom.Reset(0); err != nil {
+			logger.Reason(err).Error("Resetting the panicked domain failed.")
+			return err
+		}
+		if err = dom.Resume(); err != nil {
+			logger.Reason(err).Error("Resuming the panicked domain failed.")
+			return err
+		}
+		logger.Infof("Reset and resumed the panicked guest of %s", vmi.GetObjectMeta().GetName())
 	} else {
 		logger.Infof("Domain is not paused for %s", vmi.GetObjectMeta().GetName())
 	}
@@ -1757,9 +1910,23 @@ func (l *LibvirtDomainManager) FreezeVMI(vmi *v1.VirtualMachineInstance, unfreez
 			return err
 		}
 	}
-	_, err = l.virConn.QemuAgentCommand(`{"execute":"guest-fsfreeze-freeze"}`, domainName)
+
+	l.resetFreezeHookSteps(vmi)
+	if err := l.runFreezeHook(vmi, v1.FreezeHookStepPreFreeze); err != nil {
+		return err
+	}
+
+	if hooks := vmi.Spec.FreezeHooks; hooks != nil && len(hooks.Mountpoints) > 0 {
+		err = agent.FSFreezeList(l.virConn, domainName, hooks.Mountpoints)
+	} else {
+		_, err = l.virConn.QemuAgentCommand(`{"execute":"guest-fsfreeze-freeze"}`, domainName)
+	}
+	l.recordFreezeHookStep(vmi, v1.FreezeHookStepFreeze, nil, err)
 	if err != nil {
 		log.Log.Errorf("Failed to freeze vmi, %s", err.Error())
+		// let the application resume, since the filesystems were not frozen.
+		// A failure of the hook is reported in the freeze hook steps.
+		_ = l.runFreezeHook(vmi, v1.FreezeHookStepPostThaw)
 		return err
 	}
 
@@ -1782,11 +1949,12 @@ func (l *LibvirtDomainManager) UnfreezeVMI(vmi *v1.VirtualMachineInstance) error
 	}
 	// even if failed we should still try to unfreeze the fs
 	_, err = l.virConn.QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, domainName)
+	l.recordFreezeHookStep(vmi, v1.FreezeHookStepThaw, nil, err)
 	if err != nil {
 		log.Log.Errorf("Failed to unfreeze vmi, %s", err.Error())
 		return err
 	}
-	return nil
+	return l.runFreezeHook(vmi, v1.FreezeHookStepPostThaw)
 }
 
 func (l *LibvirtDomainManager) ResetVMI(vmi *v1.VirtualMachineInstance) error {
@@ -2201,9 +2369,74 @@ func (l *LibvirtDomainManager) GetGuestInfo() v1.VirtualMachineInstanceGuestAgen
 		})
 	}
 
+	guestInfo.Inventory = l.getGuestInventory()
+
 	return guestInfo
 }
 
+// getGuestInventory returns the guest reported resources, nil if the guest agent did not report any
+func (l *LibvirtDomainManager) getGuestInventory() *v1.VirtualMachineInstanceGuestOSInventory {
+	inventory := &v1.VirtualMachineInstanceGuestOSInventory{}
+
+	if load := l.agentData.GetLoad(); load != nil {
+		inventory.Load = &v1.VirtualMachineInstanceGuestOSLoad{
+			Load1m:  load.Load1m,
+			Load5m:  load.Load5m,
+			Load15m: load.Load15m,
+		}
+	}
+
+	if vcpus := l.agentData.GetVCPUs(); vcpus != nil {
+		inventory.CPU = &v1.VirtualMachineInstanceGuestOSCPU{
+			Online: vcpus.Online,
+			Total:  vcpus.Total,
+		}
+	}
+
+	if onlineBytes, totalBytes, ok := l.agentData.GetMemory(); ok {
+		inventory.Memory = &v1.VirtualMachineInstanceGuestOSMemory{
+			OnlineBytes: onlineBytes,
+			TotalBytes:  totalBytes,
+		}
+	}
+
+	for _, disk := range l.agentData.GetDiskStats() {
+		inventory.DiskStats = append(inventory.DiskStats, v1.VirtualMachineInstanceGuestOSDiskStats{
+			Name:         disk.Name,
+			ReadSectors:  disk.ReadSectors,
+			ReadIOs:      disk.ReadIOs,
+			WriteSectors: disk.WriteSectors,
+			WriteIOs:     disk.WriteIOs,
+		})
+	}
+
+	for _, fs := range l.agentData.GetFS(-1) {
+		inventory.DiskUsage = append(inventory.DiskUsage, v1.VirtualMachineInstanceGuestOSDiskUsage{
+			MountPoint:     fs.Mountpoint,
+			FileSystemType: fs.Type,
+			UsedBytes:      int64(fs.UsedBytes),
+			TotalBytes:     int64(fs.TotalBytes),
+		})
+	}
+
+	inventory.BootTime = l.agentData.GetBootTime()
+	inventory.RebootPending = l.agentData.GetRebootPending()
+
+	for _, hotfix := range l.agentData.GetHotfixes() {
+		inventory.Hotfixes = append(inventory.Hotfixes, v1.VirtualMachineInstanceGuestOSHotfix{
+			ID:          hotfix.ID,
+			Description: hotfix.Description,
+			InstalledOn: hotfix.InstalledOn,
+		})
+	}
+
+	if inventory.Load == nil && inventory.CPU == nil && inventory.Memory == nil && len(inventory.DiskStats) == 0 &&
+		len(inventory.DiskUsage) == 0 && inventory.BootTime == nil && inventory.RebootPending == nil && len(inventory.Hotfixes) == 0 {
+		return nil
+	}
+	return inventory
+}
+
 // InterfacesStatus returns the interfaces Guest Agent reported
 func (l *LibvirtDomainManager) InterfacesStatus() []api.InterfaceStatus {
 	return l.agentData.GetInterfaceStatus()
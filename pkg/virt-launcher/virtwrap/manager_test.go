@@ -639,6 +639,95 @@ var _ = Describe("Manager", func() {
 			// wait for the unfreeze timeout
 			time.Sleep(unfreezeTimeout + 2*time.Second)
 		})
+		Context("with freeze hooks", func() {
+			const (
+				preFreezeExec  = `{"execute":"guest-exec","arguments":{"path":"/usr/bin/flush-tables","arg":["--all"],"capture-output":true}}`
+				postThawExec   = `{"execute":"guest-exec","arguments":{"path":"/usr/bin/resume-writes","arg":[],"capture-output":true}}`
+				execReturn     = `{"return":{"pid":789}}`
+				execStatus     = `{"execute":"guest-exec-status","arguments":{"pid":789}}`
+				freezeListExec = `{"execute":"guest-fsfreeze-freeze-list","arguments":{"mountpoints":["/var/lib/db"]}}`
+			)
+
+			exitedReturn := func(exitCode int, stderr string) string {
+				return fmt.Sprintf(`{"return":{"exited":true,"exitcode":%d,"err-data":%q}}`, exitCode, base64.StdEncoding.EncodeToString([]byte(stderr)))
+			}
+
+			newVMIWithFreezeHooks := func() *v1.VirtualMachineInstance {
+				vmi := newVMI(testNamespace, testVmName)
+				vmi.Spec.FreezeHooks = &v1.FreezeHooks{
+					PreFreeze:   &v1.FreezeHookCommand{Command: "/usr/bin/flush-tables", Args: []string{"--all"}},
+					PostThaw:    &v1.FreezeHookCommand{Command: "/usr/bin/resume-writes"},
+					Mountpoints: []string{"/var/lib/db"},
+				}
+				return vmi
+			}
+
+			It("should run the hooks around freezing the listed mountpoints", func() {
+				vmi := newVMIWithFreezeHooks()
+
+				gomock.InOrder(
+					mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedThawedOutput, nil),
+					mockConn.EXPECT().QemuAgentCommand(preFreezeExec, testDomainName).Return(execReturn, nil),
+					mockConn.EXPECT().QemuAgentCommand(execStatus, testDomainName).Return(exitedReturn(0, ""), nil),
+					mockConn.EXPECT().QemuAgentCommand(freezeListExec, testDomainName).Return(`{"return":1}`, nil),
+					mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedFrozenOutput, nil),
+					mockConn.EXPECT().QemuAgentCommand(`{"execute":"guest-fsfreeze-thaw"}`, testDomainName).Return("1", nil),
+					mockConn.EXPECT().QemuAgentCommand(postThawExec, testDomainName).Return(execReturn, nil),
+					mockConn.EXPECT().QemuAgentCommand(execStatus, testDomainName).Return(exitedReturn(0, ""), nil),
+				)
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+
+				Expect(manager.FreezeVMI(vmi, 0)).To(Succeed())
+				Expect(manager.UnfreezeVMI(vmi)).To(Succeed())
+
+				freezeHooks, exists := metadataCache.FreezeHooks.Load()
+				Expect(exists).To(BeTrue())
+				for _, step := range []*api.FreezeHookStepMetadata{freezeHooks.PreFreeze, freezeHooks.Freeze, freezeHooks.Thaw, freezeHooks.PostThaw} {
+					Expect(step).ToNot(BeNil())
+					Expect(step.Succeeded).To(BeTrue())
+				}
+				Expect(*freezeHooks.PreFreeze.ExitCode).To(BeZero())
+			})
+
+			It("should not freeze if the pre freeze hook fails", func() {
+				vmi := newVMIWithFreezeHooks()
+
+				gomock.InOrder(
+					mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedThawedOutput, nil),
+					mockConn.EXPECT().QemuAgentCommand(preFreezeExec, testDomainName).Return(execReturn, nil),
+					mockConn.EXPECT().QemuAgentCommand(execStatus, testDomainName).Return(exitedReturn(1, "table locked\n"), nil),
+				)
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+
+				Expect(manager.FreezeVMI(vmi, 0)).To(MatchError("PreFreeze hook exited with code 1: table locked"))
+
+				freezeHooks, _ := metadataCache.FreezeHooks.Load()
+				Expect(freezeHooks.PreFreeze.Succeeded).To(BeFalse())
+				Expect(*freezeHooks.PreFreeze.ExitCode).To(Equal(int32(1)))
+				Expect(freezeHooks.Freeze).To(BeNil())
+			})
+
+			It("should run the post thaw hook if the freeze fails", func() {
+				vmi := newVMIWithFreezeHooks()
+
+				gomock.InOrder(
+					mockConn.EXPECT().QemuAgentCommand(`{"execute":"`+string(agentpoller.GET_FSFREEZE_STATUS)+`"}`, testDomainName).Return(expectedThawedOutput, nil),
+					mockConn.EXPECT().QemuAgentCommand(preFreezeExec, testDomainName).Return(execReturn, nil),
+					mockConn.EXPECT().QemuAgentCommand(execStatus, testDomainName).Return(exitedReturn(0, ""), nil),
+					mockConn.EXPECT().QemuAgentCommand(freezeListExec, testDomainName).Return("", fmt.Errorf("mountpoint not found")),
+					mockConn.EXPECT().QemuAgentCommand(postThawExec, testDomainName).Return(execReturn, nil),
+					mockConn.EXPECT().QemuAgentCommand(execStatus, testDomainName).Return(exitedReturn(0, ""), nil),
+				)
+				manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+
+				Expect(manager.FreezeVMI(vmi, 0)).To(MatchError("mountpoint not found"))
+
+				freezeHooks, _ := metadataCache.FreezeHooks.Load()
+				Expect(freezeHooks.Freeze.Succeeded).To(BeFalse())
+				Expect(freezeHooks.Freeze.Message).To(Equal("mountpoint not found"))
+				Expect(freezeHooks.PostThaw.Succeeded).To(BeTrue())
+			})
+		})
 		It("should update domain with memory dump info when completed successfully", func() {
 			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
 			mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_RAW, libvirt.DUMP_MEMORY_ONLY).Return(nil)
@@ -674,6 +763,26 @@ var _ = Describe("Manager", func() {
 			// not to call core dump command again
 			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
 		})
+		It("should dump the memory in the format of the memory dump volume", func() {
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().CoreDumpWithFormat(testDumpPath, libvirt.DOMAIN_CORE_DUMP_FORMAT_KDUMP_ZLIB, libvirt.DUMP_MEMORY_ONLY).Return(nil)
+
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+
+			vmi := newVMI(testNamespace, testVmName)
+			vmi.Spec.Volumes = append(vmi.Spec.Volumes, v1.Volume{
+				Name: filepath.Base(filepath.Dir(testDumpPath)),
+				VolumeSource: v1.VolumeSource{
+					MemoryDump: &v1.MemoryDumpVolumeSource{Format: v1.MemoryDumpFormatKdumpZlib},
+				},
+			})
+			Expect(manager.MemoryDump(vmi, testDumpPath)).To(Succeed())
+
+			Eventually(func() bool {
+				memoryDump, _ := metadataCache.MemoryDump.Load()
+				return memoryDump.Completed
+			}, 5*time.Second, 2).Should(BeTrue())
+		})
 		It("should update domain with memory dump info if memory dump failed", func() {
 			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
 			dumpFailure := fmt.Errorf("Memory dump failed!!")
@@ -757,6 +866,18 @@ var _ = Describe("Manager", func() {
 			// no call to unpause
 			Expect(manager.UnpauseVMI(vmi)).To(Succeed())
 		})
+		It("should reset and resume a VirtualMachineInstance preserved after a guest panic", func() {
+			vmi := newVMI(testNamespace, testVmName)
+
+			mockConn.EXPECT().LookupDomainByName(testDomainName).DoAndReturn(mockDomainWithFreeExpectation)
+			mockDomain.EXPECT().GetState().Return(libvirt.DOMAIN_CRASHED, int(libvirt.DOMAIN_CRASHED_PANICKED), nil)
+			gomock.InOrder(
+				mockDomain.EXPECT().Reset(uint32(0)).Return(nil),
+				mockDomain.EXPECT().Resume().Return(nil),
+			)
+			manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, nil, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+			Expect(manager.UnpauseVMI(vmi)).To(Succeed())
+		})
 		It("should not add discard=unmap if a disk is preallocated", func() {
 			vmi := newVMI(testNamespace, testVmName)
 			vmi.Spec.Domain.Devices.Disks = []v1.Disk{
@@ -2368,6 +2489,55 @@ var _ = Describe("Manager", func() {
 				},
 			},
 		}))
+		Expect(guestInfo.Inventory).To(Equal(&v1.VirtualMachineInstanceGuestOSInventory{
+			DiskUsage: []v1.VirtualMachineInstanceGuestOSDiskUsage{
+				{MountPoint: "/mnt/whatever", FileSystemType: "fs"},
+			},
+		}))
+	})
+
+	It("executes GetGuestInfo with the guest inventory", func() {
+		agentStore := agentpoller.NewAsyncAgentStore()
+		agentStore.Store(agentpoller.GET_LOAD, api.GuestLoad{Load1m: 1, Load5m: 0.5, Load15m: 0.25})
+		agentStore.Store(agentpoller.GET_VCPUS, api.GuestVCPUs{Online: 2, Total: 4})
+		agentStore.Store(agentpoller.GET_MEMORY_BLOCKS, api.GuestMemoryBlocks{Online: 8, Total: 8})
+		agentStore.Store(agentpoller.GET_MEMORY_BLOCK, int64(128*1024*1024))
+		agentStore.Store(agentpoller.GET_DISKSTATS, []api.DiskStats{
+			{Name: "vda", ReadIOs: 10, WriteIOs: 20},
+		})
+		agentStore.Store(agentpoller.GET_FILESYSTEM, []api.Filesystem{
+			{Name: "vda1", Mountpoint: "/", Type: "xfs", UsedBytes: 1024, TotalBytes: 4096},
+		})
+		bootTime := metav1.NewTime(time.Unix(1700000000, 0).UTC())
+		agentStore.Store(agentpoller.GET_BOOT_TIME, bootTime)
+		agentStore.Store(agentpoller.GET_REBOOT_PENDING, true)
+		agentStore.Store(agentpoller.GET_HOTFIXES, []api.Hotfix{
+			{ID: "KB5034441", Description: "Security Update", InstalledOn: &bootTime},
+		})
+
+		manager, _ := NewLibvirtDomainManager(mockConn, testVirtShareDir, testEphemeralDiskDir, &agentStore, "/usr/share/OVMF", ephemeralDiskCreatorMock, metadataCache, nil, virtconfig.DefaultDiskVerificationMemoryLimitBytes)
+		libvirtmanager := manager.(*LibvirtDomainManager)
+
+		guestInfo := libvirtmanager.GetGuestInfo()
+		Expect(guestInfo.Inventory).To(Equal(&v1.VirtualMachineInstanceGuestOSInventory{
+			Load: &v1.VirtualMachineInstanceGuestOSLoad{Load1m: 1, Load5m: 0.5, Load15m: 0.25},
+			CPU:  &v1.VirtualMachineInstanceGuestOSCPU{Online: 2, Total: 4},
+			Memory: &v1.VirtualMachineInstanceGuestOSMemory{
+				OnlineBytes: 1024 * 1024 * 1024,
+				TotalBytes:  1024 * 1024 * 1024,
+			},
+			DiskStats: []v1.VirtualMachineInstanceGuestOSDiskStats{
+				{Name: "vda", ReadIOs: 10, WriteIOs: 20},
+			},
+			DiskUsage: []v1.VirtualMachineInstanceGuestOSDiskUsage{
+				{MountPoint: "/", FileSystemType: "xfs", UsedBytes: 1024, TotalBytes: 4096},
+			},
+			BootTime:      &bootTime,
+			RebootPending: virtpointer.P(true),
+			Hotfixes: []v1.VirtualMachineInstanceGuestOSHotfix{
+				{ID: "KB5034441", Description: "Security Update", InstalledOn: &bootTime},
+			},
+		}))
 	})
 
 	It("executes GetUsers", func() {
@@ -2666,6 +2836,63 @@ var _ = Describe("getDetachedDisks", func() {
 			[]api.Disk{}),
 	)
 })
+var _ = Describe("syncDiskIOTune", func() {
+	var (
+		mockDomain *cli.MockVirDomain
+		vmi        *v1.VirtualMachineInstance
+	)
+
+	newDomainWithIOTune := func(ioTune *api.DiskIOTune) *api.Domain {
+		return &api.Domain{Spec: api.DomainSpec{Devices: api.Devices{Disks: []api.Disk{{
+			Target: api.DiskTarget{Device: "vda"},
+			Alias:  api.NewUserDefinedAlias("rootdisk"),
+			IOTune: ioTune,
+		}}}}}
+	}
+
+	BeforeEach(func() {
+		mockDomain = cli.NewMockVirDomain(gomock.NewController(GinkgoT()))
+		vmi = api2.NewMinimalVMI("testvmi")
+		vmi.Status.Phase = v1.Running
+	})
+
+	It("should not update the domain when the limits did not change", func() {
+		current := newDomainWithIOTune(&api.DiskIOTune{ReadIopsSec: 100})
+		desired := newDomainWithIOTune(&api.DiskIOTune{ReadIopsSec: 100})
+		Expect(syncDiskIOTune(desired, &current.Spec, mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should apply changed limits to the running domain", func() {
+		current := newDomainWithIOTune(nil)
+		desired := newDomainWithIOTune(&api.DiskIOTune{ReadIopsSec: 100, WriteBytesSec: 1048576})
+		mockDomain.EXPECT().SetBlockIoTune("vda", &libvirt.DomainBlockIoTuneParameters{
+			TotalBytesSecSet: true,
+			ReadBytesSecSet:  true,
+			WriteBytesSecSet: true,
+			WriteBytesSec:    1048576,
+			TotalIopsSecSet:  true,
+			ReadIopsSecSet:   true,
+			ReadIopsSec:      100,
+			WriteIopsSecSet:  true,
+		}, libvirt.DOMAIN_AFFECT_LIVE|libvirt.DOMAIN_AFFECT_CONFIG).Return(nil)
+		Expect(syncDiskIOTune(desired, &current.Spec, mockDomain, vmi)).To(Succeed())
+	})
+
+	It("should clear removed limits", func() {
+		current := newDomainWithIOTune(&api.DiskIOTune{TotalIopsSec: 100})
+		desired := newDomainWithIOTune(nil)
+		mockDomain.EXPECT().SetBlockIoTune("vda", &libvirt.DomainBlockIoTuneParameters{
+			TotalBytesSecSet: true,
+			ReadBytesSecSet:  true,
+			WriteBytesSecSet: true,
+			TotalIopsSecSet:  true,
+			ReadIopsSecSet:   true,
+			WriteIopsSecSet:  true,
+		}, libvirt.DOMAIN_AFFECT_LIVE|libvirt.DOMAIN_AFFECT_CONFIG).Return(nil)
+		Expect(syncDiskIOTune(desired, &current.Spec, mockDomain, vmi)).To(Succeed())
+	})
+})
+
 var _ = Describe("migratableDomXML", func() {
 	var ctrl *gomock.Controller
 	var mockDomain *cli.MockVirDomain
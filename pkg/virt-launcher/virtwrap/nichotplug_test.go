@@ -345,7 +345,7 @@ var _ = Describe("domain network interfaces resources", func() {
 	})
 })
 
-var _ = Describe("interface link state update", func() {
+var _ = Describe("interface link state and bandwidth update", func() {
 	DescribeTable("no change in state",
 		func(domainFrom *api.Domain,
 			domainTo *api.Domain,
@@ -354,7 +354,7 @@ var _ = Describe("interface link state update", func() {
 			networkInterfaceManager := newVirtIOInterfaceManager(
 				expectMockFunc(gomock.NewController(GinkgoT())),
 				&fakeVMConfigurator{})
-			Expect(networkInterfaceManager.updateDomainLinkState(domainFrom, domainTo)).To(Succeed())
+			Expect(networkInterfaceManager.updateDomainInterfaces(domainFrom, domainTo)).To(Succeed())
 		},
 
 		Entry("none to none",
@@ -377,6 +377,22 @@ var _ = Describe("interface link state update", func() {
 			newDomain(newDeviceInterface(defaultNet, libvirtInterfaceLinkStateDown)),
 			expectUpdateDeviceLinkStateDown,
 		),
+		Entry("bandwidth added",
+			dummyDomain(defaultNet),
+			newDomain(newDeviceInterfaceWithBandwidth(defaultNet, &api.BandWidth{
+				Inbound: &api.BandWidthLimit{Average: 1024, Burst: 64},
+			})),
+			expectUpdateDeviceBandwidth,
+		),
+		Entry("same bandwidth",
+			newDomain(newDeviceInterfaceWithBandwidth(defaultNet, &api.BandWidth{
+				Inbound: &api.BandWidthLimit{Average: 1024},
+			})),
+			newDomain(newDeviceInterfaceWithBandwidth(defaultNet, &api.BandWidth{
+				Inbound: &api.BandWidthLimit{Average: 1024},
+			})),
+			expectUpdateDeviceNotCalled,
+		),
 	)
 })
 
@@ -427,6 +443,14 @@ func expectUpdateDeviceLinkStateNone(mockController *gomock.Controller) *cli.Moc
 	return mockClient
 }
 
+func expectUpdateDeviceBandwidth(mockController *gomock.Controller) *cli.MockVirDomain {
+	mockClient := cli.NewMockVirDomain(mockController)
+
+	const interfaceWithBandwidthXML = `<interface type=""><source></source><bandwidth><inbound average="1024" burst="64"></inbound></bandwidth><alias name="ua-default"></alias></interface>`
+	mockClient.EXPECT().UpdateDeviceFlags(interfaceWithBandwidthXML, gomock.Any()).Times(1).Return(nil)
+	return mockClient
+}
+
 func vmiWithSingleBridgeInterfaceWithPodInterfaceReady(ifaceName string, nadName string) *v1.VirtualMachineInstance {
 	return &v1.VirtualMachineInstance{
 		Spec: v1.VirtualMachineInstanceSpec{
@@ -495,3 +519,10 @@ func newDeviceInterface(ifaceName, state string) api.Interface {
 		LinkState: &api.LinkState{State: state},
 	}
 }
+
+func newDeviceInterfaceWithBandwidth(ifaceName string, bandwidth *api.BandWidth) api.Interface {
+	return api.Interface{
+		Alias:     api.NewUserDefinedAlias(ifaceName),
+		BandWidth: bandwidth,
+	}
+}
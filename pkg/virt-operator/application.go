@@ -22,6 +22,7 @@ package virt_operator
 import (
 	"context"
 	"crypto/tls"
+	"encoding/json"
 	"fmt"
 	golog "log"
 	"net/http"
@@ -42,6 +43,9 @@ import (
 	operator_webhooks "kubevirt.io/kubevirt/pkg/virt-operator/webhooks"
 
 	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/kubernetes/scheme"
 	k8coresv1 "k8s.io/client-go/kubernetes/typed/core/v1"
 	clientrest "k8s.io/client-go/rest"
@@ -50,6 +54,7 @@ import (
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	"k8s.io/client-go/tools/record"
 
+	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
 	clientutil "kubevirt.io/client-go/util"
@@ -60,6 +65,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/monitoring/profiler"
 	"kubevirt.io/kubevirt/pkg/service"
 	clusterutil "kubevirt.io/kubevirt/pkg/util/cluster"
+	"kubevirt.io/kubevirt/pkg/util/registry"
 	virtconfig "kubevirt.io/kubevirt/pkg/virt-config"
 	"kubevirt.io/kubevirt/pkg/virt-controller/leaderelectionconfig"
 	install "kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/install"
@@ -110,6 +116,7 @@ func Execute() {
 	app := VirtOperatorApp{}
 
 	dumpInstallStrategy := pflag.Bool("dump-install-strategy", false, "Dump install strategy to configmap and exit")
+	dumpImages := pflag.Bool("dump-images", false, "Dump the images of the deployment to stdout and exit")
 
 	service.Setup(&app)
 
@@ -130,6 +137,13 @@ func Execute() {
 		golog.Fatal(err)
 	}
 
+	if *dumpImages {
+		if err := dumpDeploymentImages(); err != nil {
+			golog.Fatal(err)
+		}
+		os.Exit(0)
+	}
+
 	// apply any passthrough environment to this operator as well
 	for k, v := range util.GetPassthroughEnv() {
 		os.Setenv(k, v)
@@ -188,11 +202,14 @@ func Execute() {
 		InstallStrategyJob:       app.informerFactory.OperatorInstallStrategyJob(),
 		InfrastructurePod:        app.informerFactory.OperatorPod(),
 		PodDisruptionBudget:      app.informerFactory.OperatorPodDisruptionBudget(),
+		NetworkPolicy:            newNetworkPolicyInformer(app.clientSet, app.operatorNamespace),
 		Namespace:                app.informerFactory.Namespace(),
 		Secrets:                  app.informerFactory.Secrets(),
 		ConfigMap:                app.informerFactory.OperatorConfigMap(),
 		ClusterInstancetype:      app.informerFactory.VirtualMachineClusterInstancetype(),
 		ClusterPreference:        app.informerFactory.VirtualMachineClusterPreference(),
+		Node:                     app.informerFactory.KubeVirtNode(),
+		VirtualMachineInstance:   app.informerFactory.VMI(),
 	}
 
 	onOpenShift, err := clusterutil.IsOnOpenShift(app.clientSet)
@@ -299,6 +316,29 @@ func Execute() {
 	<-app.reInitChan
 }
 
+// dumpDeploymentImages prints the images of the deployment configured by the environment of virt-operator,
+// it doesn't need access to the cluster so that it can be run while preparing a disconnected installation.
+// The tags of the images are resolved with their registries.
+func dumpDeploymentImages() error {
+	config, err := util.GetConfigFromEnv()
+	if err != nil {
+		return err
+	}
+	resolver := registry.NewDigestResolver(nil)
+	deploymentImages, err := components.GetImages(config, func(image string) (string, error) {
+		return resolver.Resolve(context.Background(), image)
+	})
+	if err != nil {
+		return err
+	}
+	images, err := json.MarshalIndent(deploymentImages, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(images))
+	return nil
+}
+
 func (app *VirtOperatorApp) Run() {
 	promTLSConfig := kvtls.SetupPromTLS(app.operatorCertManager, app.clusterConfig)
 
@@ -358,6 +398,7 @@ func (app *VirtOperatorApp) Run() {
 
 	stop := app.ctx.Done()
 	app.informerFactory.Start(stop)
+	go app.informers.NetworkPolicy.Run(stop)
 
 	stopChan := app.ctx.Done()
 	cache.WaitForCacheSync(stopChan, app.informers.CRD.HasSynced, app.informers.KubeVirt.HasSynced)
@@ -457,6 +498,15 @@ func (app *VirtOperatorApp) configModificationCallback() {
 	}
 }
 
+// newNetworkPolicyInformer watches the NetworkPolicies virt-operator manages in its namespace
+func newNetworkPolicyInformer(clientSet kubecli.KubevirtClient, namespace string) cache.SharedIndexInformer {
+	labelSelector := labels.Set{v1.ManagedByLabel: v1.ManagedByLabelOperatorValue}.String()
+	lw := cache.NewFilteredListWatchFromClient(clientSet.NetworkingV1().RESTClient(), "networkpolicies", namespace, func(options *metav1.ListOptions) {
+		options.LabelSelector = labelSelector
+	})
+	return cache.NewSharedIndexInformer(lw, &networkingv1.NetworkPolicy{}, 0, cache.Indexers{})
+}
+
 func (app *VirtOperatorApp) getNewRecorder(namespace string, componentName string) record.EventRecorder {
 	eventBroadcaster := record.NewBroadcaster()
 	eventBroadcaster.StartRecordingToSink(&k8coresv1.EventSinkImpl{Interface: app.clientSet.CoreV1().Events(namespace)})
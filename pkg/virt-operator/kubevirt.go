@@ -104,11 +104,14 @@ func NewKubeVirtController(
 		InstallStrategyJobCache:               informers.InstallStrategyJob.GetStore(),
 		InfrastructurePodCache:                informers.InfrastructurePod.GetStore(),
 		PodDisruptionBudgetCache:              informers.PodDisruptionBudget.GetStore(),
+		NetworkPolicyCache:                    informers.NetworkPolicy.GetStore(),
 		NamespaceCache:                        informers.Namespace.GetStore(),
 		SecretCache:                           informers.Secrets.GetStore(),
 		ConfigMapCache:                        informers.ConfigMap.GetStore(),
 		ClusterInstancetype:                   informers.ClusterInstancetype.GetStore(),
 		ClusterPreference:                     informers.ClusterPreference.GetStore(),
+		NodeCache:                             informers.Node.GetStore(),
+		VirtualMachineInstanceCache:           informers.VirtualMachineInstance.GetStore(),
 		SCCCache:                              informers.SCC.GetStore(),
 		RouteCache:                            informers.Route.GetStore(),
 		ServiceMonitorCache:                   informers.ServiceMonitor.GetStore(),
@@ -145,6 +148,7 @@ func NewKubeVirtController(
 			InstallStrategyConfigMap:         controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("InstallStrategyConfigMap")),
 			InstallStrategyJob:               controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("Jobs")),
 			PodDisruptionBudget:              controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("PodDisruptionBudgets")),
+			NetworkPolicy:                    controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("NetworkPolicies")),
 			ServiceMonitor:                   controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("ServiceMonitor")),
 			PrometheusRule:                   controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("PrometheusRule")),
 			Secrets:                          controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("Secret")),
@@ -176,13 +180,16 @@ func NewKubeVirtController(
 			informers.InstallStrategyJob.HasSynced() &&
 			informers.InfrastructurePod.HasSynced() &&
 			informers.PodDisruptionBudget.HasSynced() &&
+			informers.NetworkPolicy.HasSynced() &&
 			informers.ServiceMonitor.HasSynced() &&
 			informers.Namespace.HasSynced() &&
 			informers.PrometheusRule.HasSynced() &&
 			informers.Secrets.HasSynced() &&
 			informers.ConfigMap.HasSynced() &&
 			informers.ValidatingAdmissionPolicyBinding.HasSynced() &&
-			informers.ValidatingAdmissionPolicy.HasSynced()
+			informers.ValidatingAdmissionPolicy.HasSynced() &&
+			informers.Node.HasSynced() &&
+			informers.VirtualMachineInstance.HasSynced()
 	}
 
 	_, err := informers.KubeVirt.AddEventHandler(cache.ResourceEventHandlerFuncs{
@@ -475,6 +482,21 @@ func NewKubeVirtController(
 		return nil, err
 	}
 
+	_, err = informers.NetworkPolicy.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			c.genericAddHandler(obj, c.kubeVirtExpectations.NetworkPolicy)
+		},
+		DeleteFunc: func(obj interface{}) {
+			c.genericDeleteHandler(obj, c.kubeVirtExpectations.NetworkPolicy)
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			c.genericUpdateHandler(oldObj, newObj, c.kubeVirtExpectations.NetworkPolicy)
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = informers.ServiceMonitor.AddEventHandler(cache.ResourceEventHandlerFuncs{
 		AddFunc: func(obj interface{}) {
 			c.genericAddHandler(obj, c.kubeVirtExpectations.ServiceMonitor)
@@ -871,14 +893,13 @@ func (c *KubeVirtController) execute(key string) error {
 
 // Loads install strategies into memory, and generates jobs to
 // create install strategies that don't exist yet.
-func (c *KubeVirtController) loadInstallStrategy(kv *v1.KubeVirt) (*install.Strategy, bool, error) {
+func (c *KubeVirtController) loadInstallStrategy(kv *v1.KubeVirt, config *operatorutil.KubeVirtDeploymentConfig) (*install.Strategy, bool, error) {
 
 	kvkey, err := controller.KeyFunc(kv)
 	if err != nil {
 		return nil, true, err
 	}
 
-	config := operatorutil.GetTargetConfigFromKV(kv)
 	// 1. see if we already loaded the install strategy
 	strategy, ok := c.getCachedInstallStrategy(config, kv.Generation)
 	if ok {
@@ -998,6 +1019,77 @@ func isUpdating(kv *v1.KubeVirt) bool {
 	return false
 }
 
+func isUpdateDryRun(kv *v1.KubeVirt) bool {
+	return isUpdating(kv) && metav1.HasAnnotation(kv.ObjectMeta, v1.KubeVirtUpgradeDryRunAnnotation)
+}
+
+func isRollingBack(kv *v1.KubeVirt) bool {
+	return isUpdating(kv) && kv.Spec.RollbackTo != ""
+}
+
+// getTargetConfig returns the deployment config to install. spec.rollbackTo takes precedence over
+// the config derived from the spec and the operator.
+func getTargetConfig(kv *v1.KubeVirt) (*operatorutil.KubeVirtDeploymentConfig, error) {
+	if kv.Spec.RollbackTo != "" {
+		return operatorutil.GetRollbackConfigFromKV(kv)
+	}
+	return operatorutil.GetTargetConfigFromKV(kv), nil
+}
+
+// recordPreviousDeployment keeps the observed deployment around once an update away from it starts, so that it
+// can be rolled back to. It is not replaced while rolling back to it or while returning from such a rollback.
+func recordPreviousDeployment(kv *v1.KubeVirt) {
+	if !isUpdating(kv) {
+		return
+	}
+
+	previous := kv.Status.PreviousDeployment
+	if previous != nil &&
+		(previous.DeploymentID == kv.Status.ObservedDeploymentID || previous.DeploymentID == kv.Status.TargetDeploymentID) {
+		return
+	}
+
+	kv.Status.PreviousDeployment = &v1.KubeVirtPreviousDeployment{
+		KubeVirtRegistry: kv.Status.ObservedKubeVirtRegistry,
+		KubeVirtVersion:  kv.Status.ObservedKubeVirtVersion,
+		DeploymentConfig: kv.Status.ObservedDeploymentConfig,
+		DeploymentID:     kv.Status.ObservedDeploymentID,
+		Generations:      append([]v1.GenerationStatus{}, kv.Status.Generations...),
+	}
+}
+
+// restorePreviousGenerations puts the generations recorded for the previous deployment back into the status once a
+// rollback to it starts. Objects the update changed since then don't match them anymore and are applied again,
+// objects it left alone are recognized as up to date.
+func restorePreviousGenerations(kv *v1.KubeVirt) {
+	previous := kv.Status.PreviousDeployment
+	if !isRollingBack(kv) || previous == nil || previous.DeploymentID != kv.Status.TargetDeploymentID {
+		return
+	}
+	for _, condition := range kv.Status.Conditions {
+		if condition.Type == v1.KubeVirtConditionProgressing && condition.Reason == util.ConditionReasonRollingBack {
+			return
+		}
+	}
+
+	generations := append([]v1.GenerationStatus{}, kv.Status.Generations...)
+	for _, previousGeneration := range previous.Generations {
+		restored := false
+		for i, generation := range generations {
+			if generation.Group == previousGeneration.Group && generation.Resource == previousGeneration.Resource &&
+				generation.Namespace == previousGeneration.Namespace && generation.Name == previousGeneration.Name {
+				generations[i] = previousGeneration
+				restored = true
+				break
+			}
+		}
+		if !restored {
+			generations = append(generations, previousGeneration)
+		}
+	}
+	kv.Status.Generations = generations
+}
+
 func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 	var targetStrategy *install.Strategy
 	var targetPending bool
@@ -1012,7 +1104,12 @@ func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 	logger := log.Log.Object(kv)
 	logger.Infof("Handling deployment")
 
-	config := operatorutil.GetTargetConfigFromKV(kv)
+	config, err := getTargetConfig(kv)
+	if err != nil {
+		util.UpdateConditionsFailedError(kv, err)
+		logger.Errorf("Failed to determine the target deployment: %v", err)
+		return err
+	}
 
 	// Record current operator version to status section
 	util.SetOperatorVersion(kv)
@@ -1020,6 +1117,9 @@ func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 	// Record the version we're targeting to install
 	config.SetTargetDeploymentConfig(kv)
 
+	recordPreviousDeployment(kv)
+	restorePreviousGenerations(kv)
+
 	// Set the default architecture
 	config.SetDefaultArchitecture(kv)
 
@@ -1027,13 +1127,15 @@ func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 		kv.Status.Phase = v1.KubeVirtPhaseDeploying
 	}
 
-	if isUpdating(kv) {
+	if isRollingBack(kv) {
+		util.UpdateConditionsRollingBack(kv)
+	} else if isUpdating(kv) {
 		util.UpdateConditionsUpdating(kv)
 	} else {
 		util.UpdateConditionsDeploying(kv)
 	}
 
-	targetStrategy, targetPending, err = c.loadInstallStrategy(kv)
+	targetStrategy, targetPending, err = c.loadInstallStrategy(kv, config)
 	if err != nil {
 		return err
 	}
@@ -1061,6 +1163,27 @@ func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 		return err
 	}
 
+	if isRollingBack(kv) {
+		// refuse the rollback before anything is changed if the target can't read all stored objects
+		if err := reconciler.VerifyRollback(); err != nil {
+			util.UpdateConditionsFailedError(kv, err)
+			logger.Errorf("Refusing to roll back: %v", err)
+			return err
+		}
+	}
+
+	if isUpdateDryRun(kv) {
+		// hold the update and only publish what it would change
+		if err := reconciler.SyncUpgradePlan(); err != nil {
+			util.UpdateConditionsFailedError(kv, err)
+			logger.Errorf("Failed to generate the upgrade plan: %v", err)
+			return err
+		}
+		util.UpdateConditionsUpdateDryRun(kv)
+		logger.Infof("Update to version %s is held by the %s annotation", kv.Status.TargetKubeVirtVersion, v1.KubeVirtUpgradeDryRunAnnotation)
+		return nil
+	}
+
 	synced, err := reconciler.Sync(c.queue)
 
 	if err != nil {
@@ -1070,6 +1193,11 @@ func (c *KubeVirtController) syncInstallation(kv *v1.KubeVirt) error {
 		return err
 	}
 
+	if err := reconciler.SyncConfigurationHistory(c.queue); err != nil {
+		// the history and the validation of the configuration are informational, they must not hold back the deployment
+		logger.Reason(err).Warning("Failed to sync the configuration history")
+	}
+
 	// the entire sync can't always occur within a single control loop execution.
 	// when synced==true that means SyncAll() has completed and has nothing left to wait on.
 	if synced {
@@ -1133,7 +1261,7 @@ func (c *KubeVirtController) syncDeletion(kv *v1.KubeVirt) error {
 
 	// If we still have cached objects around, more deletions need to take place.
 	if !c.stores.AllEmpty() {
-		_, pending, err := c.loadInstallStrategy(kv)
+		_, pending, err := c.loadInstallStrategy(kv, operatorutil.GetTargetConfigFromKV(kv))
 		if err != nil {
 			return err
 		}
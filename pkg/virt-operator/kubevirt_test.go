@@ -41,6 +41,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	k8sv1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -174,6 +175,7 @@ func (k *KubeVirtTestData) BeforeTest() {
 	informers.InstallStrategyJob, _ = testutils.NewFakeInformerFor(&batchv1.Job{})
 	informers.InfrastructurePod, _ = testutils.NewFakeInformerFor(&k8sv1.Pod{})
 	informers.PodDisruptionBudget, _ = testutils.NewFakeInformerFor(&policyv1.PodDisruptionBudget{})
+	informers.NetworkPolicy, _ = testutils.NewFakeInformerFor(&networkingv1.NetworkPolicy{})
 	informers.Namespace, _ = testutils.NewFakeInformerWithIndexersFor(
 		&k8sv1.Namespace{}, cache.Indexers{
 			"namespace_name": func(obj interface{}) ([]string, error) {
@@ -188,6 +190,8 @@ func (k *KubeVirtTestData) BeforeTest() {
 	informers.ValidatingAdmissionPolicy, _ = testutils.NewFakeInformerFor(&admissionregistrationv1.ValidatingAdmissionPolicy{})
 	informers.ClusterInstancetype, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterInstancetype{})
 	informers.ClusterPreference, _ = testutils.NewFakeInformerFor(&instancetypev1beta1.VirtualMachineClusterPreference{})
+	informers.Node, _ = testutils.NewFakeInformerFor(&k8sv1.Node{})
+	informers.VirtualMachineInstance, _ = testutils.NewFakeInformerFor(&v1.VirtualMachineInstance{})
 
 	// test OpenShift components
 	config := util.OperatorConfig{
@@ -231,6 +235,7 @@ func (k *KubeVirtTestData) BeforeTest() {
 	k.virtClient.EXPECT().SecClient().Return(k.secClient).AnyTimes()
 	k.virtClient.EXPECT().ExtensionsClient().Return(k.extClient).AnyTimes()
 	k.virtClient.EXPECT().PolicyV1().Return(k.kubeClient.PolicyV1()).AnyTimes()
+	k.virtClient.EXPECT().NetworkingV1().Return(k.kubeClient.NetworkingV1()).AnyTimes()
 	k.virtClient.EXPECT().PrometheusClient().Return(k.promClient).AnyTimes()
 	k.virtClient.EXPECT().RouteClient().Return(k.routeClient).AnyTimes()
 	k.virtClient.EXPECT().VirtualMachineClusterInstancetype().Return(k.virtFakeClient.InstancetypeV1beta1().VirtualMachineClusterInstancetypes()).AnyTimes()
@@ -1211,6 +1216,7 @@ func (k *KubeVirtTestData) addAllWithExclusionMap(config *util.KubeVirtDeploymen
 		components.NewVirtualMachineRestoreCrd, components.NewVirtualMachineInstancetypeCrd,
 		components.NewVirtualMachineClusterInstancetypeCrd, components.NewVirtualMachinePoolCrd,
 		components.NewMigrationPolicyCrd, components.NewVirtualMachinePreferenceCrd,
+		components.NewKubeVirtNamespaceConfigCrd, components.NewVirtualMachineQuotaCrd,
 		components.NewVirtualMachineClusterPreferenceCrd, components.NewVirtualMachineCloneCrd,
 	}
 	for _, f := range functions {
@@ -2639,6 +2645,160 @@ var _ = Describe("KubeVirt Operator", func() {
 			Expect(kvTestData.resourceChanges["daemonsets"][Patched]).To(Equal(0))           // namespace unpatched
 		})
 
+		It("should hold the update and publish the upgrade plan when a dry run is requested", func() {
+			kvTestData := KubeVirtTestData{}
+			kvTestData.BeforeTest()
+			defer kvTestData.AfterTest()
+
+			updatedConfig := kvTestData.getConfig("otherregistry", "9.9.10")
+
+			kv := &v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "test-install",
+					Namespace:   NAMESPACE,
+					Finalizers:  []string{util.KubeVirtFinalizer},
+					Annotations: map[string]string{v1.KubeVirtUpgradeDryRunAnnotation: "true"},
+				},
+				Spec: v1.KubeVirtSpec{
+					ImageTag:      updatedConfig.GetKubeVirtVersion(),
+					ImageRegistry: updatedConfig.GetImageRegistry(),
+					WorkloadUpdateStrategy: v1.KubeVirtWorkloadUpdateStrategy{
+						WorkloadUpdateMethods: []v1.WorkloadUpdateMethod{v1.WorkloadUpdateMethodEvict},
+					},
+				},
+				Status: v1.KubeVirtStatus{
+					Phase:           v1.KubeVirtPhaseDeployed,
+					OperatorVersion: version.Get().String(),
+				},
+			}
+			kvTestData.defaultConfig.SetTargetDeploymentConfig(kv)
+			kvTestData.defaultConfig.SetObservedDeploymentConfig(kv)
+			util.UpdateConditionsCreated(kv)
+			util.UpdateConditionsAvailable(kv)
+
+			kubecontroller.SetLatestApiVersionAnnotation(kv)
+			kvTestData.addKubeVirt(kv)
+			kvTestData.addInstallStrategy(kvTestData.defaultConfig)
+			kvTestData.addInstallStrategy(updatedConfig)
+
+			kvTestData.addAll(kvTestData.defaultConfig, kv)
+			kvTestData.addPodsAndPodDisruptionBudgets(kvTestData.defaultConfig, kv)
+
+			kvTestData.makeDeploymentsReady(kv)
+			kvTestData.makeHandlerReady()
+
+			vmi := v1.VirtualMachineInstance{
+				ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: metav1.NamespaceDefault},
+				Status: v1.VirtualMachineInstanceStatus{
+					Phase:                         v1.Running,
+					LauncherContainerImageVersion: "oldregistry/virt-launcher:old",
+				},
+			}
+			vmiInterface := kubecli.NewMockVirtualMachineInstanceInterface(kvTestData.ctrl)
+			kvTestData.virtClient.EXPECT().VirtualMachineInstance(metav1.NamespaceAll).Return(vmiInterface).Times(1)
+			vmiInterface.EXPECT().List(gomock.Any(), metav1.ListOptions{}).Return(&v1.VirtualMachineInstanceList{Items: []v1.VirtualMachineInstance{vmi}}, nil).Times(1)
+
+			var plan *util.UpgradePlan
+			kvTestData.kubeClient.Fake.PrependReactor("create", "configmaps", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+				configMap := action.(testing.CreateAction).GetObject().(*k8sv1.ConfigMap)
+				Expect(configMap.Name).To(Equal(util.UpgradePlanConfigMapName))
+				plan = &util.UpgradePlan{}
+				Expect(json.Unmarshal([]byte(configMap.Data[util.UpgradePlanConfigMapKey]), plan)).To(Succeed())
+				return true, configMap, nil
+			})
+			kvTestData.shouldExpectKubeVirtUpdateStatus(1)
+
+			kvTestData.controller.Execute()
+
+			kv = kvTestData.getLatestKubeVirt(kv)
+			// the deployed version stays available and nothing is rolled out
+			shouldExpectHCOConditions(kv, k8sv1.ConditionTrue, k8sv1.ConditionFalse, k8sv1.ConditionFalse)
+			Expect(kvTestData.totalPatches).To(Equal(0))
+			Expect(kvTestData.totalUpdates).To(Equal(0))
+
+			Expect(plan).ToNot(BeNil())
+			Expect(plan.TargetKubeVirtVersion).To(Equal(updatedConfig.GetKubeVirtVersion()))
+			Expect(plan.TargetKubeVirtRegistry).To(Equal(updatedConfig.GetImageRegistry()))
+			Expect(plan.Objects).To(ContainElement(And(
+				HaveField("Kind", "DaemonSet"),
+				HaveField("Name", "virt-handler"),
+				HaveField("Action", util.UpgradePlanActionUpdate),
+			)))
+			Expect(plan.Objects).To(ContainElement(And(
+				HaveField("Kind", "PodDisruptionBudget"),
+				HaveField("Name", "virt-controller-pdb"),
+				HaveField("Action", util.UpgradePlanActionUpdate),
+			)))
+			Expect(plan.Workloads).To(ConsistOf(util.UpgradePlanWorkload{
+				Namespace:     vmi.Namespace,
+				Name:          vmi.Name,
+				LauncherImage: vmi.Status.LauncherContainerImageVersion,
+				Action:        util.UpgradePlanActionEvict,
+			}))
+		})
+
+		It("should refuse a rollback to a version which does not know all deployed CRDs", func() {
+			kvTestData := KubeVirtTestData{}
+			kvTestData.BeforeTest()
+			defer kvTestData.AfterTest()
+
+			updatedConfig := kvTestData.getConfig("otherregistry", "9.9.10")
+
+			kv := &v1.KubeVirt{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-install",
+					Namespace:  NAMESPACE,
+					Finalizers: []string{util.KubeVirtFinalizer},
+				},
+				Spec: v1.KubeVirtSpec{
+					ImageTag:      updatedConfig.GetKubeVirtVersion(),
+					ImageRegistry: updatedConfig.GetImageRegistry(),
+				},
+				Status: v1.KubeVirtStatus{
+					Phase:           v1.KubeVirtPhaseDeployed,
+					OperatorVersion: version.Get().String(),
+				},
+			}
+			kvTestData.defaultConfig.SetObservedDeploymentConfig(kv)
+			kv.Status.PreviousDeployment = &v1.KubeVirtPreviousDeployment{
+				KubeVirtRegistry: kv.Status.ObservedKubeVirtRegistry,
+				KubeVirtVersion:  kv.Status.ObservedKubeVirtVersion,
+				DeploymentConfig: kv.Status.ObservedDeploymentConfig,
+				DeploymentID:     kv.Status.ObservedDeploymentID,
+			}
+			kv.Spec.RollbackTo = kvTestData.defaultConfig.GetDeploymentID()
+			updatedConfig.SetTargetDeploymentConfig(kv)
+			updatedConfig.SetObservedDeploymentConfig(kv)
+			util.UpdateConditionsCreated(kv)
+			util.UpdateConditionsAvailable(kv)
+
+			kubecontroller.SetLatestApiVersionAnnotation(kv)
+			kvTestData.addKubeVirt(kv)
+			kvTestData.addInstallStrategy(kvTestData.defaultConfig)
+			kvTestData.addInstallStrategy(updatedConfig)
+
+			kvTestData.addAll(updatedConfig, kv)
+			kvTestData.addPodsAndPodDisruptionBudgets(updatedConfig, kv)
+			// a CRD which was introduced by the updated version
+			kvTestData.addCrd(&extv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: "newresources.kubevirt.io"},
+				Status:     extv1.CustomResourceDefinitionStatus{StoredVersions: []string{"v1"}},
+			}, kv)
+
+			kvTestData.makeDeploymentsReady(kv)
+			kvTestData.makeHandlerReady()
+
+			kvTestData.shouldExpectKubeVirtUpdateStatus(1)
+
+			kvTestData.controller.Execute()
+
+			kv = kvTestData.getLatestKubeVirt(kv)
+			shouldExpectHCOConditions(kv, k8sv1.ConditionFalse, k8sv1.ConditionFalse, k8sv1.ConditionTrue)
+			Expect(kv.Status.TargetDeploymentID).To(Equal(kvTestData.defaultConfig.GetDeploymentID()))
+			Expect(kvTestData.totalPatches).To(Equal(0))
+			Expect(kvTestData.totalUpdates).To(Equal(0))
+		})
+
 		Context("virt-api replica count", func() {
 			var kvTestData KubeVirtTestData
 			const (
@@ -3251,6 +3411,71 @@ var _ = Describe("KubeVirt Operator", func() {
 		)
 	})
 
+	Context("previous deployment", func() {
+		newKubeVirt := func(observedID, targetID string, previous *v1.KubeVirtPreviousDeployment) *v1.KubeVirt {
+			return &v1.KubeVirt{
+				Status: v1.KubeVirtStatus{
+					ObservedKubeVirtVersion: "v-" + observedID,
+					ObservedDeploymentID:    observedID,
+					TargetDeploymentID:      targetID,
+					Generations:             []v1.GenerationStatus{{Resource: "daemonsets", Name: "virt-handler", LastGeneration: 1}},
+					PreviousDeployment:      previous,
+				},
+			}
+		}
+
+		DescribeTable("should be recorded", func(kv *v1.KubeVirt, expectedPreviousID string) {
+			recordPreviousDeployment(kv)
+			if expectedPreviousID == "" {
+				Expect(kv.Status.PreviousDeployment).To(BeNil())
+				return
+			}
+			Expect(kv.Status.PreviousDeployment).ToNot(BeNil())
+			Expect(kv.Status.PreviousDeployment.DeploymentID).To(Equal(expectedPreviousID))
+		},
+			Entry("not on a fresh install", newKubeVirt("", "b", nil), ""),
+			Entry("not while no update is in progress", newKubeVirt("a", "a", nil), ""),
+			Entry("when an update starts", newKubeVirt("a", "b", nil), "a"),
+			Entry("when the next update starts", newKubeVirt("b", "c", &v1.KubeVirtPreviousDeployment{DeploymentID: "a"}), "b"),
+			Entry("not when rolling back to it", newKubeVirt("b", "a", &v1.KubeVirtPreviousDeployment{DeploymentID: "a"}), "a"),
+			Entry("not when updating again after a rollback", newKubeVirt("a", "b", &v1.KubeVirtPreviousDeployment{DeploymentID: "a"}), "a"),
+		)
+
+		It("should keep the generations of the replaced deployment", func() {
+			kv := newKubeVirt("a", "b", nil)
+			recordPreviousDeployment(kv)
+			Expect(kv.Status.PreviousDeployment.KubeVirtVersion).To(Equal("v-a"))
+			Expect(kv.Status.PreviousDeployment.Generations).To(Equal(kv.Status.Generations))
+		})
+
+		It("should restore the generations of the previous deployment when a rollback to it starts", func() {
+			kv := newKubeVirt("b", "a", &v1.KubeVirtPreviousDeployment{
+				DeploymentID: "a",
+				Generations: []v1.GenerationStatus{
+					{Resource: "daemonsets", Name: "virt-handler", LastGeneration: 3},
+					{Resource: "deployments", Name: "virt-api", LastGeneration: 2},
+				},
+			})
+			kv.Spec.RollbackTo = "a"
+			restorePreviousGenerations(kv)
+			Expect(kv.Status.Generations).To(ConsistOf(kv.Status.PreviousDeployment.Generations))
+		})
+
+		It("should not restore the generations of the previous deployment once the rollback is in progress", func() {
+			kv := newKubeVirt("b", "a", &v1.KubeVirtPreviousDeployment{
+				DeploymentID: "a",
+				Generations:  []v1.GenerationStatus{{Resource: "daemonsets", Name: "virt-handler", LastGeneration: 3}},
+			})
+			kv.Spec.RollbackTo = "a"
+			kv.Status.Conditions = []v1.KubeVirtCondition{{
+				Type:   v1.KubeVirtConditionProgressing,
+				Reason: util.ConditionReasonRollingBack,
+			}}
+			restorePreviousGenerations(kv)
+			Expect(kv.Status.Generations).To(Equal([]v1.GenerationStatus{{Resource: "daemonsets", Name: "virt-handler", LastGeneration: 1}}))
+		})
+	})
+
 	Context("when the monitor namespace does not exist", func() {
 		It("should not create ServiceMonitor resources", func() {
 
@@ -9,9 +9,11 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/util/workqueue"
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 	"kubevirt.io/client-go/log"
@@ -41,17 +43,55 @@ const (
 	CanaryUpgradeStatusFailed                  CanaryUpgradeStatus = "failed"
 )
 
-func (r *Reconciler) syncDeployment(origDeployment *appsv1.Deployment) (*appsv1.Deployment, error) {
-	kv := r.kv
-
+// prepareDeployment returns a copy of a deployment of the install strategy with the metadata, placement and
+// component settings of the KubeVirt CR applied. The replicas are left to the caller.
+func prepareDeployment(kv *v1.KubeVirt, origDeployment *appsv1.Deployment) *appsv1.Deployment {
 	deployment := origDeployment.DeepCopy()
 
-	apps := r.clientset.AppsV1()
 	imageTag, imageRegistry, id := getTargetVersionRegistryID(kv)
-
 	injectOperatorMetadata(kv, &deployment.ObjectMeta, imageTag, imageRegistry, id, true)
 	injectOperatorMetadata(kv, &deployment.Spec.Template.ObjectMeta, imageTag, imageRegistry, id, false)
 	InjectPlacementMetadata(kv.Spec.Infra, &deployment.Spec.Template.Spec, RequireControlPlanePreferNonWorker)
+	injectInfraComponentConfig(getInfraComponentConfig(kv, deployment.Name), deployment)
+
+	return deployment
+}
+
+// prepareDaemonSet returns a copy of a daemonset of the install strategy with the metadata, placement and
+// device settings of the KubeVirt CR applied.
+func prepareDaemonSet(kv *v1.KubeVirt, origDaemonSet *appsv1.DaemonSet) *appsv1.DaemonSet {
+	daemonSet := origDaemonSet.DeepCopy()
+
+	imageTag, imageRegistry, id := getTargetVersionRegistryID(kv)
+	injectOperatorMetadata(kv, &daemonSet.ObjectMeta, imageTag, imageRegistry, id, true)
+	injectOperatorMetadata(kv, &daemonSet.Spec.Template.ObjectMeta, imageTag, imageRegistry, id, false)
+	InjectPlacementMetadata(kv.Spec.Workloads, &daemonSet.Spec.Template.Spec, AnyNode)
+
+	if daemonSet.GetName() == components.VirtHandlerName {
+		setMaxDevices(kv, daemonSet)
+	}
+
+	return daemonSet
+}
+
+// preparePodDisruptionBudget returns the PodDisruptionBudget of a deployment with the component settings of the
+// KubeVirt CR applied.
+func preparePodDisruptionBudget(kv *v1.KubeVirt, deployment *appsv1.Deployment) *policyv1.PodDisruptionBudget {
+	podDisruptionBudget := components.NewPodDisruptionBudgetForDeployment(deployment)
+	injectPodDisruptionBudgetConfig(getInfraComponentConfig(kv, deployment.Name), podDisruptionBudget)
+
+	imageTag, imageRegistry, id := getTargetVersionRegistryID(kv)
+	injectOperatorMetadata(kv, &podDisruptionBudget.ObjectMeta, imageTag, imageRegistry, id, true)
+
+	return podDisruptionBudget
+}
+
+func (r *Reconciler) syncDeployment(origDeployment *appsv1.Deployment) (*appsv1.Deployment, error) {
+	kv := r.kv
+
+	deployment := prepareDeployment(kv, origDeployment)
+
+	apps := r.clientset.AppsV1()
 
 	if kv.Spec.Infra != nil && kv.Spec.Infra.Replicas != nil {
 		replicas := int32(*kv.Spec.Infra.Replicas)
@@ -261,20 +301,15 @@ func getMaxUnavailable(daemonSet *appsv1.DaemonSet) int {
 	return daemonSetDefaultMaxUnavailable.IntValue()
 }
 
-func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet) (bool, error) {
+func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet, queue workqueue.TypedRateLimitingInterface[string]) (bool, error) {
 	kv := r.kv
 
-	daemonSet = daemonSet.DeepCopy()
+	daemonSet = prepareDaemonSet(kv, daemonSet)
 
 	apps := r.clientset.AppsV1()
-	imageTag, imageRegistry, id := getTargetVersionRegistryID(kv)
 
-	injectOperatorMetadata(kv, &daemonSet.ObjectMeta, imageTag, imageRegistry, id, true)
-	injectOperatorMetadata(kv, &daemonSet.Spec.Template.ObjectMeta, imageTag, imageRegistry, id, false)
-	InjectPlacementMetadata(kv.Spec.Workloads, &daemonSet.Spec.Template.Spec, AnyNode)
-
-	if daemonSet.GetName() == "virt-handler" {
-		setMaxDevices(r.kv, daemonSet)
+	if daemonSet.GetName() == components.VirtHandlerName && !r.handlerUpdateWavesEnabled(daemonSet) {
+		r.kv.Status.HandlerUpdate = nil
 	}
 
 	var cachedDaemonSet *appsv1.DaemonSet
@@ -304,6 +339,11 @@ func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet) (bool, error) {
 		return true, nil
 	}
 
+	// staged upgrade, see KubeVirtHandlerUpdateStrategy
+	if r.handlerUpdateWavesEnabled(daemonSet) {
+		return r.processHandlerUpdateWaves(cachedDaemonSet, daemonSet, *modified, queue)
+	}
+
 	// canary pod upgrade
 	// first update virt-handler with maxUnavailable=1
 	// patch daemonSet with new version
@@ -312,7 +352,9 @@ func (r *Reconciler) syncDaemonSet(daemonSet *appsv1.DaemonSet) (bool, error) {
 	// start the rollout of the new virt-handler again
 	// wait for all nodes to complete the rollout
 	// set maxUnavailable back to 1
-	done, err, _ := r.processCanaryUpgrade(cachedDaemonSet, daemonSet, *modified)
+	// a staged upgrade which was aborted is restarted as canary upgrade
+	forceUpdate := *modified || cachedDaemonSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType
+	done, err, _ := r.processCanaryUpgrade(cachedDaemonSet, daemonSet, forceUpdate)
 	return done, err
 }
 
@@ -328,17 +370,14 @@ func setMaxDevices(kv *v1.KubeVirt, vh *appsv1.DaemonSet) {
 
 func (r *Reconciler) syncPodDisruptionBudgetForDeployment(deployment *appsv1.Deployment) error {
 	kv := r.kv
-	podDisruptionBudget := components.NewPodDisruptionBudgetForDeployment(deployment)
-
-	imageTag, imageRegistry, id := getTargetVersionRegistryID(kv)
-	injectOperatorMetadata(kv, &podDisruptionBudget.ObjectMeta, imageTag, imageRegistry, id, true)
+	podDisruptionBudget := preparePodDisruptionBudget(kv, deployment)
 
 	pdbClient := r.clientset.PolicyV1().PodDisruptionBudgets(deployment.Namespace)
 
 	var cachedPodDisruptionBudget *policyv1.PodDisruptionBudget
 	obj, exists, _ := r.stores.PodDisruptionBudgetCache.Get(podDisruptionBudget)
 
-	if podDisruptionBudget.Spec.MinAvailable.IntValue() == 0 {
+	if !podDisruptionBudgetRequired(podDisruptionBudget) {
 		var err error
 		if exists {
 			err = pdbClient.Delete(context.Background(), podDisruptionBudget.Name, metav1.DeleteOptions{})
@@ -365,9 +404,10 @@ func (r *Reconciler) syncPodDisruptionBudgetForDeployment(deployment *appsv1.Dep
 	expectedGeneration := GetExpectedGeneration(podDisruptionBudget, kv.Status.Generations)
 
 	resourcemerge.EnsureObjectMeta(modified, &existingCopy.ObjectMeta, podDisruptionBudget.ObjectMeta)
-	// there was no change to metadata or minAvailable, the generation was right
+	// there was no change to metadata, minAvailable or maxUnavailable, the generation was right
 	if !*modified &&
-		existingCopy.Spec.MinAvailable.IntValue() == podDisruptionBudget.Spec.MinAvailable.IntValue() &&
+		equality.Semantic.DeepEqual(existingCopy.Spec.MinAvailable, podDisruptionBudget.Spec.MinAvailable) &&
+		equality.Semantic.DeepEqual(existingCopy.Spec.MaxUnavailable, podDisruptionBudget.Spec.MaxUnavailable) &&
 		existingCopy.ObjectMeta.Generation == expectedGeneration {
 		log.Log.V(4).Infof("poddisruptionbudget %v is up-to-date", cachedPodDisruptionBudget.GetName())
 		return nil
@@ -389,6 +429,80 @@ func (r *Reconciler) syncPodDisruptionBudgetForDeployment(deployment *appsv1.Dep
 	return nil
 }
 
+// getInfraComponentConfig returns the configuration of the infra component deployed under the given name
+func getInfraComponentConfig(kv *v1.KubeVirt, name string) *v1.InfraComponentConfig {
+	if kv.Spec.Infra == nil {
+		return nil
+	}
+	for i := range kv.Spec.Infra.Components {
+		if kv.Spec.Infra.Components[i].Name == name {
+			return &kv.Spec.Infra.Components[i]
+		}
+	}
+	return nil
+}
+
+func injectInfraComponentConfig(componentConfig *v1.InfraComponentConfig, deployment *appsv1.Deployment) {
+	if componentConfig == nil {
+		return
+	}
+	podSpec := &deployment.Spec.Template.Spec
+
+	for _, constraint := range componentConfig.TopologySpreadConstraints {
+		constraint := *constraint.DeepCopy()
+		// Spread the pods of the component unless the admin asked for something else
+		if constraint.LabelSelector == nil {
+			constraint.LabelSelector = deployment.Spec.Selector.DeepCopy()
+		}
+		podSpec.TopologySpreadConstraints = append(podSpec.TopologySpreadConstraints, constraint)
+	}
+
+	if componentConfig.Resources != nil && len(podSpec.Containers) > 0 {
+		resources := &podSpec.Containers[0].Resources
+		resources.Requests = mergeResourceList(resources.Requests, componentConfig.Resources.Requests)
+		resources.Limits = mergeResourceList(resources.Limits, componentConfig.Resources.Limits)
+	}
+}
+
+func mergeResourceList(resources, overrides corev1.ResourceList) corev1.ResourceList {
+	if len(overrides) == 0 {
+		return resources
+	}
+	if resources == nil {
+		resources = corev1.ResourceList{}
+	}
+	for name, quantity := range overrides {
+		resources[name] = quantity.DeepCopy()
+	}
+	return resources
+}
+
+func injectPodDisruptionBudgetConfig(componentConfig *v1.InfraComponentConfig, podDisruptionBudget *policyv1.PodDisruptionBudget) {
+	if componentConfig == nil || componentConfig.PodDisruptionBudget == nil {
+		return
+	}
+
+	config := componentConfig.PodDisruptionBudget
+	if config.MaxUnavailable != nil {
+		podDisruptionBudget.Spec.MinAvailable = nil
+		podDisruptionBudget.Spec.MaxUnavailable = pointer.P(*config.MaxUnavailable)
+	} else if config.MinAvailable != nil {
+		podDisruptionBudget.Spec.MinAvailable = pointer.P(*config.MinAvailable)
+	}
+}
+
+// podDisruptionBudgetRequired returns false if the PodDisruptionBudget would never prevent an eviction
+func podDisruptionBudgetRequired(podDisruptionBudget *policyv1.PodDisruptionBudget) bool {
+	if podDisruptionBudget.Spec.MaxUnavailable != nil {
+		return true
+	}
+	minAvailable := podDisruptionBudget.Spec.MinAvailable
+	if minAvailable == nil {
+		return false
+	}
+	return minAvailable.Type == intstr.String || minAvailable.IntValue() > 0
+}
+
 func getDesiredApiReplicas(clientset kubecli.KubevirtClient) (replicas int32, err error) {
 	nodeList, err := clientset.CoreV1().Nodes().List(context.Background(), metav1.ListOptions{})
 	if err != nil {
@@ -24,8 +24,10 @@ import (
 	"encoding/json"
 	"fmt"
 	"strings"
+	"time"
 
 	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/testutils"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/rbac"
 
@@ -36,6 +38,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	v12 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -44,6 +47,7 @@ import (
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
 
 	secv1 "github.com/openshift/api/security/v1"
 	secv1fake "github.com/openshift/client-go/security/clientset/versioned/typed/security/v1/fake"
@@ -231,6 +235,7 @@ var _ = Describe("Apply Apps", func() {
 		var mockDSCacheStore *MockStore
 		var mockPodCacheStore *cache.FakeCustomStore
 		var dsClient *fake.Clientset
+		var queue workqueue.TypedRateLimitingInterface[string]
 
 		var ctrl *gomock.Controller
 
@@ -304,6 +309,7 @@ var _ = Describe("Apply Apps", func() {
 			kvInterface := kubecli.NewMockKubeVirtInterface(ctrl)
 
 			dsClient = fake.NewSimpleClientset()
+			queue = workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]())
 
 			stores = util.Stores{}
 			mockDSCacheStore = &MockStore{}
@@ -379,7 +385,7 @@ var _ = Describe("Apply Apps", func() {
 					return true, update.GetObject(), nil
 				})
 
-				_, err = r.syncDaemonSet(daemonSet)
+				_, err = r.syncDaemonSet(daemonSet, queue)
 
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeTrue())
@@ -434,7 +440,7 @@ var _ = Describe("Apply Apps", func() {
 					return true, &appsv1.DaemonSet{}, nil
 				})
 
-				_, err = r.syncDaemonSet(daemonSet)
+				_, err = r.syncDaemonSet(daemonSet, queue)
 
 				Expect(patched).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
@@ -445,7 +451,7 @@ var _ = Describe("Apply Apps", func() {
 				containMaxDeviceFlag = false
 				kv.SetGeneration(3)
 
-				_, err = r.syncDaemonSet(daemonSet)
+				_, err = r.syncDaemonSet(daemonSet, queue)
 
 				Expect(patched).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
@@ -510,7 +516,7 @@ var _ = Describe("Apply Apps", func() {
 
 				newDs := daemonSet.DeepCopy()
 				addCustomTargetDeployment(kv, newDs)
-				done, err := r.syncDaemonSet(newDs)
+				done, err := r.syncDaemonSet(newDs, queue)
 
 				Expect(patched).To(BeTrue())
 				Expect(err).ToNot(HaveOccurred())
@@ -694,6 +700,207 @@ var _ = Describe("Apply Apps", func() {
 			)
 		})
 
+		Context("staged virt-handler upgrade", func() {
+			var r *Reconciler
+			var vmiStore cache.Store
+			var deletedPods []string
+
+			newNode := func(name, pool string) {
+				Expect(stores.NodeCache.Add(&corev1.Node{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{"pool": pool}},
+				})).To(Succeed())
+			}
+
+			newHandlerPod := func(node string, upToDate bool) *corev1.Pod {
+				pod := createDaemonSetPod(kv, daemonSet, corev1.PodRunning, true)
+				pod.Name = "virt-handler-" + node
+				pod.Namespace = Namespace
+				pod.Spec.NodeName = node
+				if !upToDate {
+					pod.Annotations[v1.InstallStrategyIdentifierAnnotation] = "old.id"
+				}
+				return pod
+			}
+
+			setHandlerPods := func(pods ...*corev1.Pod) {
+				mockPodCacheStore.ListFunc = func() []interface{} {
+					objs := []interface{}{}
+					for _, pod := range pods {
+						objs = append(objs, pod)
+					}
+					return objs
+				}
+			}
+
+			BeforeEach(func() {
+				kv.Status.TargetKubeVirtVersion = Version
+				kv.Status.TargetKubeVirtRegistry = Registry
+				kv.Status.TargetDeploymentID = Id
+				kv.Spec.HandlerUpdateStrategy = &v1.KubeVirtHandlerUpdateStrategy{
+					Waves: []v1.HandlerUpdateWave{
+						{Name: "canary", NodeSelector: map[string]string{"pool": "canary"}},
+						{Name: "main", NodeSelector: map[string]string{"pool": "main"}},
+					},
+				}
+				injectOperatorMetadata(kv, &daemonSet.ObjectMeta, Version, Registry, Id, true)
+				daemonSet.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{Type: appsv1.OnDeleteDaemonSetStrategyType}
+
+				stores.NodeCache = cache.NewStore(cache.MetaNamespaceKeyFunc)
+				newNode("node01", "canary")
+				newNode("node02", "canary")
+				newNode("node03", "main")
+
+				vmiStore = cache.NewStore(cache.MetaNamespaceKeyFunc)
+				stores.VirtualMachineInstanceCache = vmiStore
+				clientset.EXPECT().CoreV1().Return(dsClient.CoreV1()).AnyTimes()
+
+				deletedPods = nil
+				dsClient.Fake.PrependReactor("delete", "pods", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					deletedPods = append(deletedPods, action.(testing.DeleteAction).GetName())
+					return true, nil, nil
+				})
+
+				r = &Reconciler{
+					clientset:    clientset,
+					kv:           kv,
+					expectations: expectations,
+					stores:       stores,
+					recorder:     record.NewFakeRecorder(100),
+				}
+			})
+
+			It("should switch the DaemonSet to the OnDelete strategy", func() {
+				currentDs := daemonSet.DeepCopy()
+				currentDs.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType}
+				var patchedSpec *appsv1.DaemonSetSpec
+				dsClient.Fake.PrependReactor("patch", "daemonsets", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					patches, err := patch.UnmarshalPatch(action.(testing.PatchAction).GetPatch())
+					Expect(err).ToNot(HaveOccurred())
+					for _, v := range patches {
+						if v.Path == "/spec" {
+							patchedSpec = &appsv1.DaemonSetSpec{}
+							spec, err := json.Marshal(v.Value)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(json.Unmarshal(spec, patchedSpec)).To(Succeed())
+						}
+					}
+					return true, &appsv1.DaemonSet{}, nil
+				})
+
+				done, err := r.processHandlerUpdateWaves(currentDs, daemonSet.DeepCopy(), false, queue)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(done).To(BeFalse())
+				Expect(patchedSpec).ToNot(BeNil())
+				Expect(patchedSpec.UpdateStrategy.Type).To(Equal(appsv1.OnDeleteDaemonSetStrategyType))
+				Expect(kv.Status.HandlerUpdate).ToNot(BeNil())
+				Expect(kv.Status.HandlerUpdate.TargetDeploymentID).To(Equal(Id))
+				Expect(kv.Status.HandlerUpdate.CurrentWave).To(BeZero())
+				Expect(kv.Status.HandlerUpdate.Waves).To(HaveLen(2))
+			})
+
+			It("should only replace outdated pods on the nodes of the current wave", func() {
+				setHandlerPods(newHandlerPod("node01", false), newHandlerPod("node02", false), newHandlerPod("node03", false))
+
+				done, err := r.processHandlerUpdateWaves(daemonSet, daemonSet.DeepCopy(), false, queue)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(done).To(BeFalse())
+				Expect(deletedPods).To(Equal([]string{"virt-handler-node01"}))
+				wave := kv.Status.HandlerUpdate.Waves[0]
+				Expect(wave.Nodes).To(Equal([]string{"node01", "node02"}))
+				Expect(wave.StartTime).ToNot(BeNil())
+				Expect(wave.CompletionTime).To(BeNil())
+			})
+
+			It("should respect maxUnavailable within the wave", func() {
+				kv.Spec.HandlerUpdateStrategy.MaxUnavailable = pointer.P(intstr.FromString("100%"))
+				notReady := newHandlerPod("node01", true)
+				notReady.Status.ContainerStatuses[0].Ready = false
+				setHandlerPods(notReady, newHandlerPod("node02", false), newHandlerPod("node03", false))
+
+				_, err := r.processHandlerUpdateWaves(daemonSet, daemonSet.DeepCopy(), false, queue)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deletedPods).To(Equal([]string{"virt-handler-node02"}))
+
+				kv.Spec.HandlerUpdateStrategy.MaxUnavailable = nil
+				deletedPods = nil
+				_, err = r.processHandlerUpdateWaves(daemonSet, daemonSet.DeepCopy(), false, queue)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deletedPods).To(BeEmpty())
+			})
+
+			It("should halt the rollout when a VMI failed on the nodes of the wave", func() {
+				setHandlerPods(newHandlerPod("node01", true), newHandlerPod("node02", true), newHandlerPod("node03", false))
+				vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default"}}
+				vmi.Status.Phase = v1.Failed
+				vmi.Status.NodeName = "node02"
+				vmi.Status.PhaseTransitionTimestamps = []v1.VirtualMachineInstancePhaseTransitionTimestamp{
+					{Phase: v1.Failed, PhaseTransitionTimestamp: metav1.NewTime(time.Now().Add(time.Minute))},
+				}
+				Expect(vmiStore.Add(vmi)).To(Succeed())
+
+				_, err := r.processHandlerUpdateWaves(daemonSet, daemonSet.DeepCopy(), false, queue)
+				Expect(err).To(MatchError(ContainSubstring("default/testvmi")))
+				Expect(kv.Status.HandlerUpdate.CurrentWave).To(BeZero())
+				Expect(kv.Status.HandlerUpdate.Waves[0].CompletionTime).To(BeNil())
+
+				By("resuming the rollout once the failed VMI is deleted")
+				Expect(vmiStore.Delete(vmi)).To(Succeed())
+				_, err = r.processHandlerUpdateWaves(daemonSet, daemonSet.DeepCopy(), false, queue)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(kv.Status.HandlerUpdate.Waves[0].CompletionTime).ToNot(BeNil())
+				Expect(kv.Status.HandlerUpdate.CurrentWave).To(Equal(1))
+			})
+
+			DescribeTable("should move on to the next wave", func(pause time.Duration, expectedWave int) {
+				setHandlerPods(newHandlerPod("node01", true), newHandlerPod("node02", true), newHandlerPod("node03", false))
+				kv.Spec.HandlerUpdateStrategy.PauseBetweenWaves = &metav1.Duration{Duration: pause}
+				vmi := &v1.VirtualMachineInstance{ObjectMeta: metav1.ObjectMeta{Name: "testvmi", Namespace: "default"}}
+				vmi.Status.Phase = v1.Failed
+				vmi.Status.NodeName = "node03"
+				Expect(vmiStore.Add(vmi)).To(Succeed())
+
+				_, err := r.processHandlerUpdateWaves(daemonSet, daemonSet.DeepCopy(), false, queue)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(deletedPods).To(BeEmpty())
+				Expect(kv.Status.HandlerUpdate.Waves[0].CompletionTime).ToNot(BeNil())
+				Expect(kv.Status.HandlerUpdate.CurrentWave).To(Equal(expectedWave))
+			},
+				Entry("right away without a pause", time.Duration(0), 1),
+				Entry("only after the pause", time.Hour, 0),
+			)
+
+			It("should hand the remaining nodes over to the regular rollout after the last wave", func() {
+				kv.Status.HandlerUpdate = &v1.HandlerUpdateStatus{
+					TargetDeploymentID: Id,
+					CurrentWave:        2,
+					Waves:              []v1.HandlerUpdateWaveStatus{{Name: "canary"}, {Name: "main"}},
+				}
+				var rollingUpdate *appsv1.RollingUpdateDaemonSet
+				dsClient.Fake.PrependReactor("patch", "daemonsets", func(action testing.Action) (handled bool, obj runtime.Object, err error) {
+					patches, err := patch.UnmarshalPatch(action.(testing.PatchAction).GetPatch())
+					Expect(err).ToNot(HaveOccurred())
+					for _, v := range patches {
+						if v.Path == "/spec" {
+							patchedSpec := &appsv1.DaemonSetSpec{}
+							spec, err := json.Marshal(v.Value)
+							Expect(err).ToNot(HaveOccurred())
+							Expect(json.Unmarshal(spec, patchedSpec)).To(Succeed())
+							rollingUpdate = patchedSpec.UpdateStrategy.RollingUpdate
+						}
+					}
+					return true, &appsv1.DaemonSet{}, nil
+				})
+
+				newDs := daemonSet.DeepCopy()
+				newDs.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{Type: appsv1.RollingUpdateDaemonSetStrategyType}
+				done, err := r.processHandlerUpdateWaves(daemonSet, newDs, false, queue)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(done).To(BeFalse())
+				Expect(rollingUpdate).ToNot(BeNil())
+				Expect(rollingUpdate.MaxUnavailable.String()).To(Equal("10%"))
+			})
+		})
+
 	})
 
 	Context("Injecting Metadata", func() {
@@ -733,6 +940,67 @@ var _ = Describe("Apply Apps", func() {
 		})
 	})
 
+	Context("on calling injectInfraComponentConfig", func() {
+		var deployment *appsv1.Deployment
+
+		BeforeEach(func() {
+			deployment = components.NewApiServerDeployment(Namespace, Registry, "", Version, "", "", "", "", corev1.PullIfNotPresent, nil, "2", nil)
+		})
+
+		It("should leave the deployment untouched without configuration", func() {
+			expected := deployment.DeepCopy()
+			injectInfraComponentConfig(nil, deployment)
+			Expect(deployment).To(Equal(expected))
+		})
+
+		It("should spread the pods of the component by default", func() {
+			injectInfraComponentConfig(&v1.InfraComponentConfig{
+				Name: components.VirtAPIName,
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       corev1.LabelTopologyZone,
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+				}},
+			}, deployment)
+
+			constraints := deployment.Spec.Template.Spec.TopologySpreadConstraints
+			Expect(constraints).To(HaveLen(1))
+			Expect(constraints[0].TopologyKey).To(Equal(corev1.LabelTopologyZone))
+			Expect(constraints[0].LabelSelector).To(Equal(deployment.Spec.Selector))
+		})
+
+		It("should keep a configured label selector", func() {
+			selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "custom"}}
+			injectInfraComponentConfig(&v1.InfraComponentConfig{
+				Name: components.VirtAPIName,
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       corev1.LabelHostname,
+					WhenUnsatisfiable: corev1.DoNotSchedule,
+					LabelSelector:     selector,
+				}},
+			}, deployment)
+
+			Expect(deployment.Spec.Template.Spec.TopologySpreadConstraints[0].LabelSelector).To(Equal(selector))
+		})
+
+		It("should only override the configured resources", func() {
+			defaultCPURequest := deployment.Spec.Template.Spec.Containers[0].Resources.Requests[corev1.ResourceCPU]
+			injectInfraComponentConfig(&v1.InfraComponentConfig{
+				Name: components.VirtAPIName,
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("2Gi")},
+				},
+			}, deployment)
+
+			resources := deployment.Spec.Template.Spec.Containers[0].Resources
+			Expect(resources.Requests).To(HaveKeyWithValue(corev1.ResourceCPU, defaultCPURequest))
+			Expect(resources.Requests).To(HaveKeyWithValue(corev1.ResourceMemory, resource.MustParse("1Gi")))
+			Expect(resources.Limits).To(HaveKeyWithValue(corev1.ResourceMemory, resource.MustParse("2Gi")))
+		})
+	})
+
 	Context("on calling InjectPlacementMetadata", func() {
 		var componentConfig *v1.ComponentConfig
 		var nodePlacement *v1.NodePlacement
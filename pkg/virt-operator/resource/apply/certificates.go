@@ -78,3 +78,11 @@ func GetCertRenewBefore(config *k8sv1.KubeVirtSelfSignConfiguration) *metav1.Dur
 
 	return defaultDuration
 }
+
+func GetExpiryWarningThreshold(config *k8sv1.KubeVirtExternalCertificateConfiguration) *metav1.Duration {
+	if config == nil || config.ExpiryWarningThreshold == nil {
+		return &metav1.Duration{Duration: Duration7d}
+	}
+
+	return config.ExpiryWarningThreshold
+}
@@ -0,0 +1,203 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+// configurationRecheckInterval is how often a configuration with warnings is validated again,
+// the cluster may have caught up with it in the meantime
+const configurationRecheckInterval = 5 * time.Minute
+
+// SyncConfigurationHistory records spec.configuration in a ControllerRevision whenever it changes and
+// reports settings which don't match the cluster in the ConfigurationValid condition.
+func (r *Reconciler) SyncConfigurationHistory(queue workqueue.TypedRateLimitingInterface[string]) error {
+	name, err := util.ConfigurationRevisionName(&r.kv.Spec.Configuration)
+	if err != nil {
+		return err
+	}
+
+	if r.kv.Status.ConfigurationRevision == name && !util.HasConfigurationWarnings(r.kv) {
+		return nil
+	}
+
+	if r.kv.Status.ConfigurationRevision != name {
+		if err := r.recordConfigurationRevision(name); err != nil {
+			return fmt.Errorf("unable to record the configuration revision %s: %v", name, err)
+		}
+		r.kv.Status.ConfigurationRevision = name
+		log.Log.Object(r.kv).Infof("Recorded configuration revision %s", name)
+	}
+
+	warnings, err := r.validateConfiguration()
+	if err != nil {
+		return err
+	}
+	if len(warnings) == 0 {
+		util.UpdateConditionsConfigurationValid(r.kv)
+		return nil
+	}
+
+	util.UpdateConditionsConfigurationWarnings(r.kv, warnings)
+	queue.AddAfter(r.kvKey, configurationRecheckInterval)
+	return nil
+}
+
+func (r *Reconciler) recordConfigurationRevision(name string) error {
+	revisionClient := r.clientset.AppsV1().ControllerRevisions(r.kv.Namespace)
+	revisions, err := revisionClient.List(context.Background(), metav1.ListOptions{LabelSelector: util.ConfigurationRevisionLabel})
+	if err != nil {
+		return err
+	}
+	util.SortConfigurationRevisions(revisions.Items)
+
+	history := revisions.Items
+	var latest int64
+	if len(history) > 0 {
+		latest = history[len(history)-1].Revision
+	}
+
+	existing := -1
+	for i := range history {
+		if history[i].Name == name {
+			existing = i
+			break
+		}
+	}
+
+	switch {
+	case existing < 0:
+		revision, err := util.NewConfigurationRevision(r.kv, latest+1)
+		if err != nil {
+			return err
+		}
+		if _, err := revisionClient.Create(context.Background(), revision, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+	case existing == len(history)-1:
+		// the configuration is already the latest revision
+		history = history[:existing]
+	default:
+		// the configuration was reverted, move its revision to the top of the history
+		patchBytes, err := patch.New(patch.WithReplace("/revision", latest+1)).GeneratePayload()
+		if err != nil {
+			return err
+		}
+		if _, err := revisionClient.Patch(context.Background(), name, types.JSONPatchType, patchBytes, metav1.PatchOptions{}); err != nil {
+			return err
+		}
+		history = append(history[:existing], history[existing+1:]...)
+	}
+
+	// history still holds the older revisions only, the latest one is always kept
+	for len(history) >= util.ConfigurationHistoryLimit {
+		if err := revisionClient.Delete(context.Background(), history[0].Name, metav1.DeleteOptions{}); err != nil && !errors.IsNotFound(err) {
+			return err
+		}
+		history = history[1:]
+	}
+
+	return nil
+}
+
+// validateConfiguration checks the settings of spec.configuration which refer to objects or resources in
+// the cluster. The configuration is applied regardless, mismatches are only reported as warnings.
+func (r *Reconciler) validateConfiguration() ([]string, error) {
+	config := &r.kv.Spec.Configuration
+	var warnings []string
+
+	if hostDevices := config.PermittedHostDevices; hostDevices != nil {
+		var nodes []corev1.Node
+		for _, obj := range r.stores.NodeCache.List() {
+			nodes = append(nodes, *obj.(*corev1.Node))
+		}
+		for _, resourceName := range permittedHostDeviceResources(hostDevices) {
+			if !resourceAllocatable(nodes, resourceName) {
+				warnings = append(warnings, fmt.Sprintf("permitted host device %s is not allocatable on any node", resourceName))
+			}
+		}
+	}
+
+	if migrations := config.MigrationConfiguration; migrations != nil && migrations.Network != nil {
+		_, err := r.clientset.NetworkClient().K8sCniCncfIoV1().NetworkAttachmentDefinitions(r.kv.Namespace).Get(context.Background(), *migrations.Network, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			warnings = append(warnings, fmt.Sprintf("migration network %s/%s does not exist", r.kv.Namespace, *migrations.Network))
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to get the migration network: %v", err)
+		}
+	}
+
+	if config.VMStateStorageClass != "" {
+		_, err := r.clientset.StorageV1().StorageClasses().Get(context.Background(), config.VMStateStorageClass, metav1.GetOptions{})
+		if errors.IsNotFound(err) {
+			warnings = append(warnings, fmt.Sprintf("VM state storage class %s does not exist", config.VMStateStorageClass))
+		} else if err != nil {
+			return nil, fmt.Errorf("unable to get the VM state storage class: %v", err)
+		}
+	}
+
+	return warnings, nil
+}
+
+// permittedHostDeviceResources returns the resources of the permitted host devices which are advertised by KubeVirt.
+// Devices with an external resource provider are skipped, their device plugin may be deployed at any time.
+func permittedHostDeviceResources(hostDevices *v1.PermittedHostDevices) []string {
+	var resourceNames []string
+	for _, device := range hostDevices.PciHostDevices {
+		if !device.ExternalResourceProvider {
+			resourceNames = append(resourceNames, device.ResourceName)
+		}
+	}
+	for _, device := range hostDevices.MediatedDevices {
+		if !device.ExternalResourceProvider {
+			resourceNames = append(resourceNames, device.ResourceName)
+		}
+	}
+	for _, device := range hostDevices.USB {
+		if !device.ExternalResourceProvider {
+			resourceNames = append(resourceNames, device.ResourceName)
+		}
+	}
+	return resourceNames
+}
+
+func resourceAllocatable(nodes []corev1.Node, resourceName string) bool {
+	for _, node := range nodes {
+		if quantity, ok := node.Status.Allocatable[corev1.ResourceName(resourceName)]; ok && !quantity.IsZero() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,208 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	fakenetworkclient "kubevirt.io/client-go/networkattachmentdefinitionclient/fake"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+var _ = Describe("Configuration history", func() {
+	const namespace = "kubevirt"
+
+	var (
+		kv         *v1.KubeVirt
+		kubeClient *fake.Clientset
+		queue      workqueue.TypedRateLimitingInterface[string]
+		r          *Reconciler
+	)
+
+	listRevisions := func() []appsv1.ControllerRevision {
+		revisions, err := kubeClient.AppsV1().ControllerRevisions(namespace).List(context.Background(), metav1.ListOptions{LabelSelector: util.ConfigurationRevisionLabel})
+		Expect(err).ToNot(HaveOccurred())
+		util.SortConfigurationRevisions(revisions.Items)
+		return revisions.Items
+	}
+
+	setMemoryOvercommit := func(overcommit int) {
+		kv.Spec.Configuration.DeveloperConfiguration = &v1.DeveloperConfiguration{MemoryOvercommit: overcommit}
+		Expect(r.SyncConfigurationHistory(queue)).To(Succeed())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubeClient = fake.NewSimpleClientset()
+		clientset := kubecli.NewMockKubevirtClient(ctrl)
+		clientset.EXPECT().AppsV1().Return(kubeClient.AppsV1()).AnyTimes()
+		clientset.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+		clientset.EXPECT().StorageV1().Return(kubeClient.StorageV1()).AnyTimes()
+		clientset.EXPECT().NetworkClient().Return(fakenetworkclient.NewSimpleClientset()).AnyTimes()
+		queue = workqueue.NewTypedRateLimitingQueue[string](workqueue.DefaultTypedControllerRateLimiter[string]())
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: namespace, UID: "kv-uid"},
+		}
+		r = &Reconciler{
+			kv:        kv,
+			kvKey:     namespace + "/kubevirt",
+			clientset: clientset,
+			stores:    util.Stores{NodeCache: cache.NewStore(cache.MetaNamespaceKeyFunc)},
+		}
+	})
+
+	It("should record the initial configuration", func() {
+		Expect(r.SyncConfigurationHistory(queue)).To(Succeed())
+
+		revisions := listRevisions()
+		Expect(revisions).To(HaveLen(1))
+		Expect(revisions[0].Revision).To(BeEquivalentTo(1))
+		Expect(revisions[0].Name).To(Equal(kv.Status.ConfigurationRevision))
+		Expect(revisions[0].OwnerReferences).To(ConsistOf(HaveField("UID", kv.UID)))
+		Expect(kv.Status.Conditions).To(ContainElement(And(
+			HaveField("Type", v1.KubeVirtConditionConfigurationValid),
+			HaveField("Status", corev1.ConditionTrue),
+		)))
+	})
+
+	It("should not touch the history when the configuration did not change", func() {
+		Expect(r.SyncConfigurationHistory(queue)).To(Succeed())
+		kubeClient.ClearActions()
+
+		Expect(r.SyncConfigurationHistory(queue)).To(Succeed())
+		Expect(kubeClient.Actions()).To(BeEmpty())
+	})
+
+	It("should add a revision for every change", func() {
+		setMemoryOvercommit(100)
+		setMemoryOvercommit(150)
+
+		revisions := listRevisions()
+		Expect(revisions).To(HaveLen(2))
+		Expect(revisions[1].Revision).To(BeEquivalentTo(2))
+		Expect(revisions[1].Name).To(Equal(kv.Status.ConfigurationRevision))
+
+		config, err := util.ConfigurationFromRevision(&revisions[1])
+		Expect(err).ToNot(HaveOccurred())
+		Expect(config.DeveloperConfiguration.MemoryOvercommit).To(Equal(150))
+	})
+
+	It("should move a reverted configuration to the top of the history", func() {
+		setMemoryOvercommit(100)
+		setMemoryOvercommit(150)
+		setMemoryOvercommit(100)
+
+		revisions := listRevisions()
+		Expect(revisions).To(HaveLen(2))
+		Expect(revisions[1].Revision).To(BeEquivalentTo(3))
+		Expect(revisions[1].Name).To(Equal(kv.Status.ConfigurationRevision))
+	})
+
+	It("should keep a bounded history", func() {
+		for i := 0; i < util.ConfigurationHistoryLimit+3; i++ {
+			setMemoryOvercommit(100 + i)
+		}
+
+		revisions := listRevisions()
+		Expect(revisions).To(HaveLen(util.ConfigurationHistoryLimit))
+		Expect(revisions[0].Revision).To(BeEquivalentTo(4))
+		Expect(revisions[len(revisions)-1].Name).To(Equal(kv.Status.ConfigurationRevision))
+	})
+
+	Context("validation", func() {
+		expectWarnings := func(warnings ...string) {
+			Expect(kv.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", v1.KubeVirtConditionConfigurationValid),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", util.ConditionReasonConfigurationWarnings),
+			)))
+			for _, warning := range warnings {
+				Expect(kv.Status.Conditions).To(ContainElement(HaveField("Message", ContainSubstring(warning))))
+			}
+		}
+
+		It("should warn about permitted host devices which no node provides", func() {
+			Expect(r.stores.NodeCache.Add(&corev1.Node{
+				ObjectMeta: metav1.ObjectMeta{Name: "node01"},
+				Status: corev1.NodeStatus{
+					Allocatable: corev1.ResourceList{"nvidia.com/GP102GL": resource.MustParse("1")},
+				},
+			})).To(Succeed())
+
+			kv.Spec.Configuration.PermittedHostDevices = &v1.PermittedHostDevices{
+				PciHostDevices: []v1.PciHostDevice{
+					{PCIVendorSelector: "10DE:1B80", ResourceName: "nvidia.com/GP102GL"},
+					{PCIVendorSelector: "10DE:1EB8", ResourceName: "nvidia.com/TU104GL"},
+					{PCIVendorSelector: "10DE:2236", ResourceName: "nvidia.com/GA102GL", ExternalResourceProvider: true},
+				},
+			}
+			Expect(r.SyncConfigurationHistory(queue)).To(Succeed())
+
+			expectWarnings("permitted host device nvidia.com/TU104GL is not allocatable on any node")
+			Expect(kv.Status.Conditions).ToNot(ContainElement(HaveField("Message", ContainSubstring("GP102GL"))))
+			Expect(kv.Status.Conditions).ToNot(ContainElement(HaveField("Message", ContainSubstring("GA102GL"))))
+		})
+
+		It("should warn about missing referenced objects", func() {
+			kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{Network: pointer.P("migration-net")}
+			kv.Spec.Configuration.VMStateStorageClass = "tpm-state"
+			Expect(r.SyncConfigurationHistory(queue)).To(Succeed())
+
+			expectWarnings(
+				"migration network kubevirt/migration-net does not exist",
+				"VM state storage class tpm-state does not exist",
+			)
+		})
+
+		It("should clear the warnings once the cluster caught up", func() {
+			kv.Spec.Configuration.VMStateStorageClass = "tpm-state"
+			Expect(r.SyncConfigurationHistory(queue)).To(Succeed())
+			expectWarnings("VM state storage class tpm-state does not exist")
+
+			_, err := kubeClient.StorageV1().StorageClasses().Create(context.Background(), &storagev1.StorageClass{
+				ObjectMeta: metav1.ObjectMeta{Name: "tpm-state"},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(r.SyncConfigurationHistory(queue)).To(Succeed())
+			Expect(kv.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", v1.KubeVirtConditionConfigurationValid),
+				HaveField("Status", corev1.ConditionTrue),
+			)))
+		})
+	})
+})
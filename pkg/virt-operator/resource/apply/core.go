@@ -307,6 +307,11 @@ func (r *Reconciler) createOrUpdateCertificateSecrets(queue workqueue.TypedRateL
 }
 
 func (r *Reconciler) createOrUpdateComponentsWithCertificates(queue workqueue.TypedRateLimitingInterface[string]) error {
+	if r.kv.Spec.CertificateRotationStrategy.External != nil {
+		return r.createOrUpdateComponentsWithExternalCertificates(queue)
+	}
+	util.RemoveConditionCertificatesReady(r.kv)
+
 	caDuration := GetCADuration(r.kv.Spec.CertificateRotationStrategy.SelfSigned)
 	caExportDuration := GetCADuration(r.kv.Spec.CertificateRotationStrategy.SelfSigned)
 	caRenewBefore := GetCARenewBefore(r.kv.Spec.CertificateRotationStrategy.SelfSigned)
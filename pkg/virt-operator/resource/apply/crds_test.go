@@ -164,4 +164,47 @@ var _ = Describe("Apply CRDs", func() {
 
 		Expect(r.rolloutNonCompatibleCRDChanges()).To(Succeed())
 	})
+
+	Context("rollback", func() {
+		newCrd := func(name string, versions ...string) *extv1.CustomResourceDefinition {
+			crd := &extv1.CustomResourceDefinition{
+				TypeMeta: v12.TypeMeta{
+					APIVersion: extv1.SchemeGroupVersion.String(),
+					Kind:       "CustomResourceDefinition",
+				},
+				ObjectMeta: v12.ObjectMeta{
+					Name: name,
+				},
+			}
+			for _, version := range versions {
+				crd.Spec.Versions = append(crd.Spec.Versions, extv1.CustomResourceDefinitionVersion{Name: version, Served: true})
+			}
+			return crd
+		}
+
+		DescribeTable("should verify that the target install strategy can read all stored objects", func(deployedCrd *extv1.CustomResourceDefinition, storedVersions []string, shouldSucceed bool) {
+			targetStrategy := loadTargetStrategy(newCrd("test", "v1alpha3", "v1"), config, stores)
+
+			deployedCrd.Status.StoredVersions = storedVersions
+			Expect(stores.OperatorCrdCache.Add(deployedCrd)).To(Succeed())
+
+			r := &Reconciler{
+				kv:             kv,
+				targetStrategy: targetStrategy,
+				stores:         stores,
+				clientset:      clientset,
+				expectations:   expectations,
+			}
+
+			if shouldSucceed {
+				Expect(r.VerifyRollback()).To(Succeed())
+			} else {
+				Expect(r.VerifyRollback()).ToNot(Succeed())
+			}
+		},
+			Entry("with objects stored in a served version", newCrd("test", "v1alpha3", "v1"), []string{"v1alpha3", "v1"}, true),
+			Entry("with objects stored in an unknown version", newCrd("test", "v1alpha3", "v1", "v2"), []string{"v1", "v2"}, false),
+			Entry("with a crd which is not part of the target install strategy", newCrd("unknown", "v1"), []string{"v1"}, false),
+		)
+	})
 })
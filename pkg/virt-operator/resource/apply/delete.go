@@ -31,6 +31,7 @@ import (
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
@@ -158,6 +159,25 @@ func DeleteAll(kv *v1.KubeVirt,
 		}
 	}
 
+	// delete networkPolicies
+	objects = stores.NetworkPolicyCache.List()
+	for _, obj := range objects {
+		if networkPolicy, ok := obj.(*networkingv1.NetworkPolicy); ok && networkPolicy.DeletionTimestamp == nil {
+			if key, err := controller.KeyFunc(networkPolicy); err == nil {
+				expectations.NetworkPolicy.AddExpectedDeletion(kvkey, key)
+				err = clientset.NetworkingV1().NetworkPolicies(networkPolicy.Namespace).Delete(context.Background(), networkPolicy.Name, metav1.DeleteOptions{})
+				if err != nil {
+					expectations.NetworkPolicy.DeletionObserved(kvkey, key)
+					log.Log.Errorf(deleteFailedFmt, networkPolicy.Name, err)
+					return err
+				}
+			}
+		} else if !ok {
+			log.Log.Errorf(castFailedFmt, obj)
+			return nil
+		}
+	}
+
 	// delete deployments
 	objects = stores.DeploymentCache.List()
 	for _, obj := range objects {
@@ -385,6 +405,11 @@ func DeleteAll(kv *v1.KubeVirt,
 		}
 	}
 
+	err = deleteCertManagerCertificates(kv, clientset, deleteOptions)
+	if err != nil {
+		return err
+	}
+
 	objects = stores.SecretCache.List()
 	for _, obj := range objects {
 		if secret, ok := obj.(*corev1.Secret); ok && secret.DeletionTimestamp == nil {
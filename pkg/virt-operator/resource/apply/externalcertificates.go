@@ -0,0 +1,354 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"time"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourcemerge"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+// externalCertificateRecheckInterval is how often externally managed certificates are validated again.
+// They are replaced without virt-operator being involved, so their expiry has to be polled.
+const externalCertificateRecheckInterval = 5 * time.Minute
+
+var certManagerCertificateGVR = schema.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+func (r *Reconciler) createOrUpdateComponentsWithExternalCertificates(queue workqueue.TypedRateLimitingInterface[string]) error {
+	external := r.kv.Spec.CertificateRotationStrategy.External
+	queue.AddAfter(r.kvKey, externalCertificateRecheckInterval)
+
+	// The export CA signs the certificates of the export servers at runtime,
+	// it stays under the control of virt-operator.
+	caExportDuration := GetCADuration(nil)
+	caExportRenewBefore := GetCertRenewBefore(nil)
+	caExportCert, err := r.createOrUpdateCACertificateSecret(queue, components.KubeVirtExportCASecretName, caExportDuration, caExportRenewBefore)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.createOrUpdateKubeVirtCAConfigMap(queue, caExportCert, caExportRenewBefore, findRequiredCAConfigMap(components.KubeVirtExportCASecretName, r.targetStrategy.ConfigMaps()))
+	if err != nil {
+		return err
+	}
+
+	if external.CertManager != nil {
+		for _, secret := range r.componentCertificateSecrets() {
+			if err := r.createOrUpdateCertManagerCertificate(secret, external.CertManager); err != nil {
+				return err
+			}
+		}
+	}
+
+	caBundle, err := r.validateExternalCertificates(external)
+	if err != nil {
+		return err
+	}
+
+	err = r.createOrUpdateExternalCAConfigMap(findRequiredCAConfigMap(components.KubeVirtCASecretName, r.targetStrategy.ConfigMaps()), caBundle)
+	if err != nil {
+		return err
+	}
+
+	err = r.createOrUpdateValidatingWebhookConfigurations(caBundle)
+	if err != nil {
+		return err
+	}
+
+	err = r.createOrUpdateMutatingWebhookConfigurations(caBundle)
+	if err != nil {
+		return err
+	}
+
+	err = r.createOrUpdateAPIServices(caBundle)
+	if err != nil {
+		return err
+	}
+
+	return r.createOrUpdateRoutes(caBundle)
+}
+
+// componentCertificateSecrets returns the secrets holding the certificates of the KubeVirt components
+func (r *Reconciler) componentCertificateSecrets() []*corev1.Secret {
+	var secrets []*corev1.Secret
+	for _, secret := range r.targetStrategy.CertificateSecrets() {
+		if secret.Name == components.KubeVirtCASecretName || secret.Name == components.KubeVirtExportCASecretName {
+			continue
+		}
+		secrets = append(secrets, secret)
+	}
+	return secrets
+}
+
+// validateExternalCertificates checks the certificates of all components, reports the result in the
+// CertificatesReady condition and returns the CA bundle the peers of the components have to trust.
+func (r *Reconciler) validateExternalCertificates(config *v1.KubeVirtExternalCertificateConfiguration) ([]byte, error) {
+	now := time.Now()
+	var caBundle []byte
+	bundled := map[string]bool{}
+
+	var firstExpiring *x509.Certificate
+	firstExpiringSecret := ""
+
+	for _, secret := range r.componentCertificateSecrets() {
+		cachedSecret, exists, err := r.getSecret(secret)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			err := fmt.Errorf("waiting for certificate secret %s/%s", secret.Namespace, secret.Name)
+			util.UpdateConditionsCertificatesMissing(r.kv, err)
+			return nil, err
+		}
+
+		crt, caCerts, err := components.ValidateExternalCertificate(cachedSecret, now)
+		if err != nil {
+			util.UpdateConditionsCertificatesInvalid(r.kv, err)
+			return nil, err
+		}
+
+		if firstExpiring == nil || crt.Leaf.NotAfter.Before(firstExpiring.NotAfter) {
+			firstExpiring = crt.Leaf
+			firstExpiringSecret = cachedSecret.Name
+		}
+
+		for _, caCert := range caCerts {
+			if caCert.NotAfter.Before(now) || bundled[string(caCert.Raw)] {
+				continue
+			}
+			bundled[string(caCert.Raw)] = true
+			caBundle = append(caBundle, cert.EncodeCertPEM(caCert)...)
+		}
+	}
+
+	if firstExpiring == nil {
+		return caBundle, nil
+	}
+
+	if firstExpiring.NotAfter.Sub(now) < GetExpiryWarningThreshold(config).Duration {
+		log.Log.Warningf("Certificate in secret %s expires at %v", firstExpiringSecret, firstExpiring.NotAfter)
+		util.UpdateConditionsCertificatesExpiring(r.kv, firstExpiringSecret, firstExpiring.NotAfter)
+	} else {
+		util.UpdateConditionsCertificatesValid(r.kv, firstExpiringSecret, firstExpiring.NotAfter)
+	}
+
+	return caBundle, nil
+}
+
+func (r *Reconciler) createOrUpdateExternalCAConfigMap(configMap *corev1.ConfigMap, caBundle []byte) error {
+	if configMap == nil {
+		return nil
+	}
+
+	configMap = configMap.DeepCopy()
+	version, imageRegistry, id := getTargetVersionRegistryID(r.kv)
+	injectOperatorMetadata(r.kv, &configMap.ObjectMeta, version, imageRegistry, id, true)
+	configMap.Data = map[string]string{components.CABundleKey: string(caBundle)}
+
+	obj, exists, _ := r.stores.ConfigMapCache.Get(configMap)
+	if !exists {
+		r.expectations.ConfigMap.RaiseExpectations(r.kvKey, 1, 0)
+		_, err := r.clientset.CoreV1().ConfigMaps(configMap.Namespace).Create(context.Background(), configMap, metav1.CreateOptions{})
+		if err != nil {
+			r.expectations.ConfigMap.LowerExpectations(r.kvKey, 1, 0)
+			return fmt.Errorf("unable to create configMap %+v: %v", configMap, err)
+		}
+		return nil
+	}
+
+	existing := obj.(*corev1.ConfigMap)
+	modified := resourcemerge.BoolPtr(false)
+	resourcemerge.EnsureObjectMeta(modified, &existing.DeepCopy().ObjectMeta, configMap.ObjectMeta)
+
+	if !*modified && existing.Data[components.CABundleKey] == string(caBundle) {
+		log.Log.V(4).Infof("configMap %v is up-to-date", configMap.GetName())
+		return nil
+	}
+
+	patchBytes, err := createConfigMapPatch(configMap)
+	if err != nil {
+		return err
+	}
+
+	_, err = r.clientset.CoreV1().ConfigMaps(configMap.Namespace).Patch(context.Background(), configMap.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to patch configMap %+v: %v", configMap, err)
+	}
+
+	log.Log.V(2).Infof("configMap %v updated", configMap.GetName())
+	return nil
+}
+
+func (r *Reconciler) createOrUpdateCertManagerCertificate(secret *corev1.Secret, config *v1.CertManagerConfiguration) error {
+	spec, err := components.GetExternalCertificateSpec(secret)
+	if err != nil {
+		return err
+	}
+
+	certificate := newCertManagerCertificate(r.kv, secret, spec, config)
+	client := r.clientset.DynamicClient().Resource(certManagerCertificateGVR).Namespace(secret.Namespace)
+
+	existing, err := client.Get(context.Background(), secret.Name, metav1.GetOptions{})
+	if errors.IsNotFound(err) {
+		_, err = client.Create(context.Background(), certificate, metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("unable to create certificate %s/%s: %v", secret.Namespace, secret.Name, err)
+		}
+		log.Log.V(2).Infof("certificate %s/%s created", secret.Namespace, secret.Name)
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	modified := resourcemerge.BoolPtr(false)
+	existingMeta := metav1.ObjectMeta{Labels: existing.GetLabels(), Annotations: existing.GetAnnotations()}
+	resourcemerge.EnsureObjectMeta(modified, &existingMeta, metav1.ObjectMeta{Labels: certificate.GetLabels(), Annotations: certificate.GetAnnotations()})
+
+	if !*modified && equality.Semantic.DeepEqual(existing.Object["spec"], certificate.Object["spec"]) {
+		log.Log.V(4).Infof("certificate %s/%s is up-to-date", secret.Namespace, secret.Name)
+		return nil
+	}
+
+	updated := existing.DeepCopy()
+	updated.SetLabels(existingMeta.Labels)
+	updated.SetAnnotations(existingMeta.Annotations)
+	updated.Object["spec"] = certificate.Object["spec"]
+
+	_, err = client.Update(context.Background(), updated, metav1.UpdateOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to update certificate %s/%s: %v", secret.Namespace, secret.Name, err)
+	}
+
+	log.Log.V(2).Infof("certificate %s/%s updated", secret.Namespace, secret.Name)
+	return nil
+}
+
+// newCertManagerCertificate creates a cert-manager Certificate which is issued into the given component secret
+func newCertManagerCertificate(kv *v1.KubeVirt, secret *corev1.Secret, spec *components.ExternalCertificateSpec, config *v1.CertManagerConfiguration) *unstructured.Unstructured {
+	objectMeta := metav1.ObjectMeta{}
+	version, imageRegistry, id := getTargetVersionRegistryID(kv)
+	injectOperatorMetadata(kv, &objectMeta, version, imageRegistry, id, true)
+
+	issuerKind := config.IssuerRef.Kind
+	if issuerKind == "" {
+		issuerKind = "Issuer"
+	}
+	issuerGroup := config.IssuerRef.Group
+	if issuerGroup == "" {
+		issuerGroup = certManagerCertificateGVR.Group
+	}
+
+	usages := []interface{}{"digital signature", "key encipherment"}
+	for _, usage := range spec.Usages {
+		switch usage {
+		case x509.ExtKeyUsageServerAuth:
+			usages = append(usages, "server auth")
+		case x509.ExtKeyUsageClientAuth:
+			usages = append(usages, "client auth")
+		}
+	}
+
+	certificateSpec := map[string]interface{}{
+		"secretName": secret.Name,
+		// the label makes the issued secret visible to virt-operator and removes it on uninstall
+		"secretTemplate": map[string]interface{}{
+			"labels": map[string]interface{}{
+				v1.ManagedByLabel: v1.ManagedByLabelOperatorValue,
+			},
+		},
+		"commonName": spec.CommonName,
+		"usages":     usages,
+		"issuerRef": map[string]interface{}{
+			"name":  config.IssuerRef.Name,
+			"kind":  issuerKind,
+			"group": issuerGroup,
+		},
+		"privateKey": map[string]interface{}{
+			"algorithm":      "ECDSA",
+			"size":           int64(256),
+			"rotationPolicy": "Always",
+		},
+	}
+	if len(spec.DNSNames) > 0 {
+		dnsNames := []interface{}{}
+		for _, dnsName := range spec.DNSNames {
+			dnsNames = append(dnsNames, dnsName)
+		}
+		certificateSpec["dnsNames"] = dnsNames
+	}
+	if config.Duration != nil {
+		certificateSpec["duration"] = config.Duration.Duration.String()
+	}
+	if config.RenewBefore != nil {
+		certificateSpec["renewBefore"] = config.RenewBefore.Duration.String()
+	}
+
+	certificate := &unstructured.Unstructured{Object: map[string]interface{}{
+		"spec": certificateSpec,
+	}}
+	certificate.SetAPIVersion(certManagerCertificateGVR.GroupVersion().String())
+	certificate.SetKind("Certificate")
+	certificate.SetName(secret.Name)
+	certificate.SetNamespace(secret.Namespace)
+	certificate.SetLabels(objectMeta.Labels)
+	certificate.SetAnnotations(objectMeta.Annotations)
+
+	return certificate
+}
+
+func deleteCertManagerCertificates(kv *v1.KubeVirt, clientset kubecli.KubevirtClient, deleteOptions metav1.DeleteOptions) error {
+	external := kv.Spec.CertificateRotationStrategy.External
+	if external == nil || external.CertManager == nil {
+		return nil
+	}
+
+	err := clientset.DynamicClient().Resource(certManagerCertificateGVR).Namespace(kv.Namespace).DeleteCollection(context.Background(), deleteOptions, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", v1.ManagedByLabel, v1.ManagedByLabelOperatorValue),
+	})
+	if err != nil && !errors.IsNotFound(err) {
+		log.Log.Errorf("Failed to delete cert-manager certificates: %v", err)
+		return err
+	}
+
+	return nil
+}
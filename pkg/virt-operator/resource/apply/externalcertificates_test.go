@@ -0,0 +1,220 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/certificates/triple/cert"
+	fake2 "kubevirt.io/kubevirt/pkg/virt-operator/resource/apply/fake"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+var _ = Describe("External certificates", func() {
+	const namespace = "kubevirt"
+
+	var (
+		kv            *v1.KubeVirt
+		stores        util.Stores
+		clientset     *kubecli.MockKubevirtClient
+		dynamicClient *dynamicfake.FakeDynamicClient
+		r             *Reconciler
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		clientset = kubecli.NewMockKubevirtClient(ctrl)
+		clientset.EXPECT().CoreV1().Return(fake.NewSimpleClientset().CoreV1()).AnyTimes()
+		dynamicClient = dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+			map[schema.GroupVersionResource]string{certManagerCertificateGVR: "CertificateList"})
+		clientset.EXPECT().DynamicClient().Return(dynamicClient).AnyTimes()
+
+		stores = util.Stores{}
+		stores.SecretCache = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: namespace},
+			Spec: v1.KubeVirtSpec{
+				CertificateRotationStrategy: v1.KubeVirtCertificateRotateStrategy{
+					External: &v1.KubeVirtExternalCertificateConfiguration{
+						ExpiryWarningThreshold: &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+		}
+
+		r = &Reconciler{
+			kv:             kv,
+			stores:         stores,
+			clientset:      clientset,
+			expectations:   &util.Expectations{},
+			targetStrategy: &fake2.FakeStrategy{FakeCertificateSecrets: components.NewCertSecrets(namespace, namespace)},
+		}
+	})
+
+	Context("validation", func() {
+		var caSecret *corev1.Secret
+
+		addComponentSecrets := func(duration time.Duration) {
+			caCrt, err := components.LoadCertificates(caSecret)
+			Expect(err).ToNot(HaveOccurred())
+			for _, secret := range components.NewCertSecrets(namespace, namespace) {
+				Expect(components.PopulateSecretWithCertificate(secret, caCrt, &metav1.Duration{Duration: duration})).To(Succeed())
+				secret.Data[components.CACertBytesValue] = caSecret.Data[corev1.TLSCertKey]
+				Expect(stores.SecretCache.Add(secret)).To(Succeed())
+			}
+		}
+
+		BeforeEach(func() {
+			caSecret = components.NewCACertSecrets(namespace)[0]
+			Expect(components.PopulateSecretWithCertificate(caSecret, nil, &metav1.Duration{Duration: 24 * time.Hour})).To(Succeed())
+		})
+
+		It("should report valid certificates and bundle their CA once", func() {
+			addComponentSecrets(5 * time.Hour)
+
+			caBundle, err := r.validateExternalCertificates(kv.Spec.CertificateRotationStrategy.External)
+			Expect(err).ToNot(HaveOccurred())
+			bundled, err := cert.ParseCertsPEM(caBundle)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bundled).To(HaveLen(1))
+			Expect(caBundle).To(Equal(caSecret.Data[corev1.TLSCertKey]))
+
+			Expect(kv.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", v1.KubeVirtConditionCertificatesReady),
+				HaveField("Status", corev1.ConditionTrue),
+				HaveField("Reason", util.ConditionReasonCertificatesValid),
+			)))
+		})
+
+		It("should report certificates which are about to expire", func() {
+			addComponentSecrets(30 * time.Minute)
+
+			_, err := r.validateExternalCertificates(kv.Spec.CertificateRotationStrategy.External)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kv.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", v1.KubeVirtConditionCertificatesReady),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", util.ConditionReasonCertificatesExpiring),
+			)))
+		})
+
+		It("should wait for missing certificate secrets", func() {
+			_, err := r.validateExternalCertificates(kv.Spec.CertificateRotationStrategy.External)
+			Expect(err).To(MatchError(ContainSubstring("waiting for certificate secret")))
+			Expect(kv.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", v1.KubeVirtConditionCertificatesReady),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", util.ConditionReasonCertificatesMissing),
+			)))
+		})
+
+		It("should refuse certificates without CA", func() {
+			addComponentSecrets(5 * time.Hour)
+			obj, exists, err := stores.SecretCache.GetByKey(namespace + "/" + components.VirtApiCertSecretName)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			delete(obj.(*corev1.Secret).Data, components.CACertBytesValue)
+
+			_, err = r.validateExternalCertificates(kv.Spec.CertificateRotationStrategy.External)
+			Expect(err).To(HaveOccurred())
+			Expect(kv.Status.Conditions).To(ContainElement(And(
+				HaveField("Type", v1.KubeVirtConditionCertificatesReady),
+				HaveField("Status", corev1.ConditionFalse),
+				HaveField("Reason", util.ConditionReasonCertificatesInvalid),
+			)))
+		})
+	})
+
+	Context("cert-manager", func() {
+		var secret *corev1.Secret
+		var config *v1.CertManagerConfiguration
+
+		getCertificate := func() *unstructured.Unstructured {
+			certificate, err := dynamicClient.Resource(certManagerCertificateGVR).Namespace(namespace).Get(context.Background(), secret.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return certificate
+		}
+
+		BeforeEach(func() {
+			secret = components.NewCertSecrets(namespace, namespace)[0]
+			config = &v1.CertManagerConfiguration{
+				IssuerRef: v1.CertManagerIssuerReference{Name: "corporate-ca"},
+				Duration:  &metav1.Duration{Duration: 48 * time.Hour},
+			}
+		})
+
+		It("should request the certificate of the component", func() {
+			Expect(r.createOrUpdateCertManagerCertificate(secret, config)).To(Succeed())
+
+			certificate := getCertificate()
+			Expect(certificate.GetLabels()).To(HaveKeyWithValue(v1.ManagedByLabel, v1.ManagedByLabelOperatorValue))
+			Expect(nestedField(certificate.Object, "spec", "secretName")).To(Equal(secret.Name))
+			Expect(nestedField(certificate.Object, "spec", "duration")).To(Equal("48h0m0s"))
+			Expect(nestedField(certificate.Object, "spec", "issuerRef")).To(Equal(map[string]interface{}{
+				"name":  "corporate-ca",
+				"kind":  "Issuer",
+				"group": "cert-manager.io",
+			}))
+			Expect(nestedField(certificate.Object, "spec", "dnsNames")).To(ContainElement("virt-api.kubevirt.svc"))
+			Expect(nestedField(certificate.Object, "spec", "usages")).To(ContainElements("server auth", "client auth"))
+			Expect(nestedField(certificate.Object, "spec", "secretTemplate", "labels")).To(
+				HaveKeyWithValue(v1.ManagedByLabel, v1.ManagedByLabelOperatorValue))
+		})
+
+		It("should only update the certificate when it changed", func() {
+			Expect(r.createOrUpdateCertManagerCertificate(secret, config)).To(Succeed())
+			dynamicClient.ClearActions()
+
+			Expect(r.createOrUpdateCertManagerCertificate(secret, config)).To(Succeed())
+			for _, action := range dynamicClient.Actions() {
+				Expect(action.GetVerb()).To(Equal("get"))
+			}
+
+			config.IssuerRef.Kind = "ClusterIssuer"
+			Expect(r.createOrUpdateCertManagerCertificate(secret, config)).To(Succeed())
+			Expect(nestedField(getCertificate().Object, "spec", "issuerRef", "kind")).To(Equal("ClusterIssuer"))
+		})
+	})
+})
+
+func nestedField(obj map[string]interface{}, fields ...string) interface{} {
+	value, found, err := unstructured.NestedFieldCopy(obj, fields...)
+	Expect(err).ToNot(HaveOccurred())
+	Expect(found).To(BeTrue())
+	return value
+}
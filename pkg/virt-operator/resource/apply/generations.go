@@ -12,6 +12,7 @@ import (
 	operatorsv1 "github.com/openshift/api/operator/v1"
 	"github.com/openshift/library-go/pkg/operator/resource/resourcemerge"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
+	networkingv1 "k8s.io/api/networking/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -33,6 +34,9 @@ func getGroupResource(required runtime.Object) (group string, resource string, e
 	case *policyv1.PodDisruptionBudget:
 		group = "apps"
 		resource = "poddisruptionbudgets"
+	case *networkingv1.NetworkPolicy:
+		group = "networking.k8s.io"
+		resource = "networkpolicies"
 	case *appsv1.Deployment:
 		group = "apps"
 		resource = "deployments"
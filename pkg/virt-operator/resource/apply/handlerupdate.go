@@ -0,0 +1,284 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/util/workqueue"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+const (
+	failedHandlerUpdateWaveReason = "FailedHandlerUpdateWave"
+)
+
+func (r *Reconciler) handlerUpdateWavesEnabled(daemonSet *appsv1.DaemonSet) bool {
+	strategy := r.kv.Spec.HandlerUpdateStrategy
+	return daemonSet.GetName() == components.VirtHandlerName && strategy != nil && len(strategy.Waves) > 0
+}
+
+// handlerUpdateStatus returns the progress of the staged virt-handler rollout for the
+// target deployment. The progress is reset when the deployment or the waves changed.
+func (r *Reconciler) handlerUpdateStatus() *v1.HandlerUpdateStatus {
+	waves := r.kv.Spec.HandlerUpdateStrategy.Waves
+	status := r.kv.Status.HandlerUpdate
+
+	if status != nil && status.TargetDeploymentID == r.kv.Status.TargetDeploymentID && len(status.Waves) == len(waves) {
+		matches := true
+		for i := range waves {
+			matches = matches && status.Waves[i].Name == waves[i].Name
+		}
+		if matches {
+			return status
+		}
+	}
+
+	status = &v1.HandlerUpdateStatus{
+		TargetDeploymentID: r.kv.Status.TargetDeploymentID,
+	}
+	for _, wave := range waves {
+		status.Waves = append(status.Waves, v1.HandlerUpdateWaveStatus{Name: wave.Name})
+	}
+	r.kv.Status.HandlerUpdate = status
+	return status
+}
+
+// processHandlerUpdateWaves rolls virt-handler out wave by wave. While waves are pending the
+// DaemonSet uses the OnDelete strategy and the outdated pods on the nodes of the current wave
+// are replaced by the operator. Once all waves completed, the remaining nodes are handed over
+// to the regular canary rollout.
+func (r *Reconciler) processHandlerUpdateWaves(cachedDaemonSet, newDS *appsv1.DaemonSet, forceUpdate bool, queue workqueue.TypedRateLimitingInterface[string]) (bool, error) {
+	status := r.handlerUpdateStatus()
+
+	if status.CurrentWave >= len(status.Waves) {
+		if cachedDaemonSet.Spec.UpdateStrategy.Type == appsv1.OnDeleteDaemonSetStrategyType {
+			setMaxUnavailable(newDS, daemonSetFastMaxUnavailable)
+			if _, err := r.patchDaemonSet(cachedDaemonSet, newDS); err != nil {
+				return false, err
+			}
+			log.Log.V(2).Infof("all waves of daemonSet %v completed, updating the remaining nodes", newDS.GetName())
+			return false, nil
+		}
+		done, err, _ := r.processCanaryUpgrade(cachedDaemonSet, newDS, forceUpdate)
+		return done, err
+	}
+
+	if forceUpdate || !util.DaemonSetIsUpToDate(r.kv, cachedDaemonSet) ||
+		cachedDaemonSet.Spec.UpdateStrategy.Type != appsv1.OnDeleteDaemonSetStrategyType {
+		newDS.Spec.UpdateStrategy = appsv1.DaemonSetUpdateStrategy{
+			Type: appsv1.OnDeleteDaemonSetStrategyType,
+		}
+		if _, err := r.patchDaemonSet(cachedDaemonSet, newDS); err != nil {
+			return false, fmt.Errorf("unable to start staged upgrade for daemonset %+v: %v", newDS, err)
+		}
+		return false, nil
+	}
+
+	return false, r.processHandlerUpdateWave(cachedDaemonSet, status, queue)
+}
+
+func (r *Reconciler) processHandlerUpdateWave(daemonSet *appsv1.DaemonSet, status *v1.HandlerUpdateStatus, queue workqueue.TypedRateLimitingInterface[string]) error {
+	strategy := r.kv.Spec.HandlerUpdateStrategy
+	spec := strategy.Waves[status.CurrentWave]
+	wave := &status.Waves[status.CurrentWave]
+
+	selectedNodes := r.listNodes(spec.NodeSelector)
+
+	if wave.StartTime == nil {
+		wave.Nodes = r.resolveHandlerUpdateWaveNodes(daemonSet, selectedNodes, status.Waves[:status.CurrentWave])
+		wave.StartTime = pointer.P(metav1.Now())
+		log.Log.Infof("starting wave %s of daemonSet %v on nodes %s", wave.Name, daemonSet.Name, strings.Join(wave.Nodes, ", "))
+	}
+
+	// nodes which were removed or relabeled since the wave started are no longer waited on
+	nodes := map[string]bool{}
+	for _, node := range wave.Nodes {
+		if selectedNodes[node] {
+			nodes[node] = true
+		}
+	}
+
+	updatedAndReady := map[string]bool{}
+	unavailable := 0
+	var outdated []*corev1.Pod
+	for _, pod := range r.getDaemonSetPods(daemonSet) {
+		if !nodes[pod.Spec.NodeName] {
+			continue
+		}
+		upToDate := util.PodIsUpToDate(pod, r.kv)
+		if upToDate && util.PodIsCrashLooping(pod) {
+			r.recorder.Eventf(daemonSet, corev1.EventTypeWarning, failedHandlerUpdateWaveReason, "daemonSet %v rollout failed on node %s of wave %s", daemonSet.Name, pod.Spec.NodeName, wave.Name)
+			return fmt.Errorf("daemonSet %s rollout failed on node %s of wave %s", daemonSet.Name, pod.Spec.NodeName, wave.Name)
+		}
+		ready := pod.DeletionTimestamp == nil && util.PodIsReady(pod)
+		switch {
+		case upToDate && ready:
+			updatedAndReady[pod.Spec.NodeName] = true
+		case !ready:
+			unavailable++
+		}
+		if !upToDate && pod.DeletionTimestamp == nil {
+			outdated = append(outdated, pod)
+		}
+	}
+
+	if len(updatedAndReady) < len(nodes) {
+		wave.CompletionTime = nil
+		return r.replaceOutdatedHandlerPods(outdated, handlerUpdateMaxUnavailable(strategy, len(nodes))-unavailable)
+	}
+
+	if failed := r.listFailedVMIs(nodes, *wave.StartTime); len(failed) > 0 {
+		r.recorder.Eventf(daemonSet, corev1.EventTypeWarning, failedHandlerUpdateWaveReason, "halting daemonSet %v rollout, VMIs failed on the nodes of wave %s: %s; delete them to resume the rollout", daemonSet.Name, wave.Name, strings.Join(failed, ", "))
+		return fmt.Errorf("halting daemonSet %s rollout, VMIs failed on the nodes of wave %s: %s; delete them to resume the rollout", daemonSet.Name, wave.Name, strings.Join(failed, ", "))
+	}
+
+	if wave.CompletionTime == nil {
+		wave.CompletionTime = pointer.P(metav1.Now())
+		log.Log.Infof("wave %s of daemonSet %v completed", wave.Name, daemonSet.Name)
+	}
+
+	if strategy.PauseBetweenWaves != nil {
+		if remaining := time.Until(wave.CompletionTime.Add(strategy.PauseBetweenWaves.Duration)); remaining > 0 {
+			log.Log.V(4).Infof("waiting %v before starting the next wave of daemonSet %v", remaining, daemonSet.Name)
+			queue.AddAfter(r.kvKey, remaining)
+			return nil
+		}
+	}
+
+	status.CurrentWave++
+	return nil
+}
+
+// replaceOutdatedHandlerPods deletes outdated pods to let the DaemonSet controller recreate them
+// with the new template. Pods which are not ready anyway are replaced without counting against
+// the budget.
+func (r *Reconciler) replaceOutdatedHandlerPods(outdated []*corev1.Pod, budget int) error {
+	for _, pod := range outdated {
+		if util.PodIsReady(pod) {
+			if budget <= 0 {
+				continue
+			}
+			budget--
+		}
+		if err := r.clientset.CoreV1().Pods(pod.Namespace).Delete(context.Background(), pod.Name, metav1.DeleteOptions{}); err != nil {
+			return fmt.Errorf("unable to replace pod %s on node %s: %v", pod.Name, pod.Spec.NodeName, err)
+		}
+		log.Log.V(2).Infof("replacing outdated pod %s on node %s", pod.Name, pod.Spec.NodeName)
+	}
+	return nil
+}
+
+// resolveHandlerUpdateWaveNodes returns the selected nodes which run a pod of the DaemonSet
+// and are not part of a previous wave.
+func (r *Reconciler) resolveHandlerUpdateWaveNodes(daemonSet *appsv1.DaemonSet, selectedNodes map[string]bool, previous []v1.HandlerUpdateWaveStatus) []string {
+	taken := map[string]bool{}
+	for _, wave := range previous {
+		for _, node := range wave.Nodes {
+			taken[node] = true
+		}
+	}
+
+	nodes := []string{}
+	for _, pod := range r.getDaemonSetPods(daemonSet) {
+		node := pod.Spec.NodeName
+		if selectedNodes[node] && !taken[node] {
+			taken[node] = true
+			nodes = append(nodes, node)
+		}
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+func (r *Reconciler) getDaemonSetPods(daemonSet *appsv1.DaemonSet) []*corev1.Pod {
+	pods := []*corev1.Pod{}
+
+	for _, obj := range r.stores.InfrastructurePodCache.List() {
+		pod := obj.(*corev1.Pod)
+		owner := metav1.GetControllerOf(pod)
+
+		if owner != nil && owner.Name == daemonSet.Name {
+			pods = append(pods, pod)
+		}
+	}
+	return pods
+}
+
+func (r *Reconciler) listNodes(nodeSelector map[string]string) map[string]bool {
+	selector := labels.SelectorFromSet(nodeSelector)
+
+	nodes := map[string]bool{}
+	for _, obj := range r.stores.NodeCache.List() {
+		node := obj.(*corev1.Node)
+		if selector.Matches(labels.Set(node.Labels)) {
+			nodes[node.Name] = true
+		}
+	}
+	return nodes
+}
+
+// listFailedVMIs returns the VMIs which failed on the given nodes since the wave started.
+// Deleting them is how an admin acknowledges the failures and resumes the halted rollout.
+func (r *Reconciler) listFailedVMIs(nodes map[string]bool, since metav1.Time) []string {
+	failed := []string{}
+	for _, obj := range r.stores.VirtualMachineInstanceCache.List() {
+		vmi := obj.(*v1.VirtualMachineInstance)
+		if vmi.Status.Phase != v1.Failed || !nodes[vmi.Status.NodeName] {
+			continue
+		}
+		for _, transition := range vmi.Status.PhaseTransitionTimestamps {
+			if transition.Phase == v1.Failed && !transition.PhaseTransitionTimestamp.Before(&since) {
+				failed = append(failed, vmi.Namespace+"/"+vmi.Name)
+				break
+			}
+		}
+	}
+	sort.Strings(failed)
+	return failed
+}
+
+func handlerUpdateMaxUnavailable(strategy *v1.KubeVirtHandlerUpdateStrategy, nodes int) int {
+	maxUnavailable := daemonSetDefaultMaxUnavailable
+	if strategy.MaxUnavailable != nil {
+		maxUnavailable = *strategy.MaxUnavailable
+	}
+
+	value, err := intstr.GetScaledValueFromIntOrPercent(&maxUnavailable, nodes, true)
+	if err != nil || value < 1 {
+		return 1
+	}
+	return value
+}
@@ -0,0 +1,237 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openshift/library-go/pkg/operator/resource/resourcemerge"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+// apiServerServiceName is the name of the service in the default namespace which exposes the apiserver
+const apiServerServiceName = "kubernetes"
+
+// syncNetworkPolicies creates or updates the NetworkPolicies of the KubeVirt components if they are
+// enabled and removes the ones which are not required anymore
+func (r *Reconciler) syncNetworkPolicies() error {
+	required, err := r.desiredNetworkPolicies()
+	if err != nil {
+		return err
+	}
+
+	requiredNames := map[string]bool{}
+	for _, networkPolicy := range required {
+		requiredNames[networkPolicy.Name] = true
+		if err := r.createOrUpdateNetworkPolicy(networkPolicy); err != nil {
+			return err
+		}
+	}
+
+	for _, obj := range r.stores.NetworkPolicyCache.List() {
+		networkPolicy, ok := obj.(*networkingv1.NetworkPolicy)
+		if !ok || requiredNames[networkPolicy.Name] || networkPolicy.DeletionTimestamp != nil {
+			continue
+		}
+		key, err := controller.KeyFunc(networkPolicy)
+		if err != nil {
+			return err
+		}
+		r.expectations.NetworkPolicy.AddExpectedDeletion(r.kvKey, key)
+		err = r.clientset.NetworkingV1().NetworkPolicies(networkPolicy.Namespace).Delete(context.Background(), networkPolicy.Name, metav1.DeleteOptions{})
+		if err != nil {
+			r.expectations.NetworkPolicy.DeletionObserved(r.kvKey, key)
+			return fmt.Errorf("unable to delete networkpolicy %s: %v", networkPolicy.Name, err)
+		}
+		log.Log.V(2).Infof("networkpolicy %v deleted", networkPolicy.GetName())
+	}
+
+	return nil
+}
+
+// desiredNetworkPolicies returns the NetworkPolicies of the KubeVirt components, if they are enabled
+func (r *Reconciler) desiredNetworkPolicies() ([]*networkingv1.NetworkPolicy, error) {
+	if r.kv.Spec.NetworkPolicies == nil || !r.kv.Spec.NetworkPolicies.Enabled {
+		return nil, nil
+	}
+
+	apiServerPorts, err := r.getAPIServerPorts()
+	if err != nil {
+		return nil, err
+	}
+	return components.NewNetworkPolicies(r.kv.Namespace, getNetworkPolicyConfig(r.kv, r.exportProxyEnabled(), apiServerPorts)), nil
+}
+
+// getAPIServerPorts returns the ports of the kubernetes service in the default namespace and of its endpoints.
+// Depending on the network plugin, policies either see the service port or the port of the endpoint the
+// service resolved to, which differ in most clusters.
+func (r *Reconciler) getAPIServerPorts() ([]int32, error) {
+	service, err := r.clientset.CoreV1().Services(metav1.NamespaceDefault).Get(context.Background(), apiServerServiceName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the apiserver service: %v", err)
+	}
+	endpoints, err := r.clientset.CoreV1().Endpoints(metav1.NamespaceDefault).Get(context.Background(), apiServerServiceName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get the apiserver endpoints: %v", err)
+	}
+
+	ports := map[int32]bool{}
+	for _, port := range service.Spec.Ports {
+		ports[port.Port] = true
+	}
+	for _, subset := range endpoints.Subsets {
+		for _, port := range subset.Ports {
+			ports[port.Port] = true
+		}
+	}
+	if len(ports) == 0 {
+		return nil, fmt.Errorf("the apiserver service does not expose any port")
+	}
+
+	apiServerPorts := make([]int32, 0, len(ports))
+	for port := range ports {
+		apiServerPorts = append(apiServerPorts, port)
+	}
+	sort.Slice(apiServerPorts, func(i, j int) bool {
+		return apiServerPorts[i] < apiServerPorts[j]
+	})
+	return apiServerPorts, nil
+}
+
+func (r *Reconciler) createOrUpdateNetworkPolicy(networkPolicy *networkingv1.NetworkPolicy) error {
+	kv := r.kv
+	imageTag, imageRegistry, id := getTargetVersionRegistryID(kv)
+	injectOperatorMetadata(kv, &networkPolicy.ObjectMeta, imageTag, imageRegistry, id, true)
+
+	networkPolicyClient := r.clientset.NetworkingV1().NetworkPolicies(networkPolicy.Namespace)
+
+	obj, exists, _ := r.stores.NetworkPolicyCache.Get(networkPolicy)
+	if !exists {
+		r.expectations.NetworkPolicy.RaiseExpectations(r.kvKey, 1, 0)
+		createdNetworkPolicy, err := networkPolicyClient.Create(context.Background(), networkPolicy, metav1.CreateOptions{})
+		if err != nil {
+			r.expectations.NetworkPolicy.LowerExpectations(r.kvKey, 1, 0)
+			return fmt.Errorf("unable to create networkpolicy %+v: %v", networkPolicy, err)
+		}
+		log.Log.V(2).Infof("networkpolicy %v created", networkPolicy.GetName())
+		SetGeneration(&kv.Status.Generations, createdNetworkPolicy)
+
+		return nil
+	}
+
+	cachedNetworkPolicy := obj.(*networkingv1.NetworkPolicy)
+	modified := resourcemerge.BoolPtr(false)
+	existingCopy := cachedNetworkPolicy.DeepCopy()
+	expectedGeneration := GetExpectedGeneration(networkPolicy, kv.Status.Generations)
+
+	resourcemerge.EnsureObjectMeta(modified, &existingCopy.ObjectMeta, networkPolicy.ObjectMeta)
+	// there was no change to metadata or the spec, the generation was right
+	if !*modified &&
+		equality.Semantic.DeepEqual(existingCopy.Spec, networkPolicy.Spec) &&
+		existingCopy.ObjectMeta.Generation == expectedGeneration {
+		log.Log.V(4).Infof("networkpolicy %v is up-to-date", networkPolicy.GetName())
+		return nil
+	}
+
+	patchBytes, err := patch.New(getPatchWithObjectMetaAndSpec([]patch.PatchOption{}, &networkPolicy.ObjectMeta, networkPolicy.Spec)...).GeneratePayload()
+	if err != nil {
+		return err
+	}
+
+	patchedNetworkPolicy, err := networkPolicyClient.Patch(context.Background(), networkPolicy.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to patch networkpolicy %+v: %v", networkPolicy, err)
+	}
+
+	SetGeneration(&kv.Status.Generations, patchedNetworkPolicy)
+	log.Log.V(2).Infof("networkpolicy %v patched", networkPolicy.GetName())
+
+	return nil
+}
+
+// getNetworkPolicyConfig derives the settings of the NetworkPolicies from the ports the components
+// are configured with and the migration network
+func getNetworkPolicyConfig(kv *v1.KubeVirt, exportProxy bool, apiServerPorts []int32) *components.NetworkPolicyConfig {
+	flags := kv.Spec.CustomizeComponents.Flags
+	if flags == nil {
+		flags = &v1.Flags{}
+	}
+
+	migrationConfig := kv.Spec.Configuration.MigrationConfiguration
+	return &components.NetworkPolicyConfig{
+		APIPort:                     getFlagPort(flags.API, "port", components.DefaultComponentPort),
+		ControllerPort:              getFlagPort(flags.Controller, "port", components.DefaultComponentPort),
+		HandlerPort:                 getFlagPort(flags.Handler, "port", components.DefaultComponentPort),
+		HandlerConsolePort:          getFlagPort(flags.Handler, "console-server-port", components.DefaultHandlerConsoleServerPort),
+		APIServerPorts:              apiServerPorts,
+		ExportProxy:                 exportProxy,
+		PodNetworkMigrations:        migrationConfig == nil || migrationConfig.Network == nil || *migrationConfig.Network == "",
+		MonitoringNamespaceSelector: getMonitoringNamespaceSelector(kv),
+	}
+}
+
+// getFlagPort returns the port passed to a component with the given flag, flags are
+// matched case insensitively the same way they are turned into arguments
+func getFlagPort(flags map[string]string, name string, defaultPort int32) int32 {
+	for flag, value := range flags {
+		if strings.ToLower(flag) != name {
+			continue
+		}
+		if port, err := strconv.ParseInt(value, 10, 32); err == nil && port > 0 {
+			return int32(port)
+		}
+		log.Log.Warningf("ignoring invalid port %q of flag %s", value, name)
+	}
+	return defaultPort
+}
+
+func getMonitoringNamespaceSelector(kv *v1.KubeVirt) *metav1.LabelSelector {
+	if kv.Spec.NetworkPolicies != nil && kv.Spec.NetworkPolicies.MonitoringNamespaceSelector != nil {
+		return kv.Spec.NetworkPolicies.MonitoringNamespaceSelector.DeepCopy()
+	}
+
+	namespaces := util.DefaultMonitorNamespaces
+	if kv.Spec.MonitorNamespace != "" {
+		namespaces = []string{kv.Spec.MonitorNamespace}
+	}
+	return &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{{
+			Key:      corev1.LabelMetadataName,
+			Operator: metav1.LabelSelectorOpIn,
+			Values:   append([]string{}, namespaces...),
+		}},
+	}
+}
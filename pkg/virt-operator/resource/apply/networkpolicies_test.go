@@ -0,0 +1,223 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+var _ = Describe("Apply NetworkPolicies", func() {
+	apiServerPorts := []int32{443, 6443}
+
+	var kubeClient *fake.Clientset
+	var stores util.Stores
+	var kv *v1.KubeVirt
+	var r *Reconciler
+
+	listNetworkPolicies := func() []networkingv1.NetworkPolicy {
+		networkPolicies, err := kubeClient.NetworkingV1().NetworkPolicies(Namespace).List(context.Background(), metav1.ListOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return networkPolicies.Items
+	}
+
+	addCachedNetworkPolicy := func(networkPolicy *networkingv1.NetworkPolicy) {
+		injectOperatorMetadata(kv, &networkPolicy.ObjectMeta, Version, Registry, Id, true)
+		networkPolicy.SetGeneration(1)
+		SetGeneration(&kv.Status.Generations, networkPolicy)
+		Expect(stores.NetworkPolicyCache.Add(networkPolicy)).To(Succeed())
+		_, err := kubeClient.NetworkingV1().NetworkPolicies(Namespace).Create(context.Background(), networkPolicy, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubeClient = fake.NewSimpleClientset()
+
+		stores = util.Stores{}
+		stores.NetworkPolicyCache = cache.NewStore(cache.DeletionHandlingMetaNamespaceKeyFunc)
+
+		clientset := kubecli.NewMockKubevirtClient(ctrl)
+		clientset.EXPECT().NetworkingV1().Return(kubeClient.NetworkingV1()).AnyTimes()
+		clientset.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+		_, err := kubeClient.CoreV1().Services(metav1.NamespaceDefault).Create(context.Background(), &corev1.Service{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Namespace: metav1.NamespaceDefault},
+			Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Name: "https", Port: 443}}},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = kubeClient.CoreV1().Endpoints(metav1.NamespaceDefault).Create(context.Background(), &corev1.Endpoints{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubernetes", Namespace: metav1.NamespaceDefault},
+			Subsets: []corev1.EndpointSubset{{
+				Addresses: []corev1.EndpointAddress{{IP: "192.168.0.10"}},
+				Ports:     []corev1.EndpointPort{{Name: "https", Port: 6443}},
+			}},
+		}, metav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: metav1.ObjectMeta{Name: "kubevirt", Namespace: Namespace},
+			Spec: v1.KubeVirtSpec{
+				NetworkPolicies: &v1.KubeVirtNetworkPolicies{Enabled: true},
+			},
+		}
+		kv.Status.TargetKubeVirtRegistry = Registry
+		kv.Status.TargetKubeVirtVersion = Version
+		kv.Status.TargetDeploymentID = Id
+
+		r = &Reconciler{
+			kv:        kv,
+			kvKey:     Namespace + "/kubevirt",
+			stores:    stores,
+			clientset: clientset,
+			expectations: &util.Expectations{
+				NetworkPolicy: controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("NetworkPolicies")),
+			},
+		}
+	})
+
+	It("should create the NetworkPolicies of the components when enabled", func() {
+		Expect(r.syncNetworkPolicies()).To(Succeed())
+
+		networkPolicies := listNetworkPolicies()
+		Expect(networkPolicies).To(HaveLen(4))
+		for _, networkPolicy := range networkPolicies {
+			Expect(util.IsManagedByOperator(networkPolicy.Labels)).To(BeTrue())
+		}
+	})
+
+	It("should not patch NetworkPolicies which are up to date", func() {
+		for _, networkPolicy := range components.NewNetworkPolicies(Namespace, getNetworkPolicyConfig(kv, false, apiServerPorts)) {
+			addCachedNetworkPolicy(networkPolicy)
+		}
+		kubeClient.Fake.PrependReactor("patch", "networkpolicies", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+			Fail("unexpected patch of a NetworkPolicy")
+			return true, nil, nil
+		})
+
+		Expect(r.syncNetworkPolicies()).To(Succeed())
+	})
+
+	It("should patch NetworkPolicies when the configured ports changed", func() {
+		for _, networkPolicy := range components.NewNetworkPolicies(Namespace, getNetworkPolicyConfig(kv, false, apiServerPorts)) {
+			addCachedNetworkPolicy(networkPolicy)
+		}
+		kv.Spec.CustomizeComponents.Flags = &v1.Flags{Handler: map[string]string{"console-server-port": "4321"}}
+
+		var patched []string
+		kubeClient.Fake.PrependReactor("patch", "networkpolicies", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+			patched = append(patched, action.(testing.PatchAction).GetName())
+			return true, &networkingv1.NetworkPolicy{}, nil
+		})
+
+		Expect(r.syncNetworkPolicies()).To(Succeed())
+		Expect(patched).To(ConsistOf("virt-handler-network-policy"))
+	})
+
+	It("should allow the ports of the apiserver service and its endpoints", func() {
+		endpoints, err := kubeClient.CoreV1().Endpoints(metav1.NamespaceDefault).Get(context.Background(), "kubernetes", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		endpoints.Subsets[0].Ports[0].Port = 16443
+		_, err = kubeClient.CoreV1().Endpoints(metav1.NamespaceDefault).Update(context.Background(), endpoints, metav1.UpdateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(r.getAPIServerPorts()).To(Equal([]int32{443, 16443}))
+		Expect(r.syncNetworkPolicies()).To(Succeed())
+		for _, networkPolicy := range listNetworkPolicies() {
+			Expect(networkPolicy.Spec.Egress[0].Ports).To(HaveLen(2), networkPolicy.Name)
+			Expect(networkPolicy.Spec.Egress[0].Ports[1].Port.IntVal).To(BeEquivalentTo(16443), networkPolicy.Name)
+		}
+	})
+
+	It("should fail without the apiserver endpoints", func() {
+		Expect(kubeClient.CoreV1().Endpoints(metav1.NamespaceDefault).Delete(context.Background(), "kubernetes", metav1.DeleteOptions{})).To(Succeed())
+
+		Expect(r.syncNetworkPolicies()).To(MatchError(ContainSubstring("unable to get the apiserver endpoints")))
+		Expect(listNetworkPolicies()).To(BeEmpty())
+	})
+
+	It("should delete the NetworkPolicies when disabled", func() {
+		for _, networkPolicy := range components.NewNetworkPolicies(Namespace, getNetworkPolicyConfig(kv, false, apiServerPorts)) {
+			addCachedNetworkPolicy(networkPolicy)
+		}
+		kv.Spec.NetworkPolicies.Enabled = false
+
+		Expect(r.syncNetworkPolicies()).To(Succeed())
+		Expect(listNetworkPolicies()).To(BeEmpty())
+	})
+
+	Context("configuration", func() {
+		It("should follow the ports passed as flags", func() {
+			kv.Spec.CustomizeComponents.Flags = &v1.Flags{
+				API:     map[string]string{"port": "9443"},
+				Handler: map[string]string{"Console-Server-Port": "4321", "port": "invalid"},
+			}
+
+			config := getNetworkPolicyConfig(kv, false, apiServerPorts)
+			Expect(config.APIPort).To(BeEquivalentTo(9443))
+			Expect(config.ControllerPort).To(Equal(components.DefaultComponentPort))
+			Expect(config.HandlerPort).To(Equal(components.DefaultComponentPort))
+			Expect(config.HandlerConsolePort).To(BeEquivalentTo(4321))
+		})
+
+		It("should not allow migrations over the pod network with a migration network", func() {
+			Expect(getNetworkPolicyConfig(kv, false, apiServerPorts).PodNetworkMigrations).To(BeTrue())
+
+			kv.Spec.Configuration.MigrationConfiguration = &v1.MigrationConfiguration{Network: pointer.P("migration")}
+			Expect(getNetworkPolicyConfig(kv, false, apiServerPorts).PodNetworkMigrations).To(BeFalse())
+		})
+
+		It("should default the monitoring namespaces to the monitorNamespace", func() {
+			kv.Spec.MonitorNamespace = "prometheus"
+
+			selector := getNetworkPolicyConfig(kv, false, apiServerPorts).MonitoringNamespaceSelector
+			Expect(selector.MatchExpressions).To(ConsistOf(metav1.LabelSelectorRequirement{
+				Key:      corev1.LabelMetadataName,
+				Operator: metav1.LabelSelectorOpIn,
+				Values:   []string{"prometheus"},
+			}))
+		})
+
+		It("should use the configured monitoring namespace selector", func() {
+			selector := &metav1.LabelSelector{MatchLabels: map[string]string{"monitoring": "true"}}
+			kv.Spec.NetworkPolicies.MonitoringNamespaceSelector = selector
+
+			Expect(getNetworkPolicyConfig(kv, false, apiServerPorts).MonitoringNamespaceSelector).To(Equal(selector))
+		})
+	})
+})
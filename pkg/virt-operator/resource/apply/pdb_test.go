@@ -1,6 +1,7 @@
 package apply
 
 import (
+	"context"
 	"encoding/json"
 
 	jsonpatch "github.com/evanphx/json-patch"
@@ -10,7 +11,10 @@ import (
 	v12 "k8s.io/api/apps/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	_ "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1beta1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
@@ -18,6 +22,8 @@ import (
 	v1 "kubevirt.io/api/core/v1"
 	"kubevirt.io/client-go/kubecli"
 
+	"kubevirt.io/kubevirt/pkg/controller"
+	"kubevirt.io/kubevirt/pkg/pointer"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 	"kubevirt.io/kubevirt/pkg/virt-operator/util"
 )
@@ -156,4 +162,73 @@ var _ = Describe("Apply PDBs", func() {
 		})
 	})
 
+	Context("Component configuration", func() {
+		BeforeEach(func() {
+			expectations.PodDisruptionBudget = controller.NewUIDTrackingControllerExpectations(controller.NewControllerExpectationsWithName("PodDisruptionBudgets"))
+		})
+
+		setPodDisruptionBudget := func(pdb *v1.ComponentPodDisruptionBudget) {
+			kv.Spec.Infra = &v1.ComponentConfig{
+				Components: []v1.InfraComponentConfig{{
+					Name:                deployment.Name,
+					PodDisruptionBudget: pdb,
+				}},
+			}
+		}
+
+		getPDB := func() *policyv1.PodDisruptionBudget {
+			pdb, err := pdbClient.PolicyV1().PodDisruptionBudgets(Namespace).Get(context.Background(), requiredPDB.Name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			return pdb
+		}
+
+		It("should create the PDB with the configured maxUnavailable", func() {
+			maxUnavailable := intstr.FromString("50%")
+			setPodDisruptionBudget(&v1.ComponentPodDisruptionBudget{MaxUnavailable: &maxUnavailable})
+
+			Expect(r.syncPodDisruptionBudgetForDeployment(deployment)).To(Succeed())
+
+			pdb := getPDB()
+			Expect(pdb.Spec.MinAvailable).To(BeNil())
+			Expect(pdb.Spec.MaxUnavailable).To(HaveValue(Equal(maxUnavailable)))
+		})
+
+		It("should create the PDB with the configured minAvailable", func() {
+			minAvailable := intstr.FromString("30%")
+			setPodDisruptionBudget(&v1.ComponentPodDisruptionBudget{MinAvailable: &minAvailable})
+
+			Expect(r.syncPodDisruptionBudgetForDeployment(deployment)).To(Succeed())
+			Expect(getPDB().Spec.MinAvailable).To(HaveValue(Equal(minAvailable)))
+		})
+
+		It("should delete the PDB when minAvailable is 0", func() {
+			cachedPDB := getCachedPDB()
+			_, err := pdbClient.PolicyV1().PodDisruptionBudgets(Namespace).Create(context.Background(), cachedPDB, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			minAvailable := intstr.FromInt32(0)
+			setPodDisruptionBudget(&v1.ComponentPodDisruptionBudget{MinAvailable: &minAvailable})
+
+			Expect(r.syncPodDisruptionBudgetForDeployment(deployment)).To(Succeed())
+			_, err = pdbClient.PolicyV1().PodDisruptionBudgets(Namespace).Get(context.Background(), requiredPDB.Name, metav1.GetOptions{})
+			Expect(errors.IsNotFound(err)).To(BeTrue())
+		})
+
+		It("should patch the PDB when only maxUnavailable changed", func() {
+			cachedPDB := getCachedPDB()
+			maxUnavailable := intstr.FromInt32(1)
+			cachedPDB.Spec.MinAvailable = nil
+			cachedPDB.Spec.MaxUnavailable = &maxUnavailable
+			setPodDisruptionBudget(&v1.ComponentPodDisruptionBudget{MaxUnavailable: pointer.P(intstr.FromInt32(2))})
+
+			patched := false
+			pdbClient.Fake.PrependReactor("patch", "poddisruptionbudgets", func(action testing.Action) (handled bool, ret runtime.Object, err error) {
+				patched = true
+				return true, cachedPDB, nil
+			})
+
+			Expect(r.syncPodDisruptionBudgetForDeployment(deployment)).To(Succeed())
+			Expect(patched).To(BeTrue())
+		})
+	})
 })
@@ -626,6 +626,12 @@ func (r *Reconciler) Sync(queue workqueue.TypedRateLimitingInterface[string]) (b
 		return false, nil
 	}
 
+	// create/update/delete NetworkPolicies
+	err = r.syncNetworkPolicies()
+	if err != nil {
+		return false, err
+	}
+
 	err = r.createOrUpdateValidatingAdmissionPolicyBindings()
 	if err != nil {
 		return false, err
@@ -649,12 +655,12 @@ func (r *Reconciler) Sync(queue workqueue.TypedRateLimitingInterface[string]) (b
 	}
 
 	if shouldTakeUpdatePath(targetVersion, observedVersion) {
-		finished, err := r.updateKubeVirtSystem(controllerDeploymentsRolledOver)
+		finished, err := r.updateKubeVirtSystem(controllerDeploymentsRolledOver, queue)
 		if !finished || err != nil {
 			return false, err
 		}
 	} else {
-		finished, err := r.createOrRollBackSystem(apiDeploymentsRolledOver)
+		finished, err := r.createOrRollBackSystem(apiDeploymentsRolledOver, queue)
 		if !finished || err != nil {
 			return false, err
 		}
@@ -703,7 +709,7 @@ func (r *Reconciler) Sync(queue workqueue.TypedRateLimitingInterface[string]) (b
 	return true, nil
 }
 
-func (r *Reconciler) createOrRollBackSystem(apiDeploymentsRolledOver bool) (bool, error) {
+func (r *Reconciler) createOrRollBackSystem(apiDeploymentsRolledOver bool, queue workqueue.TypedRateLimitingInterface[string]) (bool, error) {
 	// CREATE/ROLLBACK PATH IS
 	// 1. apiserver - ensures validation of objects occur before allowing any control plane to act on them.
 	// 2. wait for apiservers to roll over
@@ -757,7 +763,7 @@ func (r *Reconciler) createOrRollBackSystem(apiDeploymentsRolledOver bool) (bool
 
 	// create/update Daemonsets
 	for _, daemonSet := range r.targetStrategy.DaemonSets() {
-		finished, err := r.syncDaemonSet(daemonSet)
+		finished, err := r.syncDaemonSet(daemonSet, queue)
 		if !finished || err != nil {
 			return false, err
 		}
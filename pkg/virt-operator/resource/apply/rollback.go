@@ -0,0 +1,68 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"fmt"
+
+	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// VerifyRollback makes sure that the target install strategy can read every object of the deployed CRDs.
+// Sync removes CRDs which are not part of the target install strategy, and the apiserver can't serve objects
+// which were stored in a version the target CRD doesn't know about anymore. Both would lose data, so a rollback
+// to such an install strategy is refused before anything is changed.
+func (r *Reconciler) VerifyRollback() error {
+	targetCrds := map[string]*extv1.CustomResourceDefinition{}
+	for _, crd := range r.targetStrategy.CRDs() {
+		targetCrds[crd.Name] = crd
+	}
+
+	for _, obj := range r.stores.OperatorCrdCache.List() {
+		cachedCrd, ok := obj.(*extv1.CustomResourceDefinition)
+		if !ok || cachedCrd.DeletionTimestamp != nil {
+			continue
+		}
+
+		targetCrd, exists := targetCrds[cachedCrd.Name]
+		if !exists {
+			return fmt.Errorf("unable to roll back to version %s: crd %s is not part of it and its objects would be removed",
+				r.kv.Status.TargetKubeVirtVersion, cachedCrd.Name)
+		}
+
+		for _, storedVersion := range cachedCrd.Status.StoredVersions {
+			if !isCrdVersionServed(targetCrd, storedVersion) {
+				return fmt.Errorf("unable to roll back to version %s: objects of crd %s are stored in version %s which it does not serve",
+					r.kv.Status.TargetKubeVirtVersion, cachedCrd.Name, storedVersion)
+			}
+		}
+	}
+
+	return nil
+}
+
+func isCrdVersionServed(crd *extv1.CustomResourceDefinition, version string) bool {
+	for _, v := range crd.Spec.Versions {
+		if v.Name == version {
+			return v.Served
+		}
+	}
+	return false
+}
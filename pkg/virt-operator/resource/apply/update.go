@@ -1,6 +1,10 @@
 package apply
 
-func (r *Reconciler) updateKubeVirtSystem(controllerDeploymentsRolledOver bool) (bool, error) {
+import (
+	"k8s.io/client-go/util/workqueue"
+)
+
+func (r *Reconciler) updateKubeVirtSystem(controllerDeploymentsRolledOver bool, queue workqueue.TypedRateLimitingInterface[string]) (bool, error) {
 	// UPDATE PATH IS
 	// 1. daemonsets - ensures all compute nodes are updated to handle new features
 	// 2. wait for daemonsets to roll over
@@ -10,7 +14,7 @@ func (r *Reconciler) updateKubeVirtSystem(controllerDeploymentsRolledOver bool)
 
 	// create/update Daemonsets
 	for _, daemonSet := range r.targetStrategy.DaemonSets() {
-		finished, err := r.syncDaemonSet(daemonSet)
+		finished, err := r.syncDaemonSet(daemonSet, queue)
 		if !finished || err != nil {
 			return false, err
 		}
@@ -0,0 +1,478 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/cache"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/install"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/rbac"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+const (
+	// maxPlannedValueLength caps the size of a single value in the plan, large values like CRD schemas are summarized
+	maxPlannedValueLength = 1024
+	// maxUpgradePlanSize keeps the plan configmap well below the 1MiB object size limit of the API server
+	maxUpgradePlanSize = 768 * 1024
+)
+
+type plannedObject interface {
+	runtime.Object
+	metav1.Object
+}
+
+type plannedKind struct {
+	kind    string
+	store   cache.Store
+	desired []plannedObject
+	// ignore skips deployed objects which Sync does not delete
+	ignore func(name string) bool
+}
+
+func toPlannedObjects[T plannedObject](objs []T) []plannedObject {
+	planned := make([]plannedObject, 0, len(objs))
+	for _, obj := range objs {
+		planned = append(planned, obj)
+	}
+	return planned
+}
+
+// SyncUpgradePlan stores the changes Sync would apply in the upgrade plan configmap without touching any
+// deployed object. The plan is only regenerated when the target, the KubeVirt CR or the dry-run annotation change.
+func (r *Reconciler) SyncUpgradePlan() error {
+	version, imageRegistry, id := getTargetVersionRegistryID(r.kv)
+
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      util.UpgradePlanConfigMapName,
+			Namespace: r.kv.Namespace,
+			Annotations: map[string]string{
+				v1.KubeVirtUpgradeDryRunAnnotation: r.kv.Annotations[v1.KubeVirtUpgradeDryRunAnnotation],
+			},
+		},
+	}
+	injectOperatorMetadata(r.kv, &configMap.ObjectMeta, version, imageRegistry, id, true)
+
+	obj, exists, _ := r.stores.ConfigMapCache.Get(configMap)
+	if exists && upgradePlanIsCurrent(obj.(*corev1.ConfigMap), configMap) {
+		log.Log.V(4).Infof("upgrade plan %v is up-to-date", configMap.GetName())
+		return nil
+	}
+
+	vmis := []v1.VirtualMachineInstance{}
+	for _, obj := range r.stores.VirtualMachineInstanceCache.List() {
+		vmis = append(vmis, *obj.(*v1.VirtualMachineInstance))
+	}
+
+	plan, err := r.GenerateUpgradePlan(vmis)
+	if err != nil {
+		return err
+	}
+	planBytes, err := marshalUpgradePlan(plan, maxUpgradePlanSize)
+	if err != nil {
+		return err
+	}
+	configMap.Data = map[string]string{util.UpgradePlanConfigMapKey: string(planBytes)}
+
+	if !exists {
+		r.expectations.ConfigMap.RaiseExpectations(r.kvKey, 1, 0)
+		_, err := r.clientset.CoreV1().ConfigMaps(configMap.Namespace).Create(context.Background(), configMap, metav1.CreateOptions{})
+		if err != nil {
+			r.expectations.ConfigMap.LowerExpectations(r.kvKey, 1, 0)
+			return fmt.Errorf("unable to create configMap %+v: %v", configMap.Name, err)
+		}
+		log.Log.V(2).Infof("upgrade plan %v created", configMap.GetName())
+		return nil
+	}
+
+	patchBytes, err := createConfigMapPatch(configMap)
+	if err != nil {
+		return err
+	}
+	_, err = r.clientset.CoreV1().ConfigMaps(configMap.Namespace).Patch(context.Background(), configMap.Name, types.JSONPatchType, patchBytes, metav1.PatchOptions{})
+	if err != nil {
+		return fmt.Errorf("unable to patch configMap %+v: %v", configMap.Name, err)
+	}
+	log.Log.V(2).Infof("upgrade plan %v updated", configMap.GetName())
+
+	return nil
+}
+
+// marshalUpgradePlan encodes the plan and truncates it if it exceeds maxSize. The field changes are dropped first,
+// then the workloads and objects at the end of the lists. The plan records what was left out.
+func marshalUpgradePlan(plan *util.UpgradePlan, maxSize int) ([]byte, error) {
+	planBytes, err := json.MarshalIndent(plan, "", "  ")
+	if err != nil || len(planBytes) <= maxSize {
+		return planBytes, err
+	}
+
+	plan.Truncated = true
+	for i := range plan.Objects {
+		plan.Objects[i].Changes = nil
+	}
+	for {
+		planBytes, err = json.MarshalIndent(plan, "", "  ")
+		if err != nil || len(planBytes) <= maxSize {
+			return planBytes, err
+		}
+		switch {
+		case len(plan.Workloads) > 0:
+			keep := len(plan.Workloads) / 2
+			plan.OmittedWorkloads += len(plan.Workloads) - keep
+			plan.Workloads = plan.Workloads[:keep]
+		case len(plan.Objects) > 0:
+			keep := len(plan.Objects) / 2
+			plan.OmittedObjects += len(plan.Objects) - keep
+			plan.Objects = plan.Objects[:keep]
+		default:
+			return nil, fmt.Errorf("upgrade plan exceeds %d bytes", maxSize)
+		}
+	}
+}
+
+func upgradePlanIsCurrent(existing, required *corev1.ConfigMap) bool {
+	for _, annotation := range []string{
+		v1.KubeVirtUpgradeDryRunAnnotation,
+		v1.InstallStrategyIdentifierAnnotation,
+		v1.KubeVirtGenerationAnnotation,
+	} {
+		if existing.Annotations[annotation] != required.Annotations[annotation] {
+			return false
+		}
+	}
+	return true
+}
+
+// GenerateUpgradePlan computes the objects Sync would create, update and delete to roll out the target install
+// strategy, after the CustomizeComponents patches were applied, and the VMIs the workload updater would act on
+// once the new virt-launcher image is rolled out.
+func (r *Reconciler) GenerateUpgradePlan(vmis []v1.VirtualMachineInstance) (*util.UpgradePlan, error) {
+	plan := &util.UpgradePlan{
+		ObservedKubeVirtVersion:  r.kv.Status.ObservedKubeVirtVersion,
+		ObservedKubeVirtRegistry: r.kv.Status.ObservedKubeVirtRegistry,
+		TargetKubeVirtVersion:    r.kv.Status.TargetKubeVirtVersion,
+		TargetKubeVirtRegistry:   r.kv.Status.TargetKubeVirtRegistry,
+		TargetDeploymentID:       r.kv.Status.TargetDeploymentID,
+		TargetLauncherImage:      targetLauncherImage(r.targetStrategy),
+	}
+
+	kinds, err := r.plannedKinds()
+	if err != nil {
+		return nil, err
+	}
+	for _, kind := range kinds {
+		objects, err := r.planKind(kind)
+		if err != nil {
+			return nil, err
+		}
+		plan.Objects = append(plan.Objects, objects...)
+	}
+
+	plan.Workloads = r.planWorkloads(vmis, plan.TargetLauncherImage)
+
+	return plan, nil
+}
+
+func (r *Reconciler) plannedKinds() ([]plannedKind, error) {
+	var roles []plannedObject
+	for _, role := range r.targetStrategy.Roles() {
+		if r.config.ServiceMonitorEnabled || role.Name != rbac.MONITOR_SERVICEACCOUNT_NAME {
+			roles = append(roles, role)
+		}
+	}
+	var roleBindings []plannedObject
+	for _, roleBinding := range r.targetStrategy.RoleBindings() {
+		if r.config.ServiceMonitorEnabled || roleBinding.Name != rbac.MONITOR_SERVICEACCOUNT_NAME {
+			roleBindings = append(roleBindings, roleBinding)
+		}
+	}
+
+	var deployments []*appsv1.Deployment
+	for _, deployment := range r.targetStrategy.ApiDeployments() {
+		deployments = append(deployments, r.desiredDeployment(deployment))
+	}
+	for _, deployment := range r.targetStrategy.ControllerDeployments() {
+		deployments = append(deployments, r.desiredDeployment(deployment))
+	}
+	if r.exportProxyEnabled() {
+		for _, deployment := range r.targetStrategy.ExportProxyDeployments() {
+			deployments = append(deployments, r.desiredDeployment(deployment))
+		}
+	}
+
+	var podDisruptionBudgets []plannedObject
+	for _, deployment := range deployments {
+		if podDisruptionBudget := r.desiredPodDisruptionBudget(deployment); podDisruptionBudgetRequired(podDisruptionBudget) {
+			podDisruptionBudgets = append(podDisruptionBudgets, podDisruptionBudget)
+		}
+	}
+
+	var daemonSets []plannedObject
+	for _, daemonSet := range r.targetStrategy.DaemonSets() {
+		daemonSets = append(daemonSets, prepareDaemonSet(r.kv, daemonSet))
+	}
+
+	networkPolicies, err := r.desiredNetworkPolicies()
+	if err != nil {
+		return nil, err
+	}
+
+	kinds := []plannedKind{
+		{kind: "CustomResourceDefinition", store: r.stores.OperatorCrdCache, desired: toPlannedObjects(r.targetStrategy.CRDs())},
+		{kind: "ServiceAccount", store: r.stores.ServiceAccountCache, desired: toPlannedObjects(r.targetStrategy.ServiceAccounts())},
+		{kind: "ClusterRole", store: r.stores.ClusterRoleCache, desired: toPlannedObjects(r.targetStrategy.ClusterRoles())},
+		{kind: "ClusterRoleBinding", store: r.stores.ClusterRoleBindingCache, desired: toPlannedObjects(r.targetStrategy.ClusterRoleBindings())},
+		{kind: "Role", store: r.stores.RoleCache, desired: roles},
+		{kind: "RoleBinding", store: r.stores.RoleBindingCache, desired: roleBindings},
+		{kind: "Service", store: r.stores.ServiceCache, desired: toPlannedObjects(r.targetStrategy.Services())},
+		{
+			kind:    "ValidatingWebhookConfiguration",
+			store:   r.stores.ValidationWebhookCache,
+			desired: toPlannedObjects(r.targetStrategy.ValidatingWebhookConfigurations()),
+			ignore: func(name string) bool {
+				return strings.HasPrefix(name, "virt-operator-tmp-webhook")
+			},
+		},
+		{kind: "MutatingWebhookConfiguration", store: r.stores.MutatingWebhookCache, desired: toPlannedObjects(r.targetStrategy.MutatingWebhookConfigurations())},
+		{kind: "APIService", store: r.stores.APIServiceCache, desired: toPlannedObjects(r.targetStrategy.APIServices())},
+		{kind: "Deployment", store: r.stores.DeploymentCache, desired: toPlannedObjects(deployments)},
+		{kind: "PodDisruptionBudget", store: r.stores.PodDisruptionBudgetCache, desired: podDisruptionBudgets},
+		{kind: "DaemonSet", store: r.stores.DaemonSetCache, desired: daemonSets},
+		{kind: "NetworkPolicy", store: r.stores.NetworkPolicyCache, desired: toPlannedObjects(networkPolicies)},
+	}
+	if r.config.ValidatingAdmissionPolicyEnabled {
+		kinds = append(kinds, plannedKind{kind: "ValidatingAdmissionPolicy", store: r.stores.ValidatingAdmissionPolicyCache, desired: toPlannedObjects(r.targetStrategy.ValidatingAdmissionPolicies())})
+	}
+	if r.config.ValidatingAdmissionPolicyBindingEnabled {
+		kinds = append(kinds, plannedKind{kind: "ValidatingAdmissionPolicyBinding", store: r.stores.ValidatingAdmissionPolicyBindingCache, desired: toPlannedObjects(r.targetStrategy.ValidatingAdmissionPolicyBindings())})
+	}
+
+	return kinds, nil
+}
+
+// desiredDeployment prepares a deployment like syncDeployment does it. The virt-api replicas are scaled with the
+// cluster size and are left out of the comparison.
+func (r *Reconciler) desiredDeployment(origDeployment *appsv1.Deployment) *appsv1.Deployment {
+	kv := r.kv
+	deployment := prepareDeployment(kv, origDeployment)
+
+	if kv.Spec.Infra != nil && kv.Spec.Infra.Replicas != nil {
+		replicas := int32(*kv.Spec.Infra.Replicas)
+		deployment.Spec.Replicas = &replicas
+	} else if deployment.Name == components.VirtAPIName && !replicasAlreadyPatched(kv.Spec.CustomizeComponents.Patches, components.VirtAPIName) {
+		deployment.Spec.Replicas = nil
+	}
+
+	return deployment
+}
+
+// desiredPodDisruptionBudget prepares the PodDisruptionBudget of a deployment like syncPodDisruptionBudgetForDeployment
+// does it. Deployments with scaled replicas keep the deployed replicas.
+func (r *Reconciler) desiredPodDisruptionBudget(deployment *appsv1.Deployment) *policyv1.PodDisruptionBudget {
+	if deployment.Spec.Replicas == nil {
+		if obj, exists, _ := r.stores.DeploymentCache.Get(deployment); exists {
+			deployment = deployment.DeepCopy()
+			deployment.Spec.Replicas = obj.(*appsv1.Deployment).Spec.Replicas
+		}
+	}
+	return preparePodDisruptionBudget(r.kv, deployment)
+}
+
+func (r *Reconciler) planKind(kind plannedKind) ([]util.UpgradePlanObject, error) {
+	var objects []util.UpgradePlanObject
+	version, imageRegistry, id := getTargetVersionRegistryID(r.kv)
+
+	desiredNames := map[string]bool{}
+	for _, origDesired := range kind.desired {
+		desired := origDesired.DeepCopyObject().(plannedObject)
+		desiredNames[desired.GetName()] = true
+
+		// objects which were already prepared like Sync does it are left unchanged by this
+		meta := &metav1.ObjectMeta{Labels: desired.GetLabels(), Annotations: desired.GetAnnotations()}
+		injectOperatorMetadata(r.kv, meta, version, imageRegistry, id, true)
+		desired.SetLabels(meta.Labels)
+		desired.SetAnnotations(meta.Annotations)
+
+		planned := util.UpgradePlanObject{
+			Kind:      kind.kind,
+			Namespace: desired.GetNamespace(),
+			Name:      desired.GetName(),
+		}
+
+		obj, exists, _ := kind.store.Get(desired)
+		if !exists {
+			planned.Action = util.UpgradePlanActionCreate
+			objects = append(objects, planned)
+			continue
+		}
+
+		changes, err := diffObjects(desired, obj.(runtime.Object))
+		if err != nil {
+			return nil, fmt.Errorf("unable to compare %s %s: %v", kind.kind, desired.GetName(), err)
+		}
+		if len(changes) == 0 {
+			continue
+		}
+		planned.Action = util.UpgradePlanActionUpdate
+		planned.Changes = changes
+		objects = append(objects, planned)
+	}
+
+	var deleted []util.UpgradePlanObject
+	for _, obj := range kind.store.List() {
+		existing, ok := obj.(metav1.Object)
+		if !ok || existing.GetDeletionTimestamp() != nil || desiredNames[existing.GetName()] {
+			continue
+		}
+		if kind.ignore != nil && kind.ignore(existing.GetName()) {
+			continue
+		}
+		deleted = append(deleted, util.UpgradePlanObject{
+			Kind:      kind.kind,
+			Namespace: existing.GetNamespace(),
+			Name:      existing.GetName(),
+			Action:    util.UpgradePlanActionDelete,
+		})
+	}
+	sort.Slice(deleted, func(i, j int) bool {
+		return deleted[i].Namespace+"/"+deleted[i].Name < deleted[j].Namespace+"/"+deleted[j].Name
+	})
+
+	return append(objects, deleted...), nil
+}
+
+// diffObjects compares the fields set on the desired object with the deployed one. The status and fields which are
+// only set on the deployed object, like defaults filled in by the API server, are ignored.
+func diffObjects(desired, current runtime.Object) ([]util.UpgradePlanFieldChange, error) {
+	desiredMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(desired)
+	if err != nil {
+		return nil, err
+	}
+	currentMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(current)
+	if err != nil {
+		return nil, err
+	}
+
+	delete(desiredMap, "status")
+	delete(desiredMap, "apiVersion")
+	delete(desiredMap, "kind")
+	if desiredMeta, ok := desiredMap["metadata"].(map[string]interface{}); ok {
+		desiredMap["metadata"] = map[string]interface{}{
+			"labels":      desiredMeta["labels"],
+			"annotations": desiredMeta["annotations"],
+		}
+	}
+
+	var changes []util.UpgradePlanFieldChange
+	for _, diff := range util.DiffFields(currentMap, desiredMap, true) {
+		changes = append(changes, util.UpgradePlanFieldChange{
+			Path:    diff.Path,
+			Current: summarizeValue(diff.From),
+			Desired: summarizeValue(diff.To),
+		})
+	}
+	return changes, nil
+}
+
+func summarizeValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil || len(valueBytes) <= maxPlannedValueLength {
+		return value
+	}
+	return fmt.Sprintf("<%d bytes>", len(valueBytes))
+}
+
+// planWorkloads mirrors the decisions of the workload updater for running VMIs which don't use the target
+// virt-launcher image.
+func (r *Reconciler) planWorkloads(vmis []v1.VirtualMachineInstance, launcherImage string) []util.UpgradePlanWorkload {
+	migrate, evict := false, false
+	for _, method := range r.kv.Spec.WorkloadUpdateStrategy.WorkloadUpdateMethods {
+		switch method {
+		case v1.WorkloadUpdateMethodLiveMigrate:
+			migrate = true
+		case v1.WorkloadUpdateMethodEvict:
+			evict = true
+		}
+	}
+
+	var workloads []util.UpgradePlanWorkload
+	for i := range vmis {
+		vmi := &vmis[i]
+		if !vmi.IsRunning() || vmi.IsFinal() || vmi.DeletionTimestamp != nil {
+			continue
+		}
+		if vmi.Status.LauncherContainerImageVersion == "" || vmi.Status.LauncherContainerImageVersion == launcherImage {
+			continue
+		}
+
+		action := util.UpgradePlanActionNone
+		if migrate && vmi.IsMigratable() {
+			action = util.UpgradePlanActionLiveMigrate
+		} else if evict {
+			action = util.UpgradePlanActionEvict
+		}
+		workloads = append(workloads, util.UpgradePlanWorkload{
+			Namespace:     vmi.Namespace,
+			Name:          vmi.Name,
+			NodeName:      vmi.Status.NodeName,
+			LauncherImage: vmi.Status.LauncherContainerImageVersion,
+			Action:        action,
+		})
+	}
+	sort.Slice(workloads, func(i, j int) bool {
+		return workloads[i].Namespace+"/"+workloads[i].Name < workloads[j].Namespace+"/"+workloads[j].Name
+	})
+
+	return workloads
+}
+
+// targetLauncherImage returns the virt-launcher image virt-controller is going to use.
+func targetLauncherImage(strategy install.StrategyInterface) string {
+	for _, deployment := range strategy.ControllerDeployments() {
+		for _, container := range deployment.Spec.Template.Spec.Containers {
+			for i, arg := range container.Args {
+				if arg == "--launcher-image" && i+1 < len(container.Args) {
+					return container.Args[i+1]
+				}
+			}
+		}
+	}
+	return ""
+}
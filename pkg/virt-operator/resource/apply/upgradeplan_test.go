@@ -0,0 +1,165 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package apply
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/install"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+var _ = Describe("Upgrade plan", func() {
+	newDeployment := func(image string) *appsv1.Deployment {
+		return &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "virt-controller",
+				Namespace: "kubevirt",
+				Labels:    map[string]string{v1.AppLabel: "virt-controller"},
+			},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name:  "virt-controller",
+							Image: image,
+							Args:  []string{"--launcher-image", "registry/virt-launcher:" + strings.TrimPrefix(image, "registry/virt-controller:")},
+						}},
+					},
+				},
+			},
+		}
+	}
+
+	Context("diffObjects", func() {
+		It("should ignore fields which are only set on the deployed object", func() {
+			desired := newDeployment("registry/virt-controller:v2")
+			current := desired.DeepCopy()
+			current.Spec.Replicas = pointer.P(int32(2))
+			current.Spec.Template.Spec.Containers[0].ImagePullPolicy = corev1.PullIfNotPresent
+			current.Status.ReadyReplicas = 2
+
+			Expect(diffObjects(desired, current)).To(BeEmpty())
+		})
+
+		It("should report the changed fields", func() {
+			desired := newDeployment("registry/virt-controller:v2")
+			current := newDeployment("registry/virt-controller:v1")
+
+			Expect(diffObjects(desired, current)).To(ConsistOf(
+				util.UpgradePlanFieldChange{
+					Path:    "/spec/template/spec/containers/0/args/1",
+					Current: "registry/virt-launcher:v1",
+					Desired: "registry/virt-launcher:v2",
+				},
+				util.UpgradePlanFieldChange{
+					Path:    "/spec/template/spec/containers/0/image",
+					Current: "registry/virt-controller:v1",
+					Desired: "registry/virt-controller:v2",
+				},
+			))
+		})
+
+		It("should escape the path and summarize large values", func() {
+			desired := newDeployment("registry/virt-controller:v2")
+			desired.Annotations = map[string]string{"kubevirt.io/large": strings.Repeat("x", maxPlannedValueLength)}
+			current := desired.DeepCopy()
+			current.Annotations["kubevirt.io/large"] = "small"
+
+			Expect(diffObjects(desired, current)).To(ConsistOf(util.UpgradePlanFieldChange{
+				Path:    "/metadata/annotations/kubevirt.io~1large",
+				Current: "small",
+				Desired: fmt.Sprintf("<%d bytes>", maxPlannedValueLength+2),
+			}))
+		})
+	})
+
+	Context("marshalUpgradePlan", func() {
+		newPlan := func(objects, workloads int) *util.UpgradePlan {
+			plan := &util.UpgradePlan{}
+			for i := 0; i < objects; i++ {
+				plan.Objects = append(plan.Objects, util.UpgradePlanObject{
+					Kind:   "Deployment",
+					Name:   fmt.Sprintf("deployment-%d", i),
+					Action: util.UpgradePlanActionUpdate,
+					Changes: []util.UpgradePlanFieldChange{{
+						Path:    "/spec/template/spec/containers/0/image",
+						Current: "registry/virt-controller:v1",
+						Desired: "registry/virt-controller:v2",
+					}},
+				})
+			}
+			for i := 0; i < workloads; i++ {
+				plan.Workloads = append(plan.Workloads, util.UpgradePlanWorkload{
+					Namespace: "default",
+					Name:      fmt.Sprintf("vmi-%d", i),
+					Action:    util.UpgradePlanActionLiveMigrate,
+				})
+			}
+			return plan
+		}
+
+		It("should not truncate a plan which fits", func() {
+			plan := newPlan(2, 2)
+			planBytes, err := marshalUpgradePlan(plan, maxUpgradePlanSize)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Truncated).To(BeFalse())
+			Expect(string(planBytes)).To(ContainSubstring("registry/virt-controller:v2"))
+		})
+
+		It("should drop the field changes first", func() {
+			plan := newPlan(10, 0)
+			planBytes, err := marshalUpgradePlan(plan, 2048)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(planBytes)).To(BeNumerically("<=", 2048))
+			Expect(plan.Truncated).To(BeTrue())
+			Expect(plan.Objects).To(HaveLen(10))
+			Expect(plan.OmittedObjects).To(BeZero())
+		})
+
+		It("should drop workloads and objects until the plan fits", func() {
+			plan := newPlan(100, 1000)
+			planBytes, err := marshalUpgradePlan(plan, 4096)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(planBytes)).To(BeNumerically("<=", 4096))
+			Expect(plan.Truncated).To(BeTrue())
+			Expect(plan.Workloads).To(BeEmpty())
+			Expect(plan.OmittedWorkloads).To(Equal(1000))
+			Expect(len(plan.Objects) + plan.OmittedObjects).To(Equal(100))
+		})
+	})
+
+	It("should find the target launcher image in the virt-controller arguments", func() {
+		strategy := install.NewMockStrategyInterface(gomock.NewController(GinkgoT()))
+		strategy.EXPECT().ControllerDeployments().Return([]*appsv1.Deployment{newDeployment("registry/virt-controller:v2")})
+		Expect(targetLauncherImage(strategy)).To(Equal("registry/virt-launcher:v2"))
+	})
+})
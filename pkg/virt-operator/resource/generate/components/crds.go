@@ -63,6 +63,8 @@ var (
 	VIRTUALMACHINEINSTANCEREPLICASET = "virtualmachineinstancereplicasets." + virtv1.VirtualMachineInstanceReplicaSetGroupVersionKind.Group
 	VIRTUALMACHINEINSTANCEMIGRATION  = "virtualmachineinstancemigrations." + virtv1.VirtualMachineInstanceMigrationGroupVersionKind.Group
 	KUBEVIRT                         = "kubevirts." + virtv1.KubeVirtGroupVersionKind.Group
+	KUBEVIRTNAMESPACECONFIG          = "kubevirtnamespaceconfigs." + virtv1.KubeVirtNamespaceConfigGroupVersionKind.Group
+	VIRTUALMACHINEQUOTA              = "virtualmachinequotas." + virtv1.VirtualMachineQuotaGroupVersionKind.Group
 	VIRTUALMACHINEPOOL               = "virtualmachinepools." + poolv1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOT           = "virtualmachinesnapshots." + snapshotv1beta1.SchemeGroupVersion.Group
 	VIRTUALMACHINESNAPSHOTCONTENT    = "virtualmachinesnapshotcontents." + snapshotv1beta1.SchemeGroupVersion.Group
@@ -391,6 +393,66 @@ func NewKubeVirtCrd() (*extv1.CustomResourceDefinition, error) {
 	return crd, nil
 }
 
+func NewKubeVirtNamespaceConfigCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = KUBEVIRTNAMESPACECONFIG
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group:    virtv1.KubeVirtNamespaceConfigGroupVersionKind.Group,
+		Versions: newCRDVersions(),
+		Scope:    extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "kubevirtnamespaceconfigs",
+			Singular:   "kubevirtnamespaceconfig",
+			Kind:       virtv1.KubeVirtNamespaceConfigGroupVersionKind.Kind,
+			ShortNames: []string{"kvnsconfig", "kvnsconfigs"},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
+func NewVirtualMachineQuotaCrd() (*extv1.CustomResourceDefinition, error) {
+	crd := newBlankCrd()
+
+	crd.ObjectMeta.Name = VIRTUALMACHINEQUOTA
+	crd.Spec = extv1.CustomResourceDefinitionSpec{
+		Group:    virtv1.VirtualMachineQuotaGroupVersionKind.Group,
+		Versions: newCRDVersions(),
+		Scope:    extv1.NamespaceScoped,
+
+		Names: extv1.CustomResourceDefinitionNames{
+			Plural:     "virtualmachinequotas",
+			Singular:   "virtualmachinequota",
+			Kind:       virtv1.VirtualMachineQuotaGroupVersionKind.Kind,
+			ShortNames: []string{"vmquota", "vmquotas"},
+		},
+	}
+	err := addFieldsToAllVersions(crd, []extv1.CustomResourceColumnDefinition{
+		{Name: "Age", Type: "date", JSONPath: creationTimestampJSONPath},
+	}, &extv1.CustomResourceSubresources{
+		Status: &extv1.CustomResourceSubresourceStatus{},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = patchValidationForAllVersions(crd); err != nil {
+		return nil, err
+	}
+	return crd, nil
+}
+
 func NewVirtualMachinePoolCrd() (*extv1.CustomResourceDefinition, error) {
 	crd := newBlankCrd()
 	labelSelector := ".status.labelSelector"
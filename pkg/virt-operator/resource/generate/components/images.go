@@ -0,0 +1,113 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package components
+
+import (
+	"fmt"
+	"strings"
+
+	operatorutil "kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+const (
+	VirtLauncherName     = "virt-launcher"
+	VirtExportServerName = "virt-exportserver"
+	LibguestfsToolsName  = "libguestfs-tools"
+
+	digestSeparator = "@"
+)
+
+// Image is an image a KubeVirt deployment runs or hands out to its workloads
+type Image struct {
+	Component string `json:"component"`
+	Image     string `json:"image"`
+	// Digest is the digest of the image reference, or the one its tag was resolved to
+	Digest string `json:"digest,omitempty"`
+}
+
+// ReferencedByDigest tells if the deployment pulls the image by its digest rather than by its tag
+func (i Image) ReferencedByDigest() bool {
+	return strings.Contains(i.Image, digestSeparator)
+}
+
+// DigestResolver returns the digest of the manifest the tag of an image reference points to
+type DigestResolver func(image string) (string, error)
+
+// GetImages returns all images the given deployment config refers to, the same way the install strategy
+// and virt-api assemble them. The tags of the images are resolved to their digests with resolveDigest.
+func GetImages(config *operatorutil.KubeVirtDeploymentConfig, resolveDigest DigestResolver) ([]Image, error) {
+	images := []Image{
+		newImage(config, VirtOperatorName, config.VirtOperatorImage, config.GetOperatorVersion()),
+		newImage(config, VirtAPIName, config.VirtApiImage, config.GetApiVersion()),
+		newImage(config, VirtControllerName, config.VirtControllerImage, config.GetControllerVersion()),
+		newImage(config, VirtHandlerName, config.VirtHandlerImage, config.GetHandlerVersion()),
+		newImage(config, VirtLauncherName, config.VirtLauncherImage, config.GetLauncherVersion()),
+		newImage(config, VirtExportProxyName, config.VirtExportProxyImage, config.GetExportProxyVersion()),
+		newImage(config, VirtExportServerName, config.VirtExportServerImage, config.GetExportServerVersion()),
+		newImage(config, SidecarShimName, config.SidecarShimImage, config.GetSidecarShimVersion()),
+		newImage(config, LibguestfsToolsName, config.GsImage, config.GetGsVersion()),
+	}
+	if config.PersistentReservationEnabled() {
+		images = append(images, newImage(config, PrHelperName, config.PrHelperImage, config.GetPrHelperVersion()))
+	}
+
+	for i := range images {
+		digest, err := GetImageDigest(images[i].Image, resolveDigest)
+		if err != nil {
+			return nil, err
+		}
+		images[i].Digest = digest
+	}
+	return images, nil
+}
+
+func newImage(config *operatorutil.KubeVirtDeploymentConfig, component, image, version string) Image {
+	if image == "" {
+		image = fmt.Sprintf("%s/%s%s%s", config.GetImageRegistry(), config.GetImagePrefix(), component, AddVersionSeparatorPrefix(version))
+	}
+	return Image{
+		Component: component,
+		Image:     image,
+	}
+}
+
+// GetImageRepository returns the image reference without its tag or digest
+func GetImageRepository(image string) string {
+	if i := strings.Index(image, digestSeparator); i != -1 {
+		return image[:i]
+	}
+	// a colon after the last slash separates the tag, any other one belongs to the registry port
+	if i := strings.LastIndex(image, ":"); i > strings.LastIndex(image, "/") {
+		return image[:i]
+	}
+	return image
+}
+
+// GetImageDigest returns the digest of the image reference. The digest of an image referenced by its tag is
+// looked up with resolveDigest, it is empty without one.
+func GetImageDigest(image string, resolveDigest DigestResolver) (string, error) {
+	if i := strings.Index(image, digestSeparator); i != -1 {
+		return image[i+len(digestSeparator):], nil
+	}
+	if resolveDigest == nil {
+		return "", nil
+	}
+	return resolveDigest(image)
+}
@@ -0,0 +1,136 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package components_test
+
+import (
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+)
+
+var _ = Describe("Images", func() {
+	const (
+		apiSha      = "sha256:5cfa6ac2ac38e5e4c8b1a1c8ad3d5a3e9dbe2a5c2b0e2d6b6c0b4ea04e7c1a11"
+		launcherSha = "sha256:0cd2e1d0b6e1e5f1c2e0e5c1d7f8e6b4a2c0d1e3f5a7b9c1d3e5f7a9b1c3d5e7"
+	)
+
+	getImage := func(images []components.Image, component string) *components.Image {
+		for i := range images {
+			if images[i].Component == component {
+				return &images[i]
+			}
+		}
+		return nil
+	}
+
+	It("should list the images of all components referenced by their tag", func() {
+		config := &util.KubeVirtDeploymentConfig{
+			Registry:          "registry:5000/kubevirt",
+			ImagePrefix:       "prefix-",
+			KubeVirtVersion:   "v1.3.0",
+			VirtOperatorImage: "registry:5000/kubevirt/prefix-virt-operator:v1.3.0",
+		}
+
+		images, err := components.GetImages(config, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(images).To(HaveLen(9))
+		Expect(getImage(images, components.VirtLauncherName)).To(HaveValue(Equal(components.Image{
+			Component: components.VirtLauncherName,
+			Image:     "registry:5000/kubevirt/prefix-virt-launcher:v1.3.0",
+		})))
+		Expect(getImage(images, components.LibguestfsToolsName).Image).To(Equal("registry:5000/kubevirt/prefix-libguestfs-tools:v1.3.0"))
+		Expect(getImage(images, components.PrHelperName)).To(BeNil())
+	})
+
+	It("should report the digests of images referenced by their digest", func() {
+		config := &util.KubeVirtDeploymentConfig{
+			Registry:          "quay.io/kubevirt",
+			KubeVirtVersion:   "v1.3.0",
+			VirtOperatorSha:   "sha256:operator",
+			VirtApiSha:        apiSha,
+			VirtControllerSha: "sha256:controller",
+			VirtHandlerSha:    "sha256:handler",
+			VirtLauncherSha:   launcherSha,
+			GsImage:           "mirror.example.com/libguestfs-tools:custom",
+			AdditionalProperties: map[string]string{
+				util.AdditionalPropertiesPersistentReservationEnabled: "",
+			},
+		}
+
+		images, err := components.GetImages(config, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(getImage(images, components.VirtAPIName)).To(HaveValue(Equal(components.Image{
+			Component: components.VirtAPIName,
+			Image:     "quay.io/kubevirt/virt-api@" + apiSha,
+			Digest:    apiSha,
+		})))
+		Expect(getImage(images, components.LibguestfsToolsName).Image).To(Equal("mirror.example.com/libguestfs-tools:custom"))
+		Expect(getImage(images, components.LibguestfsToolsName).Digest).To(BeEmpty())
+		Expect(getImage(images, components.PrHelperName)).ToNot(BeNil())
+	})
+
+	It("should resolve the digests of images referenced by their tag", func() {
+		config := &util.KubeVirtDeploymentConfig{
+			Registry:        "quay.io/kubevirt",
+			KubeVirtVersion: "v1.3.0",
+			VirtApiSha:      apiSha,
+		}
+
+		var resolved []string
+		images, err := components.GetImages(config, func(image string) (string, error) {
+			resolved = append(resolved, image)
+			return launcherSha, nil
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved).To(HaveLen(8))
+		Expect(resolved).ToNot(ContainElement(ContainSubstring("virt-api")))
+
+		launcher := getImage(images, components.VirtLauncherName)
+		Expect(launcher).To(HaveValue(Equal(components.Image{
+			Component: components.VirtLauncherName,
+			Image:     "quay.io/kubevirt/virt-launcher:v1.3.0",
+			Digest:    launcherSha,
+		})))
+		Expect(launcher.ReferencedByDigest()).To(BeFalse())
+		Expect(getImage(images, components.VirtAPIName).ReferencedByDigest()).To(BeTrue())
+	})
+
+	It("should fail if a tag can't be resolved", func() {
+		config := &util.KubeVirtDeploymentConfig{Registry: "quay.io/kubevirt", KubeVirtVersion: "v1.3.0"}
+		_, err := components.GetImages(config, func(image string) (string, error) {
+			return "", fmt.Errorf("failed to resolve the tag of image %s", image)
+		})
+		Expect(err).To(MatchError("failed to resolve the tag of image quay.io/kubevirt/virt-operator:v1.3.0"))
+	})
+
+	DescribeTable("should split the image reference", func(image, repository, digest string) {
+		Expect(components.GetImageRepository(image)).To(Equal(repository))
+		Expect(components.GetImageDigest(image, nil)).To(Equal(digest))
+	},
+		Entry("with a tag", "quay.io/kubevirt/virt-api:v1.3.0", "quay.io/kubevirt/virt-api", ""),
+		Entry("with a registry port", "registry:5000/virt-api:v1.3.0", "registry:5000/virt-api", ""),
+		Entry("with a registry port and without a tag", "registry:5000/virt-api", "registry:5000/virt-api", ""),
+		Entry("with a digest", "quay.io/kubevirt/virt-api@"+apiSha, "quay.io/kubevirt/virt-api", apiSha),
+	)
+})
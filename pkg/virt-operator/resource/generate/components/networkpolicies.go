@@ -0,0 +1,207 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package components
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	virtv1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+const (
+	// DefaultComponentPort is the port the KubeVirt components serve their API and metrics on
+	DefaultComponentPort int32 = 8443
+	// DefaultHandlerConsoleServerPort is the port virt-handler serves the console connections on
+	DefaultHandlerConsoleServerPort int32 = 8186
+	operatorWebhookPort             int32 = 8444
+	exportServerPort                int32 = 8443
+
+	// The migration target proxies of virt-handler listen on ports picked by the kernel
+	migrationPortRangeStart int32 = 1024
+	migrationPortRangeEnd   int32 = 65535
+
+	dnsPort int32 = 53
+)
+
+// NetworkPolicyConfig holds the settings the NetworkPolicies of the KubeVirt components follow
+type NetworkPolicyConfig struct {
+	APIPort            int32
+	ControllerPort     int32
+	HandlerPort        int32
+	HandlerConsolePort int32
+	// APIServerPorts are the ports the components reach the apiserver on. The apiserver usually doesn't run
+	// on the pod network, so its traffic can only be narrowed down by port.
+	APIServerPorts []int32
+	// ExportProxy is set if virt-exportproxy is deployed
+	ExportProxy bool
+	// PodNetworkMigrations is set if virt-handlers migrate VMIs over the pod network
+	PodNetworkMigrations bool
+	// MonitoringNamespaceSelector selects the namespaces metrics are scraped from
+	MonitoringNamespaceSelector *metav1.LabelSelector
+}
+
+// NewNetworkPolicies returns the NetworkPolicies which allow the ingress and the egress traffic of the KubeVirt
+// components. The policies are additive for ingress, they don't deny any ingress traffic on their own. Egress is
+// limited to the apiserver, DNS and the traffic between the components, plus the export servers for virt-exportproxy.
+func NewNetworkPolicies(namespace string, config *NetworkPolicyConfig) []*networkingv1.NetworkPolicy {
+	metrics := networkingv1.NetworkPolicyPeer{NamespaceSelector: config.MonitoringNamespaceSelector}
+	component := func(name string) networkingv1.NetworkPolicyPeer {
+		return networkingv1.NetworkPolicyPeer{
+			PodSelector: &metav1.LabelSelector{MatchLabels: map[string]string{virtv1.AppLabel: name}},
+		}
+	}
+	migrationPorts := []networkingv1.NetworkPolicyPort{{
+		Protocol: pointer.P(corev1.ProtocolTCP),
+		Port:     pointer.P(intstr.FromInt32(migrationPortRangeStart)),
+		EndPort:  pointer.P(migrationPortRangeEnd),
+	}}
+
+	policies := []*networkingv1.NetworkPolicy{
+		// virt-api serves the aggregated API and the webhooks to the apiserver, its source can't be narrowed down
+		newNetworkPolicy(namespace, VirtAPIName,
+			[]networkingv1.NetworkPolicyIngressRule{
+				newIngressRule(config.APIPort),
+			},
+			newEgressRules(config.APIServerPorts,
+				// console, VNC and the other subresources are proxied to virt-handler
+				newEgressRule(config.HandlerConsolePort, component(VirtHandlerName)),
+			),
+		),
+		newNetworkPolicy(namespace, VirtControllerName,
+			[]networkingv1.NetworkPolicyIngressRule{
+				newIngressRule(config.ControllerPort, metrics),
+			},
+			newEgressRules(config.APIServerPorts),
+		),
+		newNetworkPolicy(namespace, VirtOperatorName,
+			[]networkingv1.NetworkPolicyIngressRule{
+				newIngressRule(operatorWebhookPort),
+				newIngressRule(DefaultComponentPort, metrics),
+			},
+			newEgressRules(config.APIServerPorts),
+		),
+	}
+
+	handler := newNetworkPolicy(namespace, VirtHandlerName,
+		[]networkingv1.NetworkPolicyIngressRule{
+			newIngressRule(config.HandlerPort, metrics),
+			// console, VNC and the other subresources are proxied by virt-api
+			newIngressRule(config.HandlerConsolePort, component(VirtAPIName)),
+		},
+		newEgressRules(config.APIServerPorts),
+	)
+	if config.PodNetworkMigrations {
+		handler.Spec.Ingress = append(handler.Spec.Ingress, networkingv1.NetworkPolicyIngressRule{
+			Ports: migrationPorts,
+			From:  []networkingv1.NetworkPolicyPeer{component(VirtHandlerName)},
+		})
+		handler.Spec.Egress = append(handler.Spec.Egress, networkingv1.NetworkPolicyEgressRule{
+			Ports: migrationPorts,
+			To:    []networkingv1.NetworkPolicyPeer{component(VirtHandlerName)},
+		})
+	}
+	policies = append(policies, handler)
+
+	if config.ExportProxy {
+		policies = append(policies, newNetworkPolicy(namespace, VirtExportProxyName,
+			[]networkingv1.NetworkPolicyIngressRule{
+				newIngressRule(DefaultComponentPort),
+			},
+			newEgressRules(config.APIServerPorts,
+				// the export servers run in the namespaces of the exported volumes
+				newEgressRule(exportServerPort, networkingv1.NetworkPolicyPeer{NamespaceSelector: &metav1.LabelSelector{}}),
+			),
+		))
+	}
+
+	return policies
+}
+
+func newNetworkPolicy(namespace, component string, ingress []networkingv1.NetworkPolicyIngressRule, egress []networkingv1.NetworkPolicyEgressRule) *networkingv1.NetworkPolicy {
+	name := component + "-network-policy"
+	return &networkingv1.NetworkPolicy{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "networking.k8s.io/v1",
+			Kind:       "NetworkPolicy",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      name,
+			Labels: map[string]string{
+				virtv1.AppLabel: name,
+			},
+		},
+		Spec: networkingv1.NetworkPolicySpec{
+			PodSelector: metav1.LabelSelector{
+				MatchLabels: map[string]string{virtv1.AppLabel: component},
+			},
+			PolicyTypes: []networkingv1.PolicyType{networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress},
+			Ingress:     ingress,
+			Egress:      egress,
+		},
+	}
+}
+
+func newIngressRule(port int32, from ...networkingv1.NetworkPolicyPeer) networkingv1.NetworkPolicyIngressRule {
+	return networkingv1.NetworkPolicyIngressRule{
+		Ports: []networkingv1.NetworkPolicyPort{{
+			Protocol: pointer.P(corev1.ProtocolTCP),
+			Port:     pointer.P(intstr.FromInt32(port)),
+		}},
+		From: from,
+	}
+}
+
+func newEgressRule(port int32, to ...networkingv1.NetworkPolicyPeer) networkingv1.NetworkPolicyEgressRule {
+	return networkingv1.NetworkPolicyEgressRule{
+		Ports: []networkingv1.NetworkPolicyPort{{
+			Protocol: pointer.P(corev1.ProtocolTCP),
+			Port:     pointer.P(intstr.FromInt32(port)),
+		}},
+		To: to,
+	}
+}
+
+// newEgressRules returns the egress rules every component needs to reach the apiserver and
+// resolve names, followed by the given rules
+func newEgressRules(apiServerPorts []int32, rules ...networkingv1.NetworkPolicyEgressRule) []networkingv1.NetworkPolicyEgressRule {
+	apiServer := networkingv1.NetworkPolicyEgressRule{}
+	for _, port := range apiServerPorts {
+		apiServer.Ports = append(apiServer.Ports, networkingv1.NetworkPolicyPort{
+			Protocol: pointer.P(corev1.ProtocolTCP),
+			Port:     pointer.P(intstr.FromInt32(port)),
+		})
+	}
+
+	return append([]networkingv1.NetworkPolicyEgressRule{
+		apiServer,
+		{
+			Ports: []networkingv1.NetworkPolicyPort{
+				{Protocol: pointer.P(corev1.ProtocolUDP), Port: pointer.P(intstr.FromInt32(dnsPort))},
+				{Protocol: pointer.P(corev1.ProtocolTCP), Port: pointer.P(intstr.FromInt32(dnsPort))},
+			},
+		},
+	}, rules...)
+}
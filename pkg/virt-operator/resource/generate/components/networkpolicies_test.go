@@ -0,0 +1,135 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors.
+ *
+ */
+
+package components_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+)
+
+var _ = Describe("NetworkPolicies", func() {
+	var config *components.NetworkPolicyConfig
+
+	getPolicy := func(policies []*networkingv1.NetworkPolicy, component string) *networkingv1.NetworkPolicy {
+		for _, policy := range policies {
+			if policy.Spec.PodSelector.MatchLabels[v1.AppLabel] == component {
+				return policy
+			}
+		}
+		return nil
+	}
+
+	BeforeEach(func() {
+		config = &components.NetworkPolicyConfig{
+			APIPort:                     components.DefaultComponentPort,
+			ControllerPort:              components.DefaultComponentPort,
+			HandlerPort:                 components.DefaultComponentPort,
+			HandlerConsolePort:          4321,
+			APIServerPorts:              []int32{443, 16443},
+			PodNetworkMigrations:        true,
+			MonitoringNamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"monitoring": "true"}},
+		}
+	})
+
+	It("should allow the ingress and egress traffic of all components", func() {
+		policies := components.NewNetworkPolicies(testNamespace, config)
+		Expect(policies).To(HaveLen(4))
+		for _, component := range []string{components.VirtAPIName, components.VirtControllerName, components.VirtOperatorName, components.VirtHandlerName} {
+			policy := getPolicy(policies, component)
+			Expect(policy).ToNot(BeNil(), component)
+			Expect(policy.Namespace).To(Equal(testNamespace))
+			Expect(policy.Spec.PolicyTypes).To(ConsistOf(networkingv1.PolicyTypeIngress, networkingv1.PolicyTypeEgress))
+		}
+	})
+
+	It("should allow all components to reach the apiserver and DNS", func() {
+		for _, policy := range components.NewNetworkPolicies(testNamespace, config) {
+			Expect(len(policy.Spec.Egress)).To(BeNumerically(">=", 2), policy.Name)
+
+			apiServer := policy.Spec.Egress[0]
+			Expect(apiServer.To).To(BeEmpty())
+			Expect(apiServer.Ports).To(HaveLen(2))
+			Expect(apiServer.Ports[0].Port.IntVal).To(BeEquivalentTo(443))
+			Expect(apiServer.Ports[1].Port.IntVal).To(BeEquivalentTo(16443))
+
+			dns := policy.Spec.Egress[1]
+			Expect(dns.To).To(BeEmpty())
+			Expect(dns.Ports).To(HaveLen(2))
+			Expect(*dns.Ports[0].Protocol).To(Equal(corev1.ProtocolUDP))
+			Expect(dns.Ports[0].Port.IntVal).To(BeEquivalentTo(53))
+		}
+	})
+
+	It("should allow virt-api to reach the console server of virt-handler", func() {
+		api := getPolicy(components.NewNetworkPolicies(testNamespace, config), components.VirtAPIName)
+		Expect(api.Spec.Egress).To(HaveLen(3))
+		Expect(api.Spec.Egress[2].Ports[0].Port.IntVal).To(BeEquivalentTo(4321))
+		Expect(api.Spec.Egress[2].To[0].PodSelector.MatchLabels).To(Equal(map[string]string{v1.AppLabel: components.VirtHandlerName}))
+	})
+
+	It("should allow virt-api to reach the console server and virt-handlers to migrate", func() {
+		handler := getPolicy(components.NewNetworkPolicies(testNamespace, config), components.VirtHandlerName)
+		Expect(handler.Spec.Ingress).To(HaveLen(3))
+
+		console := handler.Spec.Ingress[1]
+		Expect(console.Ports[0].Port.IntVal).To(BeEquivalentTo(4321))
+		Expect(console.From[0].PodSelector.MatchLabels).To(Equal(map[string]string{v1.AppLabel: components.VirtAPIName}))
+
+		migration := handler.Spec.Ingress[2]
+		Expect(migration.Ports[0].Port.IntVal).To(BeEquivalentTo(1024))
+		Expect(migration.Ports[0].EndPort).To(HaveValue(BeEquivalentTo(65535)))
+		Expect(migration.From[0].PodSelector.MatchLabels).To(Equal(map[string]string{v1.AppLabel: components.VirtHandlerName}))
+
+		Expect(handler.Spec.Egress).To(HaveLen(3))
+		Expect(handler.Spec.Egress[2].Ports).To(Equal(migration.Ports))
+		Expect(handler.Spec.Egress[2].To[0].PodSelector.MatchLabels).To(Equal(map[string]string{v1.AppLabel: components.VirtHandlerName}))
+	})
+
+	It("should not allow migrations over the pod network with a migration network", func() {
+		config.PodNetworkMigrations = false
+		handler := getPolicy(components.NewNetworkPolicies(testNamespace, config), components.VirtHandlerName)
+		Expect(handler.Spec.Ingress).To(HaveLen(2))
+		Expect(handler.Spec.Egress).To(HaveLen(2))
+	})
+
+	It("should only allow metrics scraping from the monitoring namespaces", func() {
+		controller := getPolicy(components.NewNetworkPolicies(testNamespace, config), components.VirtControllerName)
+		Expect(controller.Spec.Ingress).To(HaveLen(1))
+		Expect(controller.Spec.Ingress[0].From).To(HaveLen(1))
+		Expect(controller.Spec.Ingress[0].From[0].NamespaceSelector).To(Equal(config.MonitoringNamespaceSelector))
+	})
+
+	It("should only include virt-exportproxy if it is deployed", func() {
+		config.ExportProxy = true
+		exportProxy := getPolicy(components.NewNetworkPolicies(testNamespace, config), components.VirtExportProxyName)
+		Expect(exportProxy).ToNot(BeNil())
+		Expect(exportProxy.Spec.Ingress[0].From).To(BeEmpty())
+		Expect(exportProxy.Spec.Egress).To(HaveLen(3))
+		Expect(exportProxy.Spec.Egress[2].To[0].NamespaceSelector).To(Equal(&metav1.LabelSelector{}))
+	})
+})
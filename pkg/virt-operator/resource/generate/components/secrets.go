@@ -32,8 +32,11 @@ const (
 	VirtControllerCertSecretName    = "kubevirt-controller-certs"
 	VirtExportProxyCertSecretName   = "kubevirt-exportproxy-certs"
 	CABundleKey                     = "ca-bundle"
+	CACertBytesValue                = "ca.crt"
 	LocalPodDNStemplateString       = "%s.%s.pod.cluster.local"
 	CaClusterLocal                  = "cluster.local"
+	handlerServerCommonName         = "kubevirt.io:system:node:virt-handler"
+	handlerClientCommonName         = "kubevirt.io:system:client:virt-handler"
 )
 
 type CertificateCreationCallback func(secret *k8sv1.Secret, caCert *tls.Certificate, duration time.Duration) (cert *x509.Certificate, key *ecdsa.PrivateKey)
@@ -105,7 +108,7 @@ var populationStrategy = map[string]CertificateCreationCallback{
 		}
 		keyPair, _ := triple.NewServerKeyPair(
 			caKeyPair,
-			"kubevirt.io:system:node:virt-handler",
+			handlerServerCommonName,
 			VirtHandlerServiceName,
 			secret.Namespace,
 			CaClusterLocal,
@@ -121,7 +124,7 @@ var populationStrategy = map[string]CertificateCreationCallback{
 			Cert: caCert.Leaf,
 		}
 		clientKeyPair, _ := triple.NewClientKeyPair(caKeyPair,
-			"kubevirt.io:system:client:virt-handler",
+			handlerClientCommonName,
 			nil,
 			duration,
 		)
@@ -165,6 +168,122 @@ func PopulateSecretWithCertificate(secret *k8sv1.Secret, caCert *tls.Certificate
 	return nil
 }
 
+// ExternalCertificateSpec describes the certificate a component secret has to hold if the
+// certificates are not issued by virt-operator.
+type ExternalCertificateSpec struct {
+	CommonName string
+	// RequireCommonName is set if peers authenticate the component by the common name of its certificate
+	RequireCommonName bool
+	// Hostname is the name peers verify when they connect to the component, empty for client certificates
+	Hostname string
+	DNSNames []string
+	// Usages are requested from the issuer, the first one is the usage the component can't work without
+	Usages []x509.ExtKeyUsage
+}
+
+func newExternalServerCertificateSpec(serviceName string, namespace string) *ExternalCertificateSpec {
+	return &ExternalCertificateSpec{
+		CommonName: fmt.Sprintf(LocalPodDNStemplateString, serviceName, namespace),
+		Hostname:   fmt.Sprintf("%s.%s.svc", serviceName, namespace),
+		DNSNames: []string{
+			serviceName,
+			fmt.Sprintf("%s.%s", serviceName, namespace),
+			fmt.Sprintf("%s.%s.svc", serviceName, namespace),
+			fmt.Sprintf("%s.%s.svc.%s", serviceName, namespace, CaClusterLocal),
+		},
+		Usages: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+}
+
+// GetExternalCertificateSpec returns what the certificate in the given component secret has to look like,
+// mirroring the certificates virt-operator issues itself.
+func GetExternalCertificateSpec(secret *k8sv1.Secret) (*ExternalCertificateSpec, error) {
+	switch secret.Name {
+	case VirtOperatorCertSecretName:
+		return newExternalServerCertificateSpec(VirtOperatorServiceName, secret.Namespace), nil
+	case VirtApiCertSecretName:
+		return newExternalServerCertificateSpec(VirtApiServiceName, secret.Namespace), nil
+	case VirtControllerCertSecretName:
+		return newExternalServerCertificateSpec(VirtControllerServiceName, secret.Namespace), nil
+	case VirtExportProxyCertSecretName:
+		return newExternalServerCertificateSpec(VirtExportProxyServiceName, secret.Namespace), nil
+	case VirtHandlerServerCertSecretName:
+		// virt-handler is reached by its pod IP, clients verify the common name instead
+		spec := newExternalServerCertificateSpec(VirtHandlerServiceName, secret.Namespace)
+		spec.CommonName = handlerServerCommonName
+		spec.RequireCommonName = true
+		spec.Hostname = ""
+		return spec, nil
+	case VirtHandlerCertSecretName:
+		return &ExternalCertificateSpec{
+			CommonName:        handlerClientCommonName,
+			RequireCommonName: true,
+			Usages:            []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		}, nil
+	}
+	return nil, fmt.Errorf("no external certificate specification found for secret %s", secret.Name)
+}
+
+// ValidateExternalCertificate verifies that an externally provided secret holds a key pair which fits
+// the component and which chains up to one of the CAs in its ca.crt key at the given time.
+// It returns the certificate and the CAs which have to be trusted by the peers of the component.
+func ValidateExternalCertificate(secret *k8sv1.Secret, now time.Time) (*tls.Certificate, []*x509.Certificate, error) {
+	spec, err := GetExternalCertificateSpec(secret)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	crt, err := LoadCertificates(secret)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid certificate in secret %s/%s: %v", secret.Namespace, secret.Name, err)
+	}
+
+	if spec.RequireCommonName && crt.Leaf.Subject.CommonName != spec.CommonName {
+		return nil, nil, fmt.Errorf("certificate in secret %s/%s has common name %s, expected %s",
+			secret.Namespace, secret.Name, crt.Leaf.Subject.CommonName, spec.CommonName)
+	}
+	if spec.Hostname != "" {
+		if err := crt.Leaf.VerifyHostname(spec.Hostname); err != nil {
+			return nil, nil, fmt.Errorf("certificate in secret %s/%s is not valid for %s: %v", secret.Namespace, secret.Name, spec.Hostname, err)
+		}
+	}
+
+	caBytes, ok := secret.Data[CACertBytesValue]
+	if !ok || len(caBytes) == 0 {
+		return nil, nil, fmt.Errorf("%s value not found in %s secret", CACertBytesValue, secret.Name)
+	}
+	caCerts, err := cert.ParseCertsPEM(caBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid CA certificates in secret %s/%s: %v", secret.Namespace, secret.Name, err)
+	}
+
+	roots := x509.NewCertPool()
+	for _, caCert := range caCerts {
+		roots.AddCert(caCert)
+	}
+	// issuers usually append the intermediate CAs to tls.crt
+	intermediates := x509.NewCertPool()
+	for _, raw := range crt.Certificate[1:] {
+		intermediate, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid intermediate certificate in secret %s/%s: %v", secret.Namespace, secret.Name, err)
+		}
+		intermediates.AddCert(intermediate)
+	}
+
+	_, err = crt.Leaf.Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   now,
+		KeyUsages:     spec.Usages[:1],
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("certificate in secret %s/%s can't be verified: %v", secret.Namespace, secret.Name, err)
+	}
+
+	return crt, caCerts, nil
+}
+
 func NewCACertSecrets(operatorNamespace string) []*k8sv1.Secret {
 	return []*k8sv1.Secret{
 		{
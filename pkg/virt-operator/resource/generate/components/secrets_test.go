@@ -263,6 +263,81 @@ var _ = Describe("Certificate Management", func() {
 		})
 	})
 
+	Context("external certificates", func() {
+		var caCrt *tls.Certificate
+
+		newCA := func() *tls.Certificate {
+			caSecret := NewCACertSecrets("test")[0]
+			Expect(PopulateSecretWithCertificate(caSecret, nil, &v1.Duration{Duration: 24 * time.Hour})).To(Succeed())
+			crt, err := LoadCertificates(caSecret)
+			Expect(err).ToNot(HaveOccurred())
+			return crt
+		}
+
+		newExternalSecret := func(name string, ca *tls.Certificate) *v12.Secret {
+			for _, secret := range NewCertSecrets("test", "test") {
+				if secret.Name == name {
+					Expect(PopulateSecretWithCertificate(secret, ca, &v1.Duration{Duration: 5 * time.Hour})).To(Succeed())
+					secret.Data[CACertBytesValue] = certutil.EncodeCertPEM(ca.Leaf)
+					return secret
+				}
+			}
+			Fail("unknown certificate secret " + name)
+			return nil
+		}
+
+		BeforeEach(func() {
+			caCrt = newCA()
+		})
+
+		DescribeTable("should accept certificates matching the component", func(secretName string) {
+			crt, caCerts, err := ValidateExternalCertificate(newExternalSecret(secretName, caCrt), time.Now())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(crt.Leaf).ToNot(BeNil())
+			Expect(caCerts).To(HaveLen(1))
+			Expect(caCerts[0].Equal(caCrt.Leaf)).To(BeTrue())
+		},
+			Entry("virt-handler", VirtHandlerCertSecretName),
+			Entry("virt-handler server", VirtHandlerServerCertSecretName),
+			Entry("virt-controller", VirtControllerCertSecretName),
+			Entry("virt-api", VirtApiCertSecretName),
+			Entry("virt-operator", VirtOperatorCertSecretName),
+			Entry("virt-exportproxy", VirtExportProxyCertSecretName),
+		)
+
+		It("should require the CA certificates", func() {
+			secret := newExternalSecret(VirtApiCertSecretName, caCrt)
+			delete(secret.Data, CACertBytesValue)
+			_, _, err := ValidateExternalCertificate(secret, time.Now())
+			Expect(err).To(MatchError(ContainSubstring(CACertBytesValue)))
+		})
+
+		It("should reject certificates which are not signed by the provided CA", func() {
+			secret := newExternalSecret(VirtApiCertSecretName, caCrt)
+			secret.Data[CACertBytesValue] = certutil.EncodeCertPEM(newCA().Leaf)
+			_, _, err := ValidateExternalCertificate(secret, time.Now())
+			Expect(err).To(MatchError(ContainSubstring("can't be verified")))
+		})
+
+		It("should reject expired certificates", func() {
+			secret := newExternalSecret(VirtApiCertSecretName, caCrt)
+			_, _, err := ValidateExternalCertificate(secret, time.Now().Add(6*time.Hour))
+			Expect(err).To(MatchError(ContainSubstring("can't be verified")))
+		})
+
+		It("should reject certificates with the wrong identity", func() {
+			secret := newExternalSecret(VirtApiCertSecretName, caCrt)
+			secret.Name = VirtHandlerCertSecretName
+			_, _, err := ValidateExternalCertificate(secret, time.Now())
+			Expect(err).To(MatchError(ContainSubstring("common name")))
+
+			secret = newExternalSecret(VirtApiCertSecretName, caCrt)
+			secret.Name = VirtControllerCertSecretName
+			_, _, err = ValidateExternalCertificate(secret, time.Now())
+			Expect(err).To(MatchError(ContainSubstring("is not valid for virt-controller.test.svc")))
+		})
+	})
+
 	It("should set the right namespaces on the certificate secrets", func() {
 		secrets := NewCertSecrets("install_namespace", "operator_namespace")
 		for _, secret := range secrets[:len(secrets)-1] {
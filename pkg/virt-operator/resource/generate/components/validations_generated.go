@@ -647,6 +647,55 @@ var CRDsValidation map[string]string = map[string]string{
       properties:
         certificateRotateStrategy:
           properties:
+            external:
+              description: |-
+                External makes KubeVirt consume the certificates of its components from user provided Secrets
+                or from cert-manager Certificates instead of issuing them itself.
+                KubeVirt only validates them, distributes their CAs and reports their expiry.
+                Mutually exclusive with SelfSigned.
+              properties:
+                certManager:
+                  description: |-
+                    CertManager makes virt-operator request the certificates of all components from cert-manager.
+                    If not set, the certificate Secrets have to be provided in the install namespace with
+                    the tls.crt, tls.key and ca.crt keys.
+                  properties:
+                    duration:
+                      description: The requested 'duration' (i.e. lifetime) of the
+                        Certificates.
+                      type: string
+                    issuerRef:
+                      description: IssuerRef references the cert-manager issuer
+                        which signs the certificates.
+                      properties:
+                        group:
+                          description: Group of the issuer. Defaults to cert-manager.io.
+                          type: string
+                        kind:
+                          description: Kind of the issuer, Issuer or ClusterIssuer.
+                            Defaults to Issuer.
+                          type: string
+                        name:
+                          description: Name of the issuer.
+                          type: string
+                      required:
+                      - name
+                      type: object
+                    renewBefore:
+                      description: |-
+                        The amount of time before the currently issued certificate's "notAfter"
+                        time that cert-manager will begin to attempt to renew the certificate.
+                      type: string
+                  required:
+                  - issuerRef
+                  type: object
+                expiryWarningThreshold:
+                  description: |-
+                    ExpiryWarningThreshold is the remaining lifetime of a certificate below which
+                    the CertificatesReady condition is set to false.
+                    Defaults to 7 days.
+                  type: string
+              type: object
             selfSigned:
               properties:
                 ca:
@@ -1264,6 +1313,30 @@ var CRDsValidation map[string]string = map[string]string{
               type: object
             minCPUModel:
               type: string
+            namespaceConfiguration:
+              description: NamespaceConfiguration controls which settings KubeVirtNamespaceConfig
+                objects may override
+              nullable: true
+              properties:
+                allowedOverrides:
+                  description: |-
+                    AllowedOverrides lists the fields of KubeVirtNamespaceConfig objects which are merged over the
+                    cluster-wide configuration. Overrides of other fields are ignored. Defaults to none.
+                  items:
+                    description: NamespaceConfigOverride is a field of the cluster-wide
+                      configuration which may be overridden per namespace
+                    enum:
+                    - cpuModel
+                    - evictionStrategy
+                    - migrations
+                    - network
+                    - smbios
+                    - supportContainerResources
+                    - virtualMachineOptions
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: set
+              type: object
             network:
               description: NetworkConfiguration holds network options
               properties:
@@ -1581,6 +1654,20 @@ var CRDsValidation map[string]string = map[string]string{
               description: VMStateStorageClass is the name of the storage class to
                 use for the PVCs created to preserve VM state, like TPM.
               type: string
+            vncTokenAccess:
+              description: VNCTokenAccess configures how the VNC consoles opened
+                with tokens are reached
+              nullable: true
+              properties:
+                externalURL:
+                  description: |-
+                    ExternalURL is the base URL virt-api is exposed at outside of the cluster, for example
+                    through an Ingress or Route which passes TLS through to the virt-api service.
+                    VNC tokens are returned with the URL of the vncproxy endpoint below it, which a browser can
+                    open with the token alone. Without it, the vncproxy endpoint is only reachable through the
+                    Kubernetes API server, which requires clients to authenticate first.
+                  type: string
+              type: object
             webhookConfiguration:
               description: |-
                 ReloadableComponentConfiguration holds all generic k8s configuration options which can
@@ -1654,6 +1741,58 @@ var CRDsValidation map[string]string = map[string]string{
               type: array
               x-kubernetes-list-type: atomic
           type: object
+        handlerUpdateStrategy:
+          description: |-
+            HandlerUpdateStrategy stages the rollout of virt-handler across sets of nodes.
+            The next wave is only started once all virt-handler pods of the current wave
+            are ready and no VMI failed on its nodes since the wave started.
+            Outdated VMIs are then updated wave by wave as well.
+            If not set, virt-handler is rolled out across all nodes at once.
+          properties:
+            maxUnavailable:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                MaxUnavailable is the maximum number of virt-handler pods within a wave
+                which are replaced at the same time. A percentage is relative to the
+                number of nodes in the wave.
+
+                Defaults to 1
+              x-kubernetes-int-or-string: true
+            pauseBetweenWaves:
+              description: |-
+                PauseBetweenWaves is the time to wait after a wave completed before
+                the next wave is started
+
+                Defaults to 0
+              type: string
+            waves:
+              description: |-
+                Waves lists the sets of nodes virt-handler is updated on, in order.
+                A node which matches more than one wave belongs to the first one.
+                Nodes which match no wave are updated after the last wave completed.
+                A wave completes once virt-handler is updated and ready on all of its nodes and no VMI failed on them since the wave started.
+                VMIs which failed halt the rollout, deleting them resumes it.
+              items:
+                description: HandlerUpdateWave is a set of nodes virt-handler is updated
+                  on together
+                properties:
+                  name:
+                    description: Name of the wave
+                    type: string
+                  nodeSelector:
+                    additionalProperties:
+                      type: string
+                    description: NodeSelector selects the nodes of the wave
+                    type: object
+                required:
+                - name
+                - nodeSelector
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         imagePullPolicy:
           description: The ImagePullPolicy to use.
           type: string
@@ -1693,6 +1832,286 @@ var CRDsValidation map[string]string = map[string]string{
           description: selectors and tolerations that should apply to KubeVirt infrastructure
             components
           properties:
+            components:
+              description: |-
+                components allows to tune the deployment of individual KubeVirt infrastructure
+                components (virt-api, virt-controller and virt-exportproxy).
+                Only supported on spec.infra.
+              items:
+                description: InfraComponentConfig describes deployment settings of a single
+                  KubeVirt infrastructure component.
+                properties:
+                  name:
+                    description: name of the component deployment, one of virt-api, virt-controller
+                      or virt-exportproxy.
+                    type: string
+                  podDisruptionBudget:
+                    description: podDisruptionBudget overrides the PodDisruptionBudget the
+                      operator creates for the component.
+                    properties:
+                      maxUnavailable:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: maxUnavailable is the number or percentage of pods which
+                          may be unavailable.
+                        x-kubernetes-int-or-string: true
+                      minAvailable:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: |-
+                          minAvailable is the number or percentage of pods which must stay available.
+                          Defaults to the number of replicas minus one.
+                        x-kubernetes-int-or-string: true
+                    type: object
+                  resources:
+                    description: |-
+                      resources overrides the resource requests and limits of the component container.
+                      Only the given requests and limits are replaced, the remaining defaults are kept.
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This is an alpha field and requires enabling the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  topologySpreadConstraints:
+                    description: |-
+                      topologySpreadConstraints describes how the pods of the component are spread across
+                      topology domains. If no labelSelector is set, the pods of the component are selected.
+                    items:
+                      description: TopologySpreadConstraint specifies how to spread matching
+                        pods among the given topology.
+                      properties:
+                        labelSelector:
+                          description: |-
+                            LabelSelector is used to find matching pods.
+                            Pods that match this label selector are counted to determine the number of pods
+                            in their corresponding topology domain.
+                          properties:
+                            matchExpressions:
+                              description: matchExpressions is a list of label selector requirements.
+                                The requirements are ANDed.
+                              items:
+                                description: |-
+                                  A label selector requirement is a selector that contains values, a key, and an operator that
+                                  relates the key and values.
+                                properties:
+                                  key:
+                                    description: key is the label key that the selector applies
+                                      to.
+                                    type: string
+                                  operator:
+                                    description: |-
+                                      operator represents a key's relationship to a set of values.
+                                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                                    type: string
+                                  values:
+                                    description: |-
+                                      values is an array of string values. If the operator is In or NotIn,
+                                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                      the values array must be empty. This array is replaced during a strategic
+                                      merge patch.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                required:
+                                - key
+                                - operator
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            matchLabels:
+                              additionalProperties:
+                                type: string
+                              description: |-
+                                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                operator is "In", and the values array contains only "value". The requirements are ANDed.
+                              type: object
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        matchLabelKeys:
+                          description: |-
+                            MatchLabelKeys is a set of pod label keys to select the pods over which
+                            spreading will be calculated. The keys are used to lookup values from the
+                            incoming pod labels, those key-value labels are ANDed with labelSelector
+                            to select the group of existing pods over which spreading will be calculated
+                            for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                            MatchLabelKeys cannot be set when LabelSelector isn't set.
+                            Keys that don't exist in the incoming pod labels will
+                            be ignored. A null or empty list means only match against labelSelector.
+
+                            This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        maxSkew:
+                          description: |-
+                            MaxSkew describes the degree to which pods may be unevenly distributed.
+                            When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                            between the number of matching pods in the target topology and the global minimum.
+                            The global minimum is the minimum number of matching pods in an eligible domain
+                            or zero if the number of eligible domains is less than MinDomains.
+                            For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                            labelSelector spread as 2/2/1:
+                            In this case, the global minimum is 1.
+                            | zone1 | zone2 | zone3 |
+                            |  P P  |  P P  |   P   |
+                            - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                            scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                            violate MaxSkew(1).
+                            - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                            When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                            to topologies that satisfy it.
+                            It's a required field. Default value is 1 and 0 is not allowed.
+                          format: int32
+                          type: integer
+                        minDomains:
+                          description: |-
+                            MinDomains indicates a minimum number of eligible domains.
+                            When the number of eligible domains with matching topology keys is less than minDomains,
+                            Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                            And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                            this value has no effect on scheduling.
+                            As a result, when the number of eligible domains is less than minDomains,
+                            scheduler won't schedule more than maxSkew Pods to those domains.
+                            If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                            Valid values are integers greater than 0.
+                            When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+                            For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                            labelSelector spread as 2/2/2:
+                            | zone1 | zone2 | zone3 |
+                            |  P P  |  P P  |  P P  |
+                            The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                            In this situation, new pod with the same labelSelector cannot be scheduled,
+                            because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                            it will violate MaxSkew.
+                          format: int32
+                          type: integer
+                        nodeAffinityPolicy:
+                          description: |-
+                            NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                            when calculating pod topology spread skew. Options are:
+                            - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                            - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+                            If this value is nil, the behavior is equivalent to the Honor policy.
+                            This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                          type: string
+                        nodeTaintsPolicy:
+                          description: |-
+                            NodeTaintsPolicy indicates how we will treat node taints when calculating
+                            pod topology spread skew. Options are:
+                            - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                            has a toleration, are included.
+                            - Ignore: node taints are ignored. All nodes are included.
+
+                            If this value is nil, the behavior is equivalent to the Ignore policy.
+                            This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                          type: string
+                        topologyKey:
+                          description: |-
+                            TopologyKey is the key of node labels. Nodes that have a label with this key
+                            and identical values are considered to be in the same topology.
+                            We consider each <key, value> as a "bucket", and try to put balanced number
+                            of pods into each bucket.
+                            We define a domain as a particular instance of a topology.
+                            Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                            nodeAffinityPolicy and nodeTaintsPolicy.
+                            e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                            And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                            It's a required field.
+                          type: string
+                        whenUnsatisfiable:
+                          description: |-
+                            WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                            the spread constraint.
+                            - DoNotSchedule (default) tells the scheduler not to schedule it.
+                            - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                              but giving higher precedence to topologies that would help reduce the
+                              skew.
+                            A constraint is considered "Unsatisfiable" for an incoming pod
+                            if and only if every possible node assignment for that pod would violate
+                            "MaxSkew" on some topology.
+                            For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                            labelSelector spread as 3/1/1:
+                            | zone1 | zone2 | zone3 |
+                            | P P P |   P   |   P   |
+                            If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                            to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                            MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                            won't make it *more* imbalanced.
+                            It's a required field.
+                          type: string
+                      required:
+                      - maxSkew
+                      - topologyKey
+                      - whenUnsatisfiable
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
             nodePlacement:
               description: |-
                 nodePlacement describes scheduling configuration for specific
@@ -2698,6 +3117,69 @@ var CRDsValidation map[string]string = map[string]string{
             The namespace Prometheus is deployed in
             Defaults to openshift-monitor
           type: string
+        networkPolicies:
+          description: |-
+            NetworkPolicies lets virt-operator generate the NetworkPolicies the KubeVirt components
+            need in clusters which deny ingress or egress traffic by default
+          properties:
+            enabled:
+              description: |-
+                Enabled makes virt-operator create and reconcile NetworkPolicies in the install namespace which
+                allow the ingress and egress traffic of the KubeVirt components, including the console and VNC connections
+                from virt-api to virt-handler, the migrations between virt-handlers and metrics scraping.
+                Egress is limited to the apiserver, DNS, the traffic between the components and the export servers.
+                The policies follow the configured ports and the migration network.
+                Defaults to false.
+              type: boolean
+            monitoringNamespaceSelector:
+              description: |-
+                MonitoringNamespaceSelector selects the namespaces which are allowed to scrape the metrics of the components.
+                Defaults to the monitorNamespace, or to the well known prometheus-operator namespaces if it is not set.
+              properties:
+                matchExpressions:
+                  description: matchExpressions is a list of label selector requirements.
+                    The requirements are ANDed.
+                  items:
+                    description: |-
+                      A label selector requirement is a selector that contains values, a key, and an operator that
+                      relates the key and values.
+                    properties:
+                      key:
+                        description: key is the label key that the selector applies
+                          to.
+                        type: string
+                      operator:
+                        description: |-
+                          operator represents a key's relationship to a set of values.
+                          Valid operators are In, NotIn, Exists and DoesNotExist.
+                        type: string
+                      values:
+                        description: |-
+                          values is an array of string values. If the operator is In or NotIn,
+                          the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                          the values array must be empty. This array is replaced during a strategic
+                          merge patch.
+                        items:
+                          type: string
+                        type: array
+                        x-kubernetes-list-type: atomic
+                    required:
+                    - key
+                    - operator
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                matchLabels:
+                  additionalProperties:
+                    type: string
+                  description: |-
+                    matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                    map is equivalent to an element of matchExpressions, whose key field is "key", the
+                    operator is "In", and the values array contains only "value". The requirements are ANDed.
+                  type: object
+              type: object
+              x-kubernetes-map-type: atomic
+          type: object
         productComponent:
           description: |-
             Designate the apps.kubevirt.io/component label for KubeVirt components.
@@ -2716,6 +3198,14 @@ var CRDsValidation map[string]string = map[string]string{
             Useful if KubeVirt is included as part of a product.
             If ProductVersion is not specified, KubeVirt's version will be used.
           type: string
+        rollbackTo:
+          description: |-
+            RollbackTo is the deployment ID of a previously deployed KubeVirt, as reported by
+            status.previousDeployment.deploymentID. While set, the install strategy of that
+            deployment is re-applied instead of the one derived from imageTag and imageRegistry.
+            The rollback is refused if objects were stored in an API version the previous
+            release can't read. Remove it to update to the regular target version again.
+          type: string
         serviceMonitorNamespace:
           description: |-
             The namespace the service monitor will be deployed
@@ -2763,31 +3253,311 @@ var CRDsValidation map[string]string = map[string]string{
         workloads:
           description: selectors and tolerations that should apply to KubeVirt workloads
           properties:
-            nodePlacement:
+            components:
               description: |-
-                nodePlacement describes scheduling configuration for specific
-                KubeVirt components
-              properties:
-                affinity:
-                  description: |-
-                    affinity enables pod affinity/anti-affinity placement expanding the types of constraints
-                    that can be expressed with nodeSelector.
-                    affinity is going to be applied to the relevant kind of pods in parallel with nodeSelector
-                    See https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/#affinity-and-anti-affinity
-                  properties:
-                    nodeAffinity:
-                      description: Describes node affinity scheduling rules for the
-                        pod.
-                      properties:
-                        preferredDuringSchedulingIgnoredDuringExecution:
-                          description: |-
-                            The scheduler will prefer to schedule pods to nodes that satisfy
-                            the affinity expressions specified by this field, but it may choose
-                            a node that violates one or more of the expressions. The node that is
-                            most preferred is the one with the greatest sum of weights, i.e.
-                            for each node that meets all of the scheduling requirements (resource
-                            request, requiredDuringScheduling affinity expressions, etc.),
-                            compute a sum by iterating through the elements of this field and adding
+                components allows to tune the deployment of individual KubeVirt infrastructure
+                components (virt-api, virt-controller and virt-exportproxy).
+                Only supported on spec.infra.
+              items:
+                description: InfraComponentConfig describes deployment settings of a single
+                  KubeVirt infrastructure component.
+                properties:
+                  name:
+                    description: name of the component deployment, one of virt-api, virt-controller
+                      or virt-exportproxy.
+                    type: string
+                  podDisruptionBudget:
+                    description: podDisruptionBudget overrides the PodDisruptionBudget the
+                      operator creates for the component.
+                    properties:
+                      maxUnavailable:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: maxUnavailable is the number or percentage of pods which
+                          may be unavailable.
+                        x-kubernetes-int-or-string: true
+                      minAvailable:
+                        anyOf:
+                        - type: integer
+                        - type: string
+                        description: |-
+                          minAvailable is the number or percentage of pods which must stay available.
+                          Defaults to the number of replicas minus one.
+                        x-kubernetes-int-or-string: true
+                    type: object
+                  resources:
+                    description: |-
+                      resources overrides the resource requests and limits of the component container.
+                      Only the given requests and limits are replaced, the remaining defaults are kept.
+                    properties:
+                      claims:
+                        description: |-
+                          Claims lists the names of resources, defined in spec.resourceClaims,
+                          that are used by this container.
+
+                          This is an alpha field and requires enabling the
+                          DynamicResourceAllocation feature gate.
+
+                          This field is immutable. It can only be set for containers.
+                        items:
+                          description: ResourceClaim references one entry in PodSpec.ResourceClaims.
+                          properties:
+                            name:
+                              description: |-
+                                Name must match the name of one entry in pod.spec.resourceClaims of
+                                the Pod where this field is used. It makes that resource available
+                                inside a container.
+                              type: string
+                            request:
+                              description: |-
+                                Request is the name chosen for a request in the referenced claim.
+                                If empty, everything from the claim is made available, otherwise
+                                only the result of this request.
+                              type: string
+                          required:
+                          - name
+                          type: object
+                        type: array
+                        x-kubernetes-list-map-keys:
+                        - name
+                        x-kubernetes-list-type: map
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  topologySpreadConstraints:
+                    description: |-
+                      topologySpreadConstraints describes how the pods of the component are spread across
+                      topology domains. If no labelSelector is set, the pods of the component are selected.
+                    items:
+                      description: TopologySpreadConstraint specifies how to spread matching
+                        pods among the given topology.
+                      properties:
+                        labelSelector:
+                          description: |-
+                            LabelSelector is used to find matching pods.
+                            Pods that match this label selector are counted to determine the number of pods
+                            in their corresponding topology domain.
+                          properties:
+                            matchExpressions:
+                              description: matchExpressions is a list of label selector requirements.
+                                The requirements are ANDed.
+                              items:
+                                description: |-
+                                  A label selector requirement is a selector that contains values, a key, and an operator that
+                                  relates the key and values.
+                                properties:
+                                  key:
+                                    description: key is the label key that the selector applies
+                                      to.
+                                    type: string
+                                  operator:
+                                    description: |-
+                                      operator represents a key's relationship to a set of values.
+                                      Valid operators are In, NotIn, Exists and DoesNotExist.
+                                    type: string
+                                  values:
+                                    description: |-
+                                      values is an array of string values. If the operator is In or NotIn,
+                                      the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                                      the values array must be empty. This array is replaced during a strategic
+                                      merge patch.
+                                    items:
+                                      type: string
+                                    type: array
+                                    x-kubernetes-list-type: atomic
+                                required:
+                                - key
+                                - operator
+                                type: object
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            matchLabels:
+                              additionalProperties:
+                                type: string
+                              description: |-
+                                matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                                map is equivalent to an element of matchExpressions, whose key field is "key", the
+                                operator is "In", and the values array contains only "value". The requirements are ANDed.
+                              type: object
+                          type: object
+                          x-kubernetes-map-type: atomic
+                        matchLabelKeys:
+                          description: |-
+                            MatchLabelKeys is a set of pod label keys to select the pods over which
+                            spreading will be calculated. The keys are used to lookup values from the
+                            incoming pod labels, those key-value labels are ANDed with labelSelector
+                            to select the group of existing pods over which spreading will be calculated
+                            for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                            MatchLabelKeys cannot be set when LabelSelector isn't set.
+                            Keys that don't exist in the incoming pod labels will
+                            be ignored. A null or empty list means only match against labelSelector.
+
+                            This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        maxSkew:
+                          description: |-
+                            MaxSkew describes the degree to which pods may be unevenly distributed.
+                            When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                            between the number of matching pods in the target topology and the global minimum.
+                            The global minimum is the minimum number of matching pods in an eligible domain
+                            or zero if the number of eligible domains is less than MinDomains.
+                            For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                            labelSelector spread as 2/2/1:
+                            In this case, the global minimum is 1.
+                            | zone1 | zone2 | zone3 |
+                            |  P P  |  P P  |   P   |
+                            - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
+                            scheduling it onto zone1(zone2) would make the ActualSkew(3-1) on zone1(zone2)
+                            violate MaxSkew(1).
+                            - if MaxSkew is 2, incoming pod can be scheduled onto any zone.
+                            When 'whenUnsatisfiable=ScheduleAnyway', it is used to give higher precedence
+                            to topologies that satisfy it.
+                            It's a required field. Default value is 1 and 0 is not allowed.
+                          format: int32
+                          type: integer
+                        minDomains:
+                          description: |-
+                            MinDomains indicates a minimum number of eligible domains.
+                            When the number of eligible domains with matching topology keys is less than minDomains,
+                            Pod Topology Spread treats "global minimum" as 0, and then the calculation of Skew is performed.
+                            And when the number of eligible domains with matching topology keys equals or greater than minDomains,
+                            this value has no effect on scheduling.
+                            As a result, when the number of eligible domains is less than minDomains,
+                            scheduler won't schedule more than maxSkew Pods to those domains.
+                            If value is nil, the constraint behaves as if MinDomains is equal to 1.
+                            Valid values are integers greater than 0.
+                            When value is not nil, WhenUnsatisfiable must be DoNotSchedule.
+
+                            For example, in a 3-zone cluster, MaxSkew is set to 2, MinDomains is set to 5 and pods with the same
+                            labelSelector spread as 2/2/2:
+                            | zone1 | zone2 | zone3 |
+                            |  P P  |  P P  |  P P  |
+                            The number of domains is less than 5(MinDomains), so "global minimum" is treated as 0.
+                            In this situation, new pod with the same labelSelector cannot be scheduled,
+                            because computed skew will be 3(3 - 0) if new Pod is scheduled to any of the three zones,
+                            it will violate MaxSkew.
+                          format: int32
+                          type: integer
+                        nodeAffinityPolicy:
+                          description: |-
+                            NodeAffinityPolicy indicates how we will treat Pod's nodeAffinity/nodeSelector
+                            when calculating pod topology spread skew. Options are:
+                            - Honor: only nodes matching nodeAffinity/nodeSelector are included in the calculations.
+                            - Ignore: nodeAffinity/nodeSelector are ignored. All nodes are included in the calculations.
+
+                            If this value is nil, the behavior is equivalent to the Honor policy.
+                            This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                          type: string
+                        nodeTaintsPolicy:
+                          description: |-
+                            NodeTaintsPolicy indicates how we will treat node taints when calculating
+                            pod topology spread skew. Options are:
+                            - Honor: nodes without taints, along with tainted nodes for which the incoming pod
+                            has a toleration, are included.
+                            - Ignore: node taints are ignored. All nodes are included.
+
+                            If this value is nil, the behavior is equivalent to the Ignore policy.
+                            This is a beta-level feature default enabled by the NodeInclusionPolicyInPodTopologySpread feature flag.
+                          type: string
+                        topologyKey:
+                          description: |-
+                            TopologyKey is the key of node labels. Nodes that have a label with this key
+                            and identical values are considered to be in the same topology.
+                            We consider each <key, value> as a "bucket", and try to put balanced number
+                            of pods into each bucket.
+                            We define a domain as a particular instance of a topology.
+                            Also, we define an eligible domain as a domain whose nodes meet the requirements of
+                            nodeAffinityPolicy and nodeTaintsPolicy.
+                            e.g. If TopologyKey is "kubernetes.io/hostname", each Node is a domain of that topology.
+                            And, if TopologyKey is "topology.kubernetes.io/zone", each zone is a domain of that topology.
+                            It's a required field.
+                          type: string
+                        whenUnsatisfiable:
+                          description: |-
+                            WhenUnsatisfiable indicates how to deal with a pod if it doesn't satisfy
+                            the spread constraint.
+                            - DoNotSchedule (default) tells the scheduler not to schedule it.
+                            - ScheduleAnyway tells the scheduler to schedule the pod in any location,
+                              but giving higher precedence to topologies that would help reduce the
+                              skew.
+                            A constraint is considered "Unsatisfiable" for an incoming pod
+                            if and only if every possible node assignment for that pod would violate
+                            "MaxSkew" on some topology.
+                            For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                            labelSelector spread as 3/1/1:
+                            | zone1 | zone2 | zone3 |
+                            | P P P |   P   |   P   |
+                            If WhenUnsatisfiable is set to DoNotSchedule, incoming pod can only be scheduled
+                            to zone2(zone3) to become 3/2/1(3/1/2) as ActualSkew(2-1) on zone2(zone3) satisfies
+                            MaxSkew(1). In other words, the cluster can still be imbalanced, but scheduler
+                            won't make it *more* imbalanced.
+                            It's a required field.
+                          type: string
+                      required:
+                      - maxSkew
+                      - topologyKey
+                      - whenUnsatisfiable
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-map-keys:
+              - name
+              x-kubernetes-list-type: map
+            nodePlacement:
+              description: |-
+                nodePlacement describes scheduling configuration for specific
+                KubeVirt components
+              properties:
+                affinity:
+                  description: |-
+                    affinity enables pod affinity/anti-affinity placement expanding the types of constraints
+                    that can be expressed with nodeSelector.
+                    affinity is going to be applied to the relevant kind of pods in parallel with nodeSelector
+                    See https://kubernetes.io/docs/concepts/scheduling-eviction/assign-pod-node/#affinity-and-anti-affinity
+                  properties:
+                    nodeAffinity:
+                      description: Describes node affinity scheduling rules for the
+                        pod.
+                      properties:
+                        preferredDuringSchedulingIgnoredDuringExecution:
+                          description: |-
+                            The scheduler will prefer to schedule pods to nodes that satisfy
+                            the affinity expressions specified by this field, but it may choose
+                            a node that violates one or more of the expressions. The node that is
+                            most preferred is the one with the greatest sum of weights, i.e.
+                            for each node that meets all of the scheduling requirements (resource
+                            request, requiredDuringScheduling affinity expressions, etc.),
+                            compute a sum by iterating through the elements of this field and adding
                             "weight" to the sum if the node matches the corresponding matchExpressions; the
                             node(s) with the highest sum are the most preferred.
                           items:
@@ -3788,6 +4558,11 @@ var CRDsValidation map[string]string = map[string]string{
             - type
             type: object
           type: array
+        configurationRevision:
+          description: |-
+            ConfigurationRevision is the name of the ControllerRevision which holds the
+            spec.configuration that is currently applied.
+          type: string
         defaultArchitecture:
           type: string
         generations:
@@ -3824,6 +4599,51 @@ var CRDsValidation map[string]string = map[string]string{
             type: object
           type: array
           x-kubernetes-list-type: atomic
+        handlerUpdate:
+          description: HandlerUpdate reports the progress of a staged virt-handler
+            rollout
+          properties:
+            currentWave:
+              description: |-
+                CurrentWave is the index of the wave which is currently rolled out.
+                It equals the number of waves once the remaining nodes are updated.
+              type: integer
+            targetDeploymentID:
+              description: TargetDeploymentID is the deployment the waves are rolled
+                out for
+              type: string
+            waves:
+              items:
+                description: HandlerUpdateWaveStatus reports the progress of a single
+                  wave
+                properties:
+                  completionTime:
+                    format: date-time
+                    nullable: true
+                    type: string
+                  name:
+                    description: Name of the wave
+                    type: string
+                  nodes:
+                    description: Nodes which belong to the wave. They are resolved
+                      when the wave is started.
+                    items:
+                      type: string
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  startTime:
+                    format: date-time
+                    nullable: true
+                    type: string
+                required:
+                - name
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          required:
+          - currentWave
+          - targetDeploymentID
+          type: object
         observedDeploymentConfig:
           type: string
         observedDeploymentID:
@@ -3843,6 +4663,57 @@ var CRDsValidation map[string]string = map[string]string{
           description: KubeVirtPhase is a label for the phase of a KubeVirt deployment
             at the current time.
           type: string
+        previousDeployment:
+          description: |-
+            PreviousDeployment is the deployment which was observed before the current one.
+            It can be restored with spec.rollbackTo.
+          properties:
+            deploymentConfig:
+              type: string
+            deploymentID:
+              type: string
+            generations:
+              description: Generations of the objects of the deployment at the time
+                it was replaced
+              items:
+                description: GenerationStatus keeps track of the generation for a
+                  given resource so that decisions about forced updates can be made.
+                properties:
+                  group:
+                    description: group is the group of the thing you're tracking
+                    type: string
+                  hash:
+                    description: hash is an optional field set for resources without
+                      generation that are content sensitive like secrets and configmaps
+                    type: string
+                  lastGeneration:
+                    description: lastGeneration is the last generation of the workload
+                      controller involved
+                    format: int64
+                    type: integer
+                  name:
+                    description: name is the name of the thing you're tracking
+                    type: string
+                  namespace:
+                    description: namespace is where the thing you're tracking is
+                    type: string
+                  resource:
+                    description: resource is the resource type of the thing you're
+                      tracking
+                    type: string
+                required:
+                - group
+                - lastGeneration
+                - name
+                - resource
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+            kubeVirtRegistry:
+              type: string
+            kubeVirtVersion:
+              type: string
+          type: object
         targetDeploymentConfig:
           type: string
         targetDeploymentID:
@@ -3856,9 +4727,12 @@ var CRDsValidation map[string]string = map[string]string{
   - spec
   type: object
 `,
-	"migrationpolicy": `openAPIV3Schema:
-  description: MigrationPolicy holds migration policy (i.e. configurations) to apply
-    to a VM or group of VMs
+	"kubevirtnamespaceconfig": `openAPIV3Schema:
+  description: |-
+    KubeVirtNamespaceConfig overrides parts of the cluster-wide KubeVirt configuration for the
+    VirtualMachineInstances of its namespace. Only the fields allowed by
+    spec.configuration.namespaceConfiguration of the KubeVirt CR are applied.
+    Namespace admins can read but not modify them.
   properties:
     apiVersion:
       description: |-
@@ -3878,34 +4752,326 @@ var CRDsValidation map[string]string = map[string]string{
     metadata:
       type: object
     spec:
+      description: |-
+        KubeVirtNamespaceConfigSpec holds the overrides of the cluster-wide configuration. Fields which are set are
+        merged over their cluster-wide counterpart, nested fields which are not set keep their cluster-wide value.
+        Lists replace the cluster-wide list.
       properties:
-        allowAutoConverge:
-          type: boolean
-        allowPostCopy:
-          type: boolean
-        allowWorkloadDisruption:
-          type: boolean
-        bandwidthPerMigration:
-          anyOf:
-          - type: integer
-          - type: string
-          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
-          x-kubernetes-int-or-string: true
-        completionTimeoutPerGiB:
-          format: int64
-          type: integer
-        selectors:
+        cpuModel:
+          description: CPUModel overrides the default CPU model of VMIs
+          type: string
+        evictionStrategy:
+          description: EvictionStrategy overrides the cluster-wide eviction strategy
+          type: string
+        migrations:
+          description: |-
+            MigrationConfiguration overrides the settings of migrations of VMIs in the namespace, like the bandwidth.
+            The parallel migration limits, disableTLS, unsafeMigrationOverride and allowPostCopy can't be overridden.
           properties:
-            namespaceSelector:
-              additionalProperties:
-                type: string
-              type: object
-            virtualMachineInstanceSelector:
-              additionalProperties:
-                type: string
-              type: object
+            allowAutoConverge:
+              description: |-
+                AllowAutoConverge allows the platform to compromise performance/availability of VMIs to
+                guarantee successful VMI live migrations. Defaults to false
+              type: boolean
+            allowPostCopy:
+              description: |-
+                AllowPostCopy enables post-copy live migrations. Such migrations allow even the busiest VMIs
+                to successfully live-migrate. However, events like a network failure can cause a VMI crash.
+                If set to true, migrations will still start in pre-copy, but switch to post-copy when
+                CompletionTimeoutPerGiB triggers. Defaults to false
+              type: boolean
+            allowWorkloadDisruption:
+              description: |-
+                AllowWorkloadDisruption indicates that the migration shouldn't be
+                canceled after acceptableCompletionTime is exceeded. Instead, if
+                permitted, migration will be switched to post-copy or the VMI will be
+                paused to allow the migration to complete
+              type: boolean
+            bandwidthPerMigration:
+              anyOf:
+              - type: integer
+              - type: string
+              description: |-
+                BandwidthPerMigration limits the amount of network bandwidth live migrations are allowed to use.
+                The value is in quantity per second. Defaults to 0 (no limit)
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            completionTimeoutPerGiB:
+              description: |-
+                CompletionTimeoutPerGiB is the maximum number of seconds per GiB a migration is allowed to take.
+                If the timeout is reached, the migration will be either paused, switched
+                to post-copy or cancelled depending on other settings. Defaults to 150
+              format: int64
+              type: integer
+            disableTLS:
+              description: |-
+                When set to true, DisableTLS will disable the additional layer of live migration encryption
+                provided by KubeVirt. This is usually a bad idea. Defaults to false
+              type: boolean
+            matchSELinuxLevelOnMigration:
+              description: |-
+                By default, the SELinux level of target virt-launcher pods is forced to the level of the source virt-launcher.
+                When set to true, MatchSELinuxLevelOnMigration lets the CRI auto-assign a random level to the target.
+                That will ensure the target virt-launcher doesn't share categories with another pod on the node.
+                However, migrations will fail when using RWX volumes that don't automatically deal with SELinux levels.
+              type: boolean
+            network:
+              description: |-
+                Network is the name of the CNI network to use for live migrations. By default, migrations go
+                through the pod network.
+              type: string
+            nodeDrainTaintKey:
+              description: |-
+                NodeDrainTaintKey defines the taint key that indicates a node should be drained.
+                Note: this option relies on the deprecated node taint feature. Default: kubevirt.io/drain
+              type: string
+            parallelMigrationsPerCluster:
+              description: |-
+                ParallelMigrationsPerCluster is the total number of concurrent live migrations
+                allowed cluster-wide. Defaults to 5
+              format: int32
+              type: integer
+            parallelOutboundMigrationsPerNode:
+              description: |-
+                ParallelOutboundMigrationsPerNode is the maximum number of concurrent outgoing live migrations
+                allowed per node. Defaults to 2
+              format: int32
+              type: integer
+            progressTimeout:
+              description: |-
+                ProgressTimeout is the maximum number of seconds a live migration is allowed to make no progress.
+                Hitting this timeout means a migration transferred 0 data for that many seconds. The migration is
+                then considered stuck and therefore cancelled. Defaults to 150
+              format: int64
+              type: integer
+            unsafeMigrationOverride:
+              description: |-
+                UnsafeMigrationOverride allows live migrations to occur even if the compatibility check
+                indicates the migration will be unsafe to the guest. Defaults to false
+              type: boolean
           type: object
-      required:
+        network:
+          description: |-
+            NetworkConfiguration overrides the network defaults, like the default network interface.
+            The binding plugins can't be overridden.
+          properties:
+            binding:
+              additionalProperties:
+                properties:
+                  computeResourceOverhead:
+                    description: |-
+                      ComputeResourceOverhead specifies the resource overhead that should be added to the compute container when using the binding.
+                      version: v1alphav1
+                    properties:
+                      limits:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Limits describes the maximum amount of compute resources allowed.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                      requests:
+                        additionalProperties:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                          x-kubernetes-int-or-string: true
+                        description: |-
+                          Requests describes the minimum amount of compute resources required.
+                          If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                          otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                          More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                        type: object
+                    type: object
+                  domainAttachmentType:
+                    description: |-
+                      DomainAttachmentType is a standard domain network attachment method kubevirt supports.
+                      Supported values: "tap", "managedTap" (since v1.4).
+                      The standard domain attachment can be used instead or in addition to the sidecarImage.
+                      version: 1alphav1
+                    type: string
+                  downwardAPI:
+                    description: |-
+                      DownwardAPI specifies what kind of data should be exposed to the binding plugin sidecar.
+                      Supported values: "device-info"
+                      version: v1alphav1
+                    type: string
+                  migration:
+                    description: |-
+                      Migration means the VM using the plugin can be safely migrated
+                      version: 1alphav1
+                    properties:
+                      method:
+                        description: |-
+                          Method defines a pre-defined migration methodology
+                          version: 1alphav1
+                        type: string
+                    type: object
+                  networkAttachmentDefinition:
+                    description: |-
+                      NetworkAttachmentDefinition references to a NetworkAttachmentDefinition CR object.
+                      Format: <name>, <namespace>/<name>.
+                      If namespace is not specified, VMI namespace is assumed.
+                      version: 1alphav1
+                    type: string
+                  sidecarImage:
+                    description: |-
+                      SidecarImage references a container image that runs in the virt-launcher pod.
+                      The sidecar handles (libvirt) domain configuration and optional services.
+                      version: 1alphav1
+                    type: string
+                type: object
+              type: object
+            defaultNetworkInterface:
+              type: string
+            permitBridgeInterfaceOnPodNetwork:
+              type: boolean
+            permitSlirpInterface:
+              description: |-
+                DeprecatedPermitSlirpInterface is an alias for the deprecated PermitSlirpInterface.
+                Deprecated: Removed in v1.3.
+              type: boolean
+          type: object
+        smbios:
+          description: SMBIOSConfig overrides the SMBIOS values exposed to guests
+          properties:
+            family:
+              type: string
+            manufacturer:
+              type: string
+            product:
+              type: string
+            sku:
+              type: string
+            version:
+              type: string
+          type: object
+        supportContainerResources:
+          description: SupportContainerResources overrides the resource requirements of the
+            supporting containers
+          items:
+            description: SupportContainerResources are used to specify the cpu/memory
+              request and limits for the containers that support various features
+              of Virtual Machines. These containers are usually idle and don't
+              require a lot of memory or cpu.
+            properties:
+              resources:
+                description: |-
+                  ResourceRequirementsWithoutClaims describes the compute resource requirements.
+                  This struct was taken from the k8s.ResourceRequirements and cleaned up the 'Claims' field.
+                properties:
+                  limits:
+                    additionalProperties:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    description: |-
+                      Limits describes the maximum amount of compute resources allowed.
+                      More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                    type: object
+                  requests:
+                    additionalProperties:
+                      anyOf:
+                      - type: integer
+                      - type: string
+                      pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                      x-kubernetes-int-or-string: true
+                    description: |-
+                      Requests describes the minimum amount of compute resources required.
+                      If Requests is omitted for a container, it defaults to Limits if that is explicitly specified,
+                      otherwise to an implementation-defined value. Requests cannot exceed Limits.
+                      More info: https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+                    type: object
+                type: object
+              type:
+                type: string
+            required:
+            - resources
+            - type
+            type: object
+          type: array
+          x-kubernetes-list-map-keys:
+          - type
+          x-kubernetes-list-type: map
+        virtualMachineOptions:
+          description: VirtualMachineOptions overrides the default options of VMs
+          properties:
+            disableFreePageReporting:
+              description: |-
+                DisableFreePageReporting disable the free page reporting of
+                memory balloon device https://libvirt.org/formatdomain.html#memory-balloon-device.
+                This will have effect only if AutoattachMemBalloon is not false and the vmi is not
+                requesting any high performance feature (dedicatedCPU/realtime/hugePages), in which free page reporting is always disabled.
+              type: object
+            disableSerialConsoleLog:
+              description: |-
+                DisableSerialConsoleLog disables logging the auto-attached default serial console.
+                If not set, serial console logs will be written to a file and then streamed from a container named 'guest-console-log'.
+                The value can be individually overridden for each VM, not relevant if AutoattachSerialConsole is disabled.
+              type: object
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
+`,
+	"migrationpolicy": `openAPIV3Schema:
+  description: MigrationPolicy holds migration policy (i.e. configurations) to apply
+    to a VM or group of VMs
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      properties:
+        allowAutoConverge:
+          type: boolean
+        allowPostCopy:
+          type: boolean
+        allowWorkloadDisruption:
+          type: boolean
+        bandwidthPerMigration:
+          anyOf:
+          - type: integer
+          - type: string
+          pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+          x-kubernetes-int-or-string: true
+        completionTimeoutPerGiB:
+          format: int64
+          type: integer
+        selectors:
+          properties:
+            namespaceSelector:
+              additionalProperties:
+                type: string
+              type: object
+            virtualMachineInstanceSelector:
+              additionalProperties:
+                type: string
+              type: object
+          type: object
+      required:
       - selectors
       type: object
     status:
@@ -6102,6 +7268,51 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune limits the throughput and the IO operations of the disk.
+                                  It is applied to a running VM without a restart when live updates are enabled.
+                                properties:
+                                  readBytesPerSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesPerSec limits the read throughput
+                                      in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readIOPS:
+                                    description: ReadIOPS limits the read IO operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesPerSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesPerSec limits the combined
+                                      read and write throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalIOPS:
+                                    description: TotalIOPS limits the combined read
+                                      and write IO operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesPerSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesPerSec limits the write
+                                      throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeIOPS:
+                                    description: WriteIOPS limits the write IO operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -6205,6 +7416,33 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        graphics:
+                          description: |-
+                            Graphics selects the remote display protocol of the default graphics device.
+                            May not be set if autoattachGraphicsDevice is disabled. Defaults to VNC.
+                          properties:
+                            spice:
+                              description: |-
+                                SPICE exposes the display through the spice subresource.
+                                Compared to VNC it supports multiple monitors, clipboard sharing and improved video streaming.
+                              properties:
+                                heads:
+                                  description: |-
+                                    Heads is the number of monitors provided to the guest.
+                                    Defaults to 1 and may not exceed SPICEGraphicsMaxHeads.
+                                  format: int32
+                                  type: integer
+                                videoModel:
+                                  description: |-
+                                    VideoModel is the display device presented to the guest.
+                                    We only support qxl or virtio. Defaults to qxl.
+                                  type: string
+                              type: object
+                            vnc:
+                              description: VNC exposes the display through the vnc
+                                subresource.
+                              type: object
+                          type: object
                         hostDevices:
                           description: Whether to attach a host device to the vmi.
                           items:
@@ -6259,6 +7497,75 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic of the interface.
+                                  It is applied to a running VM without a restart when live updates are enabled.
+                                  Only supported with the bridge and masquerade bindings.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the
+                                          traffic is shaped to, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          that can be sent at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the maximum rate at which
+                                          bursts are sent, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the
+                                          traffic is shaped to, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          that can be sent at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the maximum rate at which
+                                          bursts are sent, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -6774,6 +8081,80 @@ var CRDsValidation map[string]string = map[string]string{
                                     Requires SMM to be enabled.
                                     Defaults to true
                                   type: boolean
+                                secureBootKeys:
+                                  description: |-
+                                    SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the
+                                    EFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.
+                                    Requires SecureBoot.
+                                  properties:
+                                    configMap:
+                                      description: ConfigMap references a ConfigMap
+                                        in the namespace of the VirtualMachineInstance.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    secret:
+                                      description: Secret references a k8s Secret
+                                        in the namespace of the VirtualMachineInstance.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                  type: object
+                                varsTemplate:
+                                  description: |-
+                                    VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,
+                                    used in place of the stock template when the EFI variable store is initialized.
+                                    A persistent EFI variable store is initialized again whenever the template changes.
+                                  properties:
+                                    configMap:
+                                      description: ConfigMap references a ConfigMap
+                                        in the namespace of the VirtualMachineInstance.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    secret:
+                                      description: Secret references a k8s Secret
+                                        in the namespace of the VirtualMachineInstance.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                  type: object
                               type: object
                           type: object
                         kernelBoot:
@@ -6955,6 +8336,67 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                freezeHooks:
+                  description: |-
+                    FreezeHooks configure commands which are executed in the guest around a filesystem freeze
+                    and allow limiting the freeze to a set of mountpoints.
+                  properties:
+                    mountpoints:
+                      description: |-
+                        Mountpoints limits the freeze to the listed guest mountpoints.
+                        All guest filesystems are frozen if empty.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    postThaw:
+                      description: PostThaw is executed in the guest after the filesystems
+                        were thawed.
+                      properties:
+                        args:
+                          description: Args are passed to the command.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        command:
+                          description: Command is the path of the executable in the
+                            guest.
+                          type: string
+                        timeoutSeconds:
+                          description: |-
+                            TimeoutSeconds is the time the command may run before it is considered failed.
+                            Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                          format: int32
+                          type: integer
+                      required:
+                      - command
+                      type: object
+                    preFreeze:
+                      description: |-
+                        PreFreeze is executed in the guest before the filesystems are frozen.
+                        The filesystems are not frozen if the command fails or exits with a non-zero code.
+                      properties:
+                        args:
+                          description: Args are passed to the command.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        command:
+                          description: Command is the path of the executable in the
+                            guest.
+                          type: string
+                        timeoutSeconds:
+                          description: |-
+                            TimeoutSeconds is the time the command may run before it is considered failed.
+                            Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                          format: int32
+                          type: integer
+                      required:
+                      - command
+                      type: object
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -6991,6 +8433,28 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    grpc:
+                      description: |-
+                        GRPC specifies an action involving a GRPC port.
+                        The health check is performed inside the guest by running grpc_health_probe through the guest
+                        agent, so both have to be installed in the guest.
+                      properties:
+                        port:
+                          description: Port number of the gRPC service. Number must
+                            be in the range 1 to 65535.
+                          format: int32
+                          type: integer
+                        service:
+                          default: ""
+                          description: |-
+                            Service is the name of the service to place in the gRPC HealthCheckRequest
+                            (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                            If this is not specified, the default behavior is defined by gRPC.
+                          type: string
+                      required:
+                      - port
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -7152,6 +8616,23 @@ var CRDsValidation map[string]string = map[string]string{
                     Selector which must match a node's labels for the vmi to be scheduled on that node.
                     More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                   type: object
+                panicPolicy:
+                  description: |-
+                    PanicPolicy exposes a panic device to the guest and defines how a guest panic is handled.
+                    The panicked guest is preserved until an optional memory dump was taken and is then restarted or paused.
+                  properties:
+                    action:
+                      description: |-
+                        Action is taken once the panic was recorded and the memory dump finished.
+                        Defaults to Restart.
+                      type: string
+                    memoryDumpClaimName:
+                      description: |-
+                        MemoryDumpClaimName is the name of the pvc the guest memory is dumped to on a panic.
+                        The memory dump is only taken for VMIs owned by a VirtualMachine.
+                        It requires the HotplugVolumes feature gate and is skipped if the pvc can't hold the dump.
+                      type: string
+                  type: object
                 priorityClassName:
                   description: |-
                     If specified, indicates the pod's priority.
@@ -7189,6 +8670,28 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    grpc:
+                      description: |-
+                        GRPC specifies an action involving a GRPC port.
+                        The health check is performed inside the guest by running grpc_health_probe through the guest
+                        agent, so both have to be installed in the guest.
+                      properties:
+                        port:
+                          description: Port number of the gRPC service. Number must
+                            be in the range 1 to 65535.
+                          format: int32
+                          type: integer
+                        service:
+                          default: ""
+                          description: |-
+                            Service is the name of the service to place in the gRPC HealthCheckRequest
+                            (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                            If this is not specified, the default behavior is defined by gRPC.
+                          type: string
+                      required:
+                      - port
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -7302,6 +8805,166 @@ var CRDsValidation map[string]string = map[string]string{
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
                   type: string
+                startupProbe:
+                  description: |-
+                    StartupProbe indicates that the VirtualMachineInstance has successfully started.
+                    If specified, no other probes are executed until this completes successfully.
+                    If this probe fails, the VirtualMachineInstance will be stopped, just as if the livenessProbe failed.
+                    This can be used to allow slow booting guests a long initialization time without
+                    having to delay the liveness probe of the whole lifetime of the VirtualMachineInstance.
+                    Cannot be updated.
+                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                  properties:
+                    exec:
+                      description: |-
+                        One and only one of the following should be specified.
+                        Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
+                        If the guest agent is not available, this probe will fail.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the container, the working directory for the
+                            command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+                            not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+                            a shell, you need to explicitly call out to that shell.
+                            Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                    failureThreshold:
+                      description: |-
+                        Minimum consecutive failures for the probe to be considered failed after having succeeded.
+                        Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    grpc:
+                      description: |-
+                        GRPC specifies an action involving a GRPC port.
+                        The health check is performed inside the guest by running grpc_health_probe through the guest
+                        agent, so both have to be installed in the guest.
+                      properties:
+                        port:
+                          description: Port number of the gRPC service. Number must
+                            be in the range 1 to 65535.
+                          format: int32
+                          type: integer
+                        service:
+                          default: ""
+                          description: |-
+                            Service is the name of the service to place in the gRPC HealthCheckRequest
+                            (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                            If this is not specified, the default behavior is defined by gRPC.
+                          type: string
+                      required:
+                      - port
+                      type: object
+                    guestAgentPing:
+                      description: GuestAgentPing contacts the qemu-guest-agent for
+                        availability checks.
+                      type: object
+                    httpGet:
+                      description: HTTPGet specifies the http request to perform.
+                      properties:
+                        host:
+                          description: |-
+                            Host name to connect to, defaults to the pod IP. You probably want to set
+                            "Host" in httpHeaders instead.
+                          type: string
+                        httpHeaders:
+                          description: Custom headers to set in the request. HTTP
+                            allows repeated headers.
+                          items:
+                            description: HTTPHeader describes a custom header to be
+                              used in HTTP probes
+                            properties:
+                              name:
+                                description: |-
+                                  The header field name.
+                                  This will be canonicalized upon output, so case-variant names will be understood as the same header.
+                                type: string
+                              value:
+                                description: The header field value
+                                type: string
+                            required:
+                            - name
+                            - value
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        path:
+                          description: Path to access on the HTTP server.
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            Name or number of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                        scheme:
+                          description: |-
+                            Scheme to use for connecting to the host.
+                            Defaults to HTTP.
+                          type: string
+                      required:
+                      - port
+                      type: object
+                    initialDelaySeconds:
+                      description: |-
+                        Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                    periodSeconds:
+                      description: |-
+                        How often (in seconds) to perform the probe.
+                        Default to 10 seconds. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    successThreshold:
+                      description: |-
+                        Minimum consecutive successes for the probe to be considered successful after having failed.
+                        Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    tcpSocket:
+                      description: |-
+                        TCPSocket specifies an action involving a TCP port.
+                        TCP hooks not yet supported
+                      properties:
+                        host:
+                          description: 'Optional: Host name to connect to, defaults
+                            to the pod IP.'
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            Number or name of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                      required:
+                      - port
+                      type: object
+                    timeoutSeconds:
+                      description: |-
+                        Number of seconds after which the probe times out.
+                        For exec probes the timeout fails the probe but does not terminate the command running on the guest.
+                        This means a blocking command can result in an increasing load on the guest.
+                        A small buffer will be added to the resulting workload exec probe to compensate for delays
+                        caused by the qemu guest exec mechanism.
+                        Defaults to 1 second. Minimum value is 1.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                  type: object
                 subdomain:
                   description: |-
                     If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
@@ -7879,6 +9542,9 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: Format of the memory dump, defaults to raw
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -8094,6 +9760,9 @@ var CRDsValidation map[string]string = map[string]string{
             fileName:
               description: FileName represents the name of the output file
               type: string
+            format:
+              description: Format of the memory dump, defaults to raw
+              type: string
             message:
               description: Message is a detailed message about failure of the memory
                 dump
@@ -8316,6 +9985,51 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune limits the throughput and the IO operations of the disk.
+                          It is applied to a running VM without a restart when live updates are enabled.
+                        properties:
+                          readBytesPerSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesPerSec limits the read throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPS:
+                            description: ReadIOPS limits the read IO operations per
+                              second.
+                            format: int64
+                            type: integer
+                          totalBytesPerSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesPerSec limits the combined read
+                              and write throughput in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalIOPS:
+                            description: TotalIOPS limits the combined read and write
+                              IO operations per second.
+                            format: int64
+                            type: integer
+                          writeBytesPerSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesPerSec limits the write throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPS:
+                            description: WriteIOPS limits the write IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -8648,6 +10362,13 @@ var CRDsValidation map[string]string = map[string]string{
             NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will
             be generated automatically.
           type: string
+        persistentState:
+          description: |-
+            PersistentState selects what happens to the persistent TPM and EFI state of the source.
+            Copy clones the state into the target, Regenerate lets the target start with empty state.
+            Copying into the VM state storage class from another storage class requires CDI.
+            If this field is not specified, sources with persistent state cannot be cloned.
+          type: string
         source:
           description: |-
             Source is the object that would be cloned. Currently supported source types are:
@@ -9592,6 +11313,80 @@ var CRDsValidation map[string]string = map[string]string{
                     Requires SMM to be enabled.
                     Defaults to true
                   type: boolean
+                secureBootKeys:
+                  description: |-
+                    SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the
+                    EFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.
+                    Requires SecureBoot.
+                  properties:
+                    configMap:
+                      description: ConfigMap references a ConfigMap in the namespace
+                        of the VirtualMachineInstance.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    secret:
+                      description: Secret references a k8s Secret in the namespace
+                        of the VirtualMachineInstance.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                  type: object
+                varsTemplate:
+                  description: |-
+                    VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,
+                    used in place of the stock template when the EFI variable store is initialized.
+                    A persistent EFI variable store is initialized again whenever the template changes.
+                  properties:
+                    configMap:
+                      description: ConfigMap references a ConfigMap in the namespace
+                        of the VirtualMachineInstance.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    secret:
+                      description: Secret references a k8s Secret in the namespace
+                        of the VirtualMachineInstance.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                  type: object
               type: object
             preferredUseBios:
               description: PreferredUseBios optionally enables BIOS
@@ -11387,7 +13182,52 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
-                      lun:
+                      ioTune:
+                        description: |-
+                          IOTune limits the throughput and the IO operations of the disk.
+                          It is applied to a running VM without a restart when live updates are enabled.
+                        properties:
+                          readBytesPerSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesPerSec limits the read throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPS:
+                            description: ReadIOPS limits the read IO operations per
+                              second.
+                            format: int64
+                            type: integer
+                          totalBytesPerSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesPerSec limits the combined read
+                              and write throughput in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalIOPS:
+                            description: TotalIOPS limits the combined read and write
+                              IO operations per second.
+                            format: int64
+                            type: integer
+                          writeBytesPerSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesPerSec limits the write throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPS:
+                            description: WriteIOPS limits the write IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                        type: object
+                      lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
                           bus:
@@ -11488,6 +13328,32 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                graphics:
+                  description: |-
+                    Graphics selects the remote display protocol of the default graphics device.
+                    May not be set if autoattachGraphicsDevice is disabled. Defaults to VNC.
+                  properties:
+                    spice:
+                      description: |-
+                        SPICE exposes the display through the spice subresource.
+                        Compared to VNC it supports multiple monitors, clipboard sharing and improved video streaming.
+                      properties:
+                        heads:
+                          description: |-
+                            Heads is the number of monitors provided to the guest.
+                            Defaults to 1 and may not exceed SPICEGraphicsMaxHeads.
+                          format: int32
+                          type: integer
+                        videoModel:
+                          description: |-
+                            VideoModel is the display device presented to the guest.
+                            We only support qxl or virtio. Defaults to qxl.
+                          type: string
+                      type: object
+                    vnc:
+                      description: VNC exposes the display through the vnc subresource.
+                      type: object
+                  type: object
                 hostDevices:
                   description: Whether to attach a host device to the vmi.
                   items:
@@ -11541,6 +13407,74 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic of the interface.
+                          It is applied to a running VM without a restart when live updates are enabled.
+                          Only supported with the bridge and masquerade bindings.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic
+                                  is shaped to, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes that can
+                                  be sent at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the maximum rate at which bursts
+                                  are sent, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic
+                                  is shaped to, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes that can
+                                  be sent at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the maximum rate at which bursts
+                                  are sent, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -12051,6 +13985,80 @@ var CRDsValidation map[string]string = map[string]string{
                             Requires SMM to be enabled.
                             Defaults to true
                           type: boolean
+                        secureBootKeys:
+                          description: |-
+                            SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the
+                            EFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.
+                            Requires SecureBoot.
+                          properties:
+                            configMap:
+                              description: ConfigMap references a ConfigMap in the
+                                namespace of the VirtualMachineInstance.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            secret:
+                              description: Secret references a k8s Secret in the namespace
+                                of the VirtualMachineInstance.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                          type: object
+                        varsTemplate:
+                          description: |-
+                            VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,
+                            used in place of the stock template when the EFI variable store is initialized.
+                            A persistent EFI variable store is initialized again whenever the template changes.
+                          properties:
+                            configMap:
+                              description: ConfigMap references a ConfigMap in the
+                                namespace of the VirtualMachineInstance.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            secret:
+                              description: Secret references a k8s Secret in the namespace
+                                of the VirtualMachineInstance.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                          type: object
                       type: object
                   type: object
                 kernelBoot:
@@ -12228,6 +14236,65 @@ var CRDsValidation map[string]string = map[string]string{
             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
             - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
           type: string
+        freezeHooks:
+          description: |-
+            FreezeHooks configure commands which are executed in the guest around a filesystem freeze
+            and allow limiting the freeze to a set of mountpoints.
+          properties:
+            mountpoints:
+              description: |-
+                Mountpoints limits the freeze to the listed guest mountpoints.
+                All guest filesystems are frozen if empty.
+              items:
+                type: string
+              type: array
+              x-kubernetes-list-type: atomic
+            postThaw:
+              description: PostThaw is executed in the guest after the filesystems
+                were thawed.
+              properties:
+                args:
+                  description: Args are passed to the command.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+                command:
+                  description: Command is the path of the executable in the guest.
+                  type: string
+                timeoutSeconds:
+                  description: |-
+                    TimeoutSeconds is the time the command may run before it is considered failed.
+                    Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                  format: int32
+                  type: integer
+              required:
+              - command
+              type: object
+            preFreeze:
+              description: |-
+                PreFreeze is executed in the guest before the filesystems are frozen.
+                The filesystems are not frozen if the command fails or exits with a non-zero code.
+              properties:
+                args:
+                  description: Args are passed to the command.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+                command:
+                  description: Command is the path of the executable in the guest.
+                  type: string
+                timeoutSeconds:
+                  description: |-
+                    TimeoutSeconds is the time the command may run before it is considered failed.
+                    Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                  format: int32
+                  type: integer
+              required:
+              - command
+              type: object
+          type: object
         hostname:
           description: |-
             Specifies the hostname of the vmi
@@ -12264,6 +14331,28 @@ var CRDsValidation map[string]string = map[string]string{
                 Defaults to 3. Minimum value is 1.
               format: int32
               type: integer
+            grpc:
+              description: |-
+                GRPC specifies an action involving a GRPC port.
+                The health check is performed inside the guest by running grpc_health_probe through the guest
+                agent, so both have to be installed in the guest.
+              properties:
+                port:
+                  description: Port number of the gRPC service. Number must be in
+                    the range 1 to 65535.
+                  format: int32
+                  type: integer
+                service:
+                  default: ""
+                  description: |-
+                    Service is the name of the service to place in the gRPC HealthCheckRequest
+                    (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                    If this is not specified, the default behavior is defined by gRPC.
+                  type: string
+              required:
+              - port
+              type: object
             guestAgentPing:
               description: GuestAgentPing contacts the qemu-guest-agent for availability
                 checks.
@@ -12424,6 +14513,23 @@ var CRDsValidation map[string]string = map[string]string{
             Selector which must match a node's labels for the vmi to be scheduled on that node.
             More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
           type: object
+        panicPolicy:
+          description: |-
+            PanicPolicy exposes a panic device to the guest and defines how a guest panic is handled.
+            The panicked guest is preserved until an optional memory dump was taken and is then restarted or paused.
+          properties:
+            action:
+              description: |-
+                Action is taken once the panic was recorded and the memory dump finished.
+                Defaults to Restart.
+              type: string
+            memoryDumpClaimName:
+              description: |-
+                MemoryDumpClaimName is the name of the pvc the guest memory is dumped to on a panic.
+                The memory dump is only taken for VMIs owned by a VirtualMachine.
+                It requires the HotplugVolumes feature gate and is skipped if the pvc can't hold the dump.
+              type: string
+          type: object
         priorityClassName:
           description: |-
             If specified, indicates the pod's priority.
@@ -12461,6 +14567,28 @@ var CRDsValidation map[string]string = map[string]string{
                 Defaults to 3. Minimum value is 1.
               format: int32
               type: integer
+            grpc:
+              description: |-
+                GRPC specifies an action involving a GRPC port.
+                The health check is performed inside the guest by running grpc_health_probe through the guest
+                agent, so both have to be installed in the guest.
+              properties:
+                port:
+                  description: Port number of the gRPC service. Number must be in
+                    the range 1 to 65535.
+                  format: int32
+                  type: integer
+                service:
+                  default: ""
+                  description: |-
+                    Service is the name of the service to place in the gRPC HealthCheckRequest
+                    (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                    If this is not specified, the default behavior is defined by gRPC.
+                  type: string
+              required:
+              - port
+              type: object
             guestAgentPing:
               description: GuestAgentPing contacts the qemu-guest-agent for availability
                 checks.
@@ -12574,139 +14702,299 @@ var CRDsValidation map[string]string = map[string]string{
           description: StartStrategy can be set to "Paused" if Virtual Machine should
             be started in paused state.
           type: string
-        subdomain:
-          description: |-
-            If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
-            If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,
-            no matter if the vmi itself can pick up a hostname.
-          type: string
-        terminationGracePeriodSeconds:
-          description: Grace period observed after signalling a VirtualMachineInstance
-            to stop after which the VirtualMachineInstance is force terminated.
-          format: int64
-          type: integer
-        tolerations:
-          description: If toleration is specified, obey all the toleration rules.
-          items:
-            description: |-
-              The pod this Toleration is attached to tolerates any taint that matches
-              the triple <key,value,effect> using the matching operator <operator>.
-            properties:
-              effect:
-                description: |-
-                  Effect indicates the taint effect to match. Empty means match all taint effects.
-                  When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
-                type: string
-              key:
-                description: |-
-                  Key is the taint key that the toleration applies to. Empty means match all taint keys.
-                  If the key is empty, operator must be Exists; this combination means to match all values and all keys.
-                type: string
-              operator:
-                description: |-
-                  Operator represents a key's relationship to the value.
-                  Valid operators are Exists and Equal. Defaults to Equal.
-                  Exists is equivalent to wildcard for value, so that a pod can
-                  tolerate all taints of a particular category.
-                type: string
-              tolerationSeconds:
-                description: |-
-                  TolerationSeconds represents the period of time the toleration (which must be
-                  of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
-                  it is not set, which means tolerate the taint forever (do not evict). Zero and
-                  negative values will be treated as 0 (evict immediately) by the system.
-                format: int64
-                type: integer
-              value:
-                description: |-
-                  Value is the taint value the toleration matches to.
-                  If the operator is Exists, the value should be empty, otherwise just a regular string.
-                type: string
-            type: object
-          type: array
-        topologySpreadConstraints:
+        startupProbe:
           description: |-
-            TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology
-            domains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.
-          items:
-            description: TopologySpreadConstraint specifies how to spread matching
-              pods among the given topology.
-            properties:
-              labelSelector:
-                description: |-
-                  LabelSelector is used to find matching pods.
-                  Pods that match this label selector are counted to determine the number of pods
-                  in their corresponding topology domain.
-                properties:
-                  matchExpressions:
-                    description: matchExpressions is a list of label selector requirements.
-                      The requirements are ANDed.
-                    items:
-                      description: |-
-                        A label selector requirement is a selector that contains values, a key, and an operator that
-                        relates the key and values.
-                      properties:
-                        key:
-                          description: key is the label key that the selector applies
-                            to.
-                          type: string
-                        operator:
-                          description: |-
-                            operator represents a key's relationship to a set of values.
-                            Valid operators are In, NotIn, Exists and DoesNotExist.
-                          type: string
-                        values:
-                          description: |-
-                            values is an array of string values. If the operator is In or NotIn,
-                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
-                            the values array must be empty. This array is replaced during a strategic
-                            merge patch.
-                          items:
-                            type: string
-                          type: array
-                          x-kubernetes-list-type: atomic
-                      required:
-                      - key
-                      - operator
-                      type: object
-                    type: array
-                    x-kubernetes-list-type: atomic
-                  matchLabels:
-                    additionalProperties:
-                      type: string
-                    description: |-
-                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
-                      map is equivalent to an element of matchExpressions, whose key field is "key", the
-                      operator is "In", and the values array contains only "value". The requirements are ANDed.
-                    type: object
-                type: object
-                x-kubernetes-map-type: atomic
-              matchLabelKeys:
-                description: |-
-                  MatchLabelKeys is a set of pod label keys to select the pods over which
-                  spreading will be calculated. The keys are used to lookup values from the
-                  incoming pod labels, those key-value labels are ANDed with labelSelector
-                  to select the group of existing pods over which spreading will be calculated
-                  for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
-                  MatchLabelKeys cannot be set when LabelSelector isn't set.
-                  Keys that don't exist in the incoming pod labels will
-                  be ignored. A null or empty list means only match against labelSelector.
-
-                  This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
-                items:
-                  type: string
-                type: array
-                x-kubernetes-list-type: atomic
-              maxSkew:
-                description: |-
-                  MaxSkew describes the degree to which pods may be unevenly distributed.
-                  When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
-                  between the number of matching pods in the target topology and the global minimum.
-                  The global minimum is the minimum number of matching pods in an eligible domain
-                  or zero if the number of eligible domains is less than MinDomains.
-                  For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
-                  labelSelector spread as 2/2/1:
-                  In this case, the global minimum is 1.
+            StartupProbe indicates that the VirtualMachineInstance has successfully started.
+            If specified, no other probes are executed until this completes successfully.
+            If this probe fails, the VirtualMachineInstance will be stopped, just as if the livenessProbe failed.
+            This can be used to allow slow booting guests a long initialization time without
+            having to delay the liveness probe of the whole lifetime of the VirtualMachineInstance.
+            Cannot be updated.
+            More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+          properties:
+            exec:
+              description: |-
+                One and only one of the following should be specified.
+                Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
+                If the guest agent is not available, this probe will fail.
+              properties:
+                command:
+                  description: |-
+                    Command is the command line to execute inside the container, the working directory for the
+                    command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+                    not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+                    a shell, you need to explicitly call out to that shell.
+                    Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
+                  items:
+                    type: string
+                  type: array
+                  x-kubernetes-list-type: atomic
+              type: object
+            failureThreshold:
+              description: |-
+                Minimum consecutive failures for the probe to be considered failed after having succeeded.
+                Defaults to 3. Minimum value is 1.
+              format: int32
+              type: integer
+            grpc:
+              description: |-
+                GRPC specifies an action involving a GRPC port.
+                The health check is performed inside the guest by running grpc_health_probe through the guest
+                agent, so both have to be installed in the guest.
+              properties:
+                port:
+                  description: Port number of the gRPC service. Number must be in
+                    the range 1 to 65535.
+                  format: int32
+                  type: integer
+                service:
+                  default: ""
+                  description: |-
+                    Service is the name of the service to place in the gRPC HealthCheckRequest
+                    (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                    If this is not specified, the default behavior is defined by gRPC.
+                  type: string
+              required:
+              - port
+              type: object
+            guestAgentPing:
+              description: GuestAgentPing contacts the qemu-guest-agent for availability
+                checks.
+              type: object
+            httpGet:
+              description: HTTPGet specifies the http request to perform.
+              properties:
+                host:
+                  description: |-
+                    Host name to connect to, defaults to the pod IP. You probably want to set
+                    "Host" in httpHeaders instead.
+                  type: string
+                httpHeaders:
+                  description: Custom headers to set in the request. HTTP allows repeated
+                    headers.
+                  items:
+                    description: HTTPHeader describes a custom header to be used in
+                      HTTP probes
+                    properties:
+                      name:
+                        description: |-
+                          The header field name.
+                          This will be canonicalized upon output, so case-variant names will be understood as the same header.
+                        type: string
+                      value:
+                        description: The header field value
+                        type: string
+                    required:
+                    - name
+                    - value
+                    type: object
+                  type: array
+                  x-kubernetes-list-type: atomic
+                path:
+                  description: Path to access on the HTTP server.
+                  type: string
+                port:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Name or number of the port to access on the container.
+                    Number must be in the range 1 to 65535.
+                    Name must be an IANA_SVC_NAME.
+                  x-kubernetes-int-or-string: true
+                scheme:
+                  description: |-
+                    Scheme to use for connecting to the host.
+                    Defaults to HTTP.
+                  type: string
+              required:
+              - port
+              type: object
+            initialDelaySeconds:
+              description: |-
+                Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
+                More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+              format: int32
+              type: integer
+            periodSeconds:
+              description: |-
+                How often (in seconds) to perform the probe.
+                Default to 10 seconds. Minimum value is 1.
+              format: int32
+              type: integer
+            successThreshold:
+              description: |-
+                Minimum consecutive successes for the probe to be considered successful after having failed.
+                Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+              format: int32
+              type: integer
+            tcpSocket:
+              description: |-
+                TCPSocket specifies an action involving a TCP port.
+                TCP hooks not yet supported
+              properties:
+                host:
+                  description: 'Optional: Host name to connect to, defaults to the
+                    pod IP.'
+                  type: string
+                port:
+                  anyOf:
+                  - type: integer
+                  - type: string
+                  description: |-
+                    Number or name of the port to access on the container.
+                    Number must be in the range 1 to 65535.
+                    Name must be an IANA_SVC_NAME.
+                  x-kubernetes-int-or-string: true
+              required:
+              - port
+              type: object
+            timeoutSeconds:
+              description: |-
+                Number of seconds after which the probe times out.
+                For exec probes the timeout fails the probe but does not terminate the command running on the guest.
+                This means a blocking command can result in an increasing load on the guest.
+                A small buffer will be added to the resulting workload exec probe to compensate for delays
+                caused by the qemu guest exec mechanism.
+                Defaults to 1 second. Minimum value is 1.
+                More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+              format: int32
+              type: integer
+          type: object
+        subdomain:
+          description: |-
+            If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
+            If not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,
+            no matter if the vmi itself can pick up a hostname.
+          type: string
+        terminationGracePeriodSeconds:
+          description: Grace period observed after signalling a VirtualMachineInstance
+            to stop after which the VirtualMachineInstance is force terminated.
+          format: int64
+          type: integer
+        tolerations:
+          description: If toleration is specified, obey all the toleration rules.
+          items:
+            description: |-
+              The pod this Toleration is attached to tolerates any taint that matches
+              the triple <key,value,effect> using the matching operator <operator>.
+            properties:
+              effect:
+                description: |-
+                  Effect indicates the taint effect to match. Empty means match all taint effects.
+                  When specified, allowed values are NoSchedule, PreferNoSchedule and NoExecute.
+                type: string
+              key:
+                description: |-
+                  Key is the taint key that the toleration applies to. Empty means match all taint keys.
+                  If the key is empty, operator must be Exists; this combination means to match all values and all keys.
+                type: string
+              operator:
+                description: |-
+                  Operator represents a key's relationship to the value.
+                  Valid operators are Exists and Equal. Defaults to Equal.
+                  Exists is equivalent to wildcard for value, so that a pod can
+                  tolerate all taints of a particular category.
+                type: string
+              tolerationSeconds:
+                description: |-
+                  TolerationSeconds represents the period of time the toleration (which must be
+                  of effect NoExecute, otherwise this field is ignored) tolerates the taint. By default,
+                  it is not set, which means tolerate the taint forever (do not evict). Zero and
+                  negative values will be treated as 0 (evict immediately) by the system.
+                format: int64
+                type: integer
+              value:
+                description: |-
+                  Value is the taint value the toleration matches to.
+                  If the operator is Exists, the value should be empty, otherwise just a regular string.
+                type: string
+            type: object
+          type: array
+        topologySpreadConstraints:
+          description: |-
+            TopologySpreadConstraints describes how a group of VMIs will be spread across a given topology
+            domains. K8s scheduler will schedule VMI pods in a way which abides by the constraints.
+          items:
+            description: TopologySpreadConstraint specifies how to spread matching
+              pods among the given topology.
+            properties:
+              labelSelector:
+                description: |-
+                  LabelSelector is used to find matching pods.
+                  Pods that match this label selector are counted to determine the number of pods
+                  in their corresponding topology domain.
+                properties:
+                  matchExpressions:
+                    description: matchExpressions is a list of label selector requirements.
+                      The requirements are ANDed.
+                    items:
+                      description: |-
+                        A label selector requirement is a selector that contains values, a key, and an operator that
+                        relates the key and values.
+                      properties:
+                        key:
+                          description: key is the label key that the selector applies
+                            to.
+                          type: string
+                        operator:
+                          description: |-
+                            operator represents a key's relationship to a set of values.
+                            Valid operators are In, NotIn, Exists and DoesNotExist.
+                          type: string
+                        values:
+                          description: |-
+                            values is an array of string values. If the operator is In or NotIn,
+                            the values array must be non-empty. If the operator is Exists or DoesNotExist,
+                            the values array must be empty. This array is replaced during a strategic
+                            merge patch.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      required:
+                      - key
+                      - operator
+                      type: object
+                    type: array
+                    x-kubernetes-list-type: atomic
+                  matchLabels:
+                    additionalProperties:
+                      type: string
+                    description: |-
+                      matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels
+                      map is equivalent to an element of matchExpressions, whose key field is "key", the
+                      operator is "In", and the values array contains only "value". The requirements are ANDed.
+                    type: object
+                type: object
+                x-kubernetes-map-type: atomic
+              matchLabelKeys:
+                description: |-
+                  MatchLabelKeys is a set of pod label keys to select the pods over which
+                  spreading will be calculated. The keys are used to lookup values from the
+                  incoming pod labels, those key-value labels are ANDed with labelSelector
+                  to select the group of existing pods over which spreading will be calculated
+                  for the incoming pod. The same key is forbidden to exist in both MatchLabelKeys and LabelSelector.
+                  MatchLabelKeys cannot be set when LabelSelector isn't set.
+                  Keys that don't exist in the incoming pod labels will
+                  be ignored. A null or empty list means only match against labelSelector.
+
+                  This is a beta field and requires the MatchLabelKeysInPodTopologySpread feature gate to be enabled (enabled by default).
+                items:
+                  type: string
+                type: array
+                x-kubernetes-list-type: atomic
+              maxSkew:
+                description: |-
+                  MaxSkew describes the degree to which pods may be unevenly distributed.
+                  When 'whenUnsatisfiable=DoNotSchedule', it is the maximum permitted difference
+                  between the number of matching pods in the target topology and the global minimum.
+                  The global minimum is the minimum number of matching pods in an eligible domain
+                  or zero if the number of eligible domains is less than MinDomains.
+                  For example, in a 3-zone cluster, MaxSkew is set to 1, and pods with the same
+                  labelSelector spread as 2/2/1:
+                  In this case, the global minimum is 1.
                   | zone1 | zone2 | zone3 |
                   |  P P  |  P P  |   P   |
                   - if MaxSkew is 1, incoming pod can only be scheduled to zone3 to become 2/2/2;
@@ -13141,6 +15429,9 @@ var CRDsValidation map[string]string = map[string]string{
                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                     type: string
+                  format:
+                    description: Format of the memory dump, defaults to raw
+                    type: string
                   hotpluggable:
                     description: Hotpluggable indicates whether the volume can be
                       hotplugged and hotunplugged.
@@ -13329,6 +15620,40 @@ var CRDsValidation map[string]string = map[string]string{
             EvacuationNodeName is used to track the eviction process of a VMI. It stores the name of the node that we want
             to evacuate. It is meant to be used by KubeVirt core components only and can't be set or modified by users.
           type: string
+        freezeHooks:
+          description: FreezeHooks reports the outcome of the steps of the last freeze
+            and thaw of the guest filesystems
+          properties:
+            steps:
+              description: Steps in the order they were executed
+              items:
+                description: FreezeHookStepStatus is the outcome of a single freeze
+                  or thaw step
+                properties:
+                  exitCode:
+                    description: ExitCode of the hook command, only set for hook steps
+                    format: int32
+                    type: integer
+                  message:
+                    description: Message contains details about a failure
+                    type: string
+                  name:
+                    description: Name of the step
+                    type: string
+                  phase:
+                    description: Phase of the step, either Succeeded or Failed
+                    type: string
+                  timestamp:
+                    description: Timestamp of the completion of the step
+                    format: date-time
+                    type: string
+                required:
+                - name
+                - phase
+                type: object
+              type: array
+              x-kubernetes-list-type: atomic
+          type: object
         fsFreezeStatus:
           description: |-
             FSFreezeStatus is the state of the fs of the guest
@@ -13362,6 +15687,36 @@ var CRDsValidation map[string]string = map[string]string{
               description: Version ID of the Guest OS
               type: string
           type: object
+        guestPanic:
+          description: GuestPanic reports the last panic of the guest and how it is
+            handled
+          properties:
+            action:
+              description: Action taken after the last panic
+              type: string
+            count:
+              description: Count is the number of panics since the VMI was started
+              format: int32
+              type: integer
+            memoryDumpClaimName:
+              description: MemoryDumpClaimName is the name of the pvc the memory of
+                the last panic is dumped to
+              type: string
+            message:
+              description: Message contains details about the handling of the last
+                panic
+              type: string
+            phase:
+              description: Phase of the handling of the last panic
+              type: string
+            timestamp:
+              description: Timestamp of the last panic
+              format: date-time
+              type: string
+          required:
+          - count
+          - phase
+          type: object
         interfaces:
           description: Interfaces represent the details of available network interfaces.
           items:
@@ -14602,6 +16957,51 @@ var CRDsValidation map[string]string = map[string]string{
                           IO specifies which QEMU disk IO mode should be used.
                           Supported values are: native, default, threads.
                         type: string
+                      ioTune:
+                        description: |-
+                          IOTune limits the throughput and the IO operations of the disk.
+                          It is applied to a running VM without a restart when live updates are enabled.
+                        properties:
+                          readBytesPerSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: ReadBytesPerSec limits the read throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          readIOPS:
+                            description: ReadIOPS limits the read IO operations per
+                              second.
+                            format: int64
+                            type: integer
+                          totalBytesPerSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: TotalBytesPerSec limits the combined read
+                              and write throughput in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          totalIOPS:
+                            description: TotalIOPS limits the combined read and write
+                              IO operations per second.
+                            format: int64
+                            type: integer
+                          writeBytesPerSec:
+                            anyOf:
+                            - type: integer
+                            - type: string
+                            description: WriteBytesPerSec limits the write throughput
+                              in bytes per second.
+                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                            x-kubernetes-int-or-string: true
+                          writeIOPS:
+                            description: WriteIOPS limits the write IO operations
+                              per second.
+                            format: int64
+                            type: integer
+                        type: object
                       lun:
                         description: Attach a volume as a LUN to the vmi.
                         properties:
@@ -14703,6 +17103,32 @@ var CRDsValidation map[string]string = map[string]string{
                     type: object
                   type: array
                   x-kubernetes-list-type: atomic
+                graphics:
+                  description: |-
+                    Graphics selects the remote display protocol of the default graphics device.
+                    May not be set if autoattachGraphicsDevice is disabled. Defaults to VNC.
+                  properties:
+                    spice:
+                      description: |-
+                        SPICE exposes the display through the spice subresource.
+                        Compared to VNC it supports multiple monitors, clipboard sharing and improved video streaming.
+                      properties:
+                        heads:
+                          description: |-
+                            Heads is the number of monitors provided to the guest.
+                            Defaults to 1 and may not exceed SPICEGraphicsMaxHeads.
+                          format: int32
+                          type: integer
+                        videoModel:
+                          description: |-
+                            VideoModel is the display device presented to the guest.
+                            We only support qxl or virtio. Defaults to qxl.
+                          type: string
+                      type: object
+                    vnc:
+                      description: VNC exposes the display through the vnc subresource.
+                      type: object
+                  type: object
                 hostDevices:
                   description: Whether to attach a host device to the vmi.
                   items:
@@ -14756,6 +17182,74 @@ var CRDsValidation map[string]string = map[string]string{
                           in PCI addresses assigned to the device.
                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                         type: integer
+                      bandwidth:
+                        description: |-
+                          Bandwidth limits the traffic of the interface.
+                          It is applied to a running VM without a restart when live updates are enabled.
+                          Only supported with the bridge and masquerade bindings.
+                        properties:
+                          inbound:
+                            description: Inbound limits the traffic received by the
+                              guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic
+                                  is shaped to, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes that can
+                                  be sent at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the maximum rate at which bursts
+                                  are sent, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                          outbound:
+                            description: Outbound limits the traffic sent by the guest.
+                            properties:
+                              average:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Average is the average rate the traffic
+                                  is shaped to, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              burst:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Burst is the amount of bytes that can
+                                  be sent at the peak rate.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                              peak:
+                                anyOf:
+                                - type: integer
+                                - type: string
+                                description: Peak is the maximum rate at which bursts
+                                  are sent, in bytes per second.
+                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                x-kubernetes-int-or-string: true
+                            required:
+                            - average
+                            type: object
+                        type: object
                       binding:
                         description: |-
                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -15266,6 +17760,80 @@ var CRDsValidation map[string]string = map[string]string{
                             Requires SMM to be enabled.
                             Defaults to true
                           type: boolean
+                        secureBootKeys:
+                          description: |-
+                            SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the
+                            EFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.
+                            Requires SecureBoot.
+                          properties:
+                            configMap:
+                              description: ConfigMap references a ConfigMap in the
+                                namespace of the VirtualMachineInstance.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            secret:
+                              description: Secret references a k8s Secret in the namespace
+                                of the VirtualMachineInstance.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                          type: object
+                        varsTemplate:
+                          description: |-
+                            VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,
+                            used in place of the stock template when the EFI variable store is initialized.
+                            A persistent EFI variable store is initialized again whenever the template changes.
+                          properties:
+                            configMap:
+                              description: ConfigMap references a ConfigMap in the
+                                namespace of the VirtualMachineInstance.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                            secret:
+                              description: Secret references a k8s Secret in the namespace
+                                of the VirtualMachineInstance.
+                              properties:
+                                name:
+                                  default: ""
+                                  description: |-
+                                    Name of the referent.
+                                    This field is effectively required, but due to backwards compatibility is
+                                    allowed to be empty. Instances of this type with an empty value here are
+                                    almost certainly wrong.
+                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                  type: string
+                              type: object
+                              x-kubernetes-map-type: atomic
+                          type: object
                       type: object
                   type: object
                 kernelBoot:
@@ -17017,6 +19585,51 @@ var CRDsValidation map[string]string = map[string]string{
                                   IO specifies which QEMU disk IO mode should be used.
                                   Supported values are: native, default, threads.
                                 type: string
+                              ioTune:
+                                description: |-
+                                  IOTune limits the throughput and the IO operations of the disk.
+                                  It is applied to a running VM without a restart when live updates are enabled.
+                                properties:
+                                  readBytesPerSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: ReadBytesPerSec limits the read throughput
+                                      in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  readIOPS:
+                                    description: ReadIOPS limits the read IO operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                  totalBytesPerSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: TotalBytesPerSec limits the combined
+                                      read and write throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  totalIOPS:
+                                    description: TotalIOPS limits the combined read
+                                      and write IO operations per second.
+                                    format: int64
+                                    type: integer
+                                  writeBytesPerSec:
+                                    anyOf:
+                                    - type: integer
+                                    - type: string
+                                    description: WriteBytesPerSec limits the write
+                                      throughput in bytes per second.
+                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                    x-kubernetes-int-or-string: true
+                                  writeIOPS:
+                                    description: WriteIOPS limits the write IO operations
+                                      per second.
+                                    format: int64
+                                    type: integer
+                                type: object
                               lun:
                                 description: Attach a volume as a LUN to the vmi.
                                 properties:
@@ -17120,6 +19733,33 @@ var CRDsValidation map[string]string = map[string]string{
                             type: object
                           type: array
                           x-kubernetes-list-type: atomic
+                        graphics:
+                          description: |-
+                            Graphics selects the remote display protocol of the default graphics device.
+                            May not be set if autoattachGraphicsDevice is disabled. Defaults to VNC.
+                          properties:
+                            spice:
+                              description: |-
+                                SPICE exposes the display through the spice subresource.
+                                Compared to VNC it supports multiple monitors, clipboard sharing and improved video streaming.
+                              properties:
+                                heads:
+                                  description: |-
+                                    Heads is the number of monitors provided to the guest.
+                                    Defaults to 1 and may not exceed SPICEGraphicsMaxHeads.
+                                  format: int32
+                                  type: integer
+                                videoModel:
+                                  description: |-
+                                    VideoModel is the display device presented to the guest.
+                                    We only support qxl or virtio. Defaults to qxl.
+                                  type: string
+                              type: object
+                            vnc:
+                              description: VNC exposes the display through the vnc
+                                subresource.
+                              type: object
+                          type: object
                         hostDevices:
                           description: Whether to attach a host device to the vmi.
                           items:
@@ -17174,6 +19814,75 @@ var CRDsValidation map[string]string = map[string]string{
                                   in PCI addresses assigned to the device.
                                   This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                 type: integer
+                              bandwidth:
+                                description: |-
+                                  Bandwidth limits the traffic of the interface.
+                                  It is applied to a running VM without a restart when live updates are enabled.
+                                  Only supported with the bridge and masquerade bindings.
+                                properties:
+                                  inbound:
+                                    description: Inbound limits the traffic received
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the
+                                          traffic is shaped to, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          that can be sent at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the maximum rate at which
+                                          bursts are sent, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                  outbound:
+                                    description: Outbound limits the traffic sent
+                                      by the guest.
+                                    properties:
+                                      average:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Average is the average rate the
+                                          traffic is shaped to, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      burst:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Burst is the amount of bytes
+                                          that can be sent at the peak rate.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      peak:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: Peak is the maximum rate at which
+                                          bursts are sent, in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                    required:
+                                    - average
+                                    type: object
+                                type: object
                               binding:
                                 description: |-
                                   Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -17689,6 +20398,80 @@ var CRDsValidation map[string]string = map[string]string{
                                     Requires SMM to be enabled.
                                     Defaults to true
                                   type: boolean
+                                secureBootKeys:
+                                  description: |-
+                                    SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the
+                                    EFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.
+                                    Requires SecureBoot.
+                                  properties:
+                                    configMap:
+                                      description: ConfigMap references a ConfigMap
+                                        in the namespace of the VirtualMachineInstance.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    secret:
+                                      description: Secret references a k8s Secret
+                                        in the namespace of the VirtualMachineInstance.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                  type: object
+                                varsTemplate:
+                                  description: |-
+                                    VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,
+                                    used in place of the stock template when the EFI variable store is initialized.
+                                    A persistent EFI variable store is initialized again whenever the template changes.
+                                  properties:
+                                    configMap:
+                                      description: ConfigMap references a ConfigMap
+                                        in the namespace of the VirtualMachineInstance.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                    secret:
+                                      description: Secret references a k8s Secret
+                                        in the namespace of the VirtualMachineInstance.
+                                      properties:
+                                        name:
+                                          default: ""
+                                          description: |-
+                                            Name of the referent.
+                                            This field is effectively required, but due to backwards compatibility is
+                                            allowed to be empty. Instances of this type with an empty value here are
+                                            almost certainly wrong.
+                                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                          type: string
+                                      type: object
+                                      x-kubernetes-map-type: atomic
+                                  type: object
                               type: object
                           type: object
                         kernelBoot:
@@ -17870,6 +20653,67 @@ var CRDsValidation map[string]string = map[string]string{
                     - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                     - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                   type: string
+                freezeHooks:
+                  description: |-
+                    FreezeHooks configure commands which are executed in the guest around a filesystem freeze
+                    and allow limiting the freeze to a set of mountpoints.
+                  properties:
+                    mountpoints:
+                      description: |-
+                        Mountpoints limits the freeze to the listed guest mountpoints.
+                        All guest filesystems are frozen if empty.
+                      items:
+                        type: string
+                      type: array
+                      x-kubernetes-list-type: atomic
+                    postThaw:
+                      description: PostThaw is executed in the guest after the filesystems
+                        were thawed.
+                      properties:
+                        args:
+                          description: Args are passed to the command.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        command:
+                          description: Command is the path of the executable in the
+                            guest.
+                          type: string
+                        timeoutSeconds:
+                          description: |-
+                            TimeoutSeconds is the time the command may run before it is considered failed.
+                            Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                          format: int32
+                          type: integer
+                      required:
+                      - command
+                      type: object
+                    preFreeze:
+                      description: |-
+                        PreFreeze is executed in the guest before the filesystems are frozen.
+                        The filesystems are not frozen if the command fails or exits with a non-zero code.
+                      properties:
+                        args:
+                          description: Args are passed to the command.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        command:
+                          description: Command is the path of the executable in the
+                            guest.
+                          type: string
+                        timeoutSeconds:
+                          description: |-
+                            TimeoutSeconds is the time the command may run before it is considered failed.
+                            Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                          format: int32
+                          type: integer
+                      required:
+                      - command
+                      type: object
+                  type: object
                 hostname:
                   description: |-
                     Specifies the hostname of the vmi
@@ -17906,6 +20750,28 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    grpc:
+                      description: |-
+                        GRPC specifies an action involving a GRPC port.
+                        The health check is performed inside the guest by running grpc_health_probe through the guest
+                        agent, so both have to be installed in the guest.
+                      properties:
+                        port:
+                          description: Port number of the gRPC service. Number must
+                            be in the range 1 to 65535.
+                          format: int32
+                          type: integer
+                        service:
+                          default: ""
+                          description: |-
+                            Service is the name of the service to place in the gRPC HealthCheckRequest
+                            (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                            If this is not specified, the default behavior is defined by gRPC.
+                          type: string
+                      required:
+                      - port
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -18067,6 +20933,23 @@ var CRDsValidation map[string]string = map[string]string{
                     Selector which must match a node's labels for the vmi to be scheduled on that node.
                     More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                   type: object
+                panicPolicy:
+                  description: |-
+                    PanicPolicy exposes a panic device to the guest and defines how a guest panic is handled.
+                    The panicked guest is preserved until an optional memory dump was taken and is then restarted or paused.
+                  properties:
+                    action:
+                      description: |-
+                        Action is taken once the panic was recorded and the memory dump finished.
+                        Defaults to Restart.
+                      type: string
+                    memoryDumpClaimName:
+                      description: |-
+                        MemoryDumpClaimName is the name of the pvc the guest memory is dumped to on a panic.
+                        The memory dump is only taken for VMIs owned by a VirtualMachine.
+                        It requires the HotplugVolumes feature gate and is skipped if the pvc can't hold the dump.
+                      type: string
+                  type: object
                 priorityClassName:
                   description: |-
                     If specified, indicates the pod's priority.
@@ -18104,6 +20987,28 @@ var CRDsValidation map[string]string = map[string]string{
                         Defaults to 3. Minimum value is 1.
                       format: int32
                       type: integer
+                    grpc:
+                      description: |-
+                        GRPC specifies an action involving a GRPC port.
+                        The health check is performed inside the guest by running grpc_health_probe through the guest
+                        agent, so both have to be installed in the guest.
+                      properties:
+                        port:
+                          description: Port number of the gRPC service. Number must
+                            be in the range 1 to 65535.
+                          format: int32
+                          type: integer
+                        service:
+                          default: ""
+                          description: |-
+                            Service is the name of the service to place in the gRPC HealthCheckRequest
+                            (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                            If this is not specified, the default behavior is defined by gRPC.
+                          type: string
+                      required:
+                      - port
+                      type: object
                     guestAgentPing:
                       description: GuestAgentPing contacts the qemu-guest-agent for
                         availability checks.
@@ -18217,6 +21122,166 @@ var CRDsValidation map[string]string = map[string]string{
                   description: StartStrategy can be set to "Paused" if Virtual Machine
                     should be started in paused state.
                   type: string
+                startupProbe:
+                  description: |-
+                    StartupProbe indicates that the VirtualMachineInstance has successfully started.
+                    If specified, no other probes are executed until this completes successfully.
+                    If this probe fails, the VirtualMachineInstance will be stopped, just as if the livenessProbe failed.
+                    This can be used to allow slow booting guests a long initialization time without
+                    having to delay the liveness probe of the whole lifetime of the VirtualMachineInstance.
+                    Cannot be updated.
+                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                  properties:
+                    exec:
+                      description: |-
+                        One and only one of the following should be specified.
+                        Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
+                        If the guest agent is not available, this probe will fail.
+                      properties:
+                        command:
+                          description: |-
+                            Command is the command line to execute inside the container, the working directory for the
+                            command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+                            not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+                            a shell, you need to explicitly call out to that shell.
+                            Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
+                          items:
+                            type: string
+                          type: array
+                          x-kubernetes-list-type: atomic
+                      type: object
+                    failureThreshold:
+                      description: |-
+                        Minimum consecutive failures for the probe to be considered failed after having succeeded.
+                        Defaults to 3. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    grpc:
+                      description: |-
+                        GRPC specifies an action involving a GRPC port.
+                        The health check is performed inside the guest by running grpc_health_probe through the guest
+                        agent, so both have to be installed in the guest.
+                      properties:
+                        port:
+                          description: Port number of the gRPC service. Number must
+                            be in the range 1 to 65535.
+                          format: int32
+                          type: integer
+                        service:
+                          default: ""
+                          description: |-
+                            Service is the name of the service to place in the gRPC HealthCheckRequest
+                            (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                            If this is not specified, the default behavior is defined by gRPC.
+                          type: string
+                      required:
+                      - port
+                      type: object
+                    guestAgentPing:
+                      description: GuestAgentPing contacts the qemu-guest-agent for
+                        availability checks.
+                      type: object
+                    httpGet:
+                      description: HTTPGet specifies the http request to perform.
+                      properties:
+                        host:
+                          description: |-
+                            Host name to connect to, defaults to the pod IP. You probably want to set
+                            "Host" in httpHeaders instead.
+                          type: string
+                        httpHeaders:
+                          description: Custom headers to set in the request. HTTP
+                            allows repeated headers.
+                          items:
+                            description: HTTPHeader describes a custom header to be
+                              used in HTTP probes
+                            properties:
+                              name:
+                                description: |-
+                                  The header field name.
+                                  This will be canonicalized upon output, so case-variant names will be understood as the same header.
+                                type: string
+                              value:
+                                description: The header field value
+                                type: string
+                            required:
+                            - name
+                            - value
+                            type: object
+                          type: array
+                          x-kubernetes-list-type: atomic
+                        path:
+                          description: Path to access on the HTTP server.
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            Name or number of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                        scheme:
+                          description: |-
+                            Scheme to use for connecting to the host.
+                            Defaults to HTTP.
+                          type: string
+                      required:
+                      - port
+                      type: object
+                    initialDelaySeconds:
+                      description: |-
+                        Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                    periodSeconds:
+                      description: |-
+                        How often (in seconds) to perform the probe.
+                        Default to 10 seconds. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    successThreshold:
+                      description: |-
+                        Minimum consecutive successes for the probe to be considered successful after having failed.
+                        Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                      format: int32
+                      type: integer
+                    tcpSocket:
+                      description: |-
+                        TCPSocket specifies an action involving a TCP port.
+                        TCP hooks not yet supported
+                      properties:
+                        host:
+                          description: 'Optional: Host name to connect to, defaults
+                            to the pod IP.'
+                          type: string
+                        port:
+                          anyOf:
+                          - type: integer
+                          - type: string
+                          description: |-
+                            Number or name of the port to access on the container.
+                            Number must be in the range 1 to 65535.
+                            Name must be an IANA_SVC_NAME.
+                          x-kubernetes-int-or-string: true
+                      required:
+                      - port
+                      type: object
+                    timeoutSeconds:
+                      description: |-
+                        Number of seconds after which the probe times out.
+                        For exec probes the timeout fails the probe but does not terminate the command running on the guest.
+                        This means a blocking command can result in an increasing load on the guest.
+                        A small buffer will be added to the resulting workload exec probe to compensate for delays
+                        caused by the qemu guest exec mechanism.
+                        Defaults to 1 second. Minimum value is 1.
+                        More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                      format: int32
+                      type: integer
+                  type: object
                 subdomain:
                   description: |-
                     If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
@@ -18794,6 +21859,9 @@ var CRDsValidation map[string]string = map[string]string{
                               claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                               More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                             type: string
+                          format:
+                            description: Format of the memory dump, defaults to raw
+                            type: string
                           hotpluggable:
                             description: Hotpluggable indicates whether the volume
                               can be hotplugged and hotunplugged.
@@ -21504,6 +24572,52 @@ var CRDsValidation map[string]string = map[string]string{
                                           IO specifies which QEMU disk IO mode should be used.
                                           Supported values are: native, default, threads.
                                         type: string
+                                      ioTune:
+                                        description: |-
+                                          IOTune limits the throughput and the IO operations of the disk.
+                                          It is applied to a running VM without a restart when live updates are enabled.
+                                        properties:
+                                          readBytesPerSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: ReadBytesPerSec limits the
+                                              read throughput in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          readIOPS:
+                                            description: ReadIOPS limits the read
+                                              IO operations per second.
+                                            format: int64
+                                            type: integer
+                                          totalBytesPerSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: TotalBytesPerSec limits the
+                                              combined read and write throughput in
+                                              bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          totalIOPS:
+                                            description: TotalIOPS limits the combined
+                                              read and write IO operations per second.
+                                            format: int64
+                                            type: integer
+                                          writeBytesPerSec:
+                                            anyOf:
+                                            - type: integer
+                                            - type: string
+                                            description: WriteBytesPerSec limits the
+                                              write throughput in bytes per second.
+                                            pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                            x-kubernetes-int-or-string: true
+                                          writeIOPS:
+                                            description: WriteIOPS limits the write
+                                              IO operations per second.
+                                            format: int64
+                                            type: integer
+                                        type: object
                                       lun:
                                         description: Attach a volume as a LUN to the
                                           vmi.
@@ -21611,6 +24725,33 @@ var CRDsValidation map[string]string = map[string]string{
                                     type: object
                                   type: array
                                   x-kubernetes-list-type: atomic
+                                graphics:
+                                  description: |-
+                                    Graphics selects the remote display protocol of the default graphics device.
+                                    May not be set if autoattachGraphicsDevice is disabled. Defaults to VNC.
+                                  properties:
+                                    spice:
+                                      description: |-
+                                        SPICE exposes the display through the spice subresource.
+                                        Compared to VNC it supports multiple monitors, clipboard sharing and improved video streaming.
+                                      properties:
+                                        heads:
+                                          description: |-
+                                            Heads is the number of monitors provided to the guest.
+                                            Defaults to 1 and may not exceed SPICEGraphicsMaxHeads.
+                                          format: int32
+                                          type: integer
+                                        videoModel:
+                                          description: |-
+                                            VideoModel is the display device presented to the guest.
+                                            We only support qxl or virtio. Defaults to qxl.
+                                          type: string
+                                      type: object
+                                    vnc:
+                                      description: VNC exposes the display through
+                                        the vnc subresource.
+                                      type: object
+                                  type: object
                                 hostDevices:
                                   description: Whether to attach a host device to
                                     the vmi.
@@ -21666,6 +24807,81 @@ var CRDsValidation map[string]string = map[string]string{
                                           in PCI addresses assigned to the device.
                                           This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                         type: integer
+                                      bandwidth:
+                                        description: |-
+                                          Bandwidth limits the traffic of the interface.
+                                          It is applied to a running VM without a restart when live updates are enabled.
+                                          Only supported with the bridge and masquerade bindings.
+                                        properties:
+                                          inbound:
+                                            description: Inbound limits the traffic
+                                              received by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average is the average
+                                                  rate the traffic is shaped to, in
+                                                  bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of
+                                                  bytes that can be sent at the peak
+                                                  rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak is the maximum rate
+                                                  at which bursts are sent, in bytes
+                                                  per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                          outbound:
+                                            description: Outbound limits the traffic
+                                              sent by the guest.
+                                            properties:
+                                              average:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Average is the average
+                                                  rate the traffic is shaped to, in
+                                                  bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              burst:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Burst is the amount of
+                                                  bytes that can be sent at the peak
+                                                  rate.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              peak:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: Peak is the maximum rate
+                                                  at which bursts are sent, in bytes
+                                                  per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                            required:
+                                            - average
+                                            type: object
+                                        type: object
                                       binding:
                                         description: |-
                                           Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -22192,6 +25408,82 @@ var CRDsValidation map[string]string = map[string]string{
                                             Requires SMM to be enabled.
                                             Defaults to true
                                           type: boolean
+                                        secureBootKeys:
+                                          description: |-
+                                            SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the
+                                            EFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.
+                                            Requires SecureBoot.
+                                          properties:
+                                            configMap:
+                                              description: ConfigMap references a
+                                                ConfigMap in the namespace of the
+                                                VirtualMachineInstance.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            secret:
+                                              description: Secret references a k8s
+                                                Secret in the namespace of the VirtualMachineInstance.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                          type: object
+                                        varsTemplate:
+                                          description: |-
+                                            VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,
+                                            used in place of the stock template when the EFI variable store is initialized.
+                                            A persistent EFI variable store is initialized again whenever the template changes.
+                                          properties:
+                                            configMap:
+                                              description: ConfigMap references a
+                                                ConfigMap in the namespace of the
+                                                VirtualMachineInstance.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                            secret:
+                                              description: Secret references a k8s
+                                                Secret in the namespace of the VirtualMachineInstance.
+                                              properties:
+                                                name:
+                                                  default: ""
+                                                  description: |-
+                                                    Name of the referent.
+                                                    This field is effectively required, but due to backwards compatibility is
+                                                    allowed to be empty. Instances of this type with an empty value here are
+                                                    almost certainly wrong.
+                                                    More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                  type: string
+                                              type: object
+                                              x-kubernetes-map-type: atomic
+                                          type: object
                                       type: object
                                   type: object
                                 kernelBoot:
@@ -22379,15 +25671,313 @@ var CRDsValidation map[string]string = map[string]string{
                             - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                             - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                           type: string
+                        freezeHooks:
+                          description: |-
+                            FreezeHooks configure commands which are executed in the guest around a filesystem freeze
+                            and allow limiting the freeze to a set of mountpoints.
+                          properties:
+                            mountpoints:
+                              description: |-
+                                Mountpoints limits the freeze to the listed guest mountpoints.
+                                All guest filesystems are frozen if empty.
+                              items:
+                                type: string
+                              type: array
+                              x-kubernetes-list-type: atomic
+                            postThaw:
+                              description: PostThaw is executed in the guest after
+                                the filesystems were thawed.
+                              properties:
+                                args:
+                                  description: Args are passed to the command.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                command:
+                                  description: Command is the path of the executable
+                                    in the guest.
+                                  type: string
+                                timeoutSeconds:
+                                  description: |-
+                                    TimeoutSeconds is the time the command may run before it is considered failed.
+                                    Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - command
+                              type: object
+                            preFreeze:
+                              description: |-
+                                PreFreeze is executed in the guest before the filesystems are frozen.
+                                The filesystems are not frozen if the command fails or exits with a non-zero code.
+                              properties:
+                                args:
+                                  description: Args are passed to the command.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                command:
+                                  description: Command is the path of the executable
+                                    in the guest.
+                                  type: string
+                                timeoutSeconds:
+                                  description: |-
+                                    TimeoutSeconds is the time the command may run before it is considered failed.
+                                    Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                                  format: int32
+                                  type: integer
+                              required:
+                              - command
+                              type: object
+                          type: object
                         hostname:
                           description: |-
-                            Specifies the hostname of the vmi
-                            If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
+                            Specifies the hostname of the vmi
+                            If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
+                          type: string
+                        livenessProbe:
+                          description: |-
+                            Periodic probe of VirtualMachineInstance liveness.
+                            VirtualmachineInstances will be stopped if the probe fails.
+                            Cannot be updated.
+                            More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                          properties:
+                            exec:
+                              description: |-
+                                One and only one of the following should be specified.
+                                Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
+                                If the guest agent is not available, this probe will fail.
+                              properties:
+                                command:
+                                  description: |-
+                                    Command is the command line to execute inside the container, the working directory for the
+                                    command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+                                    not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+                                    a shell, you need to explicitly call out to that shell.
+                                    Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                              type: object
+                            failureThreshold:
+                              description: |-
+                                Minimum consecutive failures for the probe to be considered failed after having succeeded.
+                                Defaults to 3. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            grpc:
+                              description: |-
+                                GRPC specifies an action involving a GRPC port.
+                                The health check is performed inside the guest by running grpc_health_probe through the guest
+                                agent, so both have to be installed in the guest.
+                              properties:
+                                port:
+                                  description: Port number of the gRPC service. Number
+                                    must be in the range 1 to 65535.
+                                  format: int32
+                                  type: integer
+                                service:
+                                  default: ""
+                                  description: |-
+                                    Service is the name of the service to place in the gRPC HealthCheckRequest
+                                    (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                                    If this is not specified, the default behavior is defined by gRPC.
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            guestAgentPing:
+                              description: GuestAgentPing contacts the qemu-guest-agent
+                                for availability checks.
+                              type: object
+                            httpGet:
+                              description: HTTPGet specifies the http request to perform.
+                              properties:
+                                host:
+                                  description: |-
+                                    Host name to connect to, defaults to the pod IP. You probably want to set
+                                    "Host" in httpHeaders instead.
+                                  type: string
+                                httpHeaders:
+                                  description: Custom headers to set in the request.
+                                    HTTP allows repeated headers.
+                                  items:
+                                    description: HTTPHeader describes a custom header
+                                      to be used in HTTP probes
+                                    properties:
+                                      name:
+                                        description: |-
+                                          The header field name.
+                                          This will be canonicalized upon output, so case-variant names will be understood as the same header.
+                                        type: string
+                                      value:
+                                        description: The header field value
+                                        type: string
+                                    required:
+                                    - name
+                                    - value
+                                    type: object
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                path:
+                                  description: Path to access on the HTTP server.
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: |-
+                                    Name or number of the port to access on the container.
+                                    Number must be in the range 1 to 65535.
+                                    Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
+                                scheme:
+                                  description: |-
+                                    Scheme to use for connecting to the host.
+                                    Defaults to HTTP.
+                                  type: string
+                              required:
+                              - port
+                              type: object
+                            initialDelaySeconds:
+                              description: |-
+                                Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
+                                More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                              format: int32
+                              type: integer
+                            periodSeconds:
+                              description: |-
+                                How often (in seconds) to perform the probe.
+                                Default to 10 seconds. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            successThreshold:
+                              description: |-
+                                Minimum consecutive successes for the probe to be considered successful after having failed.
+                                Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                              format: int32
+                              type: integer
+                            tcpSocket:
+                              description: |-
+                                TCPSocket specifies an action involving a TCP port.
+                                TCP hooks not yet supported
+                              properties:
+                                host:
+                                  description: 'Optional: Host name to connect to,
+                                    defaults to the pod IP.'
+                                  type: string
+                                port:
+                                  anyOf:
+                                  - type: integer
+                                  - type: string
+                                  description: |-
+                                    Number or name of the port to access on the container.
+                                    Number must be in the range 1 to 65535.
+                                    Name must be an IANA_SVC_NAME.
+                                  x-kubernetes-int-or-string: true
+                              required:
+                              - port
+                              type: object
+                            timeoutSeconds:
+                              description: |-
+                                Number of seconds after which the probe times out.
+                                For exec probes the timeout fails the probe but does not terminate the command running on the guest.
+                                This means a blocking command can result in an increasing load on the guest.
+                                A small buffer will be added to the resulting workload exec probe to compensate for delays
+                                caused by the qemu guest exec mechanism.
+                                Defaults to 1 second. Minimum value is 1.
+                                More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                              format: int32
+                              type: integer
+                          type: object
+                        networks:
+                          description: List of networks that can be attached to a
+                            vm's virtual interface.
+                          items:
+                            description: Network represents a network type and a resource
+                              that should be connected to the vm.
+                            properties:
+                              multus:
+                                description: Represents the multus cni network.
+                                properties:
+                                  default:
+                                    description: |-
+                                      Select the default network and add it to the
+                                      multus-cni.io/default-network annotation.
+                                    type: boolean
+                                  networkName:
+                                    description: |-
+                                      References to a NetworkAttachmentDefinition CRD object. Format:
+                                      <networkName>, <namespace>/<networkName>. If namespace is not
+                                      specified, VMI namespace is assumed.
+                                    type: string
+                                required:
+                                - networkName
+                                type: object
+                              name:
+                                description: |-
+                                  Network name.
+                                  Must be a DNS_LABEL and unique within the vm.
+                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                type: string
+                              pod:
+                                description: Represents the stock pod network interface.
+                                properties:
+                                  vmIPv6NetworkCIDR:
+                                    description: |-
+                                      IPv6 CIDR for the vm network.
+                                      Defaults to fd10:0:2::/120 if not specified.
+                                    type: string
+                                  vmNetworkCIDR:
+                                    description: |-
+                                      CIDR for vm network.
+                                      Default 10.0.2.0/24 if not specified.
+                                    type: string
+                                type: object
+                            required:
+                            - name
+                            type: object
+                          maxItems: 256
+                          type: array
+                        nodeSelector:
+                          additionalProperties:
+                            type: string
+                          description: |-
+                            NodeSelector is a selector which must be true for the vmi to fit on a node.
+                            Selector which must match a node's labels for the vmi to be scheduled on that node.
+                            More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
+                          type: object
+                        panicPolicy:
+                          description: |-
+                            PanicPolicy exposes a panic device to the guest and defines how a guest panic is handled.
+                            The panicked guest is preserved until an optional memory dump was taken and is then restarted or paused.
+                          properties:
+                            action:
+                              description: |-
+                                Action is taken once the panic was recorded and the memory dump finished.
+                                Defaults to Restart.
+                              type: string
+                            memoryDumpClaimName:
+                              description: |-
+                                MemoryDumpClaimName is the name of the pvc the guest memory is dumped to on a panic.
+                                The memory dump is only taken for VMIs owned by a VirtualMachine.
+                                It requires the HotplugVolumes feature gate and is skipped if the pvc can't hold the dump.
+                              type: string
+                          type: object
+                        priorityClassName:
+                          description: |-
+                            If specified, indicates the pod's priority.
+                            If not specified, the pod priority will be default or zero if there is no
+                            default.
                           type: string
-                        livenessProbe:
+                        readinessProbe:
                           description: |-
-                            Periodic probe of VirtualMachineInstance liveness.
-                            VirtualmachineInstances will be stopped if the probe fails.
+                            Periodic probe of VirtualMachineInstance service readiness.
+                            VirtualmachineInstances will be removed from service endpoints if the probe fails.
                             Cannot be updated.
                             More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                           properties:
@@ -22415,6 +26005,28 @@ var CRDsValidation map[string]string = map[string]string{
                                 Defaults to 3. Minimum value is 1.
                               format: int32
                               type: integer
+                            grpc:
+                              description: |-
+                                GRPC specifies an action involving a GRPC port.
+                                The health check is performed inside the guest by running grpc_health_probe through the guest
+                                agent, so both have to be installed in the guest.
+                              properties:
+                                port:
+                                  description: Port number of the gRPC service. Number
+                                    must be in the range 1 to 65535.
+                                  format: int32
+                                  type: integer
+                                service:
+                                  default: ""
+                                  description: |-
+                                    Service is the name of the service to place in the gRPC HealthCheckRequest
+                                    (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                                    If this is not specified, the default behavior is defined by gRPC.
+                                  type: string
+                              required:
+                              - port
+                              type: object
                             guestAgentPing:
                               description: GuestAgentPing contacts the qemu-guest-agent
                                 for availability checks.
@@ -22519,73 +26131,22 @@ var CRDsValidation map[string]string = map[string]string{
                               format: int32
                               type: integer
                           type: object
-                        networks:
-                          description: List of networks that can be attached to a
-                            vm's virtual interface.
-                          items:
-                            description: Network represents a network type and a resource
-                              that should be connected to the vm.
-                            properties:
-                              multus:
-                                description: Represents the multus cni network.
-                                properties:
-                                  default:
-                                    description: |-
-                                      Select the default network and add it to the
-                                      multus-cni.io/default-network annotation.
-                                    type: boolean
-                                  networkName:
-                                    description: |-
-                                      References to a NetworkAttachmentDefinition CRD object. Format:
-                                      <networkName>, <namespace>/<networkName>. If namespace is not
-                                      specified, VMI namespace is assumed.
-                                    type: string
-                                required:
-                                - networkName
-                                type: object
-                              name:
-                                description: |-
-                                  Network name.
-                                  Must be a DNS_LABEL and unique within the vm.
-                                  More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
-                                type: string
-                              pod:
-                                description: Represents the stock pod network interface.
-                                properties:
-                                  vmIPv6NetworkCIDR:
-                                    description: |-
-                                      IPv6 CIDR for the vm network.
-                                      Defaults to fd10:0:2::/120 if not specified.
-                                    type: string
-                                  vmNetworkCIDR:
-                                    description: |-
-                                      CIDR for vm network.
-                                      Default 10.0.2.0/24 if not specified.
-                                    type: string
-                                type: object
-                            required:
-                            - name
-                            type: object
-                          maxItems: 256
-                          type: array
-                        nodeSelector:
-                          additionalProperties:
-                            type: string
-                          description: |-
-                            NodeSelector is a selector which must be true for the vmi to fit on a node.
-                            Selector which must match a node's labels for the vmi to be scheduled on that node.
-                            More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
-                          type: object
-                        priorityClassName:
+                        schedulerName:
                           description: |-
-                            If specified, indicates the pod's priority.
-                            If not specified, the pod priority will be default or zero if there is no
-                            default.
+                            If specified, the VMI will be dispatched by specified scheduler.
+                            If not specified, the VMI will be dispatched by default scheduler.
                           type: string
-                        readinessProbe:
+                        startStrategy:
+                          description: StartStrategy can be set to "Paused" if Virtual
+                            Machine should be started in paused state.
+                          type: string
+                        startupProbe:
                           description: |-
-                            Periodic probe of VirtualMachineInstance service readiness.
-                            VirtualmachineInstances will be removed from service endpoints if the probe fails.
+                            StartupProbe indicates that the VirtualMachineInstance has successfully started.
+                            If specified, no other probes are executed until this completes successfully.
+                            If this probe fails, the VirtualMachineInstance will be stopped, just as if the livenessProbe failed.
+                            This can be used to allow slow booting guests a long initialization time without
+                            having to delay the liveness probe of the whole lifetime of the VirtualMachineInstance.
                             Cannot be updated.
                             More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
                           properties:
@@ -22613,6 +26174,28 @@ var CRDsValidation map[string]string = map[string]string{
                                 Defaults to 3. Minimum value is 1.
                               format: int32
                               type: integer
+                            grpc:
+                              description: |-
+                                GRPC specifies an action involving a GRPC port.
+                                The health check is performed inside the guest by running grpc_health_probe through the guest
+                                agent, so both have to be installed in the guest.
+                              properties:
+                                port:
+                                  description: Port number of the gRPC service. Number
+                                    must be in the range 1 to 65535.
+                                  format: int32
+                                  type: integer
+                                service:
+                                  default: ""
+                                  description: |-
+                                    Service is the name of the service to place in the gRPC HealthCheckRequest
+                                    (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                                    If this is not specified, the default behavior is defined by gRPC.
+                                  type: string
+                              required:
+                              - port
+                              type: object
                             guestAgentPing:
                               description: GuestAgentPing contacts the qemu-guest-agent
                                 for availability checks.
@@ -22717,15 +26300,6 @@ var CRDsValidation map[string]string = map[string]string{
                               format: int32
                               type: integer
                           type: object
-                        schedulerName:
-                          description: |-
-                            If specified, the VMI will be dispatched by specified scheduler.
-                            If not specified, the VMI will be dispatched by default scheduler.
-                          type: string
-                        startStrategy:
-                          description: StartStrategy can be set to "Paused" if Virtual
-                            Machine should be started in paused state.
-                          type: string
                         subdomain:
                           description: |-
                             If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
@@ -23312,6 +26886,10 @@ var CRDsValidation map[string]string = map[string]string{
                                       claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                       More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                     type: string
+                                  format:
+                                    description: Format of the memory dump, defaults
+                                      to raw
+                                    type: string
                                   hotpluggable:
                                     description: Hotpluggable indicates whether the
                                       volume can be hotplugged and hotunplugged.
@@ -24063,6 +27641,80 @@ var CRDsValidation map[string]string = map[string]string{
                     Requires SMM to be enabled.
                     Defaults to true
                   type: boolean
+                secureBootKeys:
+                  description: |-
+                    SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the
+                    EFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.
+                    Requires SecureBoot.
+                  properties:
+                    configMap:
+                      description: ConfigMap references a ConfigMap in the namespace
+                        of the VirtualMachineInstance.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    secret:
+                      description: Secret references a k8s Secret in the namespace
+                        of the VirtualMachineInstance.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                  type: object
+                varsTemplate:
+                  description: |-
+                    VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,
+                    used in place of the stock template when the EFI variable store is initialized.
+                    A persistent EFI variable store is initialized again whenever the template changes.
+                  properties:
+                    configMap:
+                      description: ConfigMap references a ConfigMap in the namespace
+                        of the VirtualMachineInstance.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                    secret:
+                      description: Secret references a k8s Secret in the namespace
+                        of the VirtualMachineInstance.
+                      properties:
+                        name:
+                          default: ""
+                          description: |-
+                            Name of the referent.
+                            This field is effectively required, but due to backwards compatibility is
+                            allowed to be empty. Instances of this type with an empty value here are
+                            almost certainly wrong.
+                            More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                          type: string
+                      type: object
+                      x-kubernetes-map-type: atomic
+                  type: object
               type: object
             preferredUseBios:
               description: PreferredUseBios optionally enables BIOS
@@ -24151,6 +27803,145 @@ var CRDsValidation map[string]string = map[string]string{
   required:
   - spec
   type: object
+`,
+	"virtualmachinequota": `openAPIV3Schema:
+  description: VirtualMachineQuota caps the VM specific resources which the
+    VirtualMachineInstances of its namespace may consume. Unlike a ResourceQuota
+    it accounts for the guest and not for the virt-launcher pods.
+  properties:
+    apiVersion:
+      description: |-
+        APIVersion defines the versioned schema of this representation of an object.
+        Servers should convert recognized schemas to the latest internal value, and
+        may reject unrecognized values.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#resources
+      type: string
+    kind:
+      description: |-
+        Kind is a string value representing the REST resource this object represents.
+        Servers may infer this from the endpoint the client submits requests to.
+        Cannot be updated.
+        In CamelCase.
+        More info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds
+      type: string
+    metadata:
+      type: object
+    spec:
+      description: VirtualMachineQuotaSpec holds the limits of a
+        VirtualMachineQuota
+      properties:
+        hard:
+          description: Hard is the set of limits enforced when
+            VirtualMachineInstances are created, VirtualMachines are started or
+            VirtualMachineInstanceMigrations are created. Resources which are
+            not set are not limited.
+          properties:
+            devices:
+              additionalProperties:
+                format: int64
+                type: integer
+              description: Devices is the number of GPUs and host devices by
+                their device name
+              type: object
+            guestMemory:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestMemory is the total memory visible to the
+                guests, without the overhead of the virt-launcher pods
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            migrations:
+              description: Migrations is the number of concurrent
+                VirtualMachineInstanceMigrations
+              format: int64
+              type: integer
+            runningVMIs:
+              description: RunningVMIs is the number of VirtualMachineInstances
+              format: int64
+              type: integer
+            vcpus:
+              description: VCPUs is the total number of vCPUs, which are sockets
+                * cores * threads of every VirtualMachineInstance
+              format: int64
+              type: integer
+          type: object
+      type: object
+    status:
+      description: VirtualMachineQuotaStatus holds the usage of a
+        VirtualMachineQuota
+      properties:
+        hard:
+          description: Hard is the set of limits the usage was last computed for
+          properties:
+            devices:
+              additionalProperties:
+                format: int64
+                type: integer
+              description: Devices is the number of GPUs and host devices by
+                their device name
+              type: object
+            guestMemory:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestMemory is the total memory visible to the
+                guests, without the overhead of the virt-launcher pods
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            migrations:
+              description: Migrations is the number of concurrent
+                VirtualMachineInstanceMigrations
+              format: int64
+              type: integer
+            runningVMIs:
+              description: RunningVMIs is the number of VirtualMachineInstances
+              format: int64
+              type: integer
+            vcpus:
+              description: VCPUs is the total number of vCPUs, which are sockets
+                * cores * threads of every VirtualMachineInstance
+              format: int64
+              type: integer
+          type: object
+        used:
+          description: Used is the current usage of the limited resources in the
+            namespace
+          properties:
+            devices:
+              additionalProperties:
+                format: int64
+                type: integer
+              description: Devices is the number of GPUs and host devices by
+                their device name
+              type: object
+            guestMemory:
+              anyOf:
+              - type: integer
+              - type: string
+              description: GuestMemory is the total memory visible to the
+                guests, without the overhead of the virt-launcher pods
+              pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+              x-kubernetes-int-or-string: true
+            migrations:
+              description: Migrations is the number of concurrent
+                VirtualMachineInstanceMigrations
+              format: int64
+              type: integer
+            runningVMIs:
+              description: RunningVMIs is the number of VirtualMachineInstances
+              format: int64
+              type: integer
+            vcpus:
+              description: VCPUs is the total number of vCPUs, which are sockets
+                * cores * threads of every VirtualMachineInstance
+              format: int64
+              type: integer
+          type: object
+      type: object
+  required:
+  - spec
+  type: object
 `,
 	"virtualmachinerestore": `openAPIV3Schema:
   description: VirtualMachineRestore defines the operation of restoring a VM
@@ -26688,6 +30479,55 @@ var CRDsValidation map[string]string = map[string]string{
                                               IO specifies which QEMU disk IO mode should be used.
                                               Supported values are: native, default, threads.
                                             type: string
+                                          ioTune:
+                                            description: |-
+                                              IOTune limits the throughput and the IO operations of the disk.
+                                              It is applied to a running VM without a restart when live updates are enabled.
+                                            properties:
+                                              readBytesPerSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: ReadBytesPerSec limits
+                                                  the read throughput in bytes per
+                                                  second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              readIOPS:
+                                                description: ReadIOPS limits the read
+                                                  IO operations per second.
+                                                format: int64
+                                                type: integer
+                                              totalBytesPerSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: TotalBytesPerSec limits
+                                                  the combined read and write throughput
+                                                  in bytes per second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              totalIOPS:
+                                                description: TotalIOPS limits the
+                                                  combined read and write IO operations
+                                                  per second.
+                                                format: int64
+                                                type: integer
+                                              writeBytesPerSec:
+                                                anyOf:
+                                                - type: integer
+                                                - type: string
+                                                description: WriteBytesPerSec limits
+                                                  the write throughput in bytes per
+                                                  second.
+                                                pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                x-kubernetes-int-or-string: true
+                                              writeIOPS:
+                                                description: WriteIOPS limits the
+                                                  write IO operations per second.
+                                                format: int64
+                                                type: integer
+                                            type: object
                                           lun:
                                             description: Attach a volume as a LUN
                                               to the vmi.
@@ -26799,6 +30639,33 @@ var CRDsValidation map[string]string = map[string]string{
                                         type: object
                                       type: array
                                       x-kubernetes-list-type: atomic
+                                    graphics:
+                                      description: |-
+                                        Graphics selects the remote display protocol of the default graphics device.
+                                        May not be set if autoattachGraphicsDevice is disabled. Defaults to VNC.
+                                      properties:
+                                        spice:
+                                          description: |-
+                                            SPICE exposes the display through the spice subresource.
+                                            Compared to VNC it supports multiple monitors, clipboard sharing and improved video streaming.
+                                          properties:
+                                            heads:
+                                              description: |-
+                                                Heads is the number of monitors provided to the guest.
+                                                Defaults to 1 and may not exceed SPICEGraphicsMaxHeads.
+                                              format: int32
+                                              type: integer
+                                            videoModel:
+                                              description: |-
+                                                VideoModel is the display device presented to the guest.
+                                                We only support qxl or virtio. Defaults to qxl.
+                                              type: string
+                                          type: object
+                                        vnc:
+                                          description: VNC exposes the display through
+                                            the vnc subresource.
+                                          type: object
+                                      type: object
                                     hostDevices:
                                       description: Whether to attach a host device
                                         to the vmi.
@@ -26856,6 +30723,81 @@ var CRDsValidation map[string]string = map[string]string{
                                               in PCI addresses assigned to the device.
                                               This value is required to be unique across all devices and be between 1 and (16*1024-1).
                                             type: integer
+                                          bandwidth:
+                                            description: |-
+                                              Bandwidth limits the traffic of the interface.
+                                              It is applied to a running VM without a restart when live updates are enabled.
+                                              Only supported with the bridge and masquerade bindings.
+                                            properties:
+                                              inbound:
+                                                description: Inbound limits the traffic
+                                                  received by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average is the average
+                                                      rate the traffic is shaped to,
+                                                      in bytes per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount
+                                                      of bytes that can be sent at
+                                                      the peak rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak is the maximum
+                                                      rate at which bursts are sent,
+                                                      in bytes per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                              outbound:
+                                                description: Outbound limits the traffic
+                                                  sent by the guest.
+                                                properties:
+                                                  average:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Average is the average
+                                                      rate the traffic is shaped to,
+                                                      in bytes per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  burst:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Burst is the amount
+                                                      of bytes that can be sent at
+                                                      the peak rate.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                  peak:
+                                                    anyOf:
+                                                    - type: integer
+                                                    - type: string
+                                                    description: Peak is the maximum
+                                                      rate at which bursts are sent,
+                                                      in bytes per second.
+                                                    pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                                    x-kubernetes-int-or-string: true
+                                                required:
+                                                - average
+                                                type: object
+                                            type: object
                                           binding:
                                             description: |-
                                               Binding specifies the binding plugin that will be used to connect the interface to the guest.
@@ -27383,6 +31325,84 @@ var CRDsValidation map[string]string = map[string]string{
                                                 Requires SMM to be enabled.
                                                 Defaults to true
                                               type: boolean
+                                            secureBootKeys:
+                                              description: |-
+                                                SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the
+                                                EFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.
+                                                Requires SecureBoot.
+                                              properties:
+                                                configMap:
+                                                  description: ConfigMap references
+                                                    a ConfigMap in the namespace of
+                                                    the VirtualMachineInstance.
+                                                  properties:
+                                                    name:
+                                                      default: ""
+                                                      description: |-
+                                                        Name of the referent.
+                                                        This field is effectively required, but due to backwards compatibility is
+                                                        allowed to be empty. Instances of this type with an empty value here are
+                                                        almost certainly wrong.
+                                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                      type: string
+                                                  type: object
+                                                  x-kubernetes-map-type: atomic
+                                                secret:
+                                                  description: Secret references a
+                                                    k8s Secret in the namespace of
+                                                    the VirtualMachineInstance.
+                                                  properties:
+                                                    name:
+                                                      default: ""
+                                                      description: |-
+                                                        Name of the referent.
+                                                        This field is effectively required, but due to backwards compatibility is
+                                                        allowed to be empty. Instances of this type with an empty value here are
+                                                        almost certainly wrong.
+                                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                      type: string
+                                                  type: object
+                                                  x-kubernetes-map-type: atomic
+                                              type: object
+                                            varsTemplate:
+                                              description: |-
+                                                VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,
+                                                used in place of the stock template when the EFI variable store is initialized.
+                                                A persistent EFI variable store is initialized again whenever the template changes.
+                                              properties:
+                                                configMap:
+                                                  description: ConfigMap references
+                                                    a ConfigMap in the namespace of
+                                                    the VirtualMachineInstance.
+                                                  properties:
+                                                    name:
+                                                      default: ""
+                                                      description: |-
+                                                        Name of the referent.
+                                                        This field is effectively required, but due to backwards compatibility is
+                                                        allowed to be empty. Instances of this type with an empty value here are
+                                                        almost certainly wrong.
+                                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                      type: string
+                                                  type: object
+                                                  x-kubernetes-map-type: atomic
+                                                secret:
+                                                  description: Secret references a
+                                                    k8s Secret in the namespace of
+                                                    the VirtualMachineInstance.
+                                                  properties:
+                                                    name:
+                                                      default: ""
+                                                      description: |-
+                                                        Name of the referent.
+                                                        This field is effectively required, but due to backwards compatibility is
+                                                        allowed to be empty. Instances of this type with an empty value here are
+                                                        almost certainly wrong.
+                                                        More info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names
+                                                      type: string
+                                                  type: object
+                                                  x-kubernetes-map-type: atomic
+                                              type: object
                                           type: object
                                       type: object
                                     kernelBoot:
@@ -27571,6 +31591,67 @@ var CRDsValidation map[string]string = map[string]string{
                                 - "LiveMigrateIfPossible": the same as "LiveMigrate" but only if the VirtualMachine is Live-Migratable, otherwise it will behave as "None".
                                 - "External": the VirtualMachineInstance will be protected by a PDB and 'vmi.Status.EvacuationNodeName' will be set on eviction. This is mainly useful for cluster-api-provider-kubevirt (capk) which needs a way for VMI's to be blocked from eviction, yet signal capk that eviction has been called on the VMI so the capk controller can handle tearing the VMI down. Details can be found in the commit description https://github.com/kubevirt/kubevirt/commit/c1d77face705c8b126696bac9a3ee3825f27f1fa.
                               type: string
+                            freezeHooks:
+                              description: |-
+                                FreezeHooks configure commands which are executed in the guest around a filesystem freeze
+                                and allow limiting the freeze to a set of mountpoints.
+                              properties:
+                                mountpoints:
+                                  description: |-
+                                    Mountpoints limits the freeze to the listed guest mountpoints.
+                                    All guest filesystems are frozen if empty.
+                                  items:
+                                    type: string
+                                  type: array
+                                  x-kubernetes-list-type: atomic
+                                postThaw:
+                                  description: PostThaw is executed in the guest after
+                                    the filesystems were thawed.
+                                  properties:
+                                    args:
+                                      description: Args are passed to the command.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    command:
+                                      description: Command is the path of the executable
+                                        in the guest.
+                                      type: string
+                                    timeoutSeconds:
+                                      description: |-
+                                        TimeoutSeconds is the time the command may run before it is considered failed.
+                                        Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - command
+                                  type: object
+                                preFreeze:
+                                  description: |-
+                                    PreFreeze is executed in the guest before the filesystems are frozen.
+                                    The filesystems are not frozen if the command fails or exits with a non-zero code.
+                                  properties:
+                                    args:
+                                      description: Args are passed to the command.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    command:
+                                      description: Command is the path of the executable
+                                        in the guest.
+                                      type: string
+                                    timeoutSeconds:
+                                      description: |-
+                                        TimeoutSeconds is the time the command may run before it is considered failed.
+                                        Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+                                      format: int32
+                                      type: integer
+                                  required:
+                                  - command
+                                  type: object
+                              type: object
                             hostname:
                               description: |-
                                 Specifies the hostname of the vmi
@@ -27607,6 +31688,28 @@ var CRDsValidation map[string]string = map[string]string{
                                     Defaults to 3. Minimum value is 1.
                                   format: int32
                                   type: integer
+                                grpc:
+                                  description: |-
+                                    GRPC specifies an action involving a GRPC port.
+                                    The health check is performed inside the guest by running grpc_health_probe through the guest
+                                    agent, so both have to be installed in the guest.
+                                  properties:
+                                    port:
+                                      description: Port number of the gRPC service.
+                                        Number must be in the range 1 to 65535.
+                                      format: int32
+                                      type: integer
+                                    service:
+                                      default: ""
+                                      description: |-
+                                        Service is the name of the service to place in the gRPC HealthCheckRequest
+                                        (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                                        If this is not specified, the default behavior is defined by gRPC.
+                                      type: string
+                                  required:
+                                  - port
+                                  type: object
                                 guestAgentPing:
                                   description: GuestAgentPing contacts the qemu-guest-agent
                                     for availability checks.
@@ -27770,6 +31873,23 @@ var CRDsValidation map[string]string = map[string]string{
                                 Selector which must match a node's labels for the vmi to be scheduled on that node.
                                 More info: https://kubernetes.io/docs/concepts/configuration/assign-pod-node/
                               type: object
+                            panicPolicy:
+                              description: |-
+                                PanicPolicy exposes a panic device to the guest and defines how a guest panic is handled.
+                                The panicked guest is preserved until an optional memory dump was taken and is then restarted or paused.
+                              properties:
+                                action:
+                                  description: |-
+                                    Action is taken once the panic was recorded and the memory dump finished.
+                                    Defaults to Restart.
+                                  type: string
+                                memoryDumpClaimName:
+                                  description: |-
+                                    MemoryDumpClaimName is the name of the pvc the guest memory is dumped to on a panic.
+                                    The memory dump is only taken for VMIs owned by a VirtualMachine.
+                                    It requires the HotplugVolumes feature gate and is skipped if the pvc can't hold the dump.
+                                  type: string
+                              type: object
                             priorityClassName:
                               description: |-
                                 If specified, indicates the pod's priority.
@@ -27807,6 +31927,28 @@ var CRDsValidation map[string]string = map[string]string{
                                     Defaults to 3. Minimum value is 1.
                                   format: int32
                                   type: integer
+                                grpc:
+                                  description: |-
+                                    GRPC specifies an action involving a GRPC port.
+                                    The health check is performed inside the guest by running grpc_health_probe through the guest
+                                    agent, so both have to be installed in the guest.
+                                  properties:
+                                    port:
+                                      description: Port number of the gRPC service.
+                                        Number must be in the range 1 to 65535.
+                                      format: int32
+                                      type: integer
+                                    service:
+                                      default: ""
+                                      description: |-
+                                        Service is the name of the service to place in the gRPC HealthCheckRequest
+                                        (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                                        If this is not specified, the default behavior is defined by gRPC.
+                                      type: string
+                                  required:
+                                  - port
+                                  type: object
                                 guestAgentPing:
                                   description: GuestAgentPing contacts the qemu-guest-agent
                                     for availability checks.
@@ -27921,6 +32063,167 @@ var CRDsValidation map[string]string = map[string]string{
                               description: StartStrategy can be set to "Paused" if
                                 Virtual Machine should be started in paused state.
                               type: string
+                            startupProbe:
+                              description: |-
+                                StartupProbe indicates that the VirtualMachineInstance has successfully started.
+                                If specified, no other probes are executed until this completes successfully.
+                                If this probe fails, the VirtualMachineInstance will be stopped, just as if the livenessProbe failed.
+                                This can be used to allow slow booting guests a long initialization time without
+                                having to delay the liveness probe of the whole lifetime of the VirtualMachineInstance.
+                                Cannot be updated.
+                                More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                              properties:
+                                exec:
+                                  description: |-
+                                    One and only one of the following should be specified.
+                                    Exec specifies the action to take, it will be executed on the guest through the qemu-guest-agent.
+                                    If the guest agent is not available, this probe will fail.
+                                  properties:
+                                    command:
+                                      description: |-
+                                        Command is the command line to execute inside the container, the working directory for the
+                                        command  is root ('/') in the container's filesystem. The command is simply exec'd, it is
+                                        not run inside a shell, so traditional shell instructions ('|', etc) won't work. To use
+                                        a shell, you need to explicitly call out to that shell.
+                                        Exit status of 0 is treated as live/healthy and non-zero is unhealthy.
+                                      items:
+                                        type: string
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                  type: object
+                                failureThreshold:
+                                  description: |-
+                                    Minimum consecutive failures for the probe to be considered failed after having succeeded.
+                                    Defaults to 3. Minimum value is 1.
+                                  format: int32
+                                  type: integer
+                                grpc:
+                                  description: |-
+                                    GRPC specifies an action involving a GRPC port.
+                                    The health check is performed inside the guest by running grpc_health_probe through the guest
+                                    agent, so both have to be installed in the guest.
+                                  properties:
+                                    port:
+                                      description: Port number of the gRPC service.
+                                        Number must be in the range 1 to 65535.
+                                      format: int32
+                                      type: integer
+                                    service:
+                                      default: ""
+                                      description: |-
+                                        Service is the name of the service to place in the gRPC HealthCheckRequest
+                                        (see https://github.com/grpc/grpc/blob/master/doc/health-checking.md).
+
+                                        If this is not specified, the default behavior is defined by gRPC.
+                                      type: string
+                                  required:
+                                  - port
+                                  type: object
+                                guestAgentPing:
+                                  description: GuestAgentPing contacts the qemu-guest-agent
+                                    for availability checks.
+                                  type: object
+                                httpGet:
+                                  description: HTTPGet specifies the http request
+                                    to perform.
+                                  properties:
+                                    host:
+                                      description: |-
+                                        Host name to connect to, defaults to the pod IP. You probably want to set
+                                        "Host" in httpHeaders instead.
+                                      type: string
+                                    httpHeaders:
+                                      description: Custom headers to set in the request.
+                                        HTTP allows repeated headers.
+                                      items:
+                                        description: HTTPHeader describes a custom
+                                          header to be used in HTTP probes
+                                        properties:
+                                          name:
+                                            description: |-
+                                              The header field name.
+                                              This will be canonicalized upon output, so case-variant names will be understood as the same header.
+                                            type: string
+                                          value:
+                                            description: The header field value
+                                            type: string
+                                        required:
+                                        - name
+                                        - value
+                                        type: object
+                                      type: array
+                                      x-kubernetes-list-type: atomic
+                                    path:
+                                      description: Path to access on the HTTP server.
+                                      type: string
+                                    port:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        Name or number of the port to access on the container.
+                                        Number must be in the range 1 to 65535.
+                                        Name must be an IANA_SVC_NAME.
+                                      x-kubernetes-int-or-string: true
+                                    scheme:
+                                      description: |-
+                                        Scheme to use for connecting to the host.
+                                        Defaults to HTTP.
+                                      type: string
+                                  required:
+                                  - port
+                                  type: object
+                                initialDelaySeconds:
+                                  description: |-
+                                    Number of seconds after the VirtualMachineInstance has started before liveness probes are initiated.
+                                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                                  format: int32
+                                  type: integer
+                                periodSeconds:
+                                  description: |-
+                                    How often (in seconds) to perform the probe.
+                                    Default to 10 seconds. Minimum value is 1.
+                                  format: int32
+                                  type: integer
+                                successThreshold:
+                                  description: |-
+                                    Minimum consecutive successes for the probe to be considered successful after having failed.
+                                    Defaults to 1. Must be 1 for liveness. Minimum value is 1.
+                                  format: int32
+                                  type: integer
+                                tcpSocket:
+                                  description: |-
+                                    TCPSocket specifies an action involving a TCP port.
+                                    TCP hooks not yet supported
+                                  properties:
+                                    host:
+                                      description: 'Optional: Host name to connect
+                                        to, defaults to the pod IP.'
+                                      type: string
+                                    port:
+                                      anyOf:
+                                      - type: integer
+                                      - type: string
+                                      description: |-
+                                        Number or name of the port to access on the container.
+                                        Number must be in the range 1 to 65535.
+                                        Name must be an IANA_SVC_NAME.
+                                      x-kubernetes-int-or-string: true
+                                  required:
+                                  - port
+                                  type: object
+                                timeoutSeconds:
+                                  description: |-
+                                    Number of seconds after which the probe times out.
+                                    For exec probes the timeout fails the probe but does not terminate the command running on the guest.
+                                    This means a blocking command can result in an increasing load on the guest.
+                                    A small buffer will be added to the resulting workload exec probe to compensate for delays
+                                    caused by the qemu guest exec mechanism.
+                                    Defaults to 1 second. Minimum value is 1.
+                                    More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+                                  format: int32
+                                  type: integer
+                              type: object
                             subdomain:
                               description: |-
                                 If specified, the fully qualified vmi hostname will be "<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>".
@@ -28515,6 +32818,10 @@ var CRDsValidation map[string]string = map[string]string{
                                           claimName is the name of a PersistentVolumeClaim in the same namespace as the pod using this volume.
                                           More info: https://kubernetes.io/docs/concepts/storage/persistent-volumes#persistentvolumeclaims
                                         type: string
+                                      format:
+                                        description: Format of the memory dump, defaults
+                                          to raw
+                                        type: string
                                       hotpluggable:
                                         description: Hotpluggable indicates whether
                                           the volume can be hotplugged and hotunplugged.
@@ -28737,6 +33044,9 @@ var CRDsValidation map[string]string = map[string]string{
                           description: FileName represents the name of the output
                             file
                           type: string
+                        format:
+                          description: Format of the memory dump, defaults to raw
+                          type: string
                         message:
                           description: Message is a detailed message about failure
                             of the memory dump
@@ -28968,6 +33278,51 @@ var CRDsValidation map[string]string = map[string]string{
                                       IO specifies which QEMU disk IO mode should be used.
                                       Supported values are: native, default, threads.
                                     type: string
+                                  ioTune:
+                                    description: |-
+                                      IOTune limits the throughput and the IO operations of the disk.
+                                      It is applied to a running VM without a restart when live updates are enabled.
+                                    properties:
+                                      readBytesPerSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: ReadBytesPerSec limits the read
+                                          throughput in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      readIOPS:
+                                        description: ReadIOPS limits the read IO operations
+                                          per second.
+                                        format: int64
+                                        type: integer
+                                      totalBytesPerSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: TotalBytesPerSec limits the combined
+                                          read and write throughput in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      totalIOPS:
+                                        description: TotalIOPS limits the combined
+                                          read and write IO operations per second.
+                                        format: int64
+                                        type: integer
+                                      writeBytesPerSec:
+                                        anyOf:
+                                        - type: integer
+                                        - type: string
+                                        description: WriteBytesPerSec limits the write
+                                          throughput in bytes per second.
+                                        pattern: ^(\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))(([KMGTPE]i)|[numkMGTPE]|([eE](\+|-)?(([0-9]+(\.[0-9]*)?)|(\.[0-9]+))))?$
+                                        x-kubernetes-int-or-string: true
+                                      writeIOPS:
+                                        description: WriteIOPS limits the write IO
+                                          operations per second.
+                                        format: int64
+                                        type: integer
+                                    type: object
                                   lun:
                                     description: Attach a volume as a LUN to the vmi.
                                     properties:
@@ -109,7 +109,18 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 					"persistentvolumeclaims",
 				},
 				Verbs: []string{
-					"get",
+					"get", "list", "delete", "patch",
+				},
+			},
+			{
+				APIGroups: []string{
+					"",
+				},
+				Resources: []string{
+					"events",
+				},
+				Verbs: []string{
+					"create", "patch",
 				},
 			},
 			{
@@ -186,6 +197,8 @@ func newApiServerClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"kubevirts",
+					"kubevirtnamespaceconfigs",
+					"virtualmachinequotas",
 				},
 				Verbs: []string{
 					"get",
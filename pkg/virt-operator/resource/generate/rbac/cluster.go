@@ -43,6 +43,8 @@ const (
 	apiGuestFs            = "guestfs"
 	apiExpandVmSpec       = "expand-vm-spec"
 	apiKubevirts          = "kubevirts"
+	apiNamespaceConfigs   = "kubevirtnamespaceconfigs"
+	apiVMQuotas           = "virtualmachinequotas"
 	apiVM                 = "virtualmachines"
 	apiVMInstances        = "virtualmachineinstances"
 	apiVMIPresets         = "virtualmachineinstancepresets"
@@ -65,20 +67,31 @@ const (
 	apiVMMigrate      = "virtualmachines/migrate"
 	apiVMMemoryDump   = "virtualmachines/memorydump"
 
+	apiVMPersistentState       = "virtualmachines/persistentstate"
+	apiVMResetPersistentState  = "virtualmachines/resetpersistentstate"
+	apiVMImportPersistentState = "virtualmachines/importpersistentstate"
+
 	apiVMInstancesConsole                   = "virtualmachineinstances/console"
 	apiVMInstancesVNC                       = "virtualmachineinstances/vnc"
+	apiVMInstancesSPICE                     = "virtualmachineinstances/spice"
 	apiVMInstancesVNCScreenshot             = "virtualmachineinstances/vnc/screenshot"
+	apiVMInstancesVNCThumbnails             = "virtualmachineinstances/vnc/thumbnails"
+	apiVMInstancesVNCToken                  = "virtualmachineinstances/vnctoken"
 	apiVMInstancesPortForward               = "virtualmachineinstances/portforward"
 	apiVMInstancesPause                     = "virtualmachineinstances/pause"
 	apiVMInstancesUnpause                   = "virtualmachineinstances/unpause"
 	apiVMInstancesAddVolume                 = "virtualmachineinstances/addvolume"
 	apiVMInstancesRemoveVolume              = "virtualmachineinstances/removevolume"
+	apiVMInstancesSetLinkState              = "virtualmachineinstances/setlinkstate"
 	apiVMInstancesFreeze                    = "virtualmachineinstances/freeze"
 	apiVMInstancesUnfreeze                  = "virtualmachineinstances/unfreeze"
 	apiVMInstancesSoftReboot                = "virtualmachineinstances/softreboot"
 	apiVMInstancesReset                     = "virtualmachineinstances/reset"
 	apiVMInstancesGuestOSInfo               = "virtualmachineinstances/guestosinfo"
 	apiVMInstancesFileSysList               = "virtualmachineinstances/filesystemlist"
+	apiVMInstancesGuestFile                 = "virtualmachineinstances/guestfile"
+	apiVMInstancesEFIVars                   = "virtualmachineinstances/efivars"
+	apiVMInstancesGuestExec                 = "virtualmachineinstances/guestexec"
 	apiVMInstancesUserList                  = "virtualmachineinstances/userlist"
 	apiVMInstancesSEVFetchCertChain         = "virtualmachineinstances/sev/fetchcertchain"
 	apiVMInstancesSEVQueryLaunchMeasurement = "virtualmachineinstances/sev/querylaunchmeasurement"
@@ -195,14 +208,19 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMInstancesConsole,
 					apiVMInstancesVNC,
+					apiVMInstancesSPICE,
 					apiVMInstancesVNCScreenshot,
+					apiVMInstancesVNCThumbnails,
 					apiVMInstancesPortForward,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
+					apiVMInstancesGuestFile,
+					apiVMInstancesEFIVars,
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesFreeze,
 				},
 				Verbs: []string{
 					"get",
@@ -217,17 +235,31 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesSetLinkState,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesVNCToken,
+					apiVMInstancesGuestFile,
 				},
 				Verbs: []string{
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMInstancesGuestExec,
+				},
+				Verbs: []string{
+					"exec",
+				},
+			},
 			{
 				APIGroups: []string{
 					virtv1.SubresourceGroupName,
@@ -235,6 +267,7 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMExpandSpec,
 					apiVMPortForward,
+					apiVMPersistentState,
 				},
 				Verbs: []string{
 					"get",
@@ -251,6 +284,8 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMResetPersistentState,
+					apiVMImportPersistentState,
 				},
 				Verbs: []string{
 					"update",
@@ -363,6 +398,28 @@ func newAdminClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					GroupName,
+				},
+				Resources: []string{
+					apiNamespaceConfigs,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					GroupName,
+				},
+				Resources: []string{
+					apiVMQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -388,14 +445,19 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMInstancesConsole,
 					apiVMInstancesVNC,
+					apiVMInstancesSPICE,
 					apiVMInstancesVNCScreenshot,
+					apiVMInstancesVNCThumbnails,
 					apiVMInstancesPortForward,
 					apiVMInstancesGuestOSInfo,
 					apiVMInstancesFileSysList,
+					apiVMInstancesGuestFile,
+					apiVMInstancesEFIVars,
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
 					apiVMInstancesUSBRedir,
+					apiVMInstancesFreeze,
 				},
 				Verbs: []string{
 					"get",
@@ -410,17 +472,31 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUnpause,
 					apiVMInstancesAddVolume,
 					apiVMInstancesRemoveVolume,
+					apiVMInstancesSetLinkState,
 					apiVMInstancesFreeze,
 					apiVMInstancesUnfreeze,
 					apiVMInstancesSoftReboot,
 					apiVMInstancesReset,
 					apiVMInstancesSEVSetupSession,
 					apiVMInstancesSEVInjectLaunchSecret,
+					apiVMInstancesVNCToken,
+					apiVMInstancesGuestFile,
 				},
 				Verbs: []string{
 					"update",
 				},
 			},
+			{
+				APIGroups: []string{
+					virtv1.SubresourceGroupName,
+				},
+				Resources: []string{
+					apiVMInstancesGuestExec,
+				},
+				Verbs: []string{
+					"exec",
+				},
+			},
 			{
 				APIGroups: []string{
 					virtv1.SubresourceGroupName,
@@ -428,6 +504,7 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 				Resources: []string{
 					apiVMExpandSpec,
 					apiVMPortForward,
+					apiVMPersistentState,
 				},
 				Verbs: []string{
 					"get",
@@ -444,6 +521,8 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					apiVMAddVolume,
 					apiVMRemoveVolume,
 					apiVMMemoryDump,
+					apiVMResetPersistentState,
+					apiVMImportPersistentState,
 				},
 				Verbs: []string{
 					"update",
@@ -567,6 +646,28 @@ func newEditClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					GroupName,
+				},
+				Resources: []string{
+					apiNamespaceConfigs,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					GroupName,
+				},
+				Resources: []string{
+					apiVMQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -646,6 +747,7 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					apiVMInstancesUserList,
 					apiVMInstancesSEVFetchCertChain,
 					apiVMInstancesSEVQueryLaunchMeasurement,
+					apiVMInstancesFreeze,
 				},
 				Verbs: []string{
 					"get",
@@ -748,6 +850,28 @@ func newViewClusterRole() *rbacv1.ClusterRole {
 					"get", "list", "watch",
 				},
 			},
+			{
+				APIGroups: []string{
+					GroupName,
+				},
+				Resources: []string{
+					apiNamespaceConfigs,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
+			{
+				APIGroups: []string{
+					GroupName,
+				},
+				Resources: []string{
+					apiVMQuotas,
+				},
+				Verbs: []string{
+					"get", "list", "watch",
+				},
+			},
 		},
 	}
 }
@@ -138,6 +138,7 @@ func newHandlerClusterRole() *rbacv1.ClusterRole {
 				},
 				Resources: []string{
 					"kubevirts",
+					"kubevirtnamespaceconfigs",
 				},
 				Verbs: []string{
 					"get",
@@ -31,9 +31,11 @@ import (
 )
 
 const (
-	GroupNameSecurity = "security.openshift.io"
-	GroupNameRoute    = "route.openshift.io"
-	serviceAccountFmt = "%s:%s:%s"
+	GroupNameSecurity    = "security.openshift.io"
+	GroupNameRoute       = "route.openshift.io"
+	GroupNameCertManager = "cert-manager.io"
+	GroupNameNetworking  = "networking.k8s.io"
+	serviceAccountFmt    = "%s:%s:%s"
 )
 
 // Used for manifest generation only, not by the operator itself
@@ -533,6 +535,39 @@ func NewOperatorRole(namespace string) *rbacv1.Role {
 					"create",
 				},
 			},
+			{
+				APIGroups: []string{
+					GroupNameCertManager,
+				},
+				Resources: []string{
+					"certificates",
+				},
+				Verbs: []string{
+					"create",
+					"get",
+					"list",
+					"watch",
+					"update",
+					"delete",
+					"deletecollection",
+				},
+			},
+			{
+				APIGroups: []string{
+					GroupNameNetworking,
+				},
+				Resources: []string{
+					"networkpolicies",
+				},
+				Verbs: []string{
+					"create",
+					"get",
+					"list",
+					"watch",
+					"patch",
+					"delete",
+				},
+			},
 			{
 				APIGroups: []string{
 					"coordination.k8s.io",
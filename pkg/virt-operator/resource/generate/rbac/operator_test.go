@@ -72,6 +72,24 @@ var _ = Describe("RBAC", func() {
 			Expect(clusterRole).ToNot(BeNil())
 			expectExactRuleDoesntExists(clusterRole.Rules, "", "secrets", "get", "list", "watch")
 		})
+
+		It("can manage cert-manager certificates", func() {
+			role := getFirstItemOfType(forOperator, reflect.TypeOf(&rbacv1.Role{})).(*rbacv1.Role)
+			Expect(role.Rules).To(ContainElement(rbacv1.PolicyRule{
+				APIGroups: []string{GroupNameCertManager},
+				Resources: []string{"certificates"},
+				Verbs:     []string{"create", "get", "list", "watch", "update", "delete", "deletecollection"},
+			}))
+		})
+
+		It("can manage NetworkPolicies", func() {
+			role := getFirstItemOfType(forOperator, reflect.TypeOf(&rbacv1.Role{})).(*rbacv1.Role)
+			Expect(role.Rules).To(ContainElement(rbacv1.PolicyRule{
+				APIGroups: []string{GroupNameNetworking},
+				Resources: []string{"networkpolicies"},
+				Verbs:     []string{"create", "get", "list", "watch", "patch", "delete"},
+			}))
+		})
 	})
 
 	Context("GetKubevirtComponentsServiceAccounts", func() {
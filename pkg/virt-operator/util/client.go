@@ -21,6 +21,7 @@ package util
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
@@ -46,6 +47,14 @@ const (
 	ConditionReasonDeploying                = "DeploymentInProgress"
 	ConditionReasonUpdating                 = "UpdateInProgress"
 	ConditionReasonDeleting                 = "DeletionInProgress"
+	ConditionReasonUpdateDryRun             = "UpdateDryRun"
+	ConditionReasonRollingBack              = "RollbackInProgress"
+	ConditionReasonCertificatesValid        = "CertificatesValid"
+	ConditionReasonCertificatesExpiring     = "CertificatesExpiring"
+	ConditionReasonCertificatesMissing      = "CertificatesMissing"
+	ConditionReasonCertificatesInvalid      = "CertificatesInvalid"
+	ConditionReasonConfigurationValid       = "ConfigurationValid"
+	ConditionReasonConfigurationWarnings    = "ConfigurationWarnings"
 )
 
 func UpdateConditionsDeploying(kv *virtv1.KubeVirt) {
@@ -71,6 +80,32 @@ func UpdateConditionsUpdating(kv *virtv1.KubeVirt) {
 	updateCondition(kv, virtv1.KubeVirtConditionDegraded, k8sv1.ConditionTrue, ConditionReasonUpdating, msg)
 }
 
+func UpdateConditionsRollingBack(kv *virtv1.KubeVirt) {
+	removeCondition(kv, virtv1.KubeVirtConditionCreated)
+	removeCondition(kv, virtv1.KubeVirtConditionSynchronized)
+	msg := fmt.Sprintf("Rolling back from version %s with registry %s to previous version %s using registry %s",
+		kv.Status.ObservedKubeVirtVersion,
+		kv.Status.ObservedKubeVirtRegistry,
+		kv.Status.TargetKubeVirtVersion,
+		kv.Status.TargetKubeVirtRegistry)
+	updateCondition(kv, virtv1.KubeVirtConditionAvailable, k8sv1.ConditionTrue, ConditionReasonRollingBack, msg)
+	updateCondition(kv, virtv1.KubeVirtConditionProgressing, k8sv1.ConditionTrue, ConditionReasonRollingBack, msg)
+	updateCondition(kv, virtv1.KubeVirtConditionDegraded, k8sv1.ConditionTrue, ConditionReasonRollingBack, msg)
+}
+
+func UpdateConditionsUpdateDryRun(kv *virtv1.KubeVirt) {
+	msg := fmt.Sprintf("Update from version %s with registry %s to version %s using registry %s is held by the %s annotation, the planned changes are stored in configmap %s",
+		kv.Status.ObservedKubeVirtVersion,
+		kv.Status.ObservedKubeVirtRegistry,
+		kv.Status.TargetKubeVirtVersion,
+		kv.Status.TargetKubeVirtRegistry,
+		virtv1.KubeVirtUpgradeDryRunAnnotation,
+		UpgradePlanConfigMapName)
+	updateCondition(kv, virtv1.KubeVirtConditionAvailable, k8sv1.ConditionTrue, ConditionReasonUpdateDryRun, msg)
+	updateCondition(kv, virtv1.KubeVirtConditionProgressing, k8sv1.ConditionFalse, ConditionReasonUpdateDryRun, msg)
+	updateCondition(kv, virtv1.KubeVirtConditionDegraded, k8sv1.ConditionFalse, ConditionReasonUpdateDryRun, msg)
+}
+
 func UpdateConditionsCreated(kv *virtv1.KubeVirt) {
 	updateCondition(kv, virtv1.KubeVirtConditionCreated, k8sv1.ConditionTrue, ConditionReasonDeploymentCreated, "All resources were created.")
 }
@@ -108,6 +143,45 @@ func UpdateConditionsDeletionFailed(kv *virtv1.KubeVirt, err error) {
 	updateCondition(kv, virtv1.KubeVirtConditionSynchronized, k8sv1.ConditionFalse, ConditionReasonDeletionFailedError, fmt.Sprintf("An error occurred during deletion: %v", err))
 }
 
+func UpdateConditionsCertificatesValid(kv *virtv1.KubeVirt, secretName string, notAfter time.Time) {
+	msg := fmt.Sprintf("All certificates are valid, the first one to expire is in secret %s at %s",
+		secretName, notAfter.UTC().Format(time.RFC3339))
+	updateCondition(kv, virtv1.KubeVirtConditionCertificatesReady, k8sv1.ConditionTrue, ConditionReasonCertificatesValid, msg)
+}
+
+func UpdateConditionsCertificatesExpiring(kv *virtv1.KubeVirt, secretName string, notAfter time.Time) {
+	msg := fmt.Sprintf("The certificate in secret %s expires at %s and was not renewed yet",
+		secretName, notAfter.UTC().Format(time.RFC3339))
+	updateCondition(kv, virtv1.KubeVirtConditionCertificatesReady, k8sv1.ConditionFalse, ConditionReasonCertificatesExpiring, msg)
+}
+
+func UpdateConditionsCertificatesMissing(kv *virtv1.KubeVirt, err error) {
+	updateCondition(kv, virtv1.KubeVirtConditionCertificatesReady, k8sv1.ConditionFalse, ConditionReasonCertificatesMissing, err.Error())
+}
+
+func UpdateConditionsCertificatesInvalid(kv *virtv1.KubeVirt, err error) {
+	updateCondition(kv, virtv1.KubeVirtConditionCertificatesReady, k8sv1.ConditionFalse, ConditionReasonCertificatesInvalid, err.Error())
+}
+
+// RemoveConditionCertificatesReady drops the condition once virt-operator issues the certificates itself again
+func RemoveConditionCertificatesReady(kv *virtv1.KubeVirt) {
+	removeCondition(kv, virtv1.KubeVirtConditionCertificatesReady)
+}
+
+func UpdateConditionsConfigurationValid(kv *virtv1.KubeVirt) {
+	updateCondition(kv, virtv1.KubeVirtConditionConfigurationValid, k8sv1.ConditionTrue, ConditionReasonConfigurationValid, "The configuration matches the cluster")
+}
+
+func UpdateConditionsConfigurationWarnings(kv *virtv1.KubeVirt, warnings []string) {
+	updateCondition(kv, virtv1.KubeVirtConditionConfigurationValid, k8sv1.ConditionFalse, ConditionReasonConfigurationWarnings, strings.Join(warnings, "; "))
+}
+
+// HasConfigurationWarnings returns true if the last validation of the configuration reported warnings
+func HasConfigurationWarnings(kv *virtv1.KubeVirt) bool {
+	condition, isNew := getCondition(kv, virtv1.KubeVirtConditionConfigurationValid)
+	return !isNew && condition.Status == k8sv1.ConditionFalse
+}
+
 func updateCondition(kv *virtv1.KubeVirt, conditionType virtv1.KubeVirtConditionType, status k8sv1.ConditionStatus, reason string, message string) {
 	condition, isNew := getCondition(kv, conditionType)
 	condition.Status = status
@@ -226,6 +226,26 @@ func GetTargetConfigFromKVWithEnvVarManager(kv *v1.KubeVirt, envVarManager EnvVa
 		envVarManager)
 }
 
+// GetRollbackConfigFromKV returns the deployment config spec.rollbackTo refers to. That is the previous deployment
+// while the rollback is in progress, and the observed deployment once it was rolled out.
+func GetRollbackConfigFromKV(kv *v1.KubeVirt) (*KubeVirtDeploymentConfig, error) {
+	var deploymentConfig string
+	switch {
+	case kv.Status.PreviousDeployment != nil && kv.Status.PreviousDeployment.DeploymentID == kv.Spec.RollbackTo:
+		deploymentConfig = kv.Status.PreviousDeployment.DeploymentConfig
+	case kv.Status.ObservedDeploymentID == kv.Spec.RollbackTo:
+		deploymentConfig = kv.Status.ObservedDeploymentConfig
+	default:
+		return nil, fmt.Errorf("unable to roll back to unknown deployment %s", kv.Spec.RollbackTo)
+	}
+
+	config := &KubeVirtDeploymentConfig{}
+	if err := json.Unmarshal([]byte(deploymentConfig), config); err != nil {
+		return nil, fmt.Errorf("unable to parse deployment config of deployment %s: %v", kv.Spec.RollbackTo, err)
+	}
+	return config, nil
+}
+
 // retrieve imagePrefix from an existing deployment config (which is stored as JSON)
 func getImagePrefixFromDeploymentConfig(deploymentConfig string) (string, bool, error) {
 	var obj interface{}
@@ -250,6 +270,10 @@ func getKVMapFromSpec(spec v1.KubeVirtSpec) map[string]string {
 			// these are handled in the root deployment config already
 			continue
 		}
+		if name == "RollbackTo" {
+			// selects a stored deployment config instead of being part of one
+			continue
+		}
 		if name == "ImagePullSecrets" {
 			value, err := json.Marshal(v.Field(i).Interface())
 			if err != nil {
@@ -541,6 +565,15 @@ func (c *KubeVirtDeploymentConfig) GetSidecarShimVersion() string {
 	return c.KubeVirtVersion
 }
 
+// GetGsVersion returns the digest of the libguestfs-tools image if it is known, libguestfs-tools is optional
+// and its digest can be missing even if the other images are referenced by their digests
+func (c *KubeVirtDeploymentConfig) GetGsVersion() string {
+	if c.UseShasums() && c.GsSha != "" {
+		return c.GsSha
+	}
+	return c.KubeVirtVersion
+}
+
 func (c *KubeVirtDeploymentConfig) GetKubeVirtVersion() string {
 	return c.KubeVirtVersion
 }
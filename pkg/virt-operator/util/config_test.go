@@ -28,6 +28,8 @@ import (
 
 	k8sv1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/util/rand"
+
+	v1 "kubevirt.io/api/core/v1"
 )
 
 var _ = Describe("Operator Config", func() {
@@ -260,6 +262,52 @@ var _ = Describe("Operator Config", func() {
 		})
 	})
 
+	Describe("rollback config", func() {
+		var kv *v1.KubeVirt
+
+		BeforeEach(func() {
+			previous := &KubeVirtDeploymentConfig{ID: "previous", KubeVirtVersion: "v1.0.0"}
+			previousJson, err := previous.GetJson()
+			Expect(err).ToNot(HaveOccurred())
+			observed := &KubeVirtDeploymentConfig{ID: "observed", KubeVirtVersion: "v1.1.0"}
+			observedJson, err := observed.GetJson()
+			Expect(err).ToNot(HaveOccurred())
+
+			kv = &v1.KubeVirt{
+				Status: v1.KubeVirtStatus{
+					ObservedDeploymentID:     observed.ID,
+					ObservedDeploymentConfig: observedJson,
+					PreviousDeployment: &v1.KubeVirtPreviousDeployment{
+						DeploymentID:     previous.ID,
+						DeploymentConfig: previousJson,
+					},
+				},
+			}
+		})
+
+		DescribeTable("should be read from the deployment spec.rollbackTo refers to", func(rollbackTo, expectedVersion string) {
+			kv.Spec.RollbackTo = rollbackTo
+			config, err := GetRollbackConfigFromKV(kv)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(config.GetDeploymentID()).To(Equal(rollbackTo))
+			Expect(config.GetKubeVirtVersion()).To(Equal(expectedVersion))
+		},
+			Entry("previous deployment", "previous", "v1.0.0"),
+			Entry("observed deployment", "observed", "v1.1.0"),
+		)
+
+		It("should fail on an unknown deployment", func() {
+			kv.Spec.RollbackTo = "unknown"
+			_, err := GetRollbackConfigFromKV(kv)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("should not be part of the deployment ID", func() {
+			kv.Spec.RollbackTo = "previous"
+			Expect(getKVMapFromSpec(kv.Spec)).ToNot(HaveKey("RollbackTo"))
+		})
+	})
+
 	Describe("creating config ID", func() {
 
 		var idMissing, idEmpty, idFilled string
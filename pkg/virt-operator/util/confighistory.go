@@ -0,0 +1,123 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package util
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sort"
+
+	appsv1 "k8s.io/api/apps/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "kubevirt.io/api/core/v1"
+)
+
+const (
+	// ConfigurationRevisionLabel marks the ControllerRevisions which hold the history of spec.configuration
+	ConfigurationRevisionLabel = "kubevirt.io/configuration-revision"
+	// ConfigurationHistoryLimit is the number of configuration revisions virt-operator keeps
+	ConfigurationHistoryLimit = 10
+
+	configurationRevisionPrefix = "kubevirt-configuration-"
+	configurationHashLength     = 10
+)
+
+// ConfigurationRevisionName returns the name of the ControllerRevision which holds the given configuration.
+// Equal configurations always share the same revision.
+func ConfigurationRevisionName(config *v1.KubeVirtConfiguration) (string, error) {
+	configBytes, err := json.Marshal(config)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(configBytes)
+	return configurationRevisionPrefix + hex.EncodeToString(hash[:])[:configurationHashLength], nil
+}
+
+// NewConfigurationRevision returns the ControllerRevision which records the configuration of the KubeVirt CR
+func NewConfigurationRevision(kv *v1.KubeVirt, revision int64) (*appsv1.ControllerRevision, error) {
+	name, err := ConfigurationRevisionName(&kv.Spec.Configuration)
+	if err != nil {
+		return nil, err
+	}
+	configBytes, err := json.Marshal(kv.Spec.Configuration)
+	if err != nil {
+		return nil, err
+	}
+
+	return &appsv1.ControllerRevision{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: kv.Namespace,
+			Labels: map[string]string{
+				ConfigurationRevisionLabel: "",
+				v1.ManagedByLabel:          v1.ManagedByLabelOperatorValue,
+			},
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(kv, v1.KubeVirtGroupVersionKind),
+			},
+		},
+		Data:     runtime.RawExtension{Raw: configBytes},
+		Revision: revision,
+	}, nil
+}
+
+// ConfigurationFromRevision decodes the configuration recorded in a ControllerRevision
+func ConfigurationFromRevision(revision *appsv1.ControllerRevision) (*v1.KubeVirtConfiguration, error) {
+	config := &v1.KubeVirtConfiguration{}
+	if err := json.Unmarshal(revision.Data.Raw, config); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// SortConfigurationRevisions orders the revisions from the oldest to the newest
+func SortConfigurationRevisions(revisions []appsv1.ControllerRevision) {
+	sort.Slice(revisions, func(i, j int) bool {
+		return revisions[i].Revision < revisions[j].Revision
+	})
+}
+
+// ConfigurationChange is a field which differs between two configurations
+type ConfigurationChange struct {
+	// Path is a JSON pointer to the changed field
+	Path string      `json:"path"`
+	From interface{} `json:"from,omitempty"`
+	To   interface{} `json:"to,omitempty"`
+}
+
+// DiffConfigurations lists the fields which were added, changed or removed between two configurations
+func DiffConfigurations(from, to *v1.KubeVirtConfiguration) ([]ConfigurationChange, error) {
+	fromMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(from)
+	if err != nil {
+		return nil, err
+	}
+	toMap, err := runtime.DefaultUnstructuredConverter.ToUnstructured(to)
+	if err != nil {
+		return nil, err
+	}
+	var changes []ConfigurationChange
+	for _, diff := range DiffFields(fromMap, toMap, false) {
+		changes = append(changes, ConfigurationChange{Path: diff.Path, From: diff.From, To: diff.To})
+	}
+	return changes, nil
+}
@@ -0,0 +1,75 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package util
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+)
+
+var _ = Describe("Configuration history", func() {
+	It("should name equal configurations equally", func() {
+		first, err := ConfigurationRevisionName(&v1.KubeVirtConfiguration{VMStateStorageClass: "tpm-state"})
+		Expect(err).ToNot(HaveOccurred())
+		second, err := ConfigurationRevisionName(&v1.KubeVirtConfiguration{VMStateStorageClass: "tpm-state"})
+		Expect(err).ToNot(HaveOccurred())
+		third, err := ConfigurationRevisionName(&v1.KubeVirtConfiguration{VMStateStorageClass: "other"})
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(first).To(HavePrefix("kubevirt-configuration-"))
+		Expect(first).To(Equal(second))
+		Expect(first).ToNot(Equal(third))
+	})
+
+	It("should list added, changed and removed fields", func() {
+		from := &v1.KubeVirtConfiguration{
+			VMStateStorageClass: "tpm-state",
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates:     []string{"Snapshot"},
+				MemoryOvercommit: 100,
+			},
+		}
+		to := &v1.KubeVirtConfiguration{
+			DeveloperConfiguration: &v1.DeveloperConfiguration{
+				FeatureGates:     []string{"HotplugVolumes"},
+				MemoryOvercommit: 150,
+			},
+			EvictionStrategy: pointer.P(v1.EvictionStrategyLiveMigrate),
+		}
+
+		changes, err := DiffConfigurations(from, to)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(changes).To(ConsistOf(
+			ConfigurationChange{Path: "/developerConfiguration/featureGates/0", From: "Snapshot", To: "HotplugVolumes"},
+			ConfigurationChange{Path: "/developerConfiguration/memoryOvercommit", From: int64(100), To: int64(150)},
+			ConfigurationChange{Path: "/evictionStrategy", To: string(v1.EvictionStrategyLiveMigrate)},
+			ConfigurationChange{Path: "/vmStateStorageClass", From: "tpm-state"},
+		))
+	})
+
+	It("should report no changes for equal configurations", func() {
+		config := &v1.KubeVirtConfiguration{VMStateStorageClass: "tpm-state"}
+		Expect(DiffConfigurations(config, config.DeepCopy())).To(BeEmpty())
+	})
+})
@@ -0,0 +1,94 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package util
+
+import (
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FieldDiff is a field which differs between two unstructured objects
+type FieldDiff struct {
+	// Path is a JSON pointer to the field
+	Path string
+	From interface{}
+	To   interface{}
+}
+
+// DiffFields lists the fields which differ between two unstructured objects, ordered by path. Maps are
+// compared key by key and lists of the same length item by item. Fields which are only set in from are
+// left out with ignoreRemoved, like the fields a server fills in on the deployed object.
+func DiffFields(from, to interface{}, ignoreRemoved bool) []FieldDiff {
+	return diffFields("", from, to, ignoreRemoved)
+}
+
+func diffFields(path string, from, to interface{}, ignoreRemoved bool) []FieldDiff {
+	if ignoreRemoved && to == nil {
+		return nil
+	}
+
+	switch toValue := to.(type) {
+	case map[string]interface{}:
+		fromValue, ok := from.(map[string]interface{})
+		if !ok {
+			break
+		}
+		keys := make([]string, 0, len(toValue)+len(fromValue))
+		for key := range toValue {
+			keys = append(keys, key)
+		}
+		if !ignoreRemoved {
+			for key := range fromValue {
+				if _, exists := toValue[key]; !exists {
+					keys = append(keys, key)
+				}
+			}
+		}
+		sort.Strings(keys)
+
+		var diffs []FieldDiff
+		for _, key := range keys {
+			diffs = append(diffs, diffFields(path+"/"+escapePathSegment(key), fromValue[key], toValue[key], ignoreRemoved)...)
+		}
+		return diffs
+	case []interface{}:
+		fromValue, ok := from.([]interface{})
+		if !ok || len(fromValue) != len(toValue) {
+			break
+		}
+		var diffs []FieldDiff
+		for i := range toValue {
+			diffs = append(diffs, diffFields(path+"/"+strconv.Itoa(i), fromValue[i], toValue[i], ignoreRemoved)...)
+		}
+		return diffs
+	}
+
+	if reflect.DeepEqual(from, to) {
+		return nil
+	}
+	return []FieldDiff{{Path: path, From: from, To: to}}
+}
+
+// escapePathSegment escapes a key for a JSON pointer
+func escapePathSegment(segment string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(segment, "~", "~0"), "/", "~1")
+}
@@ -55,6 +55,7 @@ type Stores struct {
 	InstallStrategyJobCache               cache.Store
 	InfrastructurePodCache                cache.Store
 	PodDisruptionBudgetCache              cache.Store
+	NetworkPolicyCache                    cache.Store
 	ServiceMonitorCache                   cache.Store
 	NamespaceCache                        cache.Store
 	PrometheusRuleCache                   cache.Store
@@ -64,6 +65,8 @@ type Stores struct {
 	ValidatingAdmissionPolicyCache        cache.Store
 	ClusterInstancetype                   cache.Store
 	ClusterPreference                     cache.Store
+	NodeCache                             cache.Store
+	VirtualMachineInstanceCache           cache.Store
 }
 
 func (s *Stores) AllEmpty() bool {
@@ -80,6 +83,7 @@ func (s *Stores) AllEmpty() bool {
 		IsStoreEmpty(s.MutatingWebhookCache) &&
 		IsStoreEmpty(s.APIServiceCache) &&
 		IsStoreEmpty(s.PodDisruptionBudgetCache) &&
+		IsStoreEmpty(s.NetworkPolicyCache) &&
 		IsSCCStoreEmpty(s.SCCCache) &&
 		IsStoreEmpty(s.RouteCache) &&
 		IsStoreEmpty(s.ServiceMonitorCache) &&
@@ -132,6 +136,7 @@ type Expectations struct {
 	InstallStrategyConfigMap         *controller.UIDTrackingControllerExpectations
 	InstallStrategyJob               *controller.UIDTrackingControllerExpectations
 	PodDisruptionBudget              *controller.UIDTrackingControllerExpectations
+	NetworkPolicy                    *controller.UIDTrackingControllerExpectations
 	ServiceMonitor                   *controller.UIDTrackingControllerExpectations
 	PrometheusRule                   *controller.UIDTrackingControllerExpectations
 	Secrets                          *controller.UIDTrackingControllerExpectations
@@ -161,6 +166,7 @@ type Informers struct {
 	InstallStrategyJob               cache.SharedIndexInformer
 	InfrastructurePod                cache.SharedIndexInformer
 	PodDisruptionBudget              cache.SharedIndexInformer
+	NetworkPolicy                    cache.SharedIndexInformer
 	ServiceMonitor                   cache.SharedIndexInformer
 	Namespace                        cache.SharedIndexInformer
 	PrometheusRule                   cache.SharedIndexInformer
@@ -170,6 +176,8 @@ type Informers struct {
 	ValidatingAdmissionPolicy        cache.SharedIndexInformer
 	ClusterInstancetype              cache.SharedIndexInformer
 	ClusterPreference                cache.SharedIndexInformer
+	Node                             cache.SharedIndexInformer
+	VirtualMachineInstance           cache.SharedIndexInformer
 }
 
 func (e *Expectations) DeleteExpectations(key string) {
@@ -190,6 +198,7 @@ func (e *Expectations) DeleteExpectations(key string) {
 	e.InstallStrategyConfigMap.DeleteExpectations(key)
 	e.InstallStrategyJob.DeleteExpectations(key)
 	e.PodDisruptionBudget.DeleteExpectations(key)
+	e.NetworkPolicy.DeleteExpectations(key)
 	e.ServiceMonitor.DeleteExpectations(key)
 	e.PrometheusRule.DeleteExpectations(key)
 	e.Secrets.DeleteExpectations(key)
@@ -216,6 +225,7 @@ func (e *Expectations) ResetExpectations(key string) {
 	e.InstallStrategyConfigMap.SetExpectations(key, 0, 0)
 	e.InstallStrategyJob.SetExpectations(key, 0, 0)
 	e.PodDisruptionBudget.SetExpectations(key, 0, 0)
+	e.NetworkPolicy.SetExpectations(key, 0, 0)
 	e.ServiceMonitor.SetExpectations(key, 0, 0)
 	e.PrometheusRule.SetExpectations(key, 0, 0)
 	e.Secrets.SetExpectations(key, 0, 0)
@@ -242,6 +252,7 @@ func (e *Expectations) SatisfiedExpectations(key string) bool {
 		e.InstallStrategyConfigMap.SatisfiedExpectations(key) &&
 		e.InstallStrategyJob.SatisfiedExpectations(key) &&
 		e.PodDisruptionBudget.SatisfiedExpectations(key) &&
+		e.NetworkPolicy.SatisfiedExpectations(key) &&
 		e.ServiceMonitor.SatisfiedExpectations(key) &&
 		e.PrometheusRule.SatisfiedExpectations(key) &&
 		e.Secrets.SatisfiedExpectations(key) &&
@@ -0,0 +1,84 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package util
+
+const (
+	// UpgradePlanConfigMapName is the ConfigMap in the install namespace which holds the plan of a held update
+	UpgradePlanConfigMapName = "kubevirt-upgrade-plan"
+	// UpgradePlanConfigMapKey is the ConfigMap key of the JSON encoded UpgradePlan
+	UpgradePlanConfigMapKey = "plan"
+)
+
+type UpgradePlanAction string
+
+const (
+	UpgradePlanActionCreate UpgradePlanAction = "Create"
+	UpgradePlanActionUpdate UpgradePlanAction = "Update"
+	UpgradePlanActionDelete UpgradePlanAction = "Delete"
+
+	UpgradePlanActionLiveMigrate UpgradePlanAction = "LiveMigrate"
+	UpgradePlanActionEvict       UpgradePlanAction = "Evict"
+	// UpgradePlanActionNone marks an outdated VMI which the workload updater is not allowed to act on
+	UpgradePlanActionNone UpgradePlanAction = "None"
+)
+
+// UpgradePlan describes what virt-operator would change to move the deployed KubeVirt to the target install strategy.
+type UpgradePlan struct {
+	ObservedKubeVirtVersion  string `json:"observedKubeVirtVersion,omitempty"`
+	ObservedKubeVirtRegistry string `json:"observedKubeVirtRegistry,omitempty"`
+	TargetKubeVirtVersion    string `json:"targetKubeVirtVersion,omitempty"`
+	TargetKubeVirtRegistry   string `json:"targetKubeVirtRegistry,omitempty"`
+	TargetDeploymentID       string `json:"targetDeploymentID,omitempty"`
+	TargetLauncherImage      string `json:"targetLauncherImage,omitempty"`
+
+	Objects   []UpgradePlanObject   `json:"objects,omitempty"`
+	Workloads []UpgradePlanWorkload `json:"workloads,omitempty"`
+
+	// Truncated is set when the plan did not fit into the ConfigMap, the field changes of all objects are left out then
+	Truncated        bool `json:"truncated,omitempty"`
+	OmittedObjects   int  `json:"omittedObjects,omitempty"`
+	OmittedWorkloads int  `json:"omittedWorkloads,omitempty"`
+}
+
+// UpgradePlanObject is an object of the install strategy which would be created, updated or deleted.
+type UpgradePlanObject struct {
+	Kind      string            `json:"kind"`
+	Namespace string            `json:"namespace,omitempty"`
+	Name      string            `json:"name"`
+	Action    UpgradePlanAction `json:"action"`
+	// Changes lists the fields of the target object which differ from the deployed object
+	Changes []UpgradePlanFieldChange `json:"changes,omitempty"`
+}
+
+type UpgradePlanFieldChange struct {
+	// Path is a JSON pointer to the changed field
+	Path    string      `json:"path"`
+	Current interface{} `json:"current,omitempty"`
+	Desired interface{} `json:"desired,omitempty"`
+}
+
+// UpgradePlanWorkload is a running VMI which uses an outdated virt-launcher image.
+type UpgradePlanWorkload struct {
+	Namespace     string            `json:"namespace"`
+	Name          string            `json:"name"`
+	NodeName      string            `json:"nodeName,omitempty"`
+	LauncherImage string            `json:"launcherImage"`
+	Action        UpgradePlanAction `json:"action"`
+}
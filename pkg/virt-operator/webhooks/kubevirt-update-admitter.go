@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 	"strconv"
 
 	kvtls "kubevirt.io/kubevirt/pkg/util/tls"
@@ -38,6 +39,7 @@ import (
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -47,6 +49,7 @@ import (
 	webhookutils "kubevirt.io/kubevirt/pkg/util/webhooks"
 	validating_webhooks "kubevirt.io/kubevirt/pkg/util/webhooks/validating-webhooks"
 	"kubevirt.io/kubevirt/pkg/virt-operator/resource/apply"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
 )
 
 // KubeVirtUpdateAdmitter validates KubeVirt updates
@@ -78,6 +81,7 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 
 	results = append(results, validateCustomizeComponents(newKV.Spec.CustomizeComponents)...)
 	results = append(results, validateCertificates(newKV.Spec.CertificateRotationStrategy.SelfSigned)...)
+	results = append(results, validateExternalCertificates(field.NewPath("spec", "certificateRotateStrategy"), &newKV.Spec.CertificateRotationStrategy)...)
 	results = append(results, validateGuestToRequestHeadroom(newKV.Spec.Configuration.AdditionalGuestMemoryOverheadRatio)...)
 
 	if !equality.Semantic.DeepEqual(currKV.Spec.Configuration.TLSConfiguration, newKV.Spec.Configuration.TLSConfiguration) {
@@ -109,6 +113,34 @@ func (admitter *KubeVirtUpdateAdmitter) Admit(ctx context.Context, ar *admission
 
 	if newKV.Spec.Infra != nil {
 		results = append(results, validateInfraReplicas(newKV.Spec.Infra.Replicas)...)
+		results = append(results, validateInfraComponents(field.NewPath("spec", "infra", "components"), newKV.Spec.Infra.Components)...)
+	}
+
+	if newKV.Spec.Workloads != nil && len(newKV.Spec.Workloads.Components) > 0 {
+		results = append(results, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Field:   "spec.workloads.components",
+			Message: "spec.workloads.components is not supported, components can only be configured on spec.infra",
+		})
+	}
+
+	if newKV.Spec.RollbackTo != "" && newKV.Spec.RollbackTo != currKV.Spec.RollbackTo {
+		results = append(results, validateRollbackTo(field.NewPath("spec", "rollbackTo"), newKV.Spec.RollbackTo, &currKV.Status)...)
+	}
+
+	if newKV.Spec.HandlerUpdateStrategy != nil {
+		results = append(results,
+			validateHandlerUpdateStrategy(field.NewPath("spec", "handlerUpdateStrategy"), newKV.Spec.HandlerUpdateStrategy)...)
+	}
+
+	if newKV.Spec.NetworkPolicies != nil {
+		results = append(results,
+			validateNetworkPolicies(field.NewPath("spec", "networkPolicies"), newKV.Spec.NetworkPolicies)...)
+	}
+
+	if newKV.Spec.Configuration.VNCTokenAccess != nil {
+		results = append(results,
+			validateVNCTokenAccess(field.NewPath("spec", "configuration", "vncTokenAccess"), newKV.Spec.Configuration.VNCTokenAccess)...)
 	}
 
 	response := validating_webhooks.NewAdmissionResponse(results)
@@ -237,6 +269,70 @@ func validateCertificates(certConfig *v1.KubeVirtSelfSignConfiguration) []metav1
 	return statuses
 }
 
+func validateExternalCertificates(field *field.Path, strategy *v1.KubeVirtCertificateRotateStrategy) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	external := strategy.External
+	if external == nil {
+		return statuses
+	}
+	externalField := field.Child("external")
+
+	if strategy.SelfSigned != nil {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueNotSupported,
+			Field:   externalField.String(),
+			Message: fmt.Sprintf("%s and %s are mutually exclusive", externalField.String(), field.Child("selfSigned").String()),
+		})
+	}
+
+	if external.ExpiryWarningThreshold != nil && external.ExpiryWarningThreshold.Duration < 0 {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   externalField.Child("expiryWarningThreshold").String(),
+			Message: fmt.Sprintf("%s can't be negative", externalField.Child("expiryWarningThreshold").String()),
+		})
+	}
+
+	certManager := external.CertManager
+	if certManager == nil {
+		return statuses
+	}
+	certManagerField := externalField.Child("certManager")
+	issuerRefField := certManagerField.Child("issuerRef")
+
+	if certManager.IssuerRef.Name == "" {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueRequired,
+			Field:   issuerRefField.Child("name").String(),
+			Message: fmt.Sprintf("%s must be set", issuerRefField.Child("name").String()),
+		})
+	}
+
+	// external issuers come with their own kinds, only the cert-manager ones are known
+	if certManager.IssuerRef.Group == "" || certManager.IssuerRef.Group == "cert-manager.io" {
+		switch certManager.IssuerRef.Kind {
+		case "", "Issuer", "ClusterIssuer":
+		default:
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   issuerRefField.Child("kind").String(),
+				Message: fmt.Sprintf("%s must be Issuer or ClusterIssuer", issuerRefField.Child("kind").String()),
+			})
+		}
+	}
+
+	if certManager.Duration != nil && certManager.RenewBefore != nil && certManager.Duration.Duration <= certManager.RenewBefore.Duration {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   certManagerField.Child("renewBefore").String(),
+			Message: fmt.Sprintf("%s must be shorter than %s", certManagerField.Child("renewBefore").String(), certManagerField.Child("duration").String()),
+		})
+	}
+
+	return statuses
+}
+
 func validateTLSConfiguration(tlsConfiguration *v1.TLSConfiguration) []metav1.StatusCause {
 	var statuses []metav1.StatusCause
 
@@ -432,6 +528,222 @@ func validateInfraReplicas(replicas *uint8) []metav1.StatusCause {
 	return statuses
 }
 
+func validateInfraComponents(field *field.Path, componentConfigs []v1.InfraComponentConfig) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	knownComponents := map[string]bool{
+		components.VirtAPIName:         true,
+		components.VirtControllerName:  true,
+		components.VirtExportProxyName: true,
+	}
+	names := map[string]bool{}
+	for i, componentConfig := range componentConfigs {
+		componentField := field.Index(i)
+		nameField := componentField.Child("name")
+		if !knownComponents[componentConfig.Name] {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueNotSupported,
+				Field:   nameField.String(),
+				Message: fmt.Sprintf("%s %q is not supported, must be one of %s, %s or %s", nameField.String(), componentConfig.Name, components.VirtAPIName, components.VirtControllerName, components.VirtExportProxyName),
+			})
+		} else if names[componentConfig.Name] {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   nameField.String(),
+				Message: fmt.Sprintf("%s %q is already configured", nameField.String(), componentConfig.Name),
+			})
+		}
+		names[componentConfig.Name] = true
+
+		for j, constraint := range componentConfig.TopologySpreadConstraints {
+			constraintField := componentField.Child("topologySpreadConstraints").Index(j)
+			if constraint.MaxSkew <= 0 {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueInvalid,
+					Field:   constraintField.Child("maxSkew").String(),
+					Message: fmt.Sprintf("%s must be greater than 0", constraintField.Child("maxSkew").String()),
+				})
+			}
+			if constraint.WhenUnsatisfiable != corev1.DoNotSchedule && constraint.WhenUnsatisfiable != corev1.ScheduleAnyway {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Field:   constraintField.Child("whenUnsatisfiable").String(),
+					Message: fmt.Sprintf("%s must be %s or %s", constraintField.Child("whenUnsatisfiable").String(), corev1.DoNotSchedule, corev1.ScheduleAnyway),
+				})
+			}
+		}
+
+		if pdb := componentConfig.PodDisruptionBudget; pdb != nil {
+			statuses = append(statuses, validateComponentPodDisruptionBudget(componentField.Child("podDisruptionBudget"), pdb)...)
+		}
+
+		if resources := componentConfig.Resources; resources != nil {
+			resourcesField := componentField.Child("resources")
+			if len(resources.Claims) > 0 {
+				statuses = append(statuses, metav1.StatusCause{
+					Type:    metav1.CauseTypeFieldValueNotSupported,
+					Field:   resourcesField.Child("claims").String(),
+					Message: fmt.Sprintf("%s is not supported", resourcesField.Child("claims").String()),
+				})
+			}
+			for name, request := range resources.Requests {
+				if limit, ok := resources.Limits[name]; ok && request.Cmp(limit) > 0 {
+					statuses = append(statuses, metav1.StatusCause{
+						Type:    metav1.CauseTypeFieldValueInvalid,
+						Field:   resourcesField.Child("requests").Key(string(name)).String(),
+						Message: fmt.Sprintf("%s must be less than or equal to the %s limit", resourcesField.Child("requests").Key(string(name)).String(), name),
+					})
+				}
+			}
+		}
+	}
+
+	return statuses
+}
+
+func validateComponentPodDisruptionBudget(field *field.Path, pdb *v1.ComponentPodDisruptionBudget) []metav1.StatusCause {
+	if pdb.MinAvailable != nil && pdb.MaxUnavailable != nil {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.String(),
+			Message: fmt.Sprintf("%s and %s are mutually exclusive", field.Child("minAvailable").String(), field.Child("maxUnavailable").String()),
+		}}
+	}
+
+	statuses := []metav1.StatusCause{}
+	for name, value := range map[string]*intstr.IntOrString{"minAvailable": pdb.MinAvailable, "maxUnavailable": pdb.MaxUnavailable} {
+		if value == nil {
+			continue
+		}
+		valueField := field.Child(name)
+		if scaled, err := intstr.GetScaledValueFromIntOrPercent(value, 100, true); err != nil {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   valueField.String(),
+				Message: fmt.Sprintf("%s is invalid: %v", valueField.String(), err),
+			})
+		} else if scaled < 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   valueField.String(),
+				Message: fmt.Sprintf("%s can't be negative", valueField.String()),
+			})
+		}
+	}
+
+	return statuses
+}
+
+// validateRollbackTo only accepts deployments virt-operator still knows the deployment config of
+func validateRollbackTo(field *field.Path, rollbackTo string, status *v1.KubeVirtStatus) []metav1.StatusCause {
+	if status.PreviousDeployment != nil && status.PreviousDeployment.DeploymentID == rollbackTo {
+		return nil
+	}
+	if status.ObservedDeploymentID == rollbackTo {
+		return nil
+	}
+
+	return []metav1.StatusCause{{
+		Type:    metav1.CauseTypeFieldValueInvalid,
+		Field:   field.String(),
+		Message: fmt.Sprintf("%s must refer to status.previousDeployment.deploymentID, deployment %s is unknown", field.String(), rollbackTo),
+	}}
+}
+
+func validateHandlerUpdateStrategy(field *field.Path, strategy *v1.KubeVirtHandlerUpdateStrategy) []metav1.StatusCause {
+	statuses := []metav1.StatusCause{}
+
+	names := map[string]bool{}
+	for i, wave := range strategy.Waves {
+		waveField := field.Child("waves").Index(i)
+		if wave.Name == "" {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   waveField.Child("name").String(),
+				Message: fmt.Sprintf("%s must be set", waveField.Child("name").String()),
+			})
+		} else if names[wave.Name] {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueDuplicate,
+				Field:   waveField.Child("name").String(),
+				Message: fmt.Sprintf("%s %q is already used by another wave", waveField.Child("name").String(), wave.Name),
+			})
+		}
+		names[wave.Name] = true
+
+		if len(wave.NodeSelector) == 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueRequired,
+				Field:   waveField.Child("nodeSelector").String(),
+				Message: fmt.Sprintf("%s must not be empty", waveField.Child("nodeSelector").String()),
+			})
+		}
+	}
+
+	if strategy.PauseBetweenWaves != nil && strategy.PauseBetweenWaves.Duration < 0 {
+		statuses = append(statuses, metav1.StatusCause{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   field.Child("pauseBetweenWaves").String(),
+			Message: fmt.Sprintf("%s can't be negative", field.Child("pauseBetweenWaves").String()),
+		})
+	}
+
+	if strategy.MaxUnavailable != nil {
+		maxUnavailableField := field.Child("maxUnavailable")
+		value, err := intstr.GetScaledValueFromIntOrPercent(strategy.MaxUnavailable, 100, true)
+		if err != nil {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   maxUnavailableField.String(),
+				Message: fmt.Sprintf("%s is invalid: %v", maxUnavailableField.String(), err),
+			})
+		} else if value <= 0 {
+			statuses = append(statuses, metav1.StatusCause{
+				Type:    metav1.CauseTypeFieldValueInvalid,
+				Field:   maxUnavailableField.String(),
+				Message: fmt.Sprintf("%s must be greater than 0", maxUnavailableField.String()),
+			})
+		}
+	}
+
+	return statuses
+}
+
+func validateNetworkPolicies(field *field.Path, networkPolicies *v1.KubeVirtNetworkPolicies) []metav1.StatusCause {
+	if networkPolicies.MonitoringNamespaceSelector == nil {
+		return nil
+	}
+
+	if _, err := metav1.LabelSelectorAsSelector(networkPolicies.MonitoringNamespaceSelector); err != nil {
+		selectorField := field.Child("monitoringNamespaceSelector")
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   selectorField.String(),
+			Message: fmt.Sprintf("%s is invalid: %v", selectorField.String(), err),
+		}}
+	}
+
+	return nil
+}
+
+func validateVNCTokenAccess(field *field.Path, tokenAccess *v1.VNCTokenAccessConfiguration) []metav1.StatusCause {
+	if tokenAccess.ExternalURL == "" {
+		return nil
+	}
+
+	urlField := field.Child("externalURL")
+	externalURL, err := url.Parse(tokenAccess.ExternalURL)
+	if err != nil || externalURL.Scheme != "https" || externalURL.Host == "" || externalURL.RawQuery != "" || externalURL.Fragment != "" {
+		return []metav1.StatusCause{{
+			Type:    metav1.CauseTypeFieldValueInvalid,
+			Field:   urlField.String(),
+			Message: fmt.Sprintf("%s must be an absolute https URL without query or fragment", urlField.String()),
+		}}
+	}
+
+	return nil
+}
+
 func featureGatesChanged(currKVSpec, newKVSpec *v1.KubeVirtSpec) bool {
 	currDevConfig := currKVSpec.Configuration.DeveloperConfiguration
 	newDevConfig := newKVSpec.Configuration.DeveloperConfiguration
@@ -24,12 +24,16 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	v1 "kubevirt.io/api/core/v1"
@@ -138,6 +142,213 @@ var _ = Describe("Validating KubeVirtUpdate Admitter", func() {
 		)
 	})
 
+	DescribeTable("validateHandlerUpdateStrategy", func(strategy *v1.KubeVirtHandlerUpdateStrategy, expectedFields []string) {
+		causes := validateHandlerUpdateStrategy(test, strategy)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("with valid waves", &v1.KubeVirtHandlerUpdateStrategy{
+			Waves: []v1.HandlerUpdateWave{
+				{Name: "canary", NodeSelector: map[string]string{"wave": "canary"}},
+				{Name: "rest", NodeSelector: map[string]string{"wave": "rest"}},
+			},
+			PauseBetweenWaves: &metav1.Duration{Duration: time.Minute},
+			MaxUnavailable:    pointer.P(intstr.FromString("10%")),
+		}, []string{}),
+		Entry("with a wave without name and nodeSelector", &v1.KubeVirtHandlerUpdateStrategy{
+			Waves: []v1.HandlerUpdateWave{{}},
+		}, []string{test.Child("waves").Index(0).Child("name").String(), test.Child("waves").Index(0).Child("nodeSelector").String()}),
+		Entry("with duplicate wave names", &v1.KubeVirtHandlerUpdateStrategy{
+			Waves: []v1.HandlerUpdateWave{
+				{Name: "canary", NodeSelector: map[string]string{"wave": "canary"}},
+				{Name: "canary", NodeSelector: map[string]string{"wave": "rest"}},
+			},
+		}, []string{test.Child("waves").Index(1).Child("name").String()}),
+		Entry("with a negative pause", &v1.KubeVirtHandlerUpdateStrategy{
+			PauseBetweenWaves: &metav1.Duration{Duration: -time.Minute},
+		}, []string{test.Child("pauseBetweenWaves").String()}),
+		Entry("with maxUnavailable of 0", &v1.KubeVirtHandlerUpdateStrategy{
+			MaxUnavailable: pointer.P(intstr.FromInt32(0)),
+		}, []string{test.Child("maxUnavailable").String()}),
+		Entry("with an invalid maxUnavailable percentage", &v1.KubeVirtHandlerUpdateStrategy{
+			MaxUnavailable: pointer.P(intstr.FromString("ten")),
+		}, []string{test.Child("maxUnavailable").String()}),
+	)
+
+	DescribeTable("validateExternalCertificates", func(strategy *v1.KubeVirtCertificateRotateStrategy, expectedFields []string) {
+		causes := validateExternalCertificates(test, strategy)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("without external certificates", &v1.KubeVirtCertificateRotateStrategy{
+			SelfSigned: &v1.KubeVirtSelfSignConfiguration{},
+		}, []string{}),
+		Entry("with user provided secrets", &v1.KubeVirtCertificateRotateStrategy{
+			External: &v1.KubeVirtExternalCertificateConfiguration{
+				ExpiryWarningThreshold: &metav1.Duration{Duration: 24 * time.Hour},
+			},
+		}, []string{}),
+		Entry("with a cert-manager issuer", &v1.KubeVirtCertificateRotateStrategy{
+			External: &v1.KubeVirtExternalCertificateConfiguration{
+				CertManager: &v1.CertManagerConfiguration{
+					IssuerRef:   v1.CertManagerIssuerReference{Name: "corporate-ca", Kind: "ClusterIssuer"},
+					Duration:    &metav1.Duration{Duration: 48 * time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			},
+		}, []string{}),
+		Entry("with an external issuer", &v1.KubeVirtCertificateRotateStrategy{
+			External: &v1.KubeVirtExternalCertificateConfiguration{
+				CertManager: &v1.CertManagerConfiguration{
+					IssuerRef: v1.CertManagerIssuerReference{Name: "vault", Kind: "VaultIssuer", Group: "vault.example.com"},
+				},
+			},
+		}, []string{}),
+		Entry("together with selfSigned", &v1.KubeVirtCertificateRotateStrategy{
+			SelfSigned: &v1.KubeVirtSelfSignConfiguration{},
+			External:   &v1.KubeVirtExternalCertificateConfiguration{},
+		}, []string{test.Child("external").String()}),
+		Entry("with a negative expiry warning threshold", &v1.KubeVirtCertificateRotateStrategy{
+			External: &v1.KubeVirtExternalCertificateConfiguration{
+				ExpiryWarningThreshold: &metav1.Duration{Duration: -time.Hour},
+			},
+		}, []string{test.Child("external", "expiryWarningThreshold").String()}),
+		Entry("with an issuer without name and an unknown kind", &v1.KubeVirtCertificateRotateStrategy{
+			External: &v1.KubeVirtExternalCertificateConfiguration{
+				CertManager: &v1.CertManagerConfiguration{
+					IssuerRef: v1.CertManagerIssuerReference{Kind: "Unknown"},
+				},
+			},
+		}, []string{test.Child("external", "certManager", "issuerRef", "name").String(), test.Child("external", "certManager", "issuerRef", "kind").String()}),
+		Entry("with renewBefore exceeding the duration", &v1.KubeVirtCertificateRotateStrategy{
+			External: &v1.KubeVirtExternalCertificateConfiguration{
+				CertManager: &v1.CertManagerConfiguration{
+					IssuerRef:   v1.CertManagerIssuerReference{Name: "corporate-ca"},
+					Duration:    &metav1.Duration{Duration: 24 * time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 48 * time.Hour},
+				},
+			},
+		}, []string{test.Child("external", "certManager", "renewBefore").String()}),
+	)
+
+	DescribeTable("validateInfraComponents", func(componentConfigs []v1.InfraComponentConfig, expectedFields []string) {
+		causes := validateInfraComponents(test, componentConfigs)
+		Expect(causes).To(HaveLen(len(expectedFields)))
+		for _, cause := range causes {
+			Expect(cause.Field).To(BeElementOf(expectedFields))
+		}
+	},
+		Entry("with valid components", []v1.InfraComponentConfig{
+			{
+				Name: "virt-api",
+				TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{
+					MaxSkew:           1,
+					TopologyKey:       corev1.LabelTopologyZone,
+					WhenUnsatisfiable: corev1.ScheduleAnyway,
+				}},
+				PodDisruptionBudget: &v1.ComponentPodDisruptionBudget{MaxUnavailable: pointer.P(intstr.FromString("50%"))},
+			},
+			{
+				Name: "virt-controller",
+				Resources: &corev1.ResourceRequirements{
+					Requests: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("256Mi")},
+					Limits:   corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+				},
+			},
+		}, []string{}),
+		Entry("with an unknown component", []v1.InfraComponentConfig{
+			{Name: "virt-handler"},
+		}, []string{test.Index(0).Child("name").String()}),
+		Entry("with a duplicate component", []v1.InfraComponentConfig{
+			{Name: "virt-api"},
+			{Name: "virt-api"},
+		}, []string{test.Index(1).Child("name").String()}),
+		Entry("with an invalid topology spread constraint", []v1.InfraComponentConfig{{
+			Name:                      "virt-exportproxy",
+			TopologySpreadConstraints: []corev1.TopologySpreadConstraint{{TopologyKey: corev1.LabelHostname}},
+		}}, []string{
+			test.Index(0).Child("topologySpreadConstraints").Index(0).Child("maxSkew").String(),
+			test.Index(0).Child("topologySpreadConstraints").Index(0).Child("whenUnsatisfiable").String(),
+		}),
+		Entry("with minAvailable and maxUnavailable", []v1.InfraComponentConfig{{
+			Name: "virt-api",
+			PodDisruptionBudget: &v1.ComponentPodDisruptionBudget{
+				MinAvailable:   pointer.P(intstr.FromInt32(1)),
+				MaxUnavailable: pointer.P(intstr.FromInt32(1)),
+			},
+		}}, []string{test.Index(0).Child("podDisruptionBudget").String()}),
+		Entry("with a negative minAvailable", []v1.InfraComponentConfig{{
+			Name:                "virt-api",
+			PodDisruptionBudget: &v1.ComponentPodDisruptionBudget{MinAvailable: pointer.P(intstr.FromInt32(-1))},
+		}}, []string{test.Index(0).Child("podDisruptionBudget", "minAvailable").String()}),
+		Entry("with an invalid maxUnavailable percentage", []v1.InfraComponentConfig{{
+			Name:                "virt-api",
+			PodDisruptionBudget: &v1.ComponentPodDisruptionBudget{MaxUnavailable: pointer.P(intstr.FromString("half"))},
+		}}, []string{test.Index(0).Child("podDisruptionBudget", "maxUnavailable").String()}),
+		Entry("with requests exceeding limits", []v1.InfraComponentConfig{{
+			Name: "virt-controller",
+			Resources: &corev1.ResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+				Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+			},
+		}}, []string{test.Index(0).Child("resources", "requests").Key("cpu").String()}),
+	)
+
+	DescribeTable("validateRollbackTo", func(rollbackTo string, shouldSucceed bool) {
+		status := &v1.KubeVirtStatus{
+			ObservedDeploymentID: "observed",
+			PreviousDeployment:   &v1.KubeVirtPreviousDeployment{DeploymentID: "previous"},
+		}
+		causes := validateRollbackTo(test, rollbackTo, status)
+		if shouldSucceed {
+			Expect(causes).To(BeEmpty())
+		} else {
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(test.String()))
+		}
+	},
+		Entry("with the previous deployment", "previous", true),
+		Entry("with the observed deployment", "observed", true),
+		Entry("with an unknown deployment", "unknown", false),
+	)
+
+	DescribeTable("validateNetworkPolicies", func(selector *metav1.LabelSelector, shouldSucceed bool) {
+		causes := validateNetworkPolicies(test, &v1.KubeVirtNetworkPolicies{Enabled: true, MonitoringNamespaceSelector: selector})
+		if shouldSucceed {
+			Expect(causes).To(BeEmpty())
+		} else {
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(test.Child("monitoringNamespaceSelector").String()))
+		}
+	},
+		Entry("without a monitoring namespace selector", nil, true),
+		Entry("with a valid monitoring namespace selector", &metav1.LabelSelector{MatchLabels: map[string]string{"monitoring": "true"}}, true),
+		Entry("with an invalid monitoring namespace selector", &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "monitoring", Operator: "Matches"}},
+		}, false),
+	)
+
+	DescribeTable("validateVNCTokenAccess", func(externalURL string, shouldSucceed bool) {
+		causes := validateVNCTokenAccess(test, &v1.VNCTokenAccessConfiguration{ExternalURL: externalURL})
+		if shouldSucceed {
+			Expect(causes).To(BeEmpty())
+		} else {
+			Expect(causes).To(HaveLen(1))
+			Expect(causes[0].Field).To(Equal(test.Child("externalURL").String()))
+		}
+	},
+		Entry("without an external URL", "", true),
+		Entry("with an https URL", "https://vnc.example.com", true),
+		Entry("with an https URL and a path", "https://example.com/kubevirt/", true),
+		Entry("with an http URL", "http://vnc.example.com", false),
+		Entry("with a relative URL", "vnc.example.com", false),
+		Entry("with a query", "https://vnc.example.com?a=b", false),
+	)
+
 	Context("with AdditionalGuestMemoryOverheadRatio", func() {
 		DescribeTable("the ratio must be parsable to float", func(unparsableRatio string) {
 			causes := validateGuestToRequestHeadroom(&unparsableRatio)
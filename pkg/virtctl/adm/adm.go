@@ -3,7 +3,11 @@ package adm
 import (
 	"github.com/spf13/cobra"
 
+	"kubevirt.io/kubevirt/pkg/virtctl/adm/config"
+	"kubevirt.io/kubevirt/pkg/virtctl/adm/images"
 	"kubevirt.io/kubevirt/pkg/virtctl/adm/logverbosity"
+	"kubevirt.io/kubevirt/pkg/virtctl/adm/rollback"
+	"kubevirt.io/kubevirt/pkg/virtctl/adm/upgradeplan"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 )
 
@@ -19,7 +23,11 @@ func NewCommand() *cobra.Command {
 			cmd.Printf(cmd.UsageString())
 		},
 	}
+	cmd.AddCommand(config.NewCommand())
+	cmd.AddCommand(images.NewCommand())
 	cmd.AddCommand(logverbosity.NewCommand())
+	cmd.AddCommand(rollback.NewCommand())
+	cmd.AddCommand(upgradeplan.NewCommand())
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -0,0 +1,242 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	appsv1 "k8s.io/api/apps/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the history of the KubeVirt configuration.",
+		Long: `Inspects the revisions of spec.configuration which virt-operator records on every change of the KubeVirt CR.
+The ` + string(v1.KubeVirtConditionConfigurationValid) + ` condition of the KubeVirt CR reports settings of the current revision which don't match the cluster.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Printf(cmd.UsageString())
+		},
+	}
+	cmd.AddCommand(newHistoryCommand())
+	cmd.AddCommand(newDiffCommand())
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newHistoryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "history",
+		Short:   "List the recorded revisions of the KubeVirt configuration.",
+		Example: "  # List the configuration revisions, the current one is marked with a '*':\n  {{ProgramName}} adm config history",
+		Args:    cobra.NoArgs,
+		RunE:    runHistory,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func newDiffCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff [FROM] [TO]",
+		Short: "Show the changes between two revisions of the KubeVirt configuration.",
+		Long: `Shows the fields which changed between two revisions of the KubeVirt configuration.
+Revisions are referenced by their number or name. Without arguments the previous revision is compared to the current one,
+with a single argument the given revision is compared to the current one.`,
+		Example: diffUsage(),
+		Args:    cobra.MaximumNArgs(2),
+		RunE:    runDiff,
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func diffUsage() string {
+	return `  # Show what the last configuration change did:
+  {{ProgramName}} adm config diff
+
+  # Show the changes since revision 3:
+  {{ProgramName}} adm config diff 3
+
+  # Show the changes between revisions 3 and 5:
+  {{ProgramName}} adm config diff 3 5`
+}
+
+func runHistory(cmd *cobra.Command, _ []string) error {
+	kv, history, err := getHistory(cmd)
+	if err != nil {
+		return err
+	}
+	if len(history) == 0 {
+		cmd.Printf("No configuration revisions recorded for KubeVirt %s/%s\n", kv.Namespace, kv.Name)
+		return nil
+	}
+	printHistory(cmd.OutOrStdout(), kv, history)
+	return nil
+}
+
+func runDiff(cmd *cobra.Command, args []string) error {
+	kv, history, err := getHistory(cmd)
+	if err != nil {
+		return err
+	}
+
+	current := findRevision(history, kv.Status.ConfigurationRevision)
+	if current == nil {
+		return fmt.Errorf("the current configuration revision of KubeVirt %s/%s was not recorded yet", kv.Namespace, kv.Name)
+	}
+
+	var from, to *appsv1.ControllerRevision
+	switch len(args) {
+	case 0:
+		to = current
+		for i := range history {
+			if history[i].Revision < current.Revision {
+				from = &history[i]
+			}
+		}
+		if from == nil {
+			return errors.New("the current configuration revision has no predecessor")
+		}
+	case 1:
+		to = current
+		if from = findRevision(history, args[0]); from == nil {
+			return fmt.Errorf("configuration revision %s not found", args[0])
+		}
+	default:
+		if from = findRevision(history, args[0]); from == nil {
+			return fmt.Errorf("configuration revision %s not found", args[0])
+		}
+		if to = findRevision(history, args[1]); to == nil {
+			return fmt.Errorf("configuration revision %s not found", args[1])
+		}
+	}
+
+	changes, err := diffRevisions(from, to)
+	if err != nil {
+		return err
+	}
+	out := cmd.OutOrStdout()
+	fmt.Fprintf(out, "Changes from revision %d (%s) to revision %d (%s):\n", from.Revision, from.Name, to.Revision, to.Name)
+	if len(changes) == 0 {
+		fmt.Fprintln(out, "  none")
+	}
+	for _, change := range changes {
+		fmt.Fprintf(out, "  %s: %s -> %s\n", change.Path, formatValue(change.From), formatValue(change.To))
+	}
+	return nil
+}
+
+func getHistory(cmd *cobra.Command) (*v1.KubeVirt, []appsv1.ControllerRevision, error) {
+	virtClient, _, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return nil, nil, err
+	}
+	kv, err := detectInstallation(virtClient)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	revisions, err := virtClient.AppsV1().ControllerRevisions(kv.Namespace).List(context.Background(), k8smetav1.ListOptions{
+		LabelSelector: util.ConfigurationRevisionLabel,
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not list the configuration revisions: %v", err)
+	}
+	util.SortConfigurationRevisions(revisions.Items)
+	return kv, revisions.Items, nil
+}
+
+func detectInstallation(virtClient kubecli.KubevirtClient) (*v1.KubeVirt, error) {
+	kvs, err := virtClient.KubeVirt(k8smetav1.NamespaceAll).List(context.Background(), k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list KubeVirt CRs across all namespaces: %v", err)
+	}
+	if len(kvs.Items) == 0 {
+		return nil, errors.New("could not detect a KubeVirt installation")
+	}
+	if len(kvs.Items) > 1 {
+		return nil, errors.New("invalid kubevirt installation, more than one KubeVirt resource found")
+	}
+	return &kvs.Items[0], nil
+}
+
+// findRevision looks a revision up by its number or name
+func findRevision(history []appsv1.ControllerRevision, ref string) *appsv1.ControllerRevision {
+	number, err := strconv.ParseInt(ref, 10, 64)
+	for i := range history {
+		if history[i].Name == ref || (err == nil && history[i].Revision == number) {
+			return &history[i]
+		}
+	}
+	return nil
+}
+
+func diffRevisions(from, to *appsv1.ControllerRevision) ([]util.ConfigurationChange, error) {
+	fromConfig, err := util.ConfigurationFromRevision(from)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode configuration revision %s: %v", from.Name, err)
+	}
+	toConfig, err := util.ConfigurationFromRevision(to)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode configuration revision %s: %v", to.Name, err)
+	}
+	return util.DiffConfigurations(fromConfig, toConfig)
+}
+
+func printHistory(out io.Writer, kv *v1.KubeVirt, history []appsv1.ControllerRevision) {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "REVISION\tNAME\tCREATED\t")
+	for _, revision := range history {
+		marker := ""
+		if revision.Name == kv.Status.ConfigurationRevision {
+			marker = "*"
+		}
+		fmt.Fprintf(w, "%d%s\t%s\t%s\t\n", revision.Revision, marker, revision.Name,
+			revision.CreationTimestamp.UTC().Format("2006-01-02T15:04:05Z"))
+	}
+	w.Flush()
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(valueBytes)
+}
@@ -0,0 +1,125 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package config_test
+
+import (
+	"context"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Configuration history", func() {
+	const installNamespace = "kubevirt"
+
+	var (
+		kv         *v1.KubeVirt
+		kubeClient *fake.Clientset
+	)
+
+	// addRevision records the configuration like virt-operator does and makes it the current one
+	addRevision := func(revision int64, overcommit int) string {
+		kv.Spec.Configuration.DeveloperConfiguration = &v1.DeveloperConfiguration{MemoryOvercommit: overcommit}
+		controllerRevision, err := util.NewConfigurationRevision(kv, revision)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = kubeClient.AppsV1().ControllerRevisions(installNamespace).Create(context.Background(), controllerRevision, k8smetav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		kv.Status.ConfigurationRevision = controllerRevision.Name
+		return controllerRevision.Name
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "kubevirt", Namespace: installNamespace},
+		}
+		kvInterface := kubecli.NewMockKubeVirtInterface(ctrl)
+		kvInterface.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ k8smetav1.ListOptions) (*v1.KubeVirtList, error) {
+			return kubecli.NewKubeVirtList(*kv), nil
+		}).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().KubeVirt(k8smetav1.NamespaceAll).Return(kvInterface).AnyTimes()
+
+		kubeClient = fake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().AppsV1().Return(kubeClient.AppsV1()).AnyTimes()
+	})
+
+	Context("history", func() {
+		It("should report an empty history", func() {
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "config", "history")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("No configuration revisions recorded for KubeVirt kubevirt/kubevirt"))
+		})
+
+		It("should list the revisions and mark the current one", func() {
+			first := addRevision(1, 100)
+			second := addRevision(2, 150)
+
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "config", "history")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(MatchRegexp(`REVISION\s+NAME\s+CREATED`))
+			Expect(string(out)).To(MatchRegexp(`\n1\s+` + first))
+			Expect(string(out)).To(MatchRegexp(`\n2\*\s+` + second))
+		})
+	})
+
+	Context("diff", func() {
+		BeforeEach(func() {
+			addRevision(1, 100)
+			addRevision(2, 150)
+			addRevision(3, 200)
+		})
+
+		It("should compare the previous to the current revision", func() {
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "config", "diff")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("Changes from revision 2"))
+			Expect(string(out)).To(ContainSubstring("/developerConfiguration/memoryOvercommit: 150 -> 200"))
+		})
+
+		It("should compare a given revision to the current one", func() {
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "config", "diff", "1")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("/developerConfiguration/memoryOvercommit: 100 -> 200"))
+		})
+
+		It("should compare two given revisions", func() {
+			out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "config", "diff", "2", "1")()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(out)).To(ContainSubstring("/developerConfiguration/memoryOvercommit: 150 -> 100"))
+		})
+
+		It("should fail for an unknown revision", func() {
+			_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "config", "diff", "7")()
+			Expect(err).To(MatchError(ContainSubstring("configuration revision 7 not found")))
+		})
+	})
+})
@@ -0,0 +1,295 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package images
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/util/registry"
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	outputList = "list"
+	outputJSON = "json"
+	outputIDMS = "idms"
+	outputICSP = "icsp"
+
+	mirrorSetName = "kubevirt"
+)
+
+// ResolveDigestFunc looks up the digests of the images referenced by their tag
+var ResolveDigestFunc components.DigestResolver = func(image string) (string, error) {
+	return registry.NewDigestResolver(nil).Resolve(context.Background(), image)
+}
+
+type command struct {
+	output           string
+	mirrorRegistry   string
+	deploymentConfig string
+}
+
+type imageMirror struct {
+	Source  string   `json:"source"`
+	Mirrors []string `json:"mirrors"`
+}
+
+type mirrorSetMetadata struct {
+	Name string `json:"name"`
+}
+
+// mirrorSet holds the fields ImageDigestMirrorSets, ImageTagMirrorSets and ImageContentSourcePolicies have in common,
+// their spec only differs in the name of the list of mirrors
+type mirrorSet struct {
+	k8smetav1.TypeMeta `json:",inline"`
+	Metadata           mirrorSetMetadata        `json:"metadata"`
+	Spec               map[string][]imageMirror `json:"spec"`
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "images",
+		Short: "List the images of a KubeVirt deployment and generate the manifests to mirror them.",
+		Long: `Lists all images KubeVirt deploys or hands out to its workloads, including virt-launcher, virt-exportserver, sidecar-shim and libguestfs-tools.
+By default the images of the deployment virt-operator rolls out are listed, a deployment config stored in the KubeVirt CR status can be passed instead.
+With a mirror registry the command generates an ImageDigestMirrorSet and ImageTagMirrorSet or an ImageContentSourcePolicy, so disconnected clusters pull the mirrored images.
+
+The JSON output reports the digest of every image, tags are resolved to their digests with the registries of the images, which are accessed anonymously.
+Images the deployment references by their tag are still pulled by their tag, so they are mirrored through the ImageTagMirrorSet and are left out of an ImageContentSourcePolicy.`,
+		Example: usage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVarP(&c.output, "output", "o", outputList, "Output format, one of list, json, idms or icsp.")
+	cmd.Flags().StringVar(&c.mirrorRegistry, "mirror-registry", "", "The registry the images are mirrored to, required for the idms and icsp output formats.")
+	cmd.Flags().StringVar(&c.deploymentConfig, "deployment-config", "", "Path to a file with the deployment config to list the images of, instead of the one of the installation.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # List the images of the KubeVirt installation:
+  {{ProgramName}} adm images
+
+  # List the images as JSON, including their digests:
+  {{ProgramName}} adm images -o json
+
+  # Generate an ImageDigestMirrorSet and ImageTagMirrorSet for a mirror registry:
+  {{ProgramName}} adm images -o idms --mirror-registry registry.example.com/kubevirt
+
+  # List the images of a stored deployment config without accessing the cluster:
+  kubectl get kubevirt -n kubevirt kubevirt -o jsonpath='{.status.targetDeploymentConfig}' > config.json
+  {{ProgramName}} adm images --deployment-config config.json`
+}
+
+func (c *command) run(cmd *cobra.Command, _ []string) error {
+	switch c.output {
+	case outputList, outputJSON:
+	case outputIDMS, outputICSP:
+		if c.mirrorRegistry == "" {
+			return fmt.Errorf("the %s output format requires a mirror registry", c.output)
+		}
+	default:
+		return fmt.Errorf("unsupported output format %q, must be one of %s, %s, %s or %s", c.output, outputList, outputJSON, outputIDMS, outputICSP)
+	}
+
+	config, err := c.getDeploymentConfig(cmd)
+	if err != nil {
+		return err
+	}
+	var resolveDigest components.DigestResolver
+	if c.output == outputJSON {
+		resolveDigest = ResolveDigestFunc
+	}
+	images, err := components.GetImages(config, resolveDigest)
+	if err != nil {
+		return err
+	}
+
+	out := cmd.OutOrStdout()
+	switch c.output {
+	case outputList:
+		for _, image := range images {
+			fmt.Fprintln(out, image.Image)
+		}
+	case outputJSON:
+		imagesBytes, err := json.MarshalIndent(images, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out, string(imagesBytes))
+	case outputIDMS:
+		return printMirrorSets(out, newImageMirrorSets(images, c.mirrorRegistry))
+	case outputICSP:
+		for _, image := range images {
+			if !image.ReferencedByDigest() {
+				cmd.PrintErrf("Skipping image %s, ImageContentSourcePolicies only apply to images referenced by their digest\n", image.Image)
+			}
+		}
+		return printMirrorSets(out, newImageContentSourcePolicy(images, c.mirrorRegistry))
+	}
+
+	return nil
+}
+
+func (c *command) getDeploymentConfig(cmd *cobra.Command) (*util.KubeVirtDeploymentConfig, error) {
+	var deploymentConfig []byte
+	if c.deploymentConfig != "" {
+		var err error
+		if deploymentConfig, err = os.ReadFile(c.deploymentConfig); err != nil {
+			return nil, fmt.Errorf("could not read the deployment config: %v", err)
+		}
+	} else {
+		virtClient, _, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+		if err != nil {
+			return nil, err
+		}
+		kv, err := detectInstallation(virtClient)
+		if err != nil {
+			return nil, err
+		}
+		if kv.Status.TargetDeploymentConfig == "" {
+			return nil, fmt.Errorf("KubeVirt %s/%s has no target deployment yet", kv.Namespace, kv.Name)
+		}
+		deploymentConfig = []byte(kv.Status.TargetDeploymentConfig)
+	}
+
+	config := &util.KubeVirtDeploymentConfig{}
+	if err := json.Unmarshal(deploymentConfig, config); err != nil {
+		return nil, fmt.Errorf("could not decode the deployment config: %v", err)
+	}
+	return config, nil
+}
+
+func detectInstallation(virtClient kubecli.KubevirtClient) (*v1.KubeVirt, error) {
+	kvs, err := virtClient.KubeVirt(k8smetav1.NamespaceAll).List(context.Background(), k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list KubeVirt CRs across all namespaces: %v", err)
+	}
+	if len(kvs.Items) == 0 {
+		return nil, errors.New("could not detect a KubeVirt installation")
+	}
+	if len(kvs.Items) > 1 {
+		return nil, errors.New("invalid kubevirt installation, more than one KubeVirt resource found")
+	}
+	return &kvs.Items[0], nil
+}
+
+// newImageMirrorSets returns an ImageDigestMirrorSet for the images referenced by their digest and
+// an ImageTagMirrorSet for the ones referenced by their tag, if there are any
+func newImageMirrorSets(images []components.Image, mirrorRegistry string) []*mirrorSet {
+	var digestImages, tagImages []components.Image
+	for _, image := range images {
+		if image.ReferencedByDigest() {
+			digestImages = append(digestImages, image)
+		} else {
+			tagImages = append(tagImages, image)
+		}
+	}
+
+	var mirrorSets []*mirrorSet
+	if len(digestImages) > 0 {
+		mirrorSets = append(mirrorSets, newMirrorSet("config.openshift.io/v1", "ImageDigestMirrorSet", "imageDigestMirrors", digestImages, mirrorRegistry))
+	}
+	if len(tagImages) > 0 {
+		mirrorSets = append(mirrorSets, newMirrorSet("config.openshift.io/v1", "ImageTagMirrorSet", "imageTagMirrors", tagImages, mirrorRegistry))
+	}
+	return mirrorSets
+}
+
+func newImageContentSourcePolicy(images []components.Image, mirrorRegistry string) []*mirrorSet {
+	var digestImages []components.Image
+	for _, image := range images {
+		if image.ReferencedByDigest() {
+			digestImages = append(digestImages, image)
+		}
+	}
+	return []*mirrorSet{
+		newMirrorSet("operator.openshift.io/v1alpha1", "ImageContentSourcePolicy", "repositoryDigestMirrors", digestImages, mirrorRegistry),
+	}
+}
+
+func newMirrorSet(apiVersion, kind, mirrorsField string, images []components.Image, mirrorRegistry string) *mirrorSet {
+	mirrors := []imageMirror{}
+	seen := map[string]bool{}
+	for _, image := range images {
+		source := components.GetImageRepository(image.Image)
+		if seen[source] {
+			continue
+		}
+		seen[source] = true
+		mirrors = append(mirrors, imageMirror{
+			Source:  source,
+			Mirrors: []string{getMirrorRepository(source, mirrorRegistry)},
+		})
+	}
+
+	return &mirrorSet{
+		TypeMeta: k8smetav1.TypeMeta{APIVersion: apiVersion, Kind: kind},
+		Metadata: mirrorSetMetadata{Name: mirrorSetName},
+		Spec:     map[string][]imageMirror{mirrorsField: mirrors},
+	}
+}
+
+// getMirrorRepository moves the repository from its registry to the mirror registry, keeping its path
+func getMirrorRepository(repository, mirrorRegistry string) string {
+	path := repository
+	if parts := strings.SplitN(repository, "/", 2); len(parts) == 2 && isRegistryHost(parts[0]) {
+		path = parts[1]
+	}
+	return strings.TrimSuffix(mirrorRegistry, "/") + "/" + path
+}
+
+// isRegistryHost tells if the first component of an image reference is a registry, the same way container runtimes do
+func isRegistryHost(component string) bool {
+	return strings.ContainsAny(component, ".:") || component == "localhost"
+}
+
+func printMirrorSets(out io.Writer, mirrorSets []*mirrorSet) error {
+	for i, mirrorSet := range mirrorSets {
+		mirrorSetBytes, err := yaml.Marshal(mirrorSet)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			fmt.Fprintln(out, "---")
+		}
+		fmt.Fprint(out, string(mirrorSetBytes))
+	}
+	return nil
+}
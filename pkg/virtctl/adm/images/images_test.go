@@ -0,0 +1,177 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package images_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/resource/generate/components"
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+	"kubevirt.io/kubevirt/pkg/virtctl/adm/images"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Images", func() {
+	const launcherSha = "sha256:0cd2e1d0b6e1e5f1c2e0e5c1d7f8e6b4a2c0d1e3f5a7b9c1d3e5f7a9b1c3d5e7"
+
+	var kv *v1.KubeVirt
+
+	newDeploymentConfig := func(config *util.KubeVirtDeploymentConfig) string {
+		configJSON, err := config.GetJson()
+		Expect(err).ToNot(HaveOccurred())
+		return configJSON
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: "kubevirt", Namespace: "kubevirt"},
+			Status: v1.KubeVirtStatus{
+				TargetDeploymentConfig: newDeploymentConfig(&util.KubeVirtDeploymentConfig{
+					Registry:          "quay.io/kubevirt",
+					KubeVirtVersion:   "v1.3.0",
+					VirtOperatorImage: "quay.io/kubevirt/virt-operator:v1.3.0",
+					VirtLauncherImage: "quay.io/kubevirt/virt-launcher@" + launcherSha,
+				}),
+			},
+		}
+		kvInterface := kubecli.NewMockKubeVirtInterface(ctrl)
+		kvInterface.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(_, _ interface{}) (*v1.KubeVirtList, error) {
+			return kubecli.NewKubeVirtList(*kv), nil
+		}).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().KubeVirt(k8smetav1.NamespaceAll).Return(kvInterface).AnyTimes()
+
+		resolveDigestFunc := images.ResolveDigestFunc
+		images.ResolveDigestFunc = func(image string) (string, error) {
+			Fail("unexpected resolution of image " + image)
+			return "", nil
+		}
+		DeferCleanup(func() {
+			images.ResolveDigestFunc = resolveDigestFunc
+		})
+	})
+
+	It("should list the images of the installation", func() {
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "images")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("quay.io/kubevirt/virt-api:v1.3.0\n"))
+		Expect(string(out)).To(ContainSubstring("quay.io/kubevirt/virt-launcher@" + launcherSha + "\n"))
+		Expect(string(out)).To(ContainSubstring("quay.io/kubevirt/libguestfs-tools:v1.3.0\n"))
+	})
+
+	It("should resolve the tags of the images in the JSON output", func() {
+		const operatorSha = "sha256:5cfa6ac2ac38e5e4c8b1a1c8ad3d5a3e9dbe2a5c2b0e2d6b6c0b4ea04e7c1a11"
+		var resolved []string
+		images.ResolveDigestFunc = func(image string) (string, error) {
+			resolved = append(resolved, image)
+			return operatorSha, nil
+		}
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "images", "-o", "json")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resolved).To(ContainElement("quay.io/kubevirt/virt-operator:v1.3.0"))
+		Expect(resolved).ToNot(ContainElement(ContainSubstring("virt-launcher")))
+
+		var listed []components.Image
+		Expect(json.Unmarshal(out, &listed)).To(Succeed())
+		Expect(listed).To(ContainElements(
+			components.Image{Component: components.VirtOperatorName, Image: "quay.io/kubevirt/virt-operator:v1.3.0", Digest: operatorSha},
+			components.Image{Component: components.VirtLauncherName, Image: "quay.io/kubevirt/virt-launcher@" + launcherSha, Digest: launcherSha},
+		))
+	})
+
+	It("should fail if a tag can't be resolved", func() {
+		images.ResolveDigestFunc = func(image string) (string, error) {
+			return "", fmt.Errorf("failed to resolve the tag of image %s", image)
+		}
+
+		_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "images", "-o", "json")()
+		Expect(err).To(MatchError(ContainSubstring("failed to resolve the tag of image")))
+	})
+
+	It("should fail without a target deployment", func() {
+		kv.Status.TargetDeploymentConfig = ""
+		_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "images")()
+		Expect(err).To(MatchError(ContainSubstring("has no target deployment")))
+	})
+
+	It("should list the images of a deployment config file", func() {
+		configFile := filepath.Join(GinkgoT().TempDir(), "config.json")
+		Expect(os.WriteFile(configFile, []byte(newDeploymentConfig(&util.KubeVirtDeploymentConfig{
+			Registry:        "registry.example.com",
+			ImagePrefix:     "kv-",
+			KubeVirtVersion: "v1.4.0",
+		})), 0600)).To(Succeed())
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "images", "--deployment-config", configFile)()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("registry.example.com/kv-virt-handler:v1.4.0\n"))
+	})
+
+	It("should require a mirror registry to generate mirror sets", func() {
+		_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "images", "-o", "idms")()
+		Expect(err).To(MatchError(ContainSubstring("requires a mirror registry")))
+	})
+
+	It("should fail with an unsupported output format", func() {
+		_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "images", "-o", "yaml")()
+		Expect(err).To(MatchError(ContainSubstring("unsupported output format")))
+	})
+
+	It("should generate an ImageDigestMirrorSet and an ImageTagMirrorSet", func() {
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "images", "-o", "idms", "--mirror-registry", "mirror.example.com:5000/")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring(`apiVersion: config.openshift.io/v1
+kind: ImageDigestMirrorSet
+metadata:
+  name: kubevirt
+spec:
+  imageDigestMirrors:
+  - mirrors:
+    - mirror.example.com:5000/kubevirt/virt-launcher
+    source: quay.io/kubevirt/virt-launcher
+---
+`))
+		Expect(string(out)).To(ContainSubstring("kind: ImageTagMirrorSet"))
+		Expect(string(out)).To(ContainSubstring("    - mirror.example.com:5000/kubevirt/virt-api\n    source: quay.io/kubevirt/virt-api\n"))
+	})
+
+	It("should only add the images referenced by their digest to an ImageContentSourcePolicy", func() {
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "images", "-o", "icsp", "--mirror-registry", "mirror.example.com")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("kind: ImageContentSourcePolicy"))
+		Expect(string(out)).To(ContainSubstring("source: quay.io/kubevirt/virt-launcher"))
+		Expect(string(out)).ToNot(ContainSubstring("source: quay.io/kubevirt/virt-api"))
+	})
+})
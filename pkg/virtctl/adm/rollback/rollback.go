@@ -0,0 +1,133 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rollback
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const rollbackToPath = "/spec/rollbackTo"
+
+type command struct {
+	cancel bool
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Roll KubeVirt back to the previously deployed version.",
+		Long: `Sets spec.rollbackTo on the KubeVirt CR to the deployment reported in status.previousDeployment.
+virt-operator then re-applies the install strategy of that deployment. The rollback is refused if objects were stored in an API version the previous release can't read.
+Cancel the rollback to update to the regular target version again.`,
+		Example: usage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.run,
+	}
+
+	cmd.Flags().BoolVar(&c.cancel, "cancel", false, "Remove spec.rollbackTo and return to the regular target version.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Roll back to the previously deployed version:
+  {{ProgramName}} adm rollback
+
+  # Return to the regular target version:
+  {{ProgramName}} adm rollback --cancel`
+}
+
+func (c *command) run(cmd *cobra.Command, _ []string) error {
+	virtClient, _, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	kv, err := detectInstallation(virtClient)
+	if err != nil {
+		return err
+	}
+
+	if c.cancel {
+		if kv.Spec.RollbackTo == "" {
+			cmd.Printf("No rollback requested on KubeVirt %s/%s\n", kv.Namespace, kv.Name)
+			return nil
+		}
+		if err := patchKubeVirt(virtClient, kv, patch.WithTest(rollbackToPath, kv.Spec.RollbackTo), patch.WithRemove(rollbackToPath)); err != nil {
+			return err
+		}
+		cmd.Printf("Canceled the rollback of KubeVirt %s/%s\n", kv.Namespace, kv.Name)
+		return nil
+	}
+
+	previous := kv.Status.PreviousDeployment
+	if previous == nil || previous.DeploymentID == "" {
+		return fmt.Errorf("KubeVirt %s/%s has no previous deployment to roll back to", kv.Namespace, kv.Name)
+	}
+	if kv.Spec.RollbackTo == previous.DeploymentID {
+		cmd.Printf("KubeVirt %s/%s is already rolling back to version %s\n", kv.Namespace, kv.Name, previous.KubeVirtVersion)
+		return nil
+	}
+
+	if err := patchKubeVirt(virtClient, kv, patch.WithAdd(rollbackToPath, previous.DeploymentID)); err != nil {
+		return err
+	}
+	cmd.Printf("Rolling back KubeVirt %s/%s from version %s to version %s (%s)\n",
+		kv.Namespace, kv.Name, kv.Status.ObservedKubeVirtVersion, previous.KubeVirtVersion, previous.KubeVirtRegistry)
+	return nil
+}
+
+func patchKubeVirt(virtClient kubecli.KubevirtClient, kv *v1.KubeVirt, opts ...patch.PatchOption) error {
+	patchBytes, err := patch.New(opts...).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := virtClient.KubeVirt(kv.Namespace).Patch(context.Background(), kv.Name, types.JSONPatchType, patchBytes, k8smetav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("could not patch KubeVirt %s/%s: %v", kv.Namespace, kv.Name, err)
+	}
+	return nil
+}
+
+func detectInstallation(virtClient kubecli.KubevirtClient) (*v1.KubeVirt, error) {
+	kvs, err := virtClient.KubeVirt(k8smetav1.NamespaceAll).List(context.Background(), k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list KubeVirt CRs across all namespaces: %v", err)
+	}
+	if len(kvs.Items) == 0 {
+		return nil, errors.New("could not detect a KubeVirt installation")
+	}
+	if len(kvs.Items) > 1 {
+		return nil, errors.New("invalid kubevirt installation, more than one KubeVirt resource found")
+	}
+	return &kvs.Items[0], nil
+}
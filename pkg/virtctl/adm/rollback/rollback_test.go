@@ -0,0 +1,112 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package rollback_test
+
+import (
+	"context"
+	"encoding/json"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Rollback", func() {
+	const (
+		installNamespace = "kubevirt"
+		installName      = "kubevirt"
+		previousID       = "previous-id"
+	)
+
+	var kv *v1.KubeVirt
+	var kvInterface *kubecli.MockKubeVirtInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		kv = &v1.KubeVirt{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: installName, Namespace: installNamespace},
+			Status: v1.KubeVirtStatus{
+				ObservedKubeVirtVersion: "v1.1.0",
+				PreviousDeployment: &v1.KubeVirtPreviousDeployment{
+					KubeVirtVersion:  "v1.0.0",
+					KubeVirtRegistry: "registry",
+					DeploymentID:     previousID,
+				},
+			},
+		}
+		kvInterface = kubecli.NewMockKubeVirtInterface(ctrl)
+		kvInterface.EXPECT().List(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ k8smetav1.ListOptions) (*v1.KubeVirtList, error) {
+			return kubecli.NewKubeVirtList(*kv), nil
+		}).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().KubeVirt(k8smetav1.NamespaceAll).Return(kvInterface).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().KubeVirt(installNamespace).Return(kvInterface).AnyTimes()
+	})
+
+	expectPatch := func() {
+		kvInterface.EXPECT().Patch(context.Background(), installName, types.JSONPatchType, gomock.Any(), gomock.Any()).DoAndReturn(
+			func(_ context.Context, _ string, _ types.PatchType, patchData []byte, _ k8smetav1.PatchOptions, _ ...string) (*v1.KubeVirt, error) {
+				patch, err := jsonpatch.DecodePatch(patchData)
+				Expect(err).ToNot(HaveOccurred())
+				kvJson, err := json.Marshal(kv)
+				Expect(err).ToNot(HaveOccurred())
+				kvJson, err = patch.Apply(kvJson)
+				Expect(err).ToNot(HaveOccurred())
+				kv = &v1.KubeVirt{}
+				Expect(json.Unmarshal(kvJson, kv)).To(Succeed())
+				return kv, nil
+			}).Times(1)
+	}
+
+	It("should set rollbackTo to the previous deployment", func() {
+		expectPatch()
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "rollback")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("from version v1.1.0 to version v1.0.0"))
+		Expect(kv.Spec.RollbackTo).To(Equal(previousID))
+	})
+
+	It("should remove rollbackTo when the rollback is canceled", func() {
+		kv.Spec.RollbackTo = previousID
+		expectPatch()
+
+		_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "rollback", "--cancel")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kv.Spec.RollbackTo).To(BeEmpty())
+	})
+
+	It("should fail without a previous deployment", func() {
+		kv.Status.PreviousDeployment = nil
+
+		_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "rollback")()
+		Expect(err).To(MatchError(ContainSubstring("no previous deployment")))
+	})
+})
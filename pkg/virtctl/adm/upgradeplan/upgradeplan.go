@@ -0,0 +1,171 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package upgradeplan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+type command struct {
+	output string
+}
+
+func NewCommand() *cobra.Command {
+	c := command{}
+	cmd := &cobra.Command{
+		Use:   "upgrade-plan",
+		Short: "Show the changes a held KubeVirt update would apply.",
+		Long: `Shows the plan virt-operator computed for an update which is held by the ` + v1.KubeVirtUpgradeDryRunAnnotation + ` annotation on the KubeVirt CR.
+The plan lists the objects which would be created, updated or deleted and the VMIs the workload updater would live migrate or evict.
+Remove the annotation to roll out the update.`,
+		Example: usage(),
+		Args:    cobra.NoArgs,
+		RunE:    c.run,
+	}
+
+	cmd.Flags().StringVarP(&c.output, "output", "o", outputText, "Output format, one of text or json.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # Hold the next update and let virt-operator compute what it would change:
+  kubectl annotate kubevirt -n kubevirt kubevirt ` + v1.KubeVirtUpgradeDryRunAnnotation + `=true
+
+  # Show the plan:
+  {{ProgramName}} adm upgrade-plan
+
+  # Show the plan including all changed fields as JSON:
+  {{ProgramName}} adm upgrade-plan -o json`
+}
+
+func (c *command) run(cmd *cobra.Command, _ []string) error {
+	if c.output != outputText && c.output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, must be %s or %s", c.output, outputText, outputJSON)
+	}
+
+	virtClient, _, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+	kv, err := detectInstallation(virtClient)
+	if err != nil {
+		return err
+	}
+
+	configMap, err := virtClient.CoreV1().ConfigMaps(kv.Namespace).Get(context.Background(), util.UpgradePlanConfigMapName, k8smetav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("could not get the upgrade plan, ensure an update is pending and held by the %s annotation on KubeVirt %s/%s: %v",
+			v1.KubeVirtUpgradeDryRunAnnotation, kv.Namespace, kv.Name, err)
+	}
+
+	if c.output == outputJSON {
+		fmt.Fprintln(cmd.OutOrStdout(), configMap.Data[util.UpgradePlanConfigMapKey])
+		return nil
+	}
+
+	plan := &util.UpgradePlan{}
+	if err := json.Unmarshal([]byte(configMap.Data[util.UpgradePlanConfigMapKey]), plan); err != nil {
+		return fmt.Errorf("could not decode the upgrade plan: %v", err)
+	}
+	if plan.TargetDeploymentID != kv.Status.TargetDeploymentID {
+		cmd.PrintErrln("The upgrade plan does not match the current target of KubeVirt, virt-operator did not update it yet")
+	}
+	printPlan(cmd.OutOrStdout(), plan)
+
+	return nil
+}
+
+func detectInstallation(virtClient kubecli.KubevirtClient) (*v1.KubeVirt, error) {
+	kvs, err := virtClient.KubeVirt(k8smetav1.NamespaceAll).List(context.Background(), k8smetav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("could not list KubeVirt CRs across all namespaces: %v", err)
+	}
+	if len(kvs.Items) == 0 {
+		return nil, errors.New("could not detect a KubeVirt installation")
+	}
+	if len(kvs.Items) > 1 {
+		return nil, errors.New("invalid kubevirt installation, more than one KubeVirt resource found")
+	}
+	return &kvs.Items[0], nil
+}
+
+func printPlan(out io.Writer, plan *util.UpgradePlan) {
+	fmt.Fprintf(out, "Update from version %s (%s) to version %s (%s)\n",
+		plan.ObservedKubeVirtVersion, plan.ObservedKubeVirtRegistry, plan.TargetKubeVirtVersion, plan.TargetKubeVirtRegistry)
+
+	fmt.Fprintln(out, "\nObjects:")
+	if len(plan.Objects) == 0 {
+		fmt.Fprintln(out, "  none")
+	}
+	for _, obj := range plan.Objects {
+		name := obj.Name
+		if obj.Namespace != "" {
+			name = obj.Namespace + "/" + obj.Name
+		}
+		fmt.Fprintf(out, "  %-7s %s %s\n", obj.Action, obj.Kind, name)
+		for _, change := range obj.Changes {
+			fmt.Fprintf(out, "          %s: %s -> %s\n", change.Path, formatValue(change.Current), formatValue(change.Desired))
+		}
+	}
+
+	fmt.Fprintf(out, "\nWorkloads not using virt-launcher image %s:\n", plan.TargetLauncherImage)
+	if len(plan.Workloads) == 0 {
+		fmt.Fprintln(out, "  none")
+	}
+	for _, workload := range plan.Workloads {
+		fmt.Fprintf(out, "  %-11s %s/%s on node %s\n", workload.Action, workload.Namespace, workload.Name, workload.NodeName)
+	}
+
+	if plan.Truncated {
+		fmt.Fprintf(out, "\nThe plan was truncated to fit into a ConfigMap: field changes, %d objects and %d workloads are not shown\n",
+			plan.OmittedObjects, plan.OmittedWorkloads)
+	}
+}
+
+func formatValue(value interface{}) string {
+	if value == nil {
+		return "<unset>"
+	}
+	valueBytes, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(valueBytes)
+}
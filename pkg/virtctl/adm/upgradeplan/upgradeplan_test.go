@@ -0,0 +1,137 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package upgradeplan_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virt-operator/util"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Upgrade plan", func() {
+	const (
+		installNamespace = "kubevirt"
+		installName      = "kubevirt"
+		deploymentID     = "target-id"
+	)
+
+	var kubeClient *fake.Clientset
+
+	plan := &util.UpgradePlan{
+		ObservedKubeVirtVersion:  "v1.0.0",
+		ObservedKubeVirtRegistry: "registry",
+		TargetKubeVirtVersion:    "v1.1.0",
+		TargetKubeVirtRegistry:   "registry",
+		TargetDeploymentID:       deploymentID,
+		TargetLauncherImage:      "registry/virt-launcher:v1.1.0",
+		Objects: []util.UpgradePlanObject{
+			{
+				Kind:      "Deployment",
+				Namespace: installNamespace,
+				Name:      "virt-api",
+				Action:    util.UpgradePlanActionUpdate,
+				Changes: []util.UpgradePlanFieldChange{{
+					Path:    "/spec/template/spec/containers/0/image",
+					Current: "registry/virt-api:v1.0.0",
+					Desired: "registry/virt-api:v1.1.0",
+				}},
+			},
+			{Kind: "ClusterRole", Name: "kubevirt.io:obsolete", Action: util.UpgradePlanActionDelete},
+		},
+		Workloads: []util.UpgradePlanWorkload{{
+			Namespace:     k8smetav1.NamespaceDefault,
+			Name:          "testvmi",
+			NodeName:      "node01",
+			LauncherImage: "registry/virt-launcher:v1.0.0",
+			Action:        util.UpgradePlanActionLiveMigrate,
+		}},
+	}
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		kv := v1.KubeVirt{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: installName, Namespace: installNamespace},
+			Status:     v1.KubeVirtStatus{TargetDeploymentID: deploymentID},
+		}
+		kvInterface := kubecli.NewMockKubeVirtInterface(ctrl)
+		kvInterface.EXPECT().List(gomock.Any(), gomock.Any()).Return(kubecli.NewKubeVirtList(kv), nil).AnyTimes()
+		kubecli.MockKubevirtClientInstance.EXPECT().KubeVirt(k8smetav1.NamespaceAll).Return(kvInterface).AnyTimes()
+
+		kubeClient = fake.NewSimpleClientset()
+		kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+	})
+
+	addPlan := func() {
+		planBytes, err := json.Marshal(plan)
+		Expect(err).ToNot(HaveOccurred())
+		_, err = kubeClient.CoreV1().ConfigMaps(installNamespace).Create(context.Background(), &k8sv1.ConfigMap{
+			ObjectMeta: k8smetav1.ObjectMeta{Name: util.UpgradePlanConfigMapName, Namespace: installNamespace},
+			Data:       map[string]string{util.UpgradePlanConfigMapKey: string(planBytes)},
+		}, k8smetav1.CreateOptions{})
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	It("should fail when no plan was generated", func() {
+		_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "upgrade-plan")()
+		Expect(err).To(MatchError(ContainSubstring(v1.KubeVirtUpgradeDryRunAnnotation)))
+	})
+
+	It("should fail with an unsupported output format", func() {
+		_, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "upgrade-plan", "-o", "yaml")()
+		Expect(err).To(MatchError(ContainSubstring("unsupported output format")))
+	})
+
+	It("should print the plan", func() {
+		addPlan()
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "upgrade-plan")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(ContainSubstring("Update from version v1.0.0 (registry) to version v1.1.0 (registry)"))
+		Expect(string(out)).To(ContainSubstring("Update  Deployment kubevirt/virt-api"))
+		Expect(string(out)).To(ContainSubstring(`/spec/template/spec/containers/0/image: "registry/virt-api:v1.0.0" -> "registry/virt-api:v1.1.0"`))
+		Expect(string(out)).To(ContainSubstring("Delete  ClusterRole kubevirt.io:obsolete"))
+		Expect(string(out)).To(ContainSubstring("LiveMigrate default/testvmi on node node01"))
+	})
+
+	It("should print the plan as JSON", func() {
+		addPlan()
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut("adm", "upgrade-plan", "-o", "json")()
+		Expect(err).ToNot(HaveOccurred())
+		printed := &util.UpgradePlan{}
+		Expect(json.Unmarshal(out, printed)).To(Succeed())
+		Expect(printed).To(Equal(plan))
+	})
+})
@@ -0,0 +1,256 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package efi
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	k8sv1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/apimachinery/patch"
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	outputFlag = "output"
+	fileFlag   = "file"
+	secretFlag = "secret"
+	forceFlag  = "force"
+
+	varsTemplatePath = "/spec/template/spec/domain/firmware/bootloader/efi/varsTemplate"
+)
+
+func newVarsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vars",
+		Short: "Dump or edit the EFI variable store of a virtual machine.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+
+	cmd.AddCommand(
+		newDumpCommand(),
+		newEditCommand(),
+	)
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+type dump struct {
+	output string
+}
+
+func newDumpCommand() *cobra.Command {
+	c := dump{}
+	cmd := &cobra.Command{
+		Use:   "dump (VM|VMI)",
+		Short: "Dump the EFI variable store of a running virtual machine to a file.",
+		Long: `Dump the EFI variable store of a running virtual machine to a file.
+
+The dump can be inspected and modified with tools like virt-fw-vars and written back with 'efi vars edit'.`,
+		Example: dumpUsage(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVarP(&c.output, outputFlag, "o", "", "File the EFI variable store is written to.")
+	if err := cmd.MarkFlagRequired(outputFlag); err != nil {
+		panic(err)
+	}
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func dumpUsage() string {
+	return `  # Dump the EFI variable store of the virtual machine 'testvm' to VARS.fd:
+  {{ProgramName}} efi vars dump vm/testvm --output VARS.fd
+
+  # Dump the EFI variable store of the virtual machine instance 'testvmi' in 'mynamespace':
+  {{ProgramName}} efi vars dump vmi/testvmi/mynamespace -o VARS.fd`
+}
+
+func (c *dump) run(cmd *cobra.Command, args []string) error {
+	// VMs and VMIs share their name, so both kinds are addressed through the VMI
+	_, namespace, name, err := portforward.ParseTarget(args[0])
+	if err != nil {
+		return err
+	}
+
+	virtClient, defaultNamespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	efiVars, err := virtClient.VirtualMachineInstance(namespace).EFIVars(cmd.Context(), name)
+	if err != nil {
+		return fmt.Errorf("error dumping the EFI variable store of VirtualMachineInstance %s: %v", name, err)
+	}
+
+	if err := os.WriteFile(c.output, efiVars.Contents, 0600); err != nil {
+		return err
+	}
+	cmd.Printf("Dumped %d bytes of the EFI variable store to %s\n", len(efiVars.Contents), c.output)
+	return nil
+}
+
+type edit struct {
+	file   string
+	secret string
+	force  bool
+}
+
+func newEditCommand() *cobra.Command {
+	c := edit{}
+	cmd := &cobra.Command{
+		Use:   "edit VM",
+		Short: "Replace the EFI variable store of a virtual machine with an edited one.",
+		Long: `Replace the EFI variable store of a virtual machine with an edited one.
+
+The variable store is written into a Secret owned by the virtual machine, which is referenced as the
+variable store template of the virtual machine. The persistent EFI variable store is initialized
+from it at the next start of the virtual machine, discarding all changes made since.
+A variable store template the virtual machine already references is only replaced with --force.`,
+		Example: editUsage(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.run,
+	}
+	cmd.Flags().StringVarP(&c.file, fileFlag, "f", "", "File with the edited EFI variable store.")
+	if err := cmd.MarkFlagRequired(fileFlag); err != nil {
+		panic(err)
+	}
+	if err := cmd.MarkFlagFilename(fileFlag); err != nil {
+		panic(err)
+	}
+	cmd.Flags().StringVar(&c.secret, secretFlag, "", "Name of the Secret holding the EFI variable store. Defaults to <vm>-efi-vars.")
+	cmd.Flags().BoolVar(&c.force, forceFlag, false, "Replace another variable store template of the virtual machine and update the Secret even if it is not owned by the virtual machine.")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func editUsage() string {
+	return `  # Enroll an additional certificate into the EFI variable store of the virtual machine 'testvm':
+  {{ProgramName}} efi vars dump vm/testvm --output VARS.fd
+  virt-fw-vars --input VARS.fd --output VARS.edited.fd --add-db <owner-guid> cert.pem
+  {{ProgramName}} efi vars edit testvm --file VARS.edited.fd
+  {{ProgramName}} restart testvm`
+}
+
+func (c *edit) run(cmd *cobra.Command, args []string) error {
+	vmName := args[0]
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+
+	// Reading the variable store before accessing the cluster
+	contents, err := os.ReadFile(c.file)
+	if err != nil {
+		return err
+	}
+
+	vm, err := virtClient.VirtualMachine(namespace).Get(cmd.Context(), vmName, metav1.GetOptions{})
+	if err != nil {
+		return fmt.Errorf("error getting virtual machine: %v", err)
+	}
+	if !isEFI(vm) {
+		return fmt.Errorf("virtual machine %s does not boot with EFI", vmName)
+	}
+
+	secretName := c.secret
+	if secretName == "" {
+		secretName = vmName + "-efi-vars"
+	}
+
+	varsTemplate := &v1.EFIVarsSource{
+		Secret: &k8sv1.LocalObjectReference{Name: secretName},
+	}
+	current := vm.Spec.Template.Spec.Domain.Firmware.Bootloader.EFI.VarsTemplate
+	if !c.force && current != nil && !equality.Semantic.DeepEqual(current, varsTemplate) {
+		return fmt.Errorf("virtual machine %s already has another EFI variable store template, use --%s to replace it", vmName, forceFlag)
+	}
+
+	secret, err := virtClient.CoreV1().Secrets(namespace).Get(cmd.Context(), secretName, metav1.GetOptions{})
+	switch {
+	case errors.IsNotFound(err):
+		_, err = virtClient.CoreV1().Secrets(namespace).Create(cmd.Context(), newVarsSecret(vm, secretName, contents), metav1.CreateOptions{})
+		if err != nil {
+			return fmt.Errorf("error creating secret: %v", err)
+		}
+	case err != nil:
+		return fmt.Errorf("error getting secret %s: %v", secretName, err)
+	default:
+		if !c.force && !metav1.IsControlledBy(secret, vm) {
+			return fmt.Errorf("secret %s is not owned by virtual machine %s, use --%s to update it anyway", secretName, vmName, forceFlag)
+		}
+		secret.Data = map[string][]byte{v1.EFIVarsTemplateKey: contents}
+		if _, err := virtClient.CoreV1().Secrets(namespace).Update(cmd.Context(), secret, metav1.UpdateOptions{}); err != nil {
+			return fmt.Errorf("error updating secret %s: %v", secretName, err)
+		}
+	}
+
+	varsTemplatePatch, err := patch.New(patch.WithAdd(varsTemplatePath, varsTemplate)).GeneratePayload()
+	if err != nil {
+		return err
+	}
+	if _, err := virtClient.VirtualMachine(namespace).Patch(cmd.Context(), vmName, types.JSONPatchType, varsTemplatePatch, metav1.PatchOptions{}); err != nil {
+		return fmt.Errorf("error patching virtual machine: %v", err)
+	}
+
+	cmd.Printf("The EFI variable store of virtual machine %s is replaced at its next start\n", vmName)
+	return nil
+}
+
+func isEFI(vm *v1.VirtualMachine) bool {
+	if vm.Spec.Template == nil {
+		return false
+	}
+	firmware := vm.Spec.Template.Spec.Domain.Firmware
+	return firmware != nil && firmware.Bootloader != nil && firmware.Bootloader.EFI != nil
+}
+
+func newVarsSecret(vm *v1.VirtualMachine, name string, contents []byte) *k8sv1.Secret {
+	return &k8sv1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: vm.Namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(vm, v1.VirtualMachineGroupVersionKind),
+			},
+		},
+		Data: map[string][]byte{
+			v1.EFIVarsTemplateKey: contents,
+		},
+	}
+}
@@ -0,0 +1,189 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package efi_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	k8sv1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+	kubevirtfake "kubevirt.io/client-go/kubevirt/fake"
+
+	"kubevirt.io/kubevirt/pkg/libvmi"
+	"kubevirt.io/kubevirt/pkg/virtctl/efi"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("EFI vars", func() {
+	const vmName = "testvm"
+
+	var (
+		ctrl     *gomock.Controller
+		tmpDir   string
+		varsFile string
+	)
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+
+		tmpDir = GinkgoT().TempDir()
+		varsFile = filepath.Join(tmpDir, "VARS.fd")
+	})
+
+	Context("dump", func() {
+		var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+		BeforeEach(func() {
+			vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		})
+
+		It("should write the EFI variable store to the output file", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance("mynamespace").Return(vmiInterface)
+			vmiInterface.EXPECT().EFIVars(gomock.Any(), vmName).Return(v1.EFIVars{Contents: []byte("vars")}, nil)
+
+			err := testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "dump", "vm/testvm/mynamespace", "--output", varsFile)()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(os.ReadFile(varsFile)).To(Equal([]byte("vars")))
+		})
+
+		It("should fail if the EFI variable store cannot be retrieved", func() {
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface)
+			vmiInterface.EXPECT().EFIVars(gomock.Any(), vmName).Return(v1.EFIVars{}, errors.New("VMI is not running"))
+
+			err := testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "dump", "vmi/testvm", "-o", varsFile)()
+			Expect(err).To(MatchError(ContainSubstring("VMI is not running")))
+			Expect(varsFile).ToNot(BeAnExistingFile())
+		})
+	})
+
+	Context("edit", func() {
+		var (
+			kubeClient *fake.Clientset
+			virtClient *kubevirtfake.Clientset
+			vm         *v1.VirtualMachine
+		)
+
+		createVM := func(opts ...libvmi.Option) {
+			opts = append(opts, libvmi.WithNamespace(metav1.NamespaceDefault), libvmi.WithName(vmName))
+			var err error
+			vm, err = virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Create(context.Background(), libvmi.NewVirtualMachine(libvmi.New(opts...)), metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		BeforeEach(func() {
+			kubeClient = fake.NewSimpleClientset()
+			virtClient = kubevirtfake.NewSimpleClientset()
+			kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachine(metav1.NamespaceDefault).
+				Return(virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault)).AnyTimes()
+			kubecli.MockKubevirtClientInstance.EXPECT().CoreV1().Return(kubeClient.CoreV1()).AnyTimes()
+
+			Expect(os.WriteFile(varsFile, []byte("edited"), 0600)).To(Succeed())
+		})
+
+		It("should create a secret and use it as the variable store template", func() {
+			createVM(libvmi.WithUefi(true))
+
+			err := testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "edit", vmName, "--file", varsFile)()
+			Expect(err).ToNot(HaveOccurred())
+
+			secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), vmName+"-efi-vars", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.Data).To(HaveKeyWithValue(v1.EFIVarsTemplateKey, []byte("edited")))
+			Expect(metav1.IsControlledBy(secret, vm)).To(BeTrue())
+
+			vm, err = virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Spec.Template.Spec.Domain.Firmware.Bootloader.EFI.VarsTemplate).To(Equal(&v1.EFIVarsSource{
+				Secret: &k8sv1.LocalObjectReference{Name: vmName + "-efi-vars"},
+			}))
+		})
+
+		It("should refuse to update a secret which is not owned by the virtual machine", func() {
+			createVM(libvmi.WithUefi(true))
+			_, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Create(context.Background(), &k8sv1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "shared-vars", Namespace: metav1.NamespaceDefault},
+			}, metav1.CreateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "edit", vmName, "--file", varsFile, "--secret", "shared-vars")()
+			Expect(err).To(MatchError(ContainSubstring("is not owned by virtual machine")))
+
+			err = testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "edit", vmName, "--file", varsFile, "--secret", "shared-vars", "--force")()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("should refuse to replace another variable store template", func() {
+			createVM(libvmi.WithUefi(true))
+			vm.Spec.Template.Spec.Domain.Firmware.Bootloader.EFI.VarsTemplate = &v1.EFIVarsSource{
+				ConfigMap: &k8sv1.LocalObjectReference{Name: "golden-vars"},
+			}
+			_, err := virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Update(context.Background(), vm, metav1.UpdateOptions{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "edit", vmName, "--file", varsFile)()
+			Expect(err).To(MatchError(ContainSubstring("already has another EFI variable store template")))
+			_, err = kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), vmName+"-efi-vars", metav1.GetOptions{})
+			Expect(err).To(MatchError(ContainSubstring("not found")))
+
+			err = testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "edit", vmName, "--file", varsFile, "--force")()
+			Expect(err).ToNot(HaveOccurred())
+
+			vm, err = virtClient.KubevirtV1().VirtualMachines(metav1.NamespaceDefault).Get(context.Background(), vmName, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vm.Spec.Template.Spec.Domain.Firmware.Bootloader.EFI.VarsTemplate).To(Equal(&v1.EFIVarsSource{
+				Secret: &k8sv1.LocalObjectReference{Name: vmName + "-efi-vars"},
+			}))
+		})
+
+		It("should update the variable store template it created before", func() {
+			createVM(libvmi.WithUefi(true))
+
+			err := testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "edit", vmName, "--file", varsFile)()
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(os.WriteFile(varsFile, []byte("edited again"), 0600)).To(Succeed())
+			err = testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "edit", vmName, "--file", varsFile)()
+			Expect(err).ToNot(HaveOccurred())
+
+			secret, err := kubeClient.CoreV1().Secrets(metav1.NamespaceDefault).Get(context.Background(), vmName+"-efi-vars", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secret.Data).To(HaveKeyWithValue(v1.EFIVarsTemplateKey, []byte("edited again")))
+		})
+
+		It("should fail if the virtual machine does not boot with EFI", func() {
+			createVM()
+
+			err := testing.NewRepeatableVirtctlCommand(efi.COMMAND_EFI, "vars", "edit", vmName, "--file", varsFile)()
+			Expect(err).To(MatchError(ContainSubstring("does not boot with EFI")))
+		})
+	})
+})
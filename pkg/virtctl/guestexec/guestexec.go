@@ -0,0 +1,118 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package guestexec
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/portforward"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const (
+	COMMAND_GUEST_EXEC = "guest-exec"
+
+	timeoutFlag = "timeout"
+)
+
+// ExitCodeError is returned when the command executed in the guest exited with a non-zero exit code.
+// virtctl exits with the same code instead of printing the error.
+type ExitCodeError struct {
+	ExitCode int
+}
+
+func (e *ExitCodeError) Error() string {
+	return fmt.Sprintf("command terminated with exit code %d", e.ExitCode)
+}
+
+type guestExec struct {
+	timeoutSeconds int32
+}
+
+func NewCommand() *cobra.Command {
+	c := guestExec{}
+	cmd := &cobra.Command{
+		Use:   "guest-exec (VM|VMI) -- COMMAND [ARGS...]",
+		Short: "Execute a command in a virtual machine through the guest agent.",
+		Long: `Execute a command in a virtual machine through the guest agent.
+
+The output of the command is printed while it runs. Guests without a POSIX shell at /bin/sh, like Windows,
+only report the output once the command exited.
+{{ProgramName}} exits with the exit code of the command.`,
+		Example: usage(),
+		Args:    cobra.MinimumNArgs(2),
+		RunE:    c.run,
+	}
+	cmd.Flags().Int32Var(&c.timeoutSeconds, timeoutFlag, v1.GuestExecDefaultTimeoutSeconds,
+		fmt.Sprintf("Seconds to wait for the command to exit, at most %d.", v1.GuestExecMaxTimeoutSeconds))
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `  # List the root directory of the virtual machine 'testvm':
+  {{ProgramName}} guest-exec vm/testvm -- ls -la /
+
+  # Query the network configuration of the virtual machine instance 'testvmi' in 'mynamespace':
+  {{ProgramName}} guest-exec vmi/testvmi/mynamespace -- ipconfig /all
+
+  # Execute a long running script with a timeout of two minutes:
+  {{ProgramName}} guest-exec --timeout=120 vm/testvm -- /usr/local/bin/backup.sh`
+}
+
+func (c *guestExec) run(cmd *cobra.Command, args []string) error {
+	if cmd.ArgsLenAtDash() != 1 {
+		return fmt.Errorf("the command to execute must be separated from the target by '--'")
+	}
+
+	// VMs and VMIs share their name, so both kinds are addressed through the VMI
+	_, namespace, name, err := portforward.ParseTarget(args[0])
+	if err != nil {
+		return err
+	}
+
+	virtClient, defaultNamespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return fmt.Errorf("cannot obtain KubeVirt client: %v", err)
+	}
+	if namespace == "" {
+		namespace = defaultNamespace
+	}
+
+	options := &v1.GuestExecOptions{
+		Command:        args[1],
+		Args:           args[2:],
+		TimeoutSeconds: &c.timeoutSeconds,
+	}
+	result, err := virtClient.VirtualMachineInstance(namespace).GuestExec(cmd.Context(), name, options, cmd.OutOrStdout(), cmd.ErrOrStderr())
+	if err != nil {
+		return fmt.Errorf("error executing command in VirtualMachineInstance %s: %v", name, err)
+	}
+
+	if result.ExitCode != 0 {
+		return &ExitCodeError{ExitCode: int(result.ExitCode)}
+	}
+	return nil
+}
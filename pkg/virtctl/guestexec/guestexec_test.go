@@ -0,0 +1,88 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package guestexec_test
+
+import (
+	"context"
+	"errors"
+	"io"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/pointer"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestexec"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("Guest exec", func() {
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	It("should require the command to be separated by '--'", func() {
+		err := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, "vm/testvm", "ls")()
+		Expect(err).To(MatchError(ContainSubstring("must be separated from the target by '--'")))
+	})
+
+	It("should print the output of the command", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance("mynamespace").Return(vmiInterface)
+		vmiInterface.EXPECT().GuestExec(gomock.Any(), "testvm", &v1.GuestExecOptions{
+			Command:        "ls",
+			Args:           []string{"-la", "/"},
+			TimeoutSeconds: pointer.P(int32(60)),
+		}, gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, _ string, _ *v1.GuestExecOptions, stdout, _ io.Writer) (v1.GuestExecResult, error) {
+			_, err := io.WriteString(stdout, "bin\netc\n")
+			return v1.GuestExecResult{Exited: true}, err
+		})
+
+		out, err := testing.NewRepeatableVirtctlCommandWithOut(guestexec.COMMAND_GUEST_EXEC, "--timeout=60", "vm/testvm/mynamespace", "--", "ls", "-la", "/")()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(out)).To(Equal("bin\netc\n"))
+	})
+
+	It("should return the exit code of the command", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface)
+		vmiInterface.EXPECT().GuestExec(gomock.Any(), "testvmi", gomock.Any(), gomock.Any(), gomock.Any()).Return(v1.GuestExecResult{Exited: true, ExitCode: 3}, nil)
+
+		err := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, "vmi/testvmi", "--", "false")()
+		var exitErr *guestexec.ExitCodeError
+		Expect(errors.As(err, &exitErr)).To(BeTrue())
+		Expect(exitErr.ExitCode).To(Equal(3))
+	})
+
+	It("should fail if the execution fails", func() {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface)
+		vmiInterface.EXPECT().GuestExec(gomock.Any(), "testvm", gomock.Any(), gomock.Any(), gomock.Any()).Return(v1.GuestExecResult{}, errors.New("guest agent not connected"))
+
+		err := testing.NewRepeatableVirtctlCommand(guestexec.COMMAND_GUEST_EXEC, "vm/testvm", "--", "ls")()
+		Expect(err).To(MatchError(ContainSubstring("guest agent not connected")))
+	})
+})
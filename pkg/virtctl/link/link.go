@@ -0,0 +1,116 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package link
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const COMMAND_LINK = "link"
+
+func NewCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   COMMAND_LINK,
+		Short: "Manage the link state of virtual machine interfaces.",
+		Run: func(cmd *cobra.Command, args []string) {
+			cmd.Print(cmd.UsageString())
+		},
+	}
+
+	cmd.AddCommand(newSetCommand())
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+type setCommand struct {
+	dryRun bool
+}
+
+func newSetCommand() *cobra.Command {
+	c := setCommand{}
+	cmd := &cobra.Command{
+		Use:   "set (VMI) (INTERFACE) (up|down)",
+		Short: "Set the link state of an interface of a running virtual machine.",
+		Long: `Sets the link state of an interface of a running virtual machine without restarting it.
+When the virtual machine instance is owned by a virtual machine, the state is stored in the virtual machine template
+and applied to the running instance from there. Only interfaces of the template can be changed in that case.`,
+		Args:    cobra.ExactArgs(3),
+		Example: setUsage(),
+		RunE:    c.run,
+	}
+
+	cmd.Flags().BoolVar(&c.dryRun, "dry-run", false, "--dry-run=false: Flag used to set whether to perform a dry run or not. If true the command will be executed without performing any changes.")
+
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func setUsage() string {
+	return `  # Take the link of interface 'red' of virtual machine 'myvm' down:
+  {{ProgramName}} vm link set myvm red down
+
+  # Bring it back up:
+  {{ProgramName}} vm link set myvm red up`
+}
+
+func (c *setCommand) run(cmd *cobra.Command, args []string) error {
+	vmiName, ifaceName := args[0], args[1]
+
+	var state v1.InterfaceState
+	switch strings.ToLower(args[2]) {
+	case string(v1.InterfaceStateLinkUp):
+		state = v1.InterfaceStateLinkUp
+	case string(v1.InterfaceStateLinkDown):
+		state = v1.InterfaceStateLinkDown
+	default:
+		return fmt.Errorf("invalid link state %q, must be %q or %q", args[2], v1.InterfaceStateLinkUp, v1.InterfaceStateLinkDown)
+	}
+
+	virtClient, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	opts := &v1.SetInterfaceLinkStateOptions{
+		InterfaceName: ifaceName,
+		State:         state,
+	}
+	if c.dryRun {
+		cmd.Println("Dry Run execution")
+		opts.DryRun = []string{metav1.DryRunAll}
+	}
+
+	if err := virtClient.VirtualMachineInstance(namespace).SetInterfaceLinkState(cmd.Context(), vmiName, opts); err != nil {
+		return fmt.Errorf("error setting link state of interface %s of VMI %s: %v", ifaceName, vmiName, err)
+	}
+
+	cmd.Printf("Link state of interface %s of VMI %s was set to %s\n", ifaceName, vmiName, state)
+	return nil
+}
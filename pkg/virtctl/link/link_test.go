@@ -0,0 +1,74 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package link_test
+
+import (
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/link"
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+	"kubevirt.io/kubevirt/pkg/virtctl/vm"
+)
+
+var _ = Describe("Link state", func() {
+	const (
+		vmiName   = "testvmi"
+		ifaceName = "red"
+	)
+
+	var vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+	})
+
+	DescribeTable("should fail with invalid arguments", func(args ...string) {
+		cmd := testing.NewRepeatableVirtctlCommand(append([]string{vm.COMMAND_VM, link.COMMAND_LINK, "set"}, args...)...)
+		Expect(cmd()).To(HaveOccurred())
+	},
+		Entry("missing all arguments"),
+		Entry("missing the state", vmiName, ifaceName),
+		Entry("with an unknown state", vmiName, ifaceName, "sideways"),
+	)
+
+	DescribeTable("should set the link state", func(arg string, expected *v1.SetInterfaceLinkStateOptions, extraArgs ...string) {
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(metav1.NamespaceDefault).Return(vmiInterface).Times(1)
+		vmiInterface.EXPECT().SetInterfaceLinkState(gomock.Any(), vmiName, expected).Return(nil).Times(1)
+
+		args := append([]string{vm.COMMAND_VM, link.COMMAND_LINK, "set", vmiName, ifaceName, arg}, extraArgs...)
+		Expect(testing.NewRepeatableVirtctlCommand(args...)()).To(Succeed())
+	},
+		Entry("down", "down", &v1.SetInterfaceLinkStateOptions{InterfaceName: ifaceName, State: v1.InterfaceStateLinkDown}),
+		Entry("up", "up", &v1.SetInterfaceLinkStateOptions{InterfaceName: ifaceName, State: v1.InterfaceStateLinkUp}),
+		Entry("up in upper case", "UP", &v1.SetInterfaceLinkStateOptions{InterfaceName: ifaceName, State: v1.InterfaceStateLinkUp}),
+		Entry("with dry-run option", "down",
+			&v1.SetInterfaceLinkStateOptions{InterfaceName: ifaceName, State: v1.InterfaceStateLinkDown, DryRun: []string{metav1.DryRunAll}},
+			"--dry-run"),
+	)
+})
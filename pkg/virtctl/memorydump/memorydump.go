@@ -49,6 +49,7 @@ const (
 	AccessModeFlag   = "access-mode"
 	PortForwardFlag  = "port-forward"
 	FormatFlag       = "format"
+	DumpFormatFlag   = "dump-format"
 	LocalPortFlag    = "local-port"
 	OutputFileFlag   = "output"
 
@@ -65,6 +66,7 @@ var (
 	createClaim  bool
 	portForward  bool
 	format       string
+	dumpFormat   string
 	localPort    string
 	storageClass string
 	accessMode   string
@@ -83,6 +85,9 @@ func usageMemoryDump() string {
   #Create a PVC called 'memoryvolume' and dump the memory of a virtual machine instance called 'myvm' to it.
   {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --create-claim
 
+  #Dump memory of a virtual machine instance called 'myvm' in the kdump-compressed format, readable by crash.
+  {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --dump-format=kdump-zlib
+
   #Create and download memory dump to the given output file.
   {{ProgramName}} memory-dump get myvm --claim-name=memoryvolume --create-claim --output=memoryDump.dump.gz
 
@@ -113,6 +118,7 @@ func NewMemoryDumpCommand() *cobra.Command {
 	cmd.Flags().BoolVar(&createClaim, CreateClaimFlag, false, "Create the pvc that will conatin the memory dump")
 	cmd.Flags().BoolVar(&portForward, PortForwardFlag, false, "Configure and set port-forward in a random port to download the memory dump")
 	cmd.Flags().StringVar(&format, FormatFlag, "", "Specifies the format of the memory dump download (gzipped or raw).")
+	cmd.Flags().StringVar(&dumpFormat, DumpFormatFlag, "", "Specifies the format of the memory dump (raw, kdump-zlib, kdump-lzo, kdump-snappy or win-dmp).")
 	cmd.Flags().StringVar(&localPort, LocalPortFlag, "0", "Specify port for port-forward")
 	cmd.Flags().StringVar(&storageClass, StorageClassFlag, "", "The storage class for the PVC.")
 	cmd.Flags().StringVar(&accessMode, AccessModeFlag, "", "The access mode for the PVC.")
@@ -265,6 +271,7 @@ func createPVCforMemoryDump(namespace, vmName, claimName string, virtClient kube
 func createMemoryDump(namespace, vmName, claimName string, virtClient kubecli.KubevirtClient) error {
 	memoryDumpRequest := &v1.VirtualMachineMemoryDumpRequest{
 		ClaimName: claimName,
+		Format:    v1.MemoryDumpFormat(dumpFormat),
 	}
 
 	err := virtClient.VirtualMachine(namespace).MemoryDump(context.Background(), vmName, memoryDumpRequest)
@@ -147,6 +147,21 @@ var _ = Describe("MemoryDump", func() {
 		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
 	})
 
+	It("should call memory dump subresource with dump format", func() {
+		virtClient.PrependReactor("put", "virtualmachines/memorydump", func(action k8stesting.Action) (handled bool, ret runtime.Object, err error) {
+			putAction, ok := action.(kvtesting.PutAction[*v1.VirtualMachineMemoryDumpRequest])
+			Expect(ok).To(BeTrue())
+			Expect(putAction.GetOptions().Format).To(Equal(v1.MemoryDumpFormatWinDmp))
+			return true, nil, nil
+		})
+		err := runGetCmd(
+			setFlag(memorydump.ClaimNameFlag, pvcName),
+			setFlag(memorydump.DumpFormatFlag, string(v1.MemoryDumpFormatWinDmp)),
+		)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(kvtesting.FilterActions(&virtClient.Fake, "put", "virtualmachines", "memorydump")).To(HaveLen(1))
+	})
+
 	It("should call memory dump subresource without claim-name no create", func() {
 		expectVMEndpointMemoryDump("")
 		Expect(runGetCmd()).To(Succeed())
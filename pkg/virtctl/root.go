@@ -2,6 +2,7 @@ package virtctl
 
 import (
 	"context"
+	"errors"
 	"flag"
 	"fmt"
 	"os"
@@ -22,7 +23,9 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/console"
 	"kubevirt.io/kubevirt/pkg/virtctl/create"
 	"kubevirt.io/kubevirt/pkg/virtctl/credentials"
+	"kubevirt.io/kubevirt/pkg/virtctl/efi"
 	"kubevirt.io/kubevirt/pkg/virtctl/expose"
+	"kubevirt.io/kubevirt/pkg/virtctl/guestexec"
 	"kubevirt.io/kubevirt/pkg/virtctl/guestfs"
 	"kubevirt.io/kubevirt/pkg/virtctl/imageupload"
 	"kubevirt.io/kubevirt/pkg/virtctl/memorydump"
@@ -31,6 +34,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/reset"
 	"kubevirt.io/kubevirt/pkg/virtctl/scp"
 	"kubevirt.io/kubevirt/pkg/virtctl/softreboot"
+	"kubevirt.io/kubevirt/pkg/virtctl/spice"
 	"kubevirt.io/kubevirt/pkg/virtctl/ssh"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 	"kubevirt.io/kubevirt/pkg/virtctl/unpause"
@@ -105,9 +109,13 @@ func NewVirtctlCommandFn() *cobra.Command {
 		console.NewCommand(),
 		usbredir.NewCommand(),
 		vnc.NewCommand(),
+		spice.NewCommand(),
 		scp.NewCommand(),
+		guestexec.NewCommand(),
+		efi.NewCommand(),
 		ssh.NewCommand(),
 		portforward.NewCommand(),
+		vm.NewCommand(),
 		vm.NewStartCommand(),
 		vm.NewStopCommand(),
 		vm.NewRestartCommand(),
@@ -153,6 +161,10 @@ func Execute() int {
 	log.InitializeLogging(programName)
 	cmd := NewVirtctlCommand()
 	if err := cmd.Execute(); err != nil {
+		var exitCodeErr *guestexec.ExitCodeError
+		if errors.As(err, &exitCodeErr) {
+			return exitCodeErr.ExitCode
+		}
 		if versionErr := checkClientServerVersion(cmd.Context()); versionErr != nil {
 			cmd.PrintErrln(versionErr)
 		}
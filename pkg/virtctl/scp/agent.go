@@ -0,0 +1,96 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package scp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+)
+
+// agentSCP copies a single file from or to the guest using the guestfile subresources.
+// VMs and VMIs share their name, so both kinds are addressed through the VMI.
+func (o *SCP) agentSCP(ctx context.Context, local *LocalArgument, remote *RemoteArgument, toRemote bool, client kubecli.KubevirtClient) error {
+	if o.recursive {
+		return fmt.Errorf("'--%s' is not supported together with '--%s'", recursiveFlag, viaAgentFlag)
+	}
+	if o.preserve {
+		return fmt.Errorf("'--%s' is not supported together with '--%s'", preserveFlag, viaAgentFlag)
+	}
+
+	vmiClient := client.VirtualMachineInstance(remote.Namespace)
+	if toRemote {
+		contents, err := readLocalFile(local.Path)
+		if err != nil {
+			return err
+		}
+		return vmiClient.GuestFileWrite(ctx, remote.Name, &v1.GuestFile{
+			Path:     remote.Path,
+			Contents: contents,
+		})
+	}
+
+	localPath := local.Path
+	if info, err := os.Stat(localPath); err == nil && info.IsDir() {
+		localPath = filepath.Join(localPath, path.Base(strings.ReplaceAll(remote.Path, "\\", "/")))
+	} else if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed reading path %q: %v", localPath, err)
+	}
+
+	guestFile, err := vmiClient.GuestFileRead(ctx, remote.Name, remote.Path)
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(localPath, guestFile.Contents, 0o600)
+}
+
+func readLocalFile(localPath string) ([]byte, error) {
+	f, err := os.Open(localPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed reading path %q: %v", localPath, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("failed reading path %q: %v", localPath, err)
+	}
+	if info.IsDir() {
+		return nil, fmt.Errorf("local path %q is a directory, only single files can be copied with '--%s'", localPath, viaAgentFlag)
+	}
+
+	// Read one byte more than allowed to detect oversized files
+	contents, err := io.ReadAll(io.LimitReader(f, v1.GuestFileMaxSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed reading path %q: %v", localPath, err)
+	}
+	if len(contents) > v1.GuestFileMaxSize {
+		return nil, fmt.Errorf("local file %q exceeds the maximum size of %d bytes supported by '--%s'", localPath, v1.GuestFileMaxSize, viaAgentFlag)
+	}
+	return contents, nil
+}
@@ -0,0 +1,101 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package scp_test
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/golang/mock/gomock"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	v1 "kubevirt.io/api/core/v1"
+	"kubevirt.io/client-go/kubecli"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/testing"
+)
+
+var _ = Describe("SCP via guest agent", func() {
+	const (
+		vmiName   = "testvmi"
+		namespace = "mynamespace"
+	)
+
+	var (
+		vmiInterface *kubecli.MockVirtualMachineInstanceInterface
+		tmpDir       string
+	)
+
+	BeforeEach(func() {
+		ctrl := gomock.NewController(GinkgoT())
+		kubecli.GetKubevirtClientFromClientConfig = kubecli.GetMockKubevirtClientFromClientConfig
+		kubecli.MockKubevirtClientInstance = kubecli.NewMockKubevirtClient(ctrl)
+		vmiInterface = kubecli.NewMockVirtualMachineInstanceInterface(ctrl)
+		kubecli.MockKubevirtClientInstance.EXPECT().VirtualMachineInstance(namespace).Return(vmiInterface).AnyTimes()
+		tmpDir = GinkgoT().TempDir()
+	})
+
+	It("should copy a file to the guest", func() {
+		localPath := filepath.Join(tmpDir, "myfile.txt")
+		Expect(os.WriteFile(localPath, []byte("hello"), 0o600)).To(Succeed())
+
+		vmiInterface.EXPECT().GuestFileWrite(gomock.Any(), vmiName, &v1.GuestFile{
+			Path:     "/tmp/myfile.txt",
+			Contents: []byte("hello"),
+		}).Return(nil)
+
+		err := testing.NewRepeatableVirtctlCommand("scp", "--via-agent", localPath, "vmi/testvmi/mynamespace:/tmp/myfile.txt")()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	DescribeTable("should copy a file from the guest", func(target func() string, expectedPath func() string) {
+		vmiInterface.EXPECT().GuestFileRead(gomock.Any(), vmiName, "/tmp/myfile.txt").Return(v1.GuestFile{
+			Path:     "/tmp/myfile.txt",
+			Contents: []byte("hello"),
+		}, nil)
+
+		err := testing.NewRepeatableVirtctlCommand("scp", "--via-agent", "vmi/testvmi/mynamespace:/tmp/myfile.txt", target())()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(os.ReadFile(expectedPath())).To(Equal([]byte("hello")))
+	},
+		Entry("into a file",
+			func() string { return filepath.Join(tmpDir, "copy.txt") },
+			func() string { return filepath.Join(tmpDir, "copy.txt") },
+		),
+		Entry("into a directory",
+			func() string { return tmpDir },
+			func() string { return filepath.Join(tmpDir, "myfile.txt") },
+		),
+	)
+
+	It("should refuse to copy a file exceeding the maximum size", func() {
+		localPath := filepath.Join(tmpDir, "big.bin")
+		Expect(os.WriteFile(localPath, make([]byte, v1.GuestFileMaxSize+1), 0o600)).To(Succeed())
+
+		err := testing.NewRepeatableVirtctlCommand("scp", "--via-agent", localPath, "vmi/testvmi/mynamespace:/tmp/big.bin")()
+		Expect(err).To(MatchError(ContainSubstring("exceeds the maximum size")))
+	})
+
+	It("should refuse to copy recursively", func() {
+		err := testing.NewRepeatableVirtctlCommand("scp", "--via-agent", "--recursive", tmpDir, "vmi/testvmi/mynamespace:/tmp/dir")()
+		Expect(err).To(MatchError("'--recursive' is not supported together with '--via-agent'"))
+	})
+})
@@ -33,6 +33,7 @@ import (
 const (
 	recursiveFlag, recursiveFlagShort = "recursive", "r"
 	preserveFlag                      = "preserve"
+	viaAgentFlag                      = "via-agent"
 )
 
 func NewCommand() *cobra.Command {
@@ -54,6 +55,8 @@ func NewCommand() *cobra.Command {
 		"Recursively copy entire directories")
 	cmd.Flags().BoolVar(&c.preserve, preserveFlag, c.preserve,
 		"Preserves modification times, access times, and modes from the original file.")
+	cmd.Flags().BoolVar(&c.viaAgent, viaAgentFlag, c.viaAgent,
+		"Transfer a single file through the guest agent instead of SSH. The remote path has to be absolute and the file size is limited.")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	return cmd
 }
@@ -62,6 +65,7 @@ type SCP struct {
 	options   ssh.SSHOptions
 	recursive bool
 	preserve  bool
+	viaAgent  bool
 }
 
 func (o *SCP) Run(cmd *cobra.Command, args []string) error {
@@ -75,6 +79,10 @@ func (o *SCP) Run(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	if o.viaAgent {
+		return o.agentSCP(cmd.Context(), local, remote, toRemote, client)
+	}
+
 	if o.options.WrapLocalSSH {
 		clientArgs := o.buildSCPTarget(local, remote, toRemote)
 		return ssh.RunLocalClient(remote.Kind, remote.Namespace, remote.Name, &o.options, clientArgs)
@@ -128,7 +136,10 @@ func usage() string {
   {{ProgramName}} scp myfile.bin jdoe@vmi/testvmi.mynamespace:myfile.bin
 
   # Copy a file from the remote location to a local folder
-  {{ProgramName}} scp jdoe@vmi/testvmi:myfile.bin ~/myfile.bin`
+  {{ProgramName}} scp jdoe@vmi/testvmi:myfile.bin ~/myfile.bin
+
+  # Copy a file to 'testvmi' through the guest agent without requiring SSH access
+  {{ProgramName}} scp --via-agent myfile.txt vmi/testvmi:/tmp/myfile.txt`
 }
 
 func ParseTarget(source, destination string) (*LocalArgument, *RemoteArgument, bool, error) {
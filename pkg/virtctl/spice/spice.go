@@ -0,0 +1,199 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package spice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	kvcorev1 "kubevirt.io/client-go/kubevirt/typed/core/v1"
+	"kubevirt.io/client-go/log"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+const remoteViewer = "remote-viewer"
+
+type SPICE struct {
+	listenAddress string
+	proxyOnly     bool
+	port          int
+}
+
+func NewCommand() *cobra.Command {
+	log.InitializeLogging("spice")
+	c := SPICE{listenAddress: "127.0.0.1"}
+	cmd := &cobra.Command{
+		Use:     "spice (VMI)",
+		Short:   "Open a SPICE connection to a virtual machine instance.",
+		Example: usage(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    c.Run,
+	}
+	cmd.Flags().StringVar(&c.listenAddress, "address", c.listenAddress, "--address=127.0.0.1: Setting this will change the listening address of the SPICE proxy. Only used together with --proxy-only.")
+	cmd.Flags().BoolVar(&c.proxyOnly, "proxy-only", c.proxyOnly, "--proxy-only=false: Setting this true will run only the virtctl SPICE proxy and show the port where SPICE clients can connect")
+	cmd.Flags().IntVar(&c.port, "port", c.port,
+		"--port=0: Assigning a port value to this will try to run the proxy on the given port if the port is accessible; If unassigned, the proxy will run on a random port")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func (c *SPICE) Run(cmd *cobra.Command, args []string) error {
+	virtCli, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	vmi := args[0]
+	vmiClient := virtCli.VirtualMachineInstance(namespace)
+
+	// Fail early if the VMI is not reachable, the stream is used for the first client connection
+	firstStream, err := vmiClient.SPICE(vmi)
+	if err != nil {
+		return fmt.Errorf("Can't access VMI %s: %s", vmi, err.Error())
+	}
+
+	listenAddress := "127.0.0.1"
+	if c.proxyOnly {
+		listenAddress = c.listenAddress
+	}
+	lnAddr, err := net.ResolveTCPAddr("tcp", net.JoinHostPort(listenAddress, strconv.Itoa(c.port)))
+	if err != nil {
+		return fmt.Errorf("Can't resolve the address: %s", err.Error())
+	}
+	ln, err := net.ListenTCP("tcp", lnAddr)
+	if err != nil {
+		return fmt.Errorf("Can't listen on %s: %s", lnAddr, err.Error())
+	}
+	defer ln.Close()
+
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt)
+	defer cancel()
+
+	templates.PrintWarningForPausedVMI(virtCli, vmi, namespace)
+
+	proxyErr := make(chan error, 1)
+	go func() {
+		proxyErr <- proxySPICE(ln, firstStream, func() (kvcorev1.StreamInterface, error) {
+			return vmiClient.SPICE(vmi)
+		})
+	}()
+
+	port := ln.Addr().(*net.TCPAddr).Port
+	viewerErr := make(chan error, 1)
+	if c.proxyOnly {
+		optionString, err := json.Marshal(struct {
+			Port int `json:"port"`
+		}{port})
+		if err != nil {
+			return fmt.Errorf("Error encountered: %s", err.Error())
+		}
+		fmt.Fprintln(cmd.OutOrStdout(), string(optionString))
+	} else {
+		go func() {
+			viewerErr <- runRemoteViewer(ctx, port)
+		}()
+	}
+
+	select {
+	case <-ctx.Done():
+	case err = <-proxyErr:
+	case err = <-viewerErr:
+	}
+
+	if err != nil {
+		return fmt.Errorf("Error encountered: %s", err.Error())
+	}
+	return nil
+}
+
+// proxySPICE forwards every connection accepted on the listener through its own stream to
+// the VMI. A SPICE client opens a separate connection for each channel of a session, which
+// requires a dedicated websocket per connection.
+func proxySPICE(ln *net.TCPListener, firstStream kvcorev1.StreamInterface, newStream func() (kvcorev1.StreamInterface, error)) error {
+	stream := firstStream
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if errors.Is(err, net.ErrClosed) {
+				return nil
+			}
+			return fmt.Errorf("failed to accept SPICE client connection: %v", err)
+		}
+
+		if stream == nil {
+			if stream, err = newStream(); err != nil {
+				conn.Close()
+				return fmt.Errorf("failed to open SPICE stream: %v", err)
+			}
+		}
+
+		go func(conn net.Conn, stream kvcorev1.StreamInterface) {
+			defer conn.Close()
+			if err := stream.Stream(kvcorev1.StreamOptions{In: conn, Out: conn}); err != nil {
+				log.Log.V(2).Infof("SPICE stream closed: %v", err)
+			}
+		}(conn, stream)
+		stream = nil
+	}
+}
+
+func runRemoteViewer(ctx context.Context, port int) error {
+	if _, err := exec.LookPath(remoteViewer); err != nil {
+		return fmt.Errorf("could not find %s binary in $PATH", remoteViewer)
+	}
+
+	args := remoteViewerArgs(port)
+	log.Log.V(4).Infof("Executing commandline: '%s %v'", remoteViewer, args)
+	// #nosec No risk for attacker injection. args include predefined strings
+	output, err := exec.CommandContext(ctx, remoteViewer, args...).CombinedOutput()
+	if err != nil {
+		log.Log.Errorf("%s execution failed: %v, output: %v", remoteViewer, err, string(output))
+		return err
+	}
+	log.Log.V(2).Infof("%v output: %v", remoteViewer, string(output))
+	return nil
+}
+
+func remoteViewerArgs(port int) (args []string) {
+	args = append(args, fmt.Sprintf("spice://127.0.0.1:%d", port))
+	if log.Log.Verbosity(4) {
+		args = append(args, "--debug")
+	}
+	return
+}
+
+func usage() string {
+	return `  # Connect to 'testvmi' via remote-viewer:
+   {{ProgramName}} spice testvmi
+
+  # Only run the proxy and connect a SPICE client manually:
+   {{ProgramName}} spice testvmi --proxy-only --port 5930`
+}
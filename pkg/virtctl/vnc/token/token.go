@@ -0,0 +1,85 @@
+/*
+ * This file is part of the KubeVirt project
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ *
+ * Copyright The KubeVirt Authors
+ *
+ */
+
+package token
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "kubevirt.io/api/core/v1"
+
+	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
+	"kubevirt.io/kubevirt/pkg/virtctl/templates"
+)
+
+func NewTokenCommand() *cobra.Command {
+	t := Token{}
+	cmd := &cobra.Command{
+		Use:     "token (VMI)",
+		Short:   "Request a short-lived token for browser based VNC access to a virtual machine instance.",
+		Example: usage(),
+		Args:    cobra.ExactArgs(1),
+		RunE:    t.Run,
+	}
+	cmd.Flags().DurationVar(&t.ttl, "ttl", 0, "how long the token can be used to open a connection, defaults to 5 minutes and may not exceed 1 hour")
+	cmd.SetUsageTemplate(templates.UsageTemplate())
+	return cmd
+}
+
+func usage() string {
+	return `   # Request a token for 'testvmi' and print the noVNC compatible websocket path, and the URL
+   # a browser can open without credentials if virt-api is exposed outside of the cluster:
+   {{ProgramName}} vnc token testvmi
+
+   # Request a token for 'testvmi' which can be used for the next 30 minutes:
+   {{ProgramName}} vnc token testvmi --ttl 30m`
+}
+
+type Token struct {
+	ttl time.Duration
+}
+
+func (t *Token) Run(cmd *cobra.Command, args []string) error {
+	virtCli, namespace, _, err := clientconfig.ClientAndNamespaceFromContext(cmd.Context())
+	if err != nil {
+		return err
+	}
+
+	opts := &v1.VNCTokenOptions{}
+	if cmd.Flags().Changed("ttl") {
+		opts.TTLDuration = &metav1.Duration{Duration: t.ttl}
+	}
+
+	vmi := args[0]
+	vncToken, err := virtCli.VirtualMachineInstance(namespace).VNCToken(cmd.Context(), vmi, opts)
+	if err != nil {
+		return fmt.Errorf("Can't request VNC token for VMI %s: %v", vmi, err)
+	}
+
+	if vncToken.URL != "" {
+		fmt.Fprintf(cmd.OutOrStdout(), "URL: %s\n", vncToken.URL)
+	}
+	fmt.Fprintf(cmd.OutOrStdout(), "Path: %s\n", vncToken.Path)
+	fmt.Fprintf(cmd.OutOrStdout(), "Expires: %s\n", vncToken.ExpirationTimestamp.UTC().Format(time.RFC3339))
+	return nil
+}
@@ -40,6 +40,7 @@ import (
 	"kubevirt.io/kubevirt/pkg/virtctl/clientconfig"
 	"kubevirt.io/kubevirt/pkg/virtctl/templates"
 	"kubevirt.io/kubevirt/pkg/virtctl/vnc/screenshot"
+	"kubevirt.io/kubevirt/pkg/virtctl/vnc/token"
 )
 
 const (
@@ -83,6 +84,7 @@ func NewCommand() *cobra.Command {
 		"--port=0: Assigning a port value to this will try to run the proxy on the given port if the port is accessible; If unassigned, the proxy will run on a random port")
 	cmd.SetUsageTemplate(templates.UsageTemplate())
 	cmd.AddCommand(screenshot.NewScreenshotCommand())
+	cmd.AddCommand(token.NewTokenCommand())
 	return cmd
 }
 
@@ -141,6 +141,11 @@ func (in *VirtualMachineCloneSpec) DeepCopyInto(out *VirtualMachineCloneSpec) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.PersistentState != nil {
+		in, out := &in.PersistentState, &out.PersistentState
+		*out = new(PersistentStatePolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -88,8 +88,24 @@ type VirtualMachineCloneSpec struct {
 	// be generated automatically.
 	// +optional
 	NewSMBiosSerial *string `json:"newSMBiosSerial,omitempty"`
+	// PersistentState selects what happens to the persistent TPM and EFI state of the source.
+	// Copy clones the state into the target, Regenerate lets the target start with empty state.
+	// Copying into the VM state storage class from another storage class requires CDI.
+	// If this field is not specified, sources with persistent state cannot be cloned.
+	// +optional
+	PersistentState *PersistentStatePolicy `json:"persistentState,omitempty"`
 }
 
+// PersistentStatePolicy selects how the persistent TPM and EFI state of a source is cloned
+type PersistentStatePolicy string
+
+const (
+	// PersistentStateCopy clones the persistent state of the source into the target
+	PersistentStateCopy PersistentStatePolicy = "Copy"
+	// PersistentStateRegenerate lets the target start with empty persistent state
+	PersistentStateRegenerate PersistentStatePolicy = "Regenerate"
+)
+
 type VirtualMachineClonePhase string
 
 const (
@@ -24,6 +24,7 @@ func (VirtualMachineCloneSpec) SwaggerDoc() map[string]string {
 		"template":          "For a detailed description, please refer to https://kubevirt.io/user-guide/operations/clone_api/#label-annotation-filters.\n+optional",
 		"newMacAddresses":   "NewMacAddresses manually sets that target interfaces' mac addresses. The key is the interface name and the\nvalue is the new mac address. If this field is not specified, a new MAC address will\nbe generated automatically, as for any interface that is not included in this map.\n+optional",
 		"newSMBiosSerial":   "NewSMBiosSerial manually sets that target's SMbios serial. If this field is not specified, a new serial will\nbe generated automatically.\n+optional",
+		"persistentState":   "PersistentState selects what happens to the persistent TPM and EFI state of the source.\nCopy clones the state into the target, Regenerate lets the target start with empty state.\nCopying into the VM state storage class from another storage class requires CDI.\nIf this field is not specified, sources with persistent state cannot be cloned.\n+optional",
 	}
 }
 
@@ -6,6 +6,7 @@ package v1
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // NodePlacement describes node scheduling configuration.
@@ -45,4 +46,41 @@ type ComponentConfig struct {
 	// WARNING: this is an advanced feature that prevents auto-scaling for core kubevirt components. Please use with caution!
 	//+optional
 	Replicas *uint8 `json:"replicas,omitempty"`
+	// components allows to tune the deployment of individual KubeVirt infrastructure
+	// components (virt-api, virt-controller and virt-exportproxy).
+	// Only supported on spec.infra.
+	// +listType=map
+	// +listMapKey=name
+	//+optional
+	Components []InfraComponentConfig `json:"components,omitempty"`
+}
+
+// InfraComponentConfig describes deployment settings of a single KubeVirt infrastructure component.
+type InfraComponentConfig struct {
+	// name of the component deployment, one of virt-api, virt-controller or virt-exportproxy.
+	Name string `json:"name"`
+	// topologySpreadConstraints describes how the pods of the component are spread across
+	// topology domains. If no labelSelector is set, the pods of the component are selected.
+	// +listType=atomic
+	//+optional
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint `json:"topologySpreadConstraints,omitempty"`
+	// podDisruptionBudget overrides the PodDisruptionBudget the operator creates for the component.
+	//+optional
+	PodDisruptionBudget *ComponentPodDisruptionBudget `json:"podDisruptionBudget,omitempty"`
+	// resources overrides the resource requests and limits of the component container.
+	// Only the given requests and limits are replaced, the remaining defaults are kept.
+	//+optional
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// ComponentPodDisruptionBudget describes the PodDisruptionBudget of an infrastructure component.
+// Only one of minAvailable and maxUnavailable may be set.
+type ComponentPodDisruptionBudget struct {
+	// minAvailable is the number or percentage of pods which must stay available.
+	// Defaults to the number of replicas minus one.
+	//+optional
+	MinAvailable *intstr.IntOrString `json:"minAvailable,omitempty"`
+	// maxUnavailable is the number or percentage of pods which may be unavailable.
+	//+optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
 }
@@ -26,6 +26,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
 	types "k8s.io/apimachinery/pkg/types"
+	intstr "k8s.io/apimachinery/pkg/util/intstr"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -206,6 +207,33 @@ func (in *BIOS) DeepCopy() *BIOS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	out.Average = in.Average.DeepCopy()
+	if in.Peak != nil {
+		in, out := &in.Peak, &out.Peak
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Burst != nil {
+		in, out := &in.Burst, &out.Burst
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BlockSize) DeepCopyInto(out *BlockSize) {
 	*out = *in
@@ -368,6 +396,49 @@ func (in *CertConfig) DeepCopy() *CertConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerConfiguration) DeepCopyInto(out *CertManagerConfiguration) {
+	*out = *in
+	out.IssuerRef = in.IssuerRef
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.RenewBefore != nil {
+		in, out := &in.RenewBefore, &out.RenewBefore
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerConfiguration.
+func (in *CertManagerConfiguration) DeepCopy() *CertManagerConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CertManagerIssuerReference) DeepCopyInto(out *CertManagerIssuerReference) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CertManagerIssuerReference.
+func (in *CertManagerIssuerReference) DeepCopy() *CertManagerIssuerReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CertManagerIssuerReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Chassis) DeepCopyInto(out *Chassis) {
 	*out = *in
@@ -594,6 +665,13 @@ func (in *ComponentConfig) DeepCopyInto(out *ComponentConfig) {
 		*out = new(byte)
 		**out = **in
 	}
+	if in.Components != nil {
+		in, out := &in.Components, &out.Components
+		*out = make([]InfraComponentConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	return
 }
 
@@ -607,6 +685,32 @@ func (in *ComponentConfig) DeepCopy() *ComponentConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ComponentPodDisruptionBudget) DeepCopyInto(out *ComponentPodDisruptionBudget) {
+	*out = *in
+	if in.MinAvailable != nil {
+		in, out := &in.MinAvailable, &out.MinAvailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ComponentPodDisruptionBudget.
+func (in *ComponentPodDisruptionBudget) DeepCopy() *ComponentPodDisruptionBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(ComponentPodDisruptionBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigDriveSSHPublicKeyAccessCredentialPropagation) DeepCopyInto(out *ConfigDriveSSHPublicKeyAccessCredentialPropagation) {
 	*out = *in
@@ -1003,6 +1107,11 @@ func (in *Devices) DeepCopyInto(out *Devices) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Graphics != nil {
+		in, out := &in.Graphics, &out.Graphics
+		*out = new(GraphicsDevice)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.AutoattachSerialConsole != nil {
 		in, out := &in.AutoattachSerialConsole, &out.AutoattachSerialConsole
 		*out = new(bool)
@@ -1156,6 +1265,11 @@ func (in *Disk) DeepCopyInto(out *Disk) {
 		*out = new(DiskErrorPolicy)
 		**out = **in
 	}
+	if in.IOTune != nil {
+		in, out := &in.IOTune, &out.IOTune
+		*out = new(DiskIOTune)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1221,6 +1335,52 @@ func (in *DiskIOThreads) DeepCopy() *DiskIOThreads {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiskIOTune) DeepCopyInto(out *DiskIOTune) {
+	*out = *in
+	if in.TotalBytesPerSec != nil {
+		in, out := &in.TotalBytesPerSec, &out.TotalBytesPerSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.ReadBytesPerSec != nil {
+		in, out := &in.ReadBytesPerSec, &out.ReadBytesPerSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.WriteBytesPerSec != nil {
+		in, out := &in.WriteBytesPerSec, &out.WriteBytesPerSec
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.TotalIOPS != nil {
+		in, out := &in.TotalIOPS, &out.TotalIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.ReadIOPS != nil {
+		in, out := &in.ReadIOPS, &out.ReadIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.WriteIOPS != nil {
+		in, out := &in.WriteIOPS, &out.WriteIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiskIOTune.
+func (in *DiskIOTune) DeepCopy() *DiskIOTune {
+	if in == nil {
+		return nil
+	}
+	out := new(DiskIOTune)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiskTarget) DeepCopyInto(out *DiskTarget) {
 	*out = *in
@@ -1418,6 +1578,16 @@ func (in *EFI) DeepCopyInto(out *EFI) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SecureBootKeys != nil {
+		in, out := &in.SecureBootKeys, &out.SecureBootKeys
+		*out = new(EFIVarsSource)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VarsTemplate != nil {
+		in, out := &in.VarsTemplate, &out.VarsTemplate
+		*out = new(EFIVarsSource)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1431,6 +1601,62 @@ func (in *EFI) DeepCopy() *EFI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFIVars) DeepCopyInto(out *EFIVars) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Contents != nil {
+		in, out := &in.Contents, &out.Contents
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFIVars.
+func (in *EFIVars) DeepCopy() *EFIVars {
+	if in == nil {
+		return nil
+	}
+	out := new(EFIVars)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *EFIVars) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EFIVarsSource) DeepCopyInto(out *EFIVarsSource) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(corev1.LocalObjectReference)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EFIVarsSource.
+func (in *EFIVarsSource) DeepCopy() *EFIVarsSource {
+	if in == nil {
+		return nil
+	}
+	out := new(EFIVarsSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EmptyDiskSource) DeepCopyInto(out *EmptyDiskSource) {
 	*out = *in
@@ -1812,6 +2038,111 @@ func (in *Flags) DeepCopy() *Flags {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHookCommand) DeepCopyInto(out *FreezeHookCommand) {
+	*out = *in
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHookCommand.
+func (in *FreezeHookCommand) DeepCopy() *FreezeHookCommand {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHookCommand)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHookStepStatus) DeepCopyInto(out *FreezeHookStepStatus) {
+	*out = *in
+	if in.ExitCode != nil {
+		in, out := &in.ExitCode, &out.ExitCode
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHookStepStatus.
+func (in *FreezeHookStepStatus) DeepCopy() *FreezeHookStepStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHookStepStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHooks) DeepCopyInto(out *FreezeHooks) {
+	*out = *in
+	if in.PreFreeze != nil {
+		in, out := &in.PreFreeze, &out.PreFreeze
+		*out = new(FreezeHookCommand)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PostThaw != nil {
+		in, out := &in.PostThaw, &out.PostThaw
+		*out = new(FreezeHookCommand)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Mountpoints != nil {
+		in, out := &in.Mountpoints, &out.Mountpoints
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHooks.
+func (in *FreezeHooks) DeepCopy() *FreezeHooks {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHooks)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreezeHooksStatus) DeepCopyInto(out *FreezeHooksStatus) {
+	*out = *in
+	if in.Steps != nil {
+		in, out := &in.Steps, &out.Steps
+		*out = make([]FreezeHookStepStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreezeHooksStatus.
+func (in *FreezeHooksStatus) DeepCopy() *FreezeHooksStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FreezeHooksStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreezeUnfreezeTimeout) DeepCopyInto(out *FreezeUnfreezeTimeout) {
 	*out = *in
@@ -1870,6 +2201,32 @@ func (in *GenerationStatus) DeepCopy() *GenerationStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GraphicsDevice) DeepCopyInto(out *GraphicsDevice) {
+	*out = *in
+	if in.VNC != nil {
+		in, out := &in.VNC, &out.VNC
+		*out = new(VNCGraphics)
+		**out = **in
+	}
+	if in.SPICE != nil {
+		in, out := &in.SPICE, &out.SPICE
+		*out = new(SPICEGraphics)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GraphicsDevice.
+func (in *GraphicsDevice) DeepCopy() *GraphicsDevice {
+	if in == nil {
+		return nil
+	}
+	out := new(GraphicsDevice)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GuestAgentCommandInfo) DeepCopyInto(out *GuestAgentCommandInfo) {
 	*out = *in
@@ -1903,30 +2260,140 @@ func (in *GuestAgentPing) DeepCopy() *GuestAgentPing {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
+func (in *GuestExecOptions) DeepCopyInto(out *GuestExecOptions) {
 	*out = *in
-	if in.Enabled != nil {
-		in, out := &in.Enabled, &out.Enabled
-		*out = new(bool)
+	out.TypeMeta = in.TypeMeta
+	if in.Args != nil {
+		in, out := &in.Args, &out.Args
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
 		**out = **in
 	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPETTimer.
-func (in *HPETTimer) DeepCopy() *HPETTimer {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecOptions.
+func (in *GuestExecOptions) DeepCopy() *GuestExecOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(HPETTimer)
+	out := new(GuestExecOptions)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Handler) DeepCopyInto(out *Handler) {
-	*out = *in
-	if in.Exec != nil {
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GuestExecOptions) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestExecResult) DeepCopyInto(out *GuestExecResult) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestExecResult.
+func (in *GuestExecResult) DeepCopy() *GuestExecResult {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestExecResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GuestExecResult) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestFile) DeepCopyInto(out *GuestFile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	if in.Contents != nil {
+		in, out := &in.Contents, &out.Contents
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestFile.
+func (in *GuestFile) DeepCopy() *GuestFile {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GuestFile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GuestPanicStatus) DeepCopyInto(out *GuestPanicStatus) {
+	*out = *in
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GuestPanicStatus.
+func (in *GuestPanicStatus) DeepCopy() *GuestPanicStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GuestPanicStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HPETTimer) DeepCopyInto(out *HPETTimer) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HPETTimer.
+func (in *HPETTimer) DeepCopy() *HPETTimer {
+	if in == nil {
+		return nil
+	}
+	out := new(HPETTimer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Handler) DeepCopyInto(out *Handler) {
+	*out = *in
+	if in.Exec != nil {
 		in, out := &in.Exec, &out.Exec
 		*out = new(corev1.ExecAction)
 		(*in).DeepCopyInto(*out)
@@ -1946,6 +2413,11 @@ func (in *Handler) DeepCopyInto(out *Handler) {
 		*out = new(corev1.TCPSocketAction)
 		**out = **in
 	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(corev1.GRPCAction)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -1959,6 +2431,81 @@ func (in *Handler) DeepCopy() *Handler {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HandlerUpdateStatus) DeepCopyInto(out *HandlerUpdateStatus) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]HandlerUpdateWaveStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HandlerUpdateStatus.
+func (in *HandlerUpdateStatus) DeepCopy() *HandlerUpdateStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HandlerUpdateStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HandlerUpdateWave) DeepCopyInto(out *HandlerUpdateWave) {
+	*out = *in
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HandlerUpdateWave.
+func (in *HandlerUpdateWave) DeepCopy() *HandlerUpdateWave {
+	if in == nil {
+		return nil
+	}
+	out := new(HandlerUpdateWave)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HandlerUpdateWaveStatus) DeepCopyInto(out *HandlerUpdateWaveStatus) {
+	*out = *in
+	if in.Nodes != nil {
+		in, out := &in.Nodes, &out.Nodes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HandlerUpdateWaveStatus.
+func (in *HandlerUpdateWaveStatus) DeepCopy() *HandlerUpdateWaveStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HandlerUpdateWaveStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HostDevice) DeepCopyInto(out *HostDevice) {
 	*out = *in
@@ -2113,6 +2660,55 @@ func (in *I6300ESBWatchdog) DeepCopy() *I6300ESBWatchdog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ImportPersistentStateOptions) DeepCopyInto(out *ImportPersistentStateOptions) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImportPersistentStateOptions.
+func (in *ImportPersistentStateOptions) DeepCopy() *ImportPersistentStateOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(ImportPersistentStateOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InfraComponentConfig) DeepCopyInto(out *InfraComponentConfig) {
+	*out = *in
+	if in.TopologySpreadConstraints != nil {
+		in, out := &in.TopologySpreadConstraints, &out.TopologySpreadConstraints
+		*out = make([]corev1.TopologySpreadConstraint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PodDisruptionBudget != nil {
+		in, out := &in.PodDisruptionBudget, &out.PodDisruptionBudget
+		*out = new(ComponentPodDisruptionBudget)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InfraComponentConfig.
+func (in *InfraComponentConfig) DeepCopy() *InfraComponentConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(InfraComponentConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InitrdInfo) DeepCopyInto(out *InitrdInfo) {
 	*out = *in
@@ -2237,6 +2833,11 @@ func (in *Interface) DeepCopyInto(out *Interface) {
 		*out = new(DHCPOptions)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(InterfaceBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2250,6 +2851,32 @@ func (in *Interface) DeepCopy() *Interface {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InterfaceBandwidth) DeepCopyInto(out *InterfaceBandwidth) {
+	*out = *in
+	if in.Inbound != nil {
+		in, out := &in.Inbound, &out.Inbound
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Outbound != nil {
+		in, out := &in.Outbound, &out.Outbound
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InterfaceBandwidth.
+func (in *InterfaceBandwidth) DeepCopy() *InterfaceBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(InterfaceBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InterfaceBindingMethod) DeepCopyInto(out *InterfaceBindingMethod) {
 	*out = *in
@@ -2543,6 +3170,11 @@ func (in *KubeVirtCertificateRotateStrategy) DeepCopyInto(out *KubeVirtCertifica
 		*out = new(KubeVirtSelfSignConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.External != nil {
+		in, out := &in.External, &out.External
+		*out = new(KubeVirtExternalCertificateConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2726,6 +3358,16 @@ func (in *KubeVirtConfiguration) DeepCopyInto(out *KubeVirtConfiguration) {
 		*out = new(InstancetypeConfiguration)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamespaceConfiguration != nil {
+		in, out := &in.NamespaceConfiguration, &out.NamespaceConfiguration
+		*out = new(NamespaceConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.VNCTokenAccess != nil {
+		in, out := &in.VNCTokenAccess, &out.VNCTokenAccess
+		*out = new(VNCTokenAccessConfiguration)
+		**out = **in
+	}
 	return
 }
 
@@ -2739,6 +3381,65 @@ func (in *KubeVirtConfiguration) DeepCopy() *KubeVirtConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtExternalCertificateConfiguration) DeepCopyInto(out *KubeVirtExternalCertificateConfiguration) {
+	*out = *in
+	if in.CertManager != nil {
+		in, out := &in.CertManager, &out.CertManager
+		*out = new(CertManagerConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiryWarningThreshold != nil {
+		in, out := &in.ExpiryWarningThreshold, &out.ExpiryWarningThreshold
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtExternalCertificateConfiguration.
+func (in *KubeVirtExternalCertificateConfiguration) DeepCopy() *KubeVirtExternalCertificateConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtExternalCertificateConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtHandlerUpdateStrategy) DeepCopyInto(out *KubeVirtHandlerUpdateStrategy) {
+	*out = *in
+	if in.Waves != nil {
+		in, out := &in.Waves, &out.Waves
+		*out = make([]HandlerUpdateWave, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PauseBetweenWaves != nil {
+		in, out := &in.PauseBetweenWaves, &out.PauseBetweenWaves
+		*out = new(metav1.Duration)
+		**out = **in
+	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtHandlerUpdateStrategy.
+func (in *KubeVirtHandlerUpdateStrategy) DeepCopy() *KubeVirtHandlerUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtHandlerUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtList) DeepCopyInto(out *KubeVirtList) {
 	*out = *in
@@ -2772,6 +3473,156 @@ func (in *KubeVirtList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtNamespaceConfig) DeepCopyInto(out *KubeVirtNamespaceConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtNamespaceConfig.
+func (in *KubeVirtNamespaceConfig) DeepCopy() *KubeVirtNamespaceConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtNamespaceConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubeVirtNamespaceConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtNamespaceConfigList) DeepCopyInto(out *KubeVirtNamespaceConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubeVirtNamespaceConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtNamespaceConfigList.
+func (in *KubeVirtNamespaceConfigList) DeepCopy() *KubeVirtNamespaceConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtNamespaceConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubeVirtNamespaceConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtNamespaceConfigSpec) DeepCopyInto(out *KubeVirtNamespaceConfigSpec) {
+	*out = *in
+	if in.EvictionStrategy != nil {
+		in, out := &in.EvictionStrategy, &out.EvictionStrategy
+		*out = new(EvictionStrategy)
+		**out = **in
+	}
+	if in.MigrationConfiguration != nil {
+		in, out := &in.MigrationConfiguration, &out.MigrationConfiguration
+		*out = new(MigrationConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NetworkConfiguration != nil {
+		in, out := &in.NetworkConfiguration, &out.NetworkConfiguration
+		*out = new(NetworkConfiguration)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SMBIOSConfig != nil {
+		in, out := &in.SMBIOSConfig, &out.SMBIOSConfig
+		*out = new(SMBiosConfiguration)
+		**out = **in
+	}
+	if in.SupportContainerResources != nil {
+		in, out := &in.SupportContainerResources, &out.SupportContainerResources
+		*out = make([]SupportContainerResources, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VirtualMachineOptions != nil {
+		in, out := &in.VirtualMachineOptions, &out.VirtualMachineOptions
+		*out = new(VirtualMachineOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtNamespaceConfigSpec.
+func (in *KubeVirtNamespaceConfigSpec) DeepCopy() *KubeVirtNamespaceConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtNamespaceConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtNetworkPolicies) DeepCopyInto(out *KubeVirtNetworkPolicies) {
+	*out = *in
+	if in.MonitoringNamespaceSelector != nil {
+		in, out := &in.MonitoringNamespaceSelector, &out.MonitoringNamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtNetworkPolicies.
+func (in *KubeVirtNetworkPolicies) DeepCopy() *KubeVirtNetworkPolicies {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtNetworkPolicies)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeVirtPreviousDeployment) DeepCopyInto(out *KubeVirtPreviousDeployment) {
+	*out = *in
+	if in.Generations != nil {
+		in, out := &in.Generations, &out.Generations
+		*out = make([]GenerationStatus, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeVirtPreviousDeployment.
+func (in *KubeVirtPreviousDeployment) DeepCopy() *KubeVirtPreviousDeployment {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeVirtPreviousDeployment)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *KubeVirtSelfSignConfiguration) DeepCopyInto(out *KubeVirtSelfSignConfiguration) {
 	*out = *in
@@ -2822,6 +3673,11 @@ func (in *KubeVirtSpec) DeepCopyInto(out *KubeVirtSpec) {
 		copy(*out, *in)
 	}
 	in.WorkloadUpdateStrategy.DeepCopyInto(&out.WorkloadUpdateStrategy)
+	if in.HandlerUpdateStrategy != nil {
+		in, out := &in.HandlerUpdateStrategy, &out.HandlerUpdateStrategy
+		*out = new(KubeVirtHandlerUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 	in.CertificateRotationStrategy.DeepCopyInto(&out.CertificateRotationStrategy)
 	in.Configuration.DeepCopyInto(&out.Configuration)
 	if in.Infra != nil {
@@ -2835,6 +3691,11 @@ func (in *KubeVirtSpec) DeepCopyInto(out *KubeVirtSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.CustomizeComponents.DeepCopyInto(&out.CustomizeComponents)
+	if in.NetworkPolicies != nil {
+		in, out := &in.NetworkPolicies, &out.NetworkPolicies
+		*out = new(KubeVirtNetworkPolicies)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -2873,6 +3734,16 @@ func (in *KubeVirtStatus) DeepCopyInto(out *KubeVirtStatus) {
 		*out = make([]GenerationStatus, len(*in))
 		copy(*out, *in)
 	}
+	if in.HandlerUpdate != nil {
+		in, out := &in.HandlerUpdate, &out.HandlerUpdate
+		*out = new(HandlerUpdateStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PreviousDeployment != nil {
+		in, out := &in.PreviousDeployment, &out.PreviousDeployment
+		*out = new(KubeVirtPreviousDeployment)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -3310,6 +4181,27 @@ func (in *NUMAGuestMappingPassthrough) DeepCopy() *NUMAGuestMappingPassthrough {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceConfiguration) DeepCopyInto(out *NamespaceConfiguration) {
+	*out = *in
+	if in.AllowedOverrides != nil {
+		in, out := &in.AllowedOverrides, &out.AllowedOverrides
+		*out = make([]NamespaceConfigOverride, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceConfiguration.
+func (in *NamespaceConfiguration) DeepCopy() *NamespaceConfiguration {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceConfiguration)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Network) DeepCopyInto(out *Network) {
 	*out = *in
@@ -3491,6 +4383,22 @@ func (in *PITTimer) DeepCopy() *PITTimer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PanicPolicy) DeepCopyInto(out *PanicPolicy) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PanicPolicy.
+func (in *PanicPolicy) DeepCopy() *PanicPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PanicPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PauseOptions) DeepCopyInto(out *PauseOptions) {
 	*out = *in
@@ -4163,6 +5071,27 @@ func (in *SMBiosConfiguration) DeepCopy() *SMBiosConfiguration {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SPICEGraphics) DeepCopyInto(out *SPICEGraphics) {
+	*out = *in
+	if in.Heads != nil {
+		in, out := &in.Heads, &out.Heads
+		*out = new(uint32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SPICEGraphics.
+func (in *SPICEGraphics) DeepCopy() *SPICEGraphics {
+	if in == nil {
+		return nil
+	}
+	out := new(SPICEGraphics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SSHPublicKeyAccessCredential) DeepCopyInto(out *SSHPublicKeyAccessCredential) {
 	*out = *in
@@ -4307,6 +5236,27 @@ func (in *ServiceAccountVolumeSource) DeepCopy() *ServiceAccountVolumeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SetInterfaceLinkStateOptions) DeepCopyInto(out *SetInterfaceLinkStateOptions) {
+	*out = *in
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SetInterfaceLinkStateOptions.
+func (in *SetInterfaceLinkStateOptions) DeepCopy() *SetInterfaceLinkStateOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(SetInterfaceLinkStateOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SoundDevice) DeepCopyInto(out *SoundDevice) {
 	*out = *in
@@ -4743,28 +5693,107 @@ func (in *VGPUOptions) DeepCopyInto(out *VGPUOptions) {
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VGPUOptions.
-func (in *VGPUOptions) DeepCopy() *VGPUOptions {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VGPUOptions.
+func (in *VGPUOptions) DeepCopy() *VGPUOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(VGPUOptions)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VMISelector) DeepCopyInto(out *VMISelector) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMISelector.
+func (in *VMISelector) DeepCopy() *VMISelector {
+	if in == nil {
+		return nil
+	}
+	out := new(VMISelector)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VNCGraphics) DeepCopyInto(out *VNCGraphics) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VNCGraphics.
+func (in *VNCGraphics) DeepCopy() *VNCGraphics {
+	if in == nil {
+		return nil
+	}
+	out := new(VNCGraphics)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VNCToken) DeepCopyInto(out *VNCToken) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ExpirationTimestamp.DeepCopyInto(&out.ExpirationTimestamp)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VNCToken.
+func (in *VNCToken) DeepCopy() *VNCToken {
+	if in == nil {
+		return nil
+	}
+	out := new(VNCToken)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VNCToken) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VNCTokenAccessConfiguration) DeepCopyInto(out *VNCTokenAccessConfiguration) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VNCTokenAccessConfiguration.
+func (in *VNCTokenAccessConfiguration) DeepCopy() *VNCTokenAccessConfiguration {
 	if in == nil {
 		return nil
 	}
-	out := new(VGPUOptions)
+	out := new(VNCTokenAccessConfiguration)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *VMISelector) DeepCopyInto(out *VMISelector) {
+func (in *VNCTokenOptions) DeepCopyInto(out *VNCTokenOptions) {
 	*out = *in
+	if in.TTLDuration != nil {
+		in, out := &in.TTLDuration, &out.TTLDuration
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	return
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VMISelector.
-func (in *VMISelector) DeepCopy() *VMISelector {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VNCTokenOptions.
+func (in *VNCTokenOptions) DeepCopy() *VNCTokenOptions {
 	if in == nil {
 		return nil
 	}
-	out := new(VMISelector)
+	out := new(VNCTokenOptions)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -4991,6 +6020,11 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyInto(out *VirtualMachine
 		copy(*out, *in)
 	}
 	in.FSInfo.DeepCopyInto(&out.FSInfo)
+	if in.Inventory != nil {
+		in, out := &in.Inventory, &out.Inventory
+		*out = new(VirtualMachineInstanceGuestOSInventory)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -5012,6 +6046,74 @@ func (in *VirtualMachineInstanceGuestAgentInfo) DeepCopyObject() runtime.Object
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSCPU) DeepCopyInto(out *VirtualMachineInstanceGuestOSCPU) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSCPU.
+func (in *VirtualMachineInstanceGuestOSCPU) DeepCopy() *VirtualMachineInstanceGuestOSCPU {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSCPU)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSDiskStats) DeepCopyInto(out *VirtualMachineInstanceGuestOSDiskStats) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSDiskStats.
+func (in *VirtualMachineInstanceGuestOSDiskStats) DeepCopy() *VirtualMachineInstanceGuestOSDiskStats {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSDiskStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSDiskUsage) DeepCopyInto(out *VirtualMachineInstanceGuestOSDiskUsage) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSDiskUsage.
+func (in *VirtualMachineInstanceGuestOSDiskUsage) DeepCopy() *VirtualMachineInstanceGuestOSDiskUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSDiskUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSHotfix) DeepCopyInto(out *VirtualMachineInstanceGuestOSHotfix) {
+	*out = *in
+	if in.InstalledOn != nil {
+		in, out := &in.InstalledOn, &out.InstalledOn
+		*out = (*in).DeepCopy()
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSHotfix.
+func (in *VirtualMachineInstanceGuestOSHotfix) DeepCopy() *VirtualMachineInstanceGuestOSHotfix {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSHotfix)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSInfo) DeepCopyInto(out *VirtualMachineInstanceGuestOSInfo) {
 	*out = *in
@@ -5028,6 +6130,95 @@ func (in *VirtualMachineInstanceGuestOSInfo) DeepCopy() *VirtualMachineInstanceG
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSInventory) DeepCopyInto(out *VirtualMachineInstanceGuestOSInventory) {
+	*out = *in
+	if in.Load != nil {
+		in, out := &in.Load, &out.Load
+		*out = new(VirtualMachineInstanceGuestOSLoad)
+		**out = **in
+	}
+	if in.CPU != nil {
+		in, out := &in.CPU, &out.CPU
+		*out = new(VirtualMachineInstanceGuestOSCPU)
+		**out = **in
+	}
+	if in.Memory != nil {
+		in, out := &in.Memory, &out.Memory
+		*out = new(VirtualMachineInstanceGuestOSMemory)
+		**out = **in
+	}
+	if in.DiskStats != nil {
+		in, out := &in.DiskStats, &out.DiskStats
+		*out = make([]VirtualMachineInstanceGuestOSDiskStats, len(*in))
+		copy(*out, *in)
+	}
+	if in.DiskUsage != nil {
+		in, out := &in.DiskUsage, &out.DiskUsage
+		*out = make([]VirtualMachineInstanceGuestOSDiskUsage, len(*in))
+		copy(*out, *in)
+	}
+	if in.BootTime != nil {
+		in, out := &in.BootTime, &out.BootTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RebootPending != nil {
+		in, out := &in.RebootPending, &out.RebootPending
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Hotfixes != nil {
+		in, out := &in.Hotfixes, &out.Hotfixes
+		*out = make([]VirtualMachineInstanceGuestOSHotfix, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSInventory.
+func (in *VirtualMachineInstanceGuestOSInventory) DeepCopy() *VirtualMachineInstanceGuestOSInventory {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSInventory)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSLoad) DeepCopyInto(out *VirtualMachineInstanceGuestOSLoad) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSLoad.
+func (in *VirtualMachineInstanceGuestOSLoad) DeepCopy() *VirtualMachineInstanceGuestOSLoad {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSLoad)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineInstanceGuestOSMemory) DeepCopyInto(out *VirtualMachineInstanceGuestOSMemory) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineInstanceGuestOSMemory.
+func (in *VirtualMachineInstanceGuestOSMemory) DeepCopy() *VirtualMachineInstanceGuestOSMemory {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineInstanceGuestOSMemory)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineInstanceGuestOSUser) DeepCopyInto(out *VirtualMachineInstanceGuestOSUser) {
 	*out = *in
@@ -5648,6 +6839,11 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 		*out = new(Probe)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.StartupProbe != nil {
+		in, out := &in.StartupProbe, &out.StartupProbe
+		*out = new(Probe)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Networks != nil {
 		in, out := &in.Networks, &out.Networks
 		*out = make([]Network, len(*in))
@@ -5667,6 +6863,16 @@ func (in *VirtualMachineInstanceSpec) DeepCopyInto(out *VirtualMachineInstanceSp
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FreezeHooks != nil {
+		in, out := &in.FreezeHooks, &out.FreezeHooks
+		*out = new(FreezeHooks)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PanicPolicy != nil {
+		in, out := &in.PanicPolicy, &out.PanicPolicy
+		*out = new(PanicPolicy)
+		**out = **in
+	}
 	return
 }
 
@@ -5734,6 +6940,16 @@ func (in *VirtualMachineInstanceStatus) DeepCopyInto(out *VirtualMachineInstance
 		*out = new(KernelBootStatus)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.FreezeHooks != nil {
+		in, out := &in.FreezeHooks, &out.FreezeHooks
+		*out = new(FreezeHooksStatus)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GuestPanic != nil {
+		in, out := &in.GuestPanic, &out.GuestPanic
+		*out = new(GuestPanicStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TopologyHints != nil {
 		in, out := &in.TopologyHints, &out.TopologyHints
 		*out = new(TopologyHints)
@@ -5885,6 +7101,170 @@ func (in *VirtualMachineOptions) DeepCopy() *VirtualMachineOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachinePersistentState) DeepCopyInto(out *VirtualMachinePersistentState) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachinePersistentState.
+func (in *VirtualMachinePersistentState) DeepCopy() *VirtualMachinePersistentState {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachinePersistentState)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachinePersistentState) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuota) DeepCopyInto(out *VirtualMachineQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuota.
+func (in *VirtualMachineQuota) DeepCopy() *VirtualMachineQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaList) DeepCopyInto(out *VirtualMachineQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]VirtualMachineQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaList.
+func (in *VirtualMachineQuotaList) DeepCopy() *VirtualMachineQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *VirtualMachineQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaResources) DeepCopyInto(out *VirtualMachineQuotaResources) {
+	*out = *in
+	if in.RunningVMIs != nil {
+		in, out := &in.RunningVMIs, &out.RunningVMIs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.GuestMemory != nil {
+		in, out := &in.GuestMemory, &out.GuestMemory
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.VCPUs != nil {
+		in, out := &in.VCPUs, &out.VCPUs
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Devices != nil {
+		in, out := &in.Devices, &out.Devices
+		*out = make(map[string]int64, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Migrations != nil {
+		in, out := &in.Migrations, &out.Migrations
+		*out = new(int64)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaResources.
+func (in *VirtualMachineQuotaResources) DeepCopy() *VirtualMachineQuotaResources {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaResources)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaSpec) DeepCopyInto(out *VirtualMachineQuotaSpec) {
+	*out = *in
+	in.Hard.DeepCopyInto(&out.Hard)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaSpec.
+func (in *VirtualMachineQuotaSpec) DeepCopy() *VirtualMachineQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VirtualMachineQuotaStatus) DeepCopyInto(out *VirtualMachineQuotaStatus) {
+	*out = *in
+	in.Hard.DeepCopyInto(&out.Hard)
+	in.Used.DeepCopyInto(&out.Used)
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VirtualMachineQuotaStatus.
+func (in *VirtualMachineQuotaStatus) DeepCopy() *VirtualMachineQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(VirtualMachineQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VirtualMachineSpec) DeepCopyInto(out *VirtualMachineSpec) {
 	*out = *in
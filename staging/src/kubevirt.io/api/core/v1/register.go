@@ -79,6 +79,8 @@ var (
 	VirtualMachineGroupVersionKind                   = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "VirtualMachine"}
 	VirtualMachineInstanceMigrationGroupVersionKind  = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "VirtualMachineInstanceMigration"}
 	KubeVirtGroupVersionKind                         = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "KubeVirt"}
+	KubeVirtNamespaceConfigGroupVersionKind          = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "KubeVirtNamespaceConfig"}
+	VirtualMachineQuotaGroupVersionKind              = schema.GroupVersionKind{Group: core.GroupName, Version: GroupVersion.Version, Kind: "VirtualMachineQuota"}
 )
 
 var (
@@ -105,6 +107,10 @@ func AddKnownTypesGenerator(groupVersions []schema.GroupVersion) func(scheme *ru
 				&VirtualMachineList{},
 				&KubeVirt{},
 				&KubeVirtList{},
+				&KubeVirtNamespaceConfig{},
+				&KubeVirtNamespaceConfigList{},
+				&VirtualMachineQuota{},
+				&VirtualMachineQuotaList{},
 			)
 			metav1.AddToGroupVersion(scheme, groupVersion)
 		}
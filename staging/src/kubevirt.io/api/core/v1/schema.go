@@ -254,6 +254,38 @@ type EFI struct {
 	// Defaults to false
 	// +optional
 	Persistent *bool `json:"persistent,omitempty"`
+	// SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the
+	// EFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.
+	// Requires SecureBoot.
+	// +optional
+	SecureBootKeys *EFIVarsSource `json:"secureBootKeys,omitempty"`
+	// VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,
+	// used in place of the stock template when the EFI variable store is initialized.
+	// A persistent EFI variable store is initialized again whenever the template changes.
+	// +optional
+	VarsTemplate *EFIVarsSource `json:"varsTemplate,omitempty"`
+}
+
+const (
+	// EFIVarsTemplateKey is the key of the prepared EFI variable store in the VarsTemplate source
+	EFIVarsTemplateKey = "vars.fd"
+	// EFISecureBootPKKey is the key of the Platform Key certificate in the SecureBootKeys source
+	EFISecureBootPKKey = "PK"
+	// EFISecureBootKEKKey is the key of the Key Exchange Key certificate in the SecureBootKeys source
+	EFISecureBootKEKKey = "KEK"
+	// EFISecureBootDBKey is the key of the signature database certificate in the SecureBootKeys source
+	EFISecureBootDBKey = "db"
+)
+
+// EFIVarsSource references the Secret or ConfigMap holding EFI variable store data.
+// Exactly one of them must be set.
+type EFIVarsSource struct {
+	// Secret references a k8s Secret in the namespace of the VirtualMachineInstance.
+	// +optional
+	Secret *v1.LocalObjectReference `json:"secret,omitempty"`
+	// ConfigMap references a ConfigMap in the namespace of the VirtualMachineInstance.
+	// +optional
+	ConfigMap *v1.LocalObjectReference `json:"configMap,omitempty"`
 }
 
 // If set, the VM will be booted from the defined kernel / initrd.
@@ -464,6 +496,10 @@ type Devices struct {
 	// Whether to attach the default graphics device or not.
 	// VNC will not be available if set to false. Defaults to true.
 	AutoattachGraphicsDevice *bool `json:"autoattachGraphicsDevice,omitempty"`
+	// Graphics selects the remote display protocol of the default graphics device.
+	// May not be set if autoattachGraphicsDevice is disabled. Defaults to VNC.
+	// +optional
+	Graphics *GraphicsDevice `json:"graphics,omitempty"`
 	// Whether to attach the default virtio-serial console or not.
 	// Serial console access will not be available if set to false. Defaults to true.
 	AutoattachSerialConsole *bool `json:"autoattachSerialConsole,omitempty"`
@@ -535,6 +571,41 @@ const (
 	UsbClientPassthroughMaxNumberOf = 4
 )
 
+// GraphicsDevice represents the remote display protocol used for the default graphics device.
+// Only one of its members may be specified.
+type GraphicsDevice struct {
+	// VNC exposes the display through the vnc subresource.
+	// +optional
+	VNC *VNCGraphics `json:"vnc,omitempty"`
+	// SPICE exposes the display through the spice subresource.
+	// Compared to VNC it supports multiple monitors, clipboard sharing and improved video streaming.
+	// +optional
+	SPICE *SPICEGraphics `json:"spice,omitempty"`
+}
+
+type VNCGraphics struct{}
+
+type SPICEGraphics struct {
+	// Heads is the number of monitors provided to the guest.
+	// Defaults to 1 and may not exceed SPICEGraphicsMaxHeads.
+	// +optional
+	Heads *uint32 `json:"heads,omitempty"`
+	// VideoModel is the display device presented to the guest.
+	// We only support qxl or virtio. Defaults to qxl.
+	// +optional
+	VideoModel SPICEVideoModel `json:"videoModel,omitempty"`
+}
+
+type SPICEVideoModel string
+
+const (
+	SPICEVideoModelQXL    SPICEVideoModel = "qxl"
+	SPICEVideoModelVirtIO SPICEVideoModel = "virtio"
+
+	// SPICEGraphicsMaxHeads represents the upper limit of monitors allowed by KubeVirt.
+	SPICEGraphicsMaxHeads = 4
+)
+
 // Represents the user's configuration to emulate sound cards in the VMI.
 type SoundDevice struct {
 	// User's defined name for this sound device
@@ -662,6 +733,33 @@ type Disk struct {
 	// If specified, it can change the default error policy (stop) for the disk
 	// +optional
 	ErrorPolicy *DiskErrorPolicy `json:"errorPolicy,omitempty"`
+	// IOTune limits the throughput and the IO operations of the disk.
+	// It is applied to a running VM without a restart when live updates are enabled.
+	// +optional
+	IOTune *DiskIOTune `json:"ioTune,omitempty"`
+}
+
+// DiskIOTune represents the throughput and IO operation limits of a disk.
+// A total limit can't be combined with a read or write limit of the same kind.
+type DiskIOTune struct {
+	// TotalBytesPerSec limits the combined read and write throughput in bytes per second.
+	// +optional
+	TotalBytesPerSec *resource.Quantity `json:"totalBytesPerSec,omitempty"`
+	// ReadBytesPerSec limits the read throughput in bytes per second.
+	// +optional
+	ReadBytesPerSec *resource.Quantity `json:"readBytesPerSec,omitempty"`
+	// WriteBytesPerSec limits the write throughput in bytes per second.
+	// +optional
+	WriteBytesPerSec *resource.Quantity `json:"writeBytesPerSec,omitempty"`
+	// TotalIOPS limits the combined read and write IO operations per second.
+	// +optional
+	TotalIOPS *int64 `json:"totalIOPS,omitempty"`
+	// ReadIOPS limits the read IO operations per second.
+	// +optional
+	ReadIOPS *int64 `json:"readIOPS,omitempty"`
+	// WriteIOPS limits the write IO operations per second.
+	// +optional
+	WriteIOPS *int64 `json:"writeIOPS,omitempty"`
 }
 
 // CustomBlockSize represents the desired logical and physical block size for a VM disk.
@@ -883,6 +981,9 @@ type MemoryDumpVolumeSource struct {
 	// Directly attached to the virt launcher
 	// +optional
 	PersistentVolumeClaimVolumeSource `json:",inline"`
+	// Format of the memory dump, defaults to raw
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 }
 
 type EphemeralVolumeSource struct {
@@ -1287,6 +1388,33 @@ type Interface struct {
 	// Empty value functions as `up`.
 	// +optional
 	State InterfaceState `json:"state,omitempty"`
+	// Bandwidth limits the traffic of the interface.
+	// It is applied to a running VM without a restart when live updates are enabled.
+	// Only supported with the bridge and masquerade bindings.
+	// +optional
+	Bandwidth *InterfaceBandwidth `json:"bandwidth,omitempty"`
+}
+
+// InterfaceBandwidth represents the traffic limits of an interface.
+type InterfaceBandwidth struct {
+	// Inbound limits the traffic received by the guest.
+	// +optional
+	Inbound *BandwidthLimit `json:"inbound,omitempty"`
+	// Outbound limits the traffic sent by the guest.
+	// +optional
+	Outbound *BandwidthLimit `json:"outbound,omitempty"`
+}
+
+// BandwidthLimit represents the traffic limit in one direction of an interface.
+type BandwidthLimit struct {
+	// Average is the average rate the traffic is shaped to, in bytes per second.
+	Average resource.Quantity `json:"average"`
+	// Peak is the maximum rate at which bursts are sent, in bytes per second.
+	// +optional
+	Peak *resource.Quantity `json:"peak,omitempty"`
+	// Burst is the amount of bytes that can be sent at the peak rate.
+	// +optional
+	Burst *resource.Quantity `json:"burst,omitempty"`
 }
 
 type InterfaceState string
@@ -122,9 +122,19 @@ func (BIOS) SwaggerDoc() map[string]string {
 
 func (EFI) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":           "If set, EFI will be used instead of BIOS.",
-		"secureBoot": "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
-		"persistent": "If set to true, Persistent will persist the EFI NVRAM across reboots.\nDefaults to false\n+optional",
+		"":               "If set, EFI will be used instead of BIOS.",
+		"secureBoot":     "If set, SecureBoot will be enabled and the OVMF roms will be swapped for\nSecureBoot-enabled ones.\nRequires SMM to be enabled.\nDefaults to true\n+optional",
+		"persistent":     "If set to true, Persistent will persist the EFI NVRAM across reboots.\nDefaults to false\n+optional",
+		"secureBootKeys": "SecureBootKeys references a Secret or ConfigMap with the certificates enrolled into the\nEFI variable store when it is initialized. The certificates are read from the PK, KEK and db keys.\nRequires SecureBoot.\n+optional",
+		"varsTemplate":   "VarsTemplate references a Secret or ConfigMap with a prepared EFI variable store named vars.fd,\nused in place of the stock template when the EFI variable store is initialized.\nA persistent EFI variable store is initialized again whenever the template changes.\n+optional",
+	}
+}
+
+func (EFIVarsSource) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "EFIVarsSource references the Secret or ConfigMap holding EFI variable store data.\nExactly one of them must be set.",
+		"secret":    "Secret references a k8s Secret in the namespace of the VirtualMachineInstance.\n+optional",
+		"configMap": "ConfigMap references a ConfigMap in the namespace of the VirtualMachineInstance.\n+optional",
 	}
 }
 
@@ -254,6 +264,7 @@ func (Devices) SwaggerDoc() map[string]string {
 		"inputs":                     "Inputs describe input devices",
 		"autoattachPodInterface":     "Whether to attach a pod network interface. Defaults to true.",
 		"autoattachGraphicsDevice":   "Whether to attach the default graphics device or not.\nVNC will not be available if set to false. Defaults to true.",
+		"graphics":                   "Graphics selects the remote display protocol of the default graphics device.\nMay not be set if autoattachGraphicsDevice is disabled. Defaults to VNC.\n+optional",
 		"autoattachSerialConsole":    "Whether to attach the default virtio-serial console or not.\nSerial console access will not be available if set to false. Defaults to true.",
 		"logSerialConsole":           "Whether to log the auto-attached default serial console or not.\nSerial console logs will be collect to a file and then streamed from a named `guest-console-log`.\nNot relevant if autoattachSerialConsole is disabled.\nDefaults to cluster wide setting on VirtualMachineOptions.",
 		"autoattachMemBalloon":       "Whether to attach the Memory balloon device with default period.\nPeriod can be adjusted in virt-config.\nDefaults to true.\n+optional",
@@ -278,6 +289,25 @@ func (ClientPassthroughDevices) SwaggerDoc() map[string]string {
 	}
 }
 
+func (GraphicsDevice) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "GraphicsDevice represents the remote display protocol used for the default graphics device.\nOnly one of its members may be specified.",
+		"vnc":   "VNC exposes the display through the vnc subresource.\n+optional",
+		"spice": "SPICE exposes the display through the spice subresource.\nCompared to VNC it supports multiple monitors, clipboard sharing and improved video streaming.\n+optional",
+	}
+}
+
+func (VNCGraphics) SwaggerDoc() map[string]string {
+	return map[string]string{}
+}
+
+func (SPICEGraphics) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"heads":      "Heads is the number of monitors provided to the guest.\nDefaults to 1 and may not exceed SPICEGraphicsMaxHeads.\n+optional",
+		"videoModel": "VideoModel is the display device presented to the guest.\nWe only support qxl or virtio. Defaults to qxl.\n+optional",
+	}
+}
+
 func (SoundDevice) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":      "Represents the user's configuration to emulate sound cards in the VMI.",
@@ -352,6 +382,19 @@ func (Disk) SwaggerDoc() map[string]string {
 		"blockSize":         "If specified, the virtual disk will be presented with the given block sizes.\n+optional",
 		"shareable":         "If specified the disk is made sharable and multiple write from different VMs are permitted\n+optional",
 		"errorPolicy":       "If specified, it can change the default error policy (stop) for the disk\n+optional",
+		"ioTune":            "IOTune limits the throughput and the IO operations of the disk.\nIt is applied to a running VM without a restart when live updates are enabled.\n+optional",
+	}
+}
+
+func (DiskIOTune) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "DiskIOTune represents the throughput and IO operation limits of a disk.\nA total limit can't be combined with a read or write limit of the same kind.",
+		"totalBytesPerSec": "TotalBytesPerSec limits the combined read and write throughput in bytes per second.\n+optional",
+		"readBytesPerSec":  "ReadBytesPerSec limits the read throughput in bytes per second.\n+optional",
+		"writeBytesPerSec": "WriteBytesPerSec limits the write throughput in bytes per second.\n+optional",
+		"totalIOPS":        "TotalIOPS limits the combined read and write IO operations per second.\n+optional",
+		"readIOPS":         "ReadIOPS limits the read IO operations per second.\n+optional",
+		"writeIOPS":        "WriteIOPS limits the write IO operations per second.\n+optional",
 	}
 }
 
@@ -476,7 +519,9 @@ func (PersistentVolumeClaimVolumeSource) SwaggerDoc() map[string]string {
 }
 
 func (MemoryDumpVolumeSource) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"format": "Format of the memory dump, defaults to raw\n+optional",
+	}
 }
 
 func (EphemeralVolumeSource) SwaggerDoc() map[string]string {
@@ -679,6 +724,24 @@ func (Interface) SwaggerDoc() map[string]string {
 		"tag":         "If specified, the virtual network interface address and its tag will be provided to the guest via config drive\n+optional",
 		"acpiIndex":   "If specified, the ACPI index is used to provide network interface device naming, that is stable across changes\nin PCI addresses assigned to the device.\nThis value is required to be unique across all devices and be between 1 and (16*1024-1).\n+optional",
 		"state":       "State represents the requested operational state of the interface.\nThe supported values are:\n`absent`, expressing a request to remove the interface.\n`down`, expressing a request to set the link down.\n`up`, expressing a request to set the link up.\nEmpty value functions as `up`.\n+optional",
+		"bandwidth":   "Bandwidth limits the traffic of the interface.\nIt is applied to a running VM without a restart when live updates are enabled.\nOnly supported with the bridge and masquerade bindings.\n+optional",
+	}
+}
+
+func (InterfaceBandwidth) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "InterfaceBandwidth represents the traffic limits of an interface.",
+		"inbound":  "Inbound limits the traffic received by the guest.\n+optional",
+		"outbound": "Outbound limits the traffic sent by the guest.\n+optional",
+	}
+}
+
+func (BandwidthLimit) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":        "BandwidthLimit represents the traffic limit in one direction of an interface.",
+		"average": "Average is the average rate the traffic is shaped to, in bytes per second.",
+		"peak":    "Peak is the maximum rate at which bursts are sent, in bytes per second.\n+optional",
+		"burst":   "Burst is the amount of bytes that can be sent at the peak rate.\n+optional",
 	}
 }
 
@@ -32,6 +32,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
 
 	cdiv1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 )
@@ -138,6 +139,15 @@ type VirtualMachineInstanceSpec struct {
 	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
 	// +optional
 	ReadinessProbe *Probe `json:"readinessProbe,omitempty"`
+	// StartupProbe indicates that the VirtualMachineInstance has successfully started.
+	// If specified, no other probes are executed until this completes successfully.
+	// If this probe fails, the VirtualMachineInstance will be stopped, just as if the livenessProbe failed.
+	// This can be used to allow slow booting guests a long initialization time without
+	// having to delay the liveness probe of the whole lifetime of the VirtualMachineInstance.
+	// Cannot be updated.
+	// More info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes
+	// +optional
+	StartupProbe *Probe `json:"startupProbe,omitempty"`
 	// Specifies the hostname of the vmi
 	// If not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.
 	// +optional
@@ -168,6 +178,14 @@ type VirtualMachineInstanceSpec struct {
 	// +optional
 	// +kubebuilder:validation:MaxItems:=256
 	AccessCredentials []AccessCredential `json:"accessCredentials,omitempty"`
+	// FreezeHooks configure commands which are executed in the guest around a filesystem freeze
+	// and allow limiting the freeze to a set of mountpoints.
+	// +optional
+	FreezeHooks *FreezeHooks `json:"freezeHooks,omitempty"`
+	// PanicPolicy exposes a panic device to the guest and defines how a guest panic is handled.
+	// The panicked guest is preserved until an optional memory dump was taken and is then restarted or paused.
+	// +optional
+	PanicPolicy *PanicPolicy `json:"panicPolicy,omitempty"`
 	// Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components
 	Architecture string `json:"architecture,omitempty"`
 }
@@ -262,6 +280,14 @@ type VirtualMachineInstanceStatus struct {
 	// +optional
 	FSFreezeStatus string `json:"fsFreezeStatus,omitempty"`
 
+	// FreezeHooks reports the outcome of the steps of the last freeze and thaw of the guest filesystems
+	// +optional
+	FreezeHooks *FreezeHooksStatus `json:"freezeHooks,omitempty"`
+
+	// GuestPanic reports the last panic of the guest and how it is handled
+	// +optional
+	GuestPanic *GuestPanicStatus `json:"guestPanic,omitempty"`
+
 	// +optional
 	TopologyHints *TopologyHints `json:"topologyHints,omitempty"`
 
@@ -572,6 +598,12 @@ const (
 	// Indicates that the VMI is hot(un)plugging memory
 	VirtualMachineInstanceMemoryChange VirtualMachineInstanceConditionType = "HotMemoryChange"
 
+	// Reflects whether the guest released the memory requested by a memory hot-unplug
+	VirtualMachineInstanceMemoryUnplug VirtualMachineInstanceConditionType = "HotMemoryUnplug"
+
+	// Reflects whether the guest released the vCPUs requested by a CPU hot-unplug
+	VirtualMachineInstanceCPUUnplug VirtualMachineInstanceConditionType = "HotCPUUnplug"
+
 	// Indicates that the VMI has an updates in its volume set
 	VirtualMachineInstanceVolumesChange VirtualMachineInstanceConditionType = "VolumesChange"
 
@@ -612,6 +644,18 @@ const (
 	VirtualMachineInstanceReasonNotMigratable = "NotMigratable"
 	// Reason means that the volume update change was cancelled
 	VirtualMachineInstanceReasonVolumesChangeCancellation = "VolumesChangeCancellation"
+	// Reason means that the guest is still releasing the hot-unplugged memory
+	VirtualMachineInstanceReasonMemoryUnplugInProgress = "MemoryUnplugInProgress"
+	// Reason means that the guest memory converged to the requested size after a hot-unplug
+	VirtualMachineInstanceReasonMemoryUnplugConverged = "MemoryUnplugConverged"
+	// Reason means that the guest did not release the hot-unplugged memory in time
+	VirtualMachineInstanceReasonMemoryUnplugTimedOut = "MemoryUnplugTimedOut"
+	// Reason means that the guest is still releasing the hot-unplugged vCPUs
+	VirtualMachineInstanceReasonCPUUnplugInProgress = "CPUUnplugInProgress"
+	// Reason means that the guest vCPU count converged to the requested topology after a hot-unplug
+	VirtualMachineInstanceReasonCPUUnplugConverged = "CPUUnplugConverged"
+	// Reason means that the guest did not release the hot-unplugged vCPUs in time
+	VirtualMachineInstanceReasonCPUUnplugTimedOut = "CPUUnplugTimedOut"
 )
 
 const (
@@ -957,6 +1001,8 @@ const (
 	KubeVirtCustomizeComponentAnnotationHash = "kubevirt.io/customizer-identifier"
 	// This annotation represents the kubevirt generation that was used to create a resource
 	KubeVirtGenerationAnnotation = "kubevirt.io/generation"
+	// This annotation on the KubeVirt CR holds a pending update and lets virt-operator store the planned changes instead of applying them
+	KubeVirtUpgradeDryRunAnnotation = "kubevirt.io/upgrade-dry-run"
 	// This annotation represents that this object is for temporary use during updates
 	EphemeralBackupObject = "kubevirt.io/ephemeral-backup-object"
 	// This annotation represents that the annotated object is for temporary use during pod/volume provisioning
@@ -1028,6 +1074,11 @@ const (
 	// For more info: https://libvirt.org/kbase/debuglogs.html
 	CustomLibvirtLogFiltersAnnotation string = "kubevirt.io/libvirt-log-filters"
 
+	// GuestPatchComplianceIntervalAnnotation opts the VMI into polling the boot time, the pending reboot
+	// and the hotfixes of the guest. The value is the polling interval, like "10m". The data is collected
+	// by running commands in the guest through the guest agent, so it is disabled by default.
+	GuestPatchComplianceIntervalAnnotation string = "kubevirt.io/guest-patch-compliance-interval"
+
 	// RealtimeLabel marks the node as capable of running realtime workloads
 	RealtimeLabel string = "kubevirt.io/realtime"
 
@@ -1889,6 +1940,11 @@ type Handler struct {
 	// TODO: implement a realistic TCP lifecycle hook
 	// +optional
 	TCPSocket *k8sv1.TCPSocketAction `json:"tcpSocket,omitempty"`
+	// GRPC specifies an action involving a GRPC port.
+	// The health check is performed inside the guest by running grpc_health_probe through the guest
+	// agent, so both have to be installed in the guest.
+	// +optional
+	GRPC *k8sv1.GRPCAction `json:"grpc,omitempty"`
 }
 
 // Probe describes a health check to be performed against a VirtualMachineInstance to determine whether it is
@@ -1972,6 +2028,54 @@ type CertConfig struct {
 
 type KubeVirtCertificateRotateStrategy struct {
 	SelfSigned *KubeVirtSelfSignConfiguration `json:"selfSigned,omitempty"`
+	// External makes KubeVirt consume the certificates of its components from user provided Secrets
+	// or from cert-manager Certificates instead of issuing them itself.
+	// KubeVirt only validates them, distributes their CAs and reports their expiry.
+	// Mutually exclusive with SelfSigned.
+	// +optional
+	External *KubeVirtExternalCertificateConfiguration `json:"external,omitempty"`
+}
+
+// KubeVirtExternalCertificateConfiguration describes where the externally managed certificates come from.
+type KubeVirtExternalCertificateConfiguration struct {
+	// CertManager makes virt-operator request the certificates of all components from cert-manager.
+	// If not set, the certificate Secrets have to be provided in the install namespace with
+	// the tls.crt, tls.key and ca.crt keys.
+	// +optional
+	CertManager *CertManagerConfiguration `json:"certManager,omitempty"`
+
+	// ExpiryWarningThreshold is the remaining lifetime of a certificate below which
+	// the CertificatesReady condition is set to false.
+	// Defaults to 7 days.
+	// +optional
+	ExpiryWarningThreshold *metav1.Duration `json:"expiryWarningThreshold,omitempty"`
+}
+
+// CertManagerConfiguration describes the cert-manager Certificates created for the KubeVirt components.
+type CertManagerConfiguration struct {
+	// IssuerRef references the cert-manager issuer which signs the certificates.
+	IssuerRef CertManagerIssuerReference `json:"issuerRef"`
+
+	// The requested 'duration' (i.e. lifetime) of the Certificates.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// The amount of time before the currently issued certificate's "notAfter"
+	// time that cert-manager will begin to attempt to renew the certificate.
+	// +optional
+	RenewBefore *metav1.Duration `json:"renewBefore,omitempty"`
+}
+
+// CertManagerIssuerReference references a cert-manager Issuer or ClusterIssuer.
+type CertManagerIssuerReference struct {
+	// Name of the issuer.
+	Name string `json:"name"`
+	// Kind of the issuer, Issuer or ClusterIssuer. Defaults to Issuer.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+	// Group of the issuer. Defaults to cert-manager.io.
+	// +optional
+	Group string `json:"group,omitempty"`
 }
 
 type WorkloadUpdateMethod string
@@ -2020,6 +2124,59 @@ type KubeVirtWorkloadUpdateStrategy struct {
 	BatchEvictionInterval *metav1.Duration `json:"batchEvictionInterval,omitempty"`
 }
 
+// KubeVirtHandlerUpdateStrategy defines how virt-handler is rolled out across the nodes of the cluster
+type KubeVirtHandlerUpdateStrategy struct {
+	// Waves lists the sets of nodes virt-handler is updated on, in order.
+	// A node which matches more than one wave belongs to the first one.
+	// Nodes which match no wave are updated after the last wave completed.
+	// A wave completes once virt-handler is updated and ready on all of its nodes and no VMI failed on them since the wave started.
+	// VMIs which failed halt the rollout, deleting them resumes it.
+	// +listType=atomic
+	// +optional
+	Waves []HandlerUpdateWave `json:"waves,omitempty"`
+
+	// PauseBetweenWaves is the time to wait after a wave completed before
+	// the next wave is started
+	//
+	// Defaults to 0
+	//
+	// +optional
+	PauseBetweenWaves *metav1.Duration `json:"pauseBetweenWaves,omitempty"`
+
+	// MaxUnavailable is the maximum number of virt-handler pods within a wave
+	// which are replaced at the same time. A percentage is relative to the
+	// number of nodes in the wave.
+	//
+	// Defaults to 1
+	//
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+}
+
+// HandlerUpdateWave is a set of nodes virt-handler is updated on together
+type HandlerUpdateWave struct {
+	// Name of the wave
+	Name string `json:"name"`
+	// NodeSelector selects the nodes of the wave
+	NodeSelector map[string]string `json:"nodeSelector"`
+}
+
+// KubeVirtNetworkPolicies configures the NetworkPolicies virt-operator generates for the KubeVirt components
+type KubeVirtNetworkPolicies struct {
+	// Enabled makes virt-operator create and reconcile NetworkPolicies in the install namespace which
+	// allow the ingress and egress traffic of the KubeVirt components, including the console and VNC connections
+	// from virt-api to virt-handler, the migrations between virt-handlers and metrics scraping.
+	// Egress is limited to the apiserver, DNS, the traffic between the components and the export servers.
+	// The policies follow the configured ports and the migration network.
+	// Defaults to false.
+	// +optional
+	Enabled bool `json:"enabled,omitempty"`
+	// MonitoringNamespaceSelector selects the namespaces which are allowed to scrape the metrics of the components.
+	// Defaults to the monitorNamespace, or to the well known prometheus-operator namespaces if it is not set.
+	// +optional
+	MonitoringNamespaceSelector *metav1.LabelSelector `json:"monitoringNamespaceSelector,omitempty"`
+}
+
 type KubeVirtSpec struct {
 	// The image tag to use for the continer images installed.
 	// Defaults to the same tag as the operator's container image.
@@ -2028,6 +2185,14 @@ type KubeVirtSpec struct {
 	// Defaults to the same registry the operator's container image is pulled from.
 	ImageRegistry string `json:"imageRegistry,omitempty"`
 
+	// RollbackTo is the deployment ID of a previously deployed KubeVirt, as reported by
+	// status.previousDeployment.deploymentID. While set, the install strategy of that
+	// deployment is re-applied instead of the one derived from imageTag and imageRegistry.
+	// The rollback is refused if objects were stored in an API version the previous
+	// release can't read. Remove it to update to the regular target version again.
+	// +optional
+	RollbackTo string `json:"rollbackTo,omitempty"`
+
 	// The ImagePullPolicy to use.
 	ImagePullPolicy k8sv1.PullPolicy `json:"imagePullPolicy,omitempty" valid:"required"`
 
@@ -2053,6 +2218,14 @@ type KubeVirtSpec struct {
 	// automated workload updates
 	WorkloadUpdateStrategy KubeVirtWorkloadUpdateStrategy `json:"workloadUpdateStrategy,omitempty"`
 
+	// HandlerUpdateStrategy stages the rollout of virt-handler across sets of nodes.
+	// The next wave is only started once all virt-handler pods of the current wave
+	// are ready and no VMI failed on its nodes since the wave started.
+	// Outdated VMIs are then updated wave by wave as well.
+	// If not set, virt-handler is rolled out across all nodes at once.
+	// +optional
+	HandlerUpdateStrategy *KubeVirtHandlerUpdateStrategy `json:"handlerUpdateStrategy,omitempty"`
+
 	// Specifies if kubevirt can be deleted if workloads are still present.
 	// This is mainly a precaution to avoid accidental data loss
 	UninstallStrategy KubeVirtUninstallStrategy `json:"uninstallStrategy,omitempty"`
@@ -2087,6 +2260,11 @@ type KubeVirtSpec struct {
 	Workloads *ComponentConfig `json:"workloads,omitempty"`
 
 	CustomizeComponents CustomizeComponents `json:"customizeComponents,omitempty"`
+
+	// NetworkPolicies lets virt-operator generate the NetworkPolicies the KubeVirt components
+	// need in clusters which deny ingress or egress traffic by default
+	// +optional
+	NetworkPolicies *KubeVirtNetworkPolicies `json:"networkPolicies,omitempty"`
 }
 
 type CustomizeComponents struct {
@@ -2167,6 +2345,56 @@ type KubeVirtStatus struct {
 	DefaultArchitecture                     string              `json:"defaultArchitecture,omitempty"`
 	// +listType=atomic
 	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
+	// HandlerUpdate reports the progress of a staged virt-handler rollout
+	// +optional
+	HandlerUpdate *HandlerUpdateStatus `json:"handlerUpdate,omitempty" optional:"true"`
+	// PreviousDeployment is the deployment which was observed before the current one.
+	// It can be restored with spec.rollbackTo.
+	// +optional
+	PreviousDeployment *KubeVirtPreviousDeployment `json:"previousDeployment,omitempty" optional:"true"`
+	// ConfigurationRevision is the name of the ControllerRevision which holds the
+	// spec.configuration that is currently applied.
+	// +optional
+	ConfigurationRevision string `json:"configurationRevision,omitempty" optional:"true"`
+}
+
+// KubeVirtPreviousDeployment records a completely rolled out deployment which was replaced by an update
+type KubeVirtPreviousDeployment struct {
+	KubeVirtRegistry string `json:"kubeVirtRegistry,omitempty" optional:"true"`
+	KubeVirtVersion  string `json:"kubeVirtVersion,omitempty" optional:"true"`
+	DeploymentConfig string `json:"deploymentConfig,omitempty" optional:"true"`
+	DeploymentID     string `json:"deploymentID,omitempty" optional:"true"`
+	// Generations of the objects of the deployment at the time it was replaced
+	// +listType=atomic
+	Generations []GenerationStatus `json:"generations,omitempty" optional:"true"`
+}
+
+// HandlerUpdateStatus reports the progress of a staged virt-handler rollout
+type HandlerUpdateStatus struct {
+	// TargetDeploymentID is the deployment the waves are rolled out for
+	TargetDeploymentID string `json:"targetDeploymentID"`
+	// CurrentWave is the index of the wave which is currently rolled out.
+	// It equals the number of waves once the remaining nodes are updated.
+	CurrentWave int `json:"currentWave"`
+	// +listType=atomic
+	// +optional
+	Waves []HandlerUpdateWaveStatus `json:"waves,omitempty"`
+}
+
+// HandlerUpdateWaveStatus reports the progress of a single wave
+type HandlerUpdateWaveStatus struct {
+	// Name of the wave
+	Name string `json:"name"`
+	// Nodes which belong to the wave. They are resolved when the wave is started.
+	// +listType=atomic
+	// +optional
+	Nodes []string `json:"nodes,omitempty"`
+	// +optional
+	// +nullable
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+	// +optional
+	// +nullable
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
 }
 
 // KubeVirtPhase is a label for the phase of a KubeVirt deployment at the current time.
@@ -2214,6 +2442,10 @@ const (
 	KubeVirtConditionProgressing KubeVirtConditionType = "Progressing"
 	// Whether KubeVirt is not functioning completely
 	KubeVirtConditionDegraded KubeVirtConditionType = "Degraded"
+	// Whether the externally managed certificates are valid and not about to expire
+	KubeVirtConditionCertificatesReady KubeVirtConditionType = "CertificatesReady"
+	// Whether the applied configuration matches the cluster, for example that the referenced objects exist
+	KubeVirtConditionConfigurationValid KubeVirtConditionType = "ConfigurationValid"
 )
 
 const (
@@ -2357,6 +2589,85 @@ type VirtualMachineInstanceGuestAgentInfo struct {
 	// FSFreezeStatus is the state of the fs of the guest
 	// it can be either frozen or thawed
 	FSFreezeStatus string `json:"fsFreezeStatus,omitempty"`
+	// Inventory contains the load, cpu, memory and disk statistics reported by the guest
+	// +optional
+	Inventory *VirtualMachineInstanceGuestOSInventory `json:"inventory,omitempty"`
+}
+
+// VirtualMachineInstanceGuestOSInventory represents the resources and statistics as seen by the guest
+type VirtualMachineInstanceGuestOSInventory struct {
+	// Load is the guest os load average
+	// +optional
+	Load *VirtualMachineInstanceGuestOSLoad `json:"load,omitempty"`
+	// CPU is the number of vCPUs known to the guest os
+	// +optional
+	CPU *VirtualMachineInstanceGuestOSCPU `json:"cpu,omitempty"`
+	// Memory is the amount of memory known to the guest os
+	// +optional
+	Memory *VirtualMachineInstanceGuestOSMemory `json:"memory,omitempty"`
+	// DiskStats are the I/O counters of the guest os block devices, the usage of the
+	// guest os filesystems is reported in diskUsage
+	// +optional
+	// +listType=atomic
+	DiskStats []VirtualMachineInstanceGuestOSDiskStats `json:"diskStats,omitempty"`
+	// DiskUsage is the used and total space of the guest os filesystems
+	// +optional
+	// +listType=atomic
+	DiskUsage []VirtualMachineInstanceGuestOSDiskUsage `json:"diskUsage,omitempty"`
+	// BootTime is the time the guest os booted, only reported if the VMI has the kubevirt.io/guest-patch-compliance-interval annotation
+	// +optional
+	BootTime *metav1.Time `json:"bootTime,omitempty"`
+	// RebootPending tells whether the guest os has to be rebooted to apply installed updates, only reported if the VMI has the kubevirt.io/guest-patch-compliance-interval annotation
+	// +optional
+	RebootPending *bool `json:"rebootPending,omitempty"`
+	// Hotfixes are the hotfixes installed in a Windows guest os, only reported if the VMI has the kubevirt.io/guest-patch-compliance-interval annotation
+	// +optional
+	// +listType=atomic
+	Hotfixes []VirtualMachineInstanceGuestOSHotfix `json:"hotfixes,omitempty"`
+}
+
+// VirtualMachineInstanceGuestOSLoad represents the guest os load average
+type VirtualMachineInstanceGuestOSLoad struct {
+	Load1m  float64 `json:"load1m"`
+	Load5m  float64 `json:"load5m"`
+	Load15m float64 `json:"load15m"`
+}
+
+// VirtualMachineInstanceGuestOSCPU represents the vCPUs known to the guest os
+type VirtualMachineInstanceGuestOSCPU struct {
+	Online int `json:"online"`
+	Total  int `json:"total"`
+}
+
+// VirtualMachineInstanceGuestOSMemory represents the memory known to the guest os
+type VirtualMachineInstanceGuestOSMemory struct {
+	OnlineBytes int64 `json:"onlineBytes"`
+	TotalBytes  int64 `json:"totalBytes"`
+}
+
+// VirtualMachineInstanceGuestOSDiskStats represents the I/O statistics of a guest os block device
+type VirtualMachineInstanceGuestOSDiskStats struct {
+	Name         string `json:"name"`
+	ReadSectors  int64  `json:"readSectors,omitempty"`
+	ReadIOs      int64  `json:"readIOs,omitempty"`
+	WriteSectors int64  `json:"writeSectors,omitempty"`
+	WriteIOs     int64  `json:"writeIOs,omitempty"`
+}
+
+// VirtualMachineInstanceGuestOSDiskUsage represents the usage of a guest os filesystem
+type VirtualMachineInstanceGuestOSDiskUsage struct {
+	MountPoint     string `json:"mountPoint"`
+	FileSystemType string `json:"fileSystemType,omitempty"`
+	UsedBytes      int64  `json:"usedBytes"`
+	TotalBytes     int64  `json:"totalBytes"`
+}
+
+// VirtualMachineInstanceGuestOSHotfix represents a hotfix installed in a Windows guest os
+type VirtualMachineInstanceGuestOSHotfix struct {
+	ID          string `json:"id"`
+	Description string `json:"description,omitempty"`
+	// +optional
+	InstalledOn *metav1.Time `json:"installedOn,omitempty"`
 }
 
 // List of commands that QEMU guest agent supports
@@ -2416,6 +2727,159 @@ type FreezeUnfreezeTimeout struct {
 	UnfreezeTimeout *metav1.Duration `json:"unfreezeTimeout"`
 }
 
+// FreezeHooks allow an application consistent freeze of the guest filesystems
+type FreezeHooks struct {
+	// PreFreeze is executed in the guest before the filesystems are frozen.
+	// The filesystems are not frozen if the command fails or exits with a non-zero code.
+	// +optional
+	PreFreeze *FreezeHookCommand `json:"preFreeze,omitempty"`
+	// PostThaw is executed in the guest after the filesystems were thawed.
+	// +optional
+	PostThaw *FreezeHookCommand `json:"postThaw,omitempty"`
+	// Mountpoints limits the freeze to the listed guest mountpoints.
+	// All guest filesystems are frozen if empty.
+	// +optional
+	// +listType=atomic
+	Mountpoints []string `json:"mountpoints,omitempty"`
+}
+
+// FreezeHookCommand is a command executed in the guest through the guest agent
+type FreezeHookCommand struct {
+	// Command is the path of the executable in the guest.
+	Command string `json:"command"`
+	// Args are passed to the command.
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time the command may run before it is considered failed.
+	// Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// GetTimeoutSeconds returns the timeout of the command, falling back to GuestExecDefaultTimeoutSeconds
+func (c *FreezeHookCommand) GetTimeoutSeconds() int32 {
+	if c == nil {
+		return 0
+	}
+	if c.TimeoutSeconds != nil {
+		return *c.TimeoutSeconds
+	}
+	return GuestExecDefaultTimeoutSeconds
+}
+
+// GetHooksTimeoutSeconds returns the time the pre freeze and post thaw commands may run in total
+func (h *FreezeHooks) GetHooksTimeoutSeconds() int32 {
+	if h == nil {
+		return 0
+	}
+	return h.PreFreeze.GetTimeoutSeconds() + h.PostThaw.GetTimeoutSeconds()
+}
+
+type FreezeHookStepName string
+
+const (
+	FreezeHookStepPreFreeze FreezeHookStepName = "PreFreeze"
+	FreezeHookStepFreeze    FreezeHookStepName = "Freeze"
+	FreezeHookStepThaw      FreezeHookStepName = "Thaw"
+	FreezeHookStepPostThaw  FreezeHookStepName = "PostThaw"
+)
+
+type FreezeHookStepPhase string
+
+const (
+	FreezeHookStepSucceeded FreezeHookStepPhase = "Succeeded"
+	FreezeHookStepFailed    FreezeHookStepPhase = "Failed"
+)
+
+// FreezeHooksStatus reports the steps of the last freeze and thaw of the guest filesystems
+type FreezeHooksStatus struct {
+	// Steps in the order they were executed
+	// +optional
+	// +listType=atomic
+	Steps []FreezeHookStepStatus `json:"steps,omitempty"`
+}
+
+// FreezeHookStepStatus is the outcome of a single freeze or thaw step
+type FreezeHookStepStatus struct {
+	// Name of the step
+	Name FreezeHookStepName `json:"name"`
+	// Phase of the step, either Succeeded or Failed
+	Phase FreezeHookStepPhase `json:"phase"`
+	// ExitCode of the hook command, only set for hook steps
+	// +optional
+	ExitCode *int32 `json:"exitCode,omitempty"`
+	// Message contains details about a failure
+	// +optional
+	Message string `json:"message,omitempty"`
+	// Timestamp of the completion of the step
+	// +optional
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+}
+
+type PanicAction string
+
+const (
+	// PanicActionRestart resets the guest after a panic
+	PanicActionRestart PanicAction = "Restart"
+	// PanicActionPause keeps the panicked guest paused until it is unpaused or restarted
+	PanicActionPause PanicAction = "Pause"
+)
+
+// PanicPolicy defines how a guest panic is handled
+type PanicPolicy struct {
+	// Action is taken once the panic was recorded and the memory dump finished.
+	// Defaults to Restart.
+	// +optional
+	Action PanicAction `json:"action,omitempty"`
+	// MemoryDumpClaimName is the name of the pvc the guest memory is dumped to on a panic.
+	// The memory dump is only taken for VMIs owned by a VirtualMachine.
+	// It requires the HotplugVolumes feature gate and is skipped if the pvc can't hold the dump.
+	// +optional
+	MemoryDumpClaimName string `json:"memoryDumpClaimName,omitempty"`
+}
+
+// GetAction returns the action of the policy, falling back to PanicActionRestart
+func (p *PanicPolicy) GetAction() PanicAction {
+	if p == nil || p.Action == "" {
+		return PanicActionRestart
+	}
+	return p.Action
+}
+
+type GuestPanicPhase string
+
+const (
+	// GuestPanicMemoryDumpInProgress means that the memory of the panicked guest is dumped to the claim of the panic policy
+	GuestPanicMemoryDumpInProgress GuestPanicPhase = "MemoryDumpInProgress"
+	// GuestPanicRestarting means that the panicked guest is being reset
+	GuestPanicRestarting GuestPanicPhase = "Restarting"
+	// GuestPanicPaused means that the panicked guest is kept paused
+	GuestPanicPaused GuestPanicPhase = "Paused"
+	// GuestPanicRecovered means that the guest runs again after the panic
+	GuestPanicRecovered GuestPanicPhase = "Recovered"
+)
+
+// GuestPanicStatus reports the last panic of the guest
+type GuestPanicStatus struct {
+	// Phase of the handling of the last panic
+	Phase GuestPanicPhase `json:"phase"`
+	// Count is the number of panics since the VMI was started
+	Count int32 `json:"count"`
+	// Timestamp of the last panic
+	// +optional
+	Timestamp *metav1.Time `json:"timestamp,omitempty"`
+	// Action taken after the last panic
+	// +optional
+	Action PanicAction `json:"action,omitempty"`
+	// MemoryDumpClaimName is the name of the pvc the memory of the last panic is dumped to
+	// +optional
+	MemoryDumpClaimName string `json:"memoryDumpClaimName,omitempty"`
+	// Message contains details about the handling of the last panic
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // VirtualMachineMemoryDumpRequest represent the memory dump request phase and info
 type VirtualMachineMemoryDumpRequest struct {
 	// ClaimName is the name of the pvc that will contain the memory dump
@@ -2425,6 +2889,9 @@ type VirtualMachineMemoryDumpRequest struct {
 	// Remove represents request of dissociating the memory dump pvc
 	// +optional
 	Remove bool `json:"remove,omitempty"`
+	// Format of the memory dump, defaults to raw
+	// +optional
+	Format MemoryDumpFormat `json:"format,omitempty"`
 	// StartTimestamp represents the time the memory dump started
 	// +optional
 	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
@@ -2456,6 +2923,39 @@ const (
 	MemoryDumpFailed MemoryDumpPhase = "Failed"
 )
 
+// MemoryDumpFormat is the libvirt core dump format the memory is dumped in
+type MemoryDumpFormat string
+
+const (
+	// MemoryDumpFormatRaw is an ELF core file
+	MemoryDumpFormatRaw MemoryDumpFormat = "raw"
+	// MemoryDumpFormatKdumpZlib is a kdump-compressed file with zlib compression
+	MemoryDumpFormatKdumpZlib MemoryDumpFormat = "kdump-zlib"
+	// MemoryDumpFormatKdumpLzo is a kdump-compressed file with lzo compression
+	MemoryDumpFormatKdumpLzo MemoryDumpFormat = "kdump-lzo"
+	// MemoryDumpFormatKdumpSnappy is a kdump-compressed file with snappy compression
+	MemoryDumpFormatKdumpSnappy MemoryDumpFormat = "kdump-snappy"
+	// MemoryDumpFormatWinDmp is a Windows crash dump, which requires a Windows guest
+	MemoryDumpFormatWinDmp MemoryDumpFormat = "win-dmp"
+)
+
+// VirtualMachinePersistentState describes where the persistent TPM and EFI state of a VirtualMachine is stored
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachinePersistentState struct {
+	metav1.TypeMeta `json:",inline"`
+	// ClaimName is the name of the pvc holding the persistent state.
+	// It can be exported with a VirtualMachineExport of kind PersistentVolumeClaim.
+	ClaimName string `json:"claimName"`
+}
+
+// ImportPersistentStateOptions is provided when replacing the persistent TPM and EFI state of a VirtualMachine
+type ImportPersistentStateOptions struct {
+	// ClaimName is the name of the pvc holding the state to import.
+	// The VirtualMachine takes ownership of the pvc and its previous state is deleted.
+	ClaimName string `json:"claimName"`
+}
+
 // AddVolumeOptions is provided when dynamically hot plugging a volume and disk
 type AddVolumeOptions struct {
 	// Name represents the name that will be used to map the
@@ -2480,6 +2980,107 @@ type ScreenshotOptions struct {
 	MoveCursor bool `json:"moveCursor"`
 }
 
+// VNCTokenOptions are provided when requesting a token for browser based VNC access.
+type VNCTokenOptions struct {
+	// TTLDuration limits the lifetime of the token.
+	// Defaults to 5 minutes and may not exceed 1 hour.
+	// +optional
+	TTLDuration *metav1.Duration `json:"ttlDuration,omitempty"`
+}
+
+// VNCToken grants time limited access to the VNC console of a VirtualMachineInstance
+// through the vncproxy endpoint, without any further authentication.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VNCToken struct {
+	metav1.TypeMeta `json:",inline"`
+	// Token is the signed token which has to be passed to the vncproxy endpoint.
+	Token string `json:"token"`
+	// Path is the virt-api path, including the token, which a noVNC client can connect to.
+	// Through the Kubernetes API server the path requires the client to authenticate as well.
+	Path string `json:"path"`
+	// URL is the absolute URL, including the token, which a noVNC client can connect to without
+	// any other credentials. It is only set if spec.configuration.vncTokenAccess.externalURL of
+	// the KubeVirt CR is set.
+	// +optional
+	URL string `json:"url,omitempty"`
+	// ExpirationTimestamp is the time after which the token is no longer accepted.
+	ExpirationTimestamp metav1.Time `json:"expirationTimestamp"`
+}
+
+// GuestFileMaxSize is the maximum size in bytes of a file transferred through the guest agent.
+const GuestFileMaxSize = 2 * 1024 * 1024
+
+// GuestFile is a file in the guest of a VirtualMachineInstance, transferred through the guest agent.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GuestFile struct {
+	metav1.TypeMeta `json:",inline"`
+	// Path is the path of the file in the guest.
+	Path string `json:"path"`
+	// Contents of the file, may not exceed GuestFileMaxSize bytes.
+	// +optional
+	Contents []byte `json:"contents,omitempty"`
+}
+
+const (
+	// GuestExecDefaultTimeoutSeconds is the time a command executed through the guest agent may run if no timeout is requested.
+	GuestExecDefaultTimeoutSeconds = 30
+	// GuestExecMaxTimeoutSeconds is the maximum time a command executed through the guest agent may run.
+	GuestExecMaxTimeoutSeconds = 300
+)
+
+// GuestExecOptions describe a command which is executed in the guest of a VirtualMachineInstance through the guest agent.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GuestExecOptions struct {
+	metav1.TypeMeta `json:",inline"`
+	// Command is the path of the executable in the guest.
+	Command string `json:"command"`
+	// Args are passed to the command.
+	// +optional
+	// +listType=atomic
+	Args []string `json:"args,omitempty"`
+	// TimeoutSeconds is the time the command may run before it is abandoned.
+	// Defaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.
+	// +optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// GuestExecResult is the output of a command executed in the guest through the guest agent.
+// The guestexec subresource streams a GuestExecResult for every chunk of output while the command runs,
+// the last one is marked as exited and holds the exit code.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type GuestExecResult struct {
+	metav1.TypeMeta `json:",inline"`
+	// ExitCode of the command, only set once it exited.
+	// +optional
+	ExitCode int32 `json:"exitCode,omitempty"`
+	// Stdout is the standard output the command wrote since the previous result.
+	// +optional
+	Stdout string `json:"stdout,omitempty"`
+	// Stderr is the standard error the command wrote since the previous result.
+	// +optional
+	Stderr string `json:"stderr,omitempty"`
+	// Exited is set on the last result, once the command exited.
+	// +optional
+	Exited bool `json:"exited,omitempty"`
+}
+
+// EFIVarsMaxSize is the maximum size in bytes of an EFI variable store returned by the efivars subresource.
+const EFIVarsMaxSize = 4 * 1024 * 1024
+
+// EFIVars is the EFI variable store of a VirtualMachineInstance.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type EFIVars struct {
+	metav1.TypeMeta `json:",inline"`
+	// Contents of the EFI variable store.
+	// +optional
+	Contents []byte `json:"contents,omitempty"`
+}
+
 type VSOCKOptions struct {
 	TargetPort uint32 `json:"targetPort"`
 	UseTLS     *bool  `json:"useTLS,omitempty"`
@@ -2500,6 +3101,22 @@ type RemoveVolumeOptions struct {
 	DryRun []string `json:"dryRun,omitempty"`
 }
 
+// SetInterfaceLinkStateOptions is provided when setting the link state of an interface
+type SetInterfaceLinkStateOptions struct {
+	// InterfaceName is the name of the interface whose link state is set
+	InterfaceName string `json:"interfaceName"`
+	// State is the desired link state of the interface. Valid values are up and down.
+	State InterfaceState `json:"state"`
+	// When present, indicates that modifications should not be
+	// persisted. An invalid or unrecognized dryRun directive will
+	// result in an error response and no further processing of the
+	// request. Valid values are:
+	// - All: all dry run stages will be processed
+	// +optional
+	// +listType=atomic
+	DryRun []string `json:"dryRun,omitempty"`
+}
+
 type TokenBucketRateLimiter struct {
 	// QPS indicates the maximum QPS to the apiserver from this client.
 	// If it's zero, the component default will be used
@@ -2606,6 +3223,161 @@ type KubeVirtConfiguration struct {
 	// Instancetype configuration
 	// +nullable
 	Instancetype *InstancetypeConfiguration `json:"instancetype,omitempty"`
+
+	// NamespaceConfiguration controls which settings KubeVirtNamespaceConfig objects may override
+	// +nullable
+	NamespaceConfiguration *NamespaceConfiguration `json:"namespaceConfiguration,omitempty"`
+
+	// VNCTokenAccess configures how the VNC consoles opened with tokens are reached
+	// +nullable
+	VNCTokenAccess *VNCTokenAccessConfiguration `json:"vncTokenAccess,omitempty"`
+}
+
+// VNCTokenAccessConfiguration configures how the VNC consoles opened with tokens are reached
+type VNCTokenAccessConfiguration struct {
+	// ExternalURL is the base URL virt-api is exposed at outside of the cluster, for example
+	// through an Ingress or Route which passes TLS through to the virt-api service.
+	// VNC tokens are returned with the URL of the vncproxy endpoint below it, which a browser can
+	// open with the token alone. Without it, the vncproxy endpoint is only reachable through the
+	// Kubernetes API server, which requires clients to authenticate first.
+	// +optional
+	ExternalURL string `json:"externalURL,omitempty"`
+}
+
+// NamespaceConfiguration controls the per-namespace overrides of the cluster-wide configuration
+type NamespaceConfiguration struct {
+	// AllowedOverrides lists the fields of KubeVirtNamespaceConfig objects which are merged over the
+	// cluster-wide configuration. Overrides of other fields are ignored. Defaults to none.
+	// +listType=set
+	// +optional
+	AllowedOverrides []NamespaceConfigOverride `json:"allowedOverrides,omitempty"`
+}
+
+// NamespaceConfigOverride is a field of the cluster-wide configuration which may be overridden per namespace
+// +kubebuilder:validation:Enum=cpuModel;evictionStrategy;migrations;network;smbios;supportContainerResources;virtualMachineOptions
+type NamespaceConfigOverride string
+
+const (
+	NamespaceConfigOverrideCPUModel                  NamespaceConfigOverride = "cpuModel"
+	NamespaceConfigOverrideEvictionStrategy          NamespaceConfigOverride = "evictionStrategy"
+	NamespaceConfigOverrideMigrations                NamespaceConfigOverride = "migrations"
+	NamespaceConfigOverrideNetwork                   NamespaceConfigOverride = "network"
+	NamespaceConfigOverrideSMBIOS                    NamespaceConfigOverride = "smbios"
+	NamespaceConfigOverrideSupportContainerResources NamespaceConfigOverride = "supportContainerResources"
+	NamespaceConfigOverrideVirtualMachineOptions     NamespaceConfigOverride = "virtualMachineOptions"
+)
+
+// KubeVirtNamespaceConfig overrides parts of the cluster-wide KubeVirt configuration for the
+// VirtualMachineInstances of its namespace. Only the fields allowed by
+// spec.configuration.namespaceConfiguration of the KubeVirt CR are applied.
+// Namespace admins can read but not modify them.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+// +genclient:noStatus
+type KubeVirtNamespaceConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              KubeVirtNamespaceConfigSpec `json:"spec" valid:"required"`
+}
+
+// KubeVirtNamespaceConfigList is a list of KubeVirtNamespaceConfigs
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type KubeVirtNamespaceConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubeVirtNamespaceConfig `json:"items"`
+}
+
+// KubeVirtNamespaceConfigSpec holds the overrides of the cluster-wide configuration. Fields which are set are
+// merged over their cluster-wide counterpart, nested fields which are not set keep their cluster-wide value.
+// Lists replace the cluster-wide list.
+type KubeVirtNamespaceConfigSpec struct {
+	// CPUModel overrides the default CPU model of VMIs
+	// +optional
+	CPUModel string `json:"cpuModel,omitempty"`
+	// EvictionStrategy overrides the cluster-wide eviction strategy
+	// +optional
+	EvictionStrategy *EvictionStrategy `json:"evictionStrategy,omitempty"`
+	// MigrationConfiguration overrides the settings of migrations of VMIs in the namespace, like the bandwidth.
+	// The parallel migration limits, disableTLS, unsafeMigrationOverride and allowPostCopy can't be overridden.
+	// +optional
+	MigrationConfiguration *MigrationConfiguration `json:"migrations,omitempty"`
+	// NetworkConfiguration overrides the network defaults, like the default network interface.
+	// The binding plugins can't be overridden.
+	// +optional
+	NetworkConfiguration *NetworkConfiguration `json:"network,omitempty"`
+	// SMBIOSConfig overrides the SMBIOS values exposed to guests
+	// +optional
+	SMBIOSConfig *SMBiosConfiguration `json:"smbios,omitempty"`
+	// SupportContainerResources overrides the resource requirements of the supporting containers
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	SupportContainerResources []SupportContainerResources `json:"supportContainerResources,omitempty"`
+	// VirtualMachineOptions overrides the default options of VMs
+	// +optional
+	VirtualMachineOptions *VirtualMachineOptions `json:"virtualMachineOptions,omitempty"`
+}
+
+// VirtualMachineQuota caps the VM specific resources which the VirtualMachineInstances of its namespace may consume.
+// Unlike a ResourceQuota it accounts for the guest and not for the virt-launcher pods.
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +genclient
+type VirtualMachineQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              VirtualMachineQuotaSpec `json:"spec" valid:"required"`
+	// +optional
+	Status VirtualMachineQuotaStatus `json:"status,omitempty"`
+}
+
+// VirtualMachineQuotaList is a list of VirtualMachineQuotas
+//
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type VirtualMachineQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []VirtualMachineQuota `json:"items"`
+}
+
+// VirtualMachineQuotaSpec holds the limits of a VirtualMachineQuota
+type VirtualMachineQuotaSpec struct {
+	// Hard is the set of limits enforced when VirtualMachineInstances are created, VirtualMachines are
+	// started or VirtualMachineInstanceMigrations are created. Resources which are not set are not limited.
+	Hard VirtualMachineQuotaResources `json:"hard"`
+}
+
+// VirtualMachineQuotaStatus holds the usage of a VirtualMachineQuota
+type VirtualMachineQuotaStatus struct {
+	// Hard is the set of limits the usage was last computed for
+	// +optional
+	Hard VirtualMachineQuotaResources `json:"hard,omitempty"`
+	// Used is the current usage of the limited resources in the namespace
+	// +optional
+	Used VirtualMachineQuotaResources `json:"used,omitempty"`
+}
+
+// VirtualMachineQuotaResources are the VM specific resources a VirtualMachineQuota accounts for.
+// VirtualMachineInstances and VirtualMachineInstanceMigrations in a final phase don't consume any.
+type VirtualMachineQuotaResources struct {
+	// RunningVMIs is the number of VirtualMachineInstances
+	// +optional
+	RunningVMIs *int64 `json:"runningVMIs,omitempty"`
+	// GuestMemory is the total memory visible to the guests, without the overhead of the virt-launcher pods
+	// +optional
+	GuestMemory *resource.Quantity `json:"guestMemory,omitempty"`
+	// VCPUs is the total number of vCPUs, which are sockets * cores * threads of every VirtualMachineInstance
+	// +optional
+	VCPUs *int64 `json:"vcpus,omitempty"`
+	// Devices is the number of GPUs and host devices by their device name
+	// +optional
+	Devices map[string]int64 `json:"devices,omitempty"`
+	// Migrations is the number of concurrent VirtualMachineInstanceMigrations
+	// +optional
+	Migrations *int64 `json:"migrations,omitempty"`
 }
 
 type InstancetypeConfiguration struct {
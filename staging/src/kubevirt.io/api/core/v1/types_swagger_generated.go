@@ -32,12 +32,15 @@ func (VirtualMachineInstanceSpec) SwaggerDoc() map[string]string {
 		"volumes":                       "List of volumes that can be mounted by disks belonging to the vmi.\n+kubebuilder:validation:MaxItems:=256",
 		"livenessProbe":                 "Periodic probe of VirtualMachineInstance liveness.\nVirtualmachineInstances will be stopped if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"readinessProbe":                "Periodic probe of VirtualMachineInstance service readiness.\nVirtualmachineInstances will be removed from service endpoints if the probe fails.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
+		"startupProbe":                  "StartupProbe indicates that the VirtualMachineInstance has successfully started.\nIf specified, no other probes are executed until this completes successfully.\nIf this probe fails, the VirtualMachineInstance will be stopped, just as if the livenessProbe failed.\nThis can be used to allow slow booting guests a long initialization time without\nhaving to delay the liveness probe of the whole lifetime of the VirtualMachineInstance.\nCannot be updated.\nMore info: https://kubernetes.io/docs/concepts/workloads/pods/pod-lifecycle#container-probes\n+optional",
 		"hostname":                      "Specifies the hostname of the vmi\nIf not specified, the hostname will be set to the name of the vmi, if dhcp or cloud-init is configured properly.\n+optional",
 		"subdomain":                     "If specified, the fully qualified vmi hostname will be \"<hostname>.<subdomain>.<pod namespace>.svc.<cluster domain>\".\nIf not specified, the vmi will not have a domainname at all. The DNS entry will resolve to the vmi,\nno matter if the vmi itself can pick up a hostname.\n+optional",
 		"networks":                      "List of networks that can be attached to a vm's virtual interface.\n+kubebuilder:validation:MaxItems:=256",
 		"dnsPolicy":                     "Set DNS policy for the pod.\nDefaults to \"ClusterFirst\".\nValid values are 'ClusterFirstWithHostNet', 'ClusterFirst', 'Default' or 'None'.\nDNS parameters given in DNSConfig will be merged with the policy selected with DNSPolicy.\nTo have DNS options set along with hostNetwork, you have to specify DNS policy\nexplicitly to 'ClusterFirstWithHostNet'.\n+optional",
 		"dnsConfig":                     "Specifies the DNS parameters of a pod.\nParameters specified here will be merged to the generated DNS\nconfiguration based on DNSPolicy.\n+optional",
 		"accessCredentials":             "Specifies a set of public keys to inject into the vm guest\n+listType=atomic\n+optional\n+kubebuilder:validation:MaxItems:=256",
+		"freezeHooks":                   "FreezeHooks configure commands which are executed in the guest around a filesystem freeze\nand allow limiting the freeze to a set of mountpoints.\n+optional",
+		"panicPolicy":                   "PanicPolicy exposes a panic device to the guest and defines how a guest panic is handled.\nThe panicked guest is preserved until an optional memory dump was taken and is then restarted or paused.\n+optional",
 		"architecture":                  "Specifies the architecture of the vm guest you are attempting to run. Defaults to the compiled architecture of the KubeVirt components",
 	}
 }
@@ -74,6 +77,8 @@ func (VirtualMachineInstanceStatus) SwaggerDoc() map[string]string {
 		"volumeStatus":                  "VolumeStatus contains the statuses of all the volumes\n+optional\n+listType=atomic",
 		"kernelBootStatus":              "KernelBootStatus contains info about the kernelBootContainer\n+optional",
 		"fsFreezeStatus":                "FSFreezeStatus is the state of the fs of the guest\nit can be either frozen or thawed\n+optional",
+		"freezeHooks":                   "FreezeHooks reports the outcome of the steps of the last freeze and thaw of the guest filesystems\n+optional",
+		"guestPanic":                    "GuestPanic reports the last panic of the guest and how it is handled\n+optional",
 		"topologyHints":                 "+optional",
 		"virtualMachineRevisionName":    "VirtualMachineRevisionName is used to get the vm revision of the vmi when doing\nan online vm snapshot\n+optional",
 		"runtimeUser":                   "RuntimeUser is used to determine what user will be used in launcher\n+optional",
@@ -480,6 +485,7 @@ func (Handler) SwaggerDoc() map[string]string {
 		"guestAgentPing": "GuestAgentPing contacts the qemu-guest-agent for availability checks.\n+optional",
 		"httpGet":        "HTTPGet specifies the http request to perform.\n+optional",
 		"tcpSocket":      "TCPSocket specifies an action involving a TCP port.\nTCP hooks not yet supported\n+optional",
+		"grpc":           "GRPC specifies an action involving a GRPC port.\nThe health check is performed inside the guest by running grpc_health_probe through the guest\nagent, so both have to be installed in the guest.\n+optional",
 	}
 }
 
@@ -525,7 +531,35 @@ func (CertConfig) SwaggerDoc() map[string]string {
 }
 
 func (KubeVirtCertificateRotateStrategy) SwaggerDoc() map[string]string {
-	return map[string]string{}
+	return map[string]string{
+		"external": "External makes KubeVirt consume the certificates of its components from user provided Secrets\nor from cert-manager Certificates instead of issuing them itself.\nKubeVirt only validates them, distributes their CAs and reports their expiry.\nMutually exclusive with SelfSigned.\n+optional",
+	}
+}
+
+func (KubeVirtExternalCertificateConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                       "KubeVirtExternalCertificateConfiguration describes where the externally managed certificates come from.",
+		"certManager":            "CertManager makes virt-operator request the certificates of all components from cert-manager.\nIf not set, the certificate Secrets have to be provided in the install namespace with\nthe tls.crt, tls.key and ca.crt keys.\n+optional",
+		"expiryWarningThreshold": "ExpiryWarningThreshold is the remaining lifetime of a certificate below which\nthe CertificatesReady condition is set to false.\nDefaults to 7 days.\n+optional",
+	}
+}
+
+func (CertManagerConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "CertManagerConfiguration describes the cert-manager Certificates created for the KubeVirt components.",
+		"issuerRef":   "IssuerRef references the cert-manager issuer which signs the certificates.",
+		"duration":    "The requested 'duration' (i.e. lifetime) of the Certificates.\n+optional",
+		"renewBefore": "The amount of time before the currently issued certificate's \"notAfter\"\ntime that cert-manager will begin to attempt to renew the certificate.\n+optional",
+	}
+}
+
+func (CertManagerIssuerReference) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "CertManagerIssuerReference references a cert-manager Issuer or ClusterIssuer.",
+		"name":  "Name of the issuer.",
+		"kind":  "Kind of the issuer, Issuer or ClusterIssuer. Defaults to Issuer.\n+optional",
+		"group": "Group of the issuer. Defaults to cert-manager.io.\n+optional",
+	}
 }
 
 func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
@@ -537,16 +571,43 @@ func (KubeVirtWorkloadUpdateStrategy) SwaggerDoc() map[string]string {
 	}
 }
 
+func (KubeVirtHandlerUpdateStrategy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                  "KubeVirtHandlerUpdateStrategy defines how virt-handler is rolled out across the nodes of the cluster",
+		"waves":             "Waves lists the sets of nodes virt-handler is updated on, in order.\nA node which matches more than one wave belongs to the first one.\nNodes which match no wave are updated after the last wave completed.\nA wave completes once virt-handler is updated and ready on all of its nodes and no VMI failed on them since the wave started.\nVMIs which failed halt the rollout, deleting them resumes it.\n+listType=atomic\n+optional",
+		"pauseBetweenWaves": "PauseBetweenWaves is the time to wait after a wave completed before\nthe next wave is started\n\nDefaults to 0\n\n+optional",
+		"maxUnavailable":    "MaxUnavailable is the maximum number of virt-handler pods within a wave\nwhich are replaced at the same time. A percentage is relative to the\nnumber of nodes in the wave.\n\nDefaults to 1\n\n+optional",
+	}
+}
+
+func (HandlerUpdateWave) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":             "HandlerUpdateWave is a set of nodes virt-handler is updated on together",
+		"name":         "Name of the wave",
+		"nodeSelector": "NodeSelector selects the nodes of the wave",
+	}
+}
+
+func (KubeVirtNetworkPolicies) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                            "KubeVirtNetworkPolicies configures the NetworkPolicies virt-operator generates for the KubeVirt components",
+		"enabled":                     "Enabled makes virt-operator create and reconcile NetworkPolicies in the install namespace which\nallow the ingress and egress traffic of the KubeVirt components, including the console and VNC connections\nfrom virt-api to virt-handler, the migrations between virt-handlers and metrics scraping.\nEgress is limited to the apiserver, DNS, the traffic between the components and the export servers.\nThe policies follow the configured ports and the migration network.\nDefaults to false.\n+optional",
+		"monitoringNamespaceSelector": "MonitoringNamespaceSelector selects the namespaces which are allowed to scrape the metrics of the components.\nDefaults to the monitorNamespace, or to the well known prometheus-operator namespaces if it is not set.\n+optional",
+	}
+}
+
 func (KubeVirtSpec) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"imageTag":                "The image tag to use for the continer images installed.\nDefaults to the same tag as the operator's container image.",
 		"imageRegistry":           "The image registry to pull the container images from\nDefaults to the same registry the operator's container image is pulled from.",
+		"rollbackTo":              "RollbackTo is the deployment ID of a previously deployed KubeVirt, as reported by\nstatus.previousDeployment.deploymentID. While set, the install strategy of that\ndeployment is re-applied instead of the one derived from imageTag and imageRegistry.\nThe rollback is refused if objects were stored in an API version the previous\nrelease can't read. Remove it to update to the regular target version again.\n+optional",
 		"imagePullPolicy":         "The ImagePullPolicy to use.",
 		"imagePullSecrets":        "The imagePullSecrets to pull the container images from\nDefaults to none\n+listType=atomic",
 		"monitorNamespace":        "The namespace Prometheus is deployed in\nDefaults to openshift-monitor",
 		"serviceMonitorNamespace": "The namespace the service monitor will be deployed\n When ServiceMonitorNamespace is set, then we'll install the service monitor object in that namespace\notherwise we will use the monitoring namespace.",
 		"monitorAccount":          "The name of the Prometheus service account that needs read-access to KubeVirt endpoints\nDefaults to prometheus-k8s",
 		"workloadUpdateStrategy":  "WorkloadUpdateStrategy defines at the cluster level how to handle\nautomated workload updates",
+		"handlerUpdateStrategy":   "HandlerUpdateStrategy stages the rollout of virt-handler across sets of nodes.\nThe next wave is only started once all virt-handler pods of the current wave\nare ready and no VMI failed on its nodes since the wave started.\nOutdated VMIs are then updated wave by wave as well.\nIf not set, virt-handler is rolled out across all nodes at once.\n+optional",
 		"uninstallStrategy":       "Specifies if kubevirt can be deleted if workloads are still present.\nThis is mainly a precaution to avoid accidental data loss",
 		"productVersion":          "Designate the apps.kubevirt.io/version label for KubeVirt components.\nUseful if KubeVirt is included as part of a product.\nIf ProductVersion is not specified, KubeVirt's version will be used.",
 		"productName":             "Designate the apps.kubevirt.io/part-of label for KubeVirt components.\nUseful if KubeVirt is included as part of a product.\nIf ProductName is not specified, the part-of label will be omitted.",
@@ -554,6 +615,7 @@ func (KubeVirtSpec) SwaggerDoc() map[string]string {
 		"configuration":           "holds kubevirt configurations.\nsame as the virt-configMap",
 		"infra":                   "selectors and tolerations that should apply to KubeVirt infrastructure components\n+optional",
 		"workloads":               "selectors and tolerations that should apply to KubeVirt workloads\n+optional",
+		"networkPolicies":         "NetworkPolicies lets virt-operator generate the NetworkPolicies the KubeVirt components\nneed in clusters which deny ingress or egress traffic by default\n+optional",
 	}
 }
 
@@ -591,8 +653,37 @@ func (GenerationStatus) SwaggerDoc() map[string]string {
 
 func (KubeVirtStatus) SwaggerDoc() map[string]string {
 	return map[string]string{
-		"":            "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
-		"generations": "+listType=atomic",
+		"":                      "KubeVirtStatus represents information pertaining to a KubeVirt deployment.",
+		"generations":           "+listType=atomic",
+		"handlerUpdate":         "HandlerUpdate reports the progress of a staged virt-handler rollout\n+optional",
+		"previousDeployment":    "PreviousDeployment is the deployment which was observed before the current one.\nIt can be restored with spec.rollbackTo.\n+optional",
+		"configurationRevision": "ConfigurationRevision is the name of the ControllerRevision which holds the\nspec.configuration that is currently applied.\n+optional",
+	}
+}
+
+func (KubeVirtPreviousDeployment) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "KubeVirtPreviousDeployment records a completely rolled out deployment which was replaced by an update",
+		"generations": "Generations of the objects of the deployment at the time it was replaced\n+listType=atomic",
+	}
+}
+
+func (HandlerUpdateStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                   "HandlerUpdateStatus reports the progress of a staged virt-handler rollout",
+		"targetDeploymentID": "TargetDeploymentID is the deployment the waves are rolled out for",
+		"currentWave":        "CurrentWave is the index of the wave which is currently rolled out.\nIt equals the number of waves once the remaining nodes are updated.",
+		"waves":              "+listType=atomic\n+optional",
+	}
+}
+
+func (HandlerUpdateWaveStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "HandlerUpdateWaveStatus reports the progress of a single wave",
+		"name":           "Name of the wave",
+		"nodes":          "Nodes which belong to the wave. They are resolved when the wave is started.\n+listType=atomic\n+optional",
+		"startTime":      "+optional\n+nullable",
+		"completionTime": "+optional\n+nullable",
 	}
 }
 
@@ -661,6 +752,58 @@ func (VirtualMachineInstanceGuestAgentInfo) SwaggerDoc() map[string]string {
 		"userList":          "UserList is a list of active guest OS users",
 		"fsInfo":            "FSInfo is a guest os filesystem information containing the disk mapping and disk mounts with usage",
 		"fsFreezeStatus":    "FSFreezeStatus is the state of the fs of the guest\nit can be either frozen or thawed",
+		"inventory":         "Inventory contains the load, cpu, memory and disk statistics reported by the guest\n+optional",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSInventory) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "VirtualMachineInstanceGuestOSInventory represents the resources and statistics as seen by the guest",
+		"load":          "Load is the guest os load average\n+optional",
+		"cpu":           "CPU is the number of vCPUs known to the guest os\n+optional",
+		"memory":        "Memory is the amount of memory known to the guest os\n+optional",
+		"diskStats":     "DiskStats are the I/O counters of the guest os block devices, the usage of the\nguest os filesystems is reported in diskUsage\n+optional\n+listType=atomic",
+		"diskUsage":     "DiskUsage is the used and total space of the guest os filesystems\n+optional\n+listType=atomic",
+		"bootTime":      "BootTime is the time the guest os booted, only reported if the VMI has the kubevirt.io/guest-patch-compliance-interval annotation\n+optional",
+		"rebootPending": "RebootPending tells whether the guest os has to be rebooted to apply installed updates, only reported if the VMI has the kubevirt.io/guest-patch-compliance-interval annotation\n+optional",
+		"hotfixes":      "Hotfixes are the hotfixes installed in a Windows guest os, only reported if the VMI has the kubevirt.io/guest-patch-compliance-interval annotation\n+optional\n+listType=atomic",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSLoad) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineInstanceGuestOSLoad represents the guest os load average",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSCPU) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineInstanceGuestOSCPU represents the vCPUs known to the guest os",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSMemory) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineInstanceGuestOSMemory represents the memory known to the guest os",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSDiskStats) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineInstanceGuestOSDiskStats represents the I/O statistics of a guest os block device",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSDiskUsage) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineInstanceGuestOSDiskUsage represents the usage of a guest os filesystem",
+	}
+}
+
+func (VirtualMachineInstanceGuestOSHotfix) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineInstanceGuestOSHotfix represents a hotfix installed in a Windows guest os",
+		"installedOn": "+optional",
 	}
 }
 
@@ -712,12 +855,69 @@ func (FreezeUnfreezeTimeout) SwaggerDoc() map[string]string {
 	}
 }
 
+func (FreezeHooks) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "FreezeHooks allow an application consistent freeze of the guest filesystems",
+		"preFreeze":   "PreFreeze is executed in the guest before the filesystems are frozen.\nThe filesystems are not frozen if the command fails or exits with a non-zero code.\n+optional",
+		"postThaw":    "PostThaw is executed in the guest after the filesystems were thawed.\n+optional",
+		"mountpoints": "Mountpoints limits the freeze to the listed guest mountpoints.\nAll guest filesystems are frozen if empty.\n+optional\n+listType=atomic",
+	}
+}
+
+func (FreezeHookCommand) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "FreezeHookCommand is a command executed in the guest through the guest agent",
+		"command":        "Command is the path of the executable in the guest.",
+		"args":           "Args are passed to the command.\n+optional\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is the time the command may run before it is considered failed.\nDefaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.\n+optional",
+	}
+}
+
+func (FreezeHooksStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":      "FreezeHooksStatus reports the steps of the last freeze and thaw of the guest filesystems",
+		"steps": "Steps in the order they were executed\n+optional\n+listType=atomic",
+	}
+}
+
+func (FreezeHookStepStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "FreezeHookStepStatus is the outcome of a single freeze or thaw step",
+		"name":      "Name of the step",
+		"phase":     "Phase of the step, either Succeeded or Failed",
+		"exitCode":  "ExitCode of the hook command, only set for hook steps\n+optional",
+		"message":   "Message contains details about a failure\n+optional",
+		"timestamp": "Timestamp of the completion of the step\n+optional",
+	}
+}
+
+func (PanicPolicy) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "PanicPolicy defines how a guest panic is handled",
+		"action":              "Action is taken once the panic was recorded and the memory dump finished.\nDefaults to Restart.\n+optional",
+		"memoryDumpClaimName": "MemoryDumpClaimName is the name of the pvc the guest memory is dumped to on a panic.\nThe memory dump is only taken for VMIs owned by a VirtualMachine.\nIt requires the HotplugVolumes feature gate and is skipped if the pvc can't hold the dump.\n+optional",
+	}
+}
+
+func (GuestPanicStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "GuestPanicStatus reports the last panic of the guest",
+		"phase":               "Phase of the handling of the last panic",
+		"count":               "Count is the number of panics since the VMI was started",
+		"timestamp":           "Timestamp of the last panic\n+optional",
+		"action":              "Action taken after the last panic\n+optional",
+		"memoryDumpClaimName": "MemoryDumpClaimName is the name of the pvc the memory of the last panic is dumped to\n+optional",
+		"message":             "Message contains details about the handling of the last panic\n+optional",
+	}
+}
+
 func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":               "VirtualMachineMemoryDumpRequest represent the memory dump request phase and info",
 		"claimName":      "ClaimName is the name of the pvc that will contain the memory dump",
 		"phase":          "Phase represents the memory dump phase",
 		"remove":         "Remove represents request of dissociating the memory dump pvc\n+optional",
+		"format":         "Format of the memory dump, defaults to raw\n+optional",
 		"startTimestamp": "StartTimestamp represents the time the memory dump started\n+optional",
 		"endTimestamp":   "EndTimestamp represents the time the memory dump was completed\n+optional",
 		"fileName":       "FileName represents the name of the output file\n+optional",
@@ -725,6 +925,20 @@ func (VirtualMachineMemoryDumpRequest) SwaggerDoc() map[string]string {
 	}
 }
 
+func (VirtualMachinePersistentState) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "VirtualMachinePersistentState describes where the persistent TPM and EFI state of a VirtualMachine is stored\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"claimName": "ClaimName is the name of the pvc holding the persistent state.\nIt can be exported with a VirtualMachineExport of kind PersistentVolumeClaim.",
+	}
+}
+
+func (ImportPersistentStateOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":          "ImportPersistentStateOptions is provided when replacing the persistent TPM and EFI state of a VirtualMachine",
+		"claimName": "ClaimName is the name of the pvc holding the state to import.\nThe VirtualMachine takes ownership of the pvc and its previous state is deleted.",
+	}
+}
+
 func (AddVolumeOptions) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"":             "AddVolumeOptions is provided when dynamically hot plugging a volume and disk",
@@ -739,6 +953,57 @@ func (ScreenshotOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
 
+func (VNCTokenOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VNCTokenOptions are provided when requesting a token for browser based VNC access.",
+		"ttlDuration": "TTLDuration limits the lifetime of the token.\nDefaults to 5 minutes and may not exceed 1 hour.\n+optional",
+	}
+}
+
+func (VNCToken) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                    "VNCToken grants time limited access to the VNC console of a VirtualMachineInstance\nthrough the vncproxy endpoint, without any further authentication.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"token":               "Token is the signed token which has to be passed to the vncproxy endpoint.",
+		"path":                "Path is the virt-api path, including the token, which a noVNC client can connect to.\nThrough the Kubernetes API server the path requires the client to authenticate as well.",
+		"url":                 "URL is the absolute URL, including the token, which a noVNC client can connect to without\nany other credentials. It is only set if spec.configuration.vncTokenAccess.externalURL of\nthe KubeVirt CR is set.\n+optional",
+		"expirationTimestamp": "ExpirationTimestamp is the time after which the token is no longer accepted.",
+	}
+}
+
+func (GuestFile) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "GuestFile is a file in the guest of a VirtualMachineInstance, transferred through the guest agent.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"path":     "Path is the path of the file in the guest.",
+		"contents": "Contents of the file, may not exceed GuestFileMaxSize bytes.\n+optional",
+	}
+}
+
+func (GuestExecOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":               "GuestExecOptions describe a command which is executed in the guest of a VirtualMachineInstance through the guest agent.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"command":        "Command is the path of the executable in the guest.",
+		"args":           "Args are passed to the command.\n+optional\n+listType=atomic",
+		"timeoutSeconds": "TimeoutSeconds is the time the command may run before it is abandoned.\nDefaults to GuestExecDefaultTimeoutSeconds and may not exceed GuestExecMaxTimeoutSeconds.\n+optional",
+	}
+}
+
+func (GuestExecResult) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "GuestExecResult is the output of a command executed in the guest through the guest agent.\nThe guestexec subresource streams a GuestExecResult for every chunk of output while the command runs,\nthe last one is marked as exited and holds the exit code.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"exitCode": "ExitCode of the command, only set once it exited.\n+optional",
+		"stdout":   "Stdout is the standard output the command wrote since the previous result.\n+optional",
+		"stderr":   "Stderr is the standard error the command wrote since the previous result.\n+optional",
+		"exited":   "Exited is set on the last result, once the command exited.\n+optional",
+	}
+}
+
+func (EFIVars) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":         "EFIVars is the EFI variable store of a VirtualMachineInstance.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+		"contents": "Contents of the EFI variable store.\n+optional",
+	}
+}
+
 func (VSOCKOptions) SwaggerDoc() map[string]string {
 	return map[string]string{}
 }
@@ -751,6 +1016,15 @@ func (RemoveVolumeOptions) SwaggerDoc() map[string]string {
 	}
 }
 
+func (SetInterfaceLinkStateOptions) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":              "SetInterfaceLinkStateOptions is provided when setting the link state of an interface",
+		"interfaceName": "InterfaceName is the name of the interface whose link state is set",
+		"state":         "State is the desired link state of the interface. Valid values are up and down.",
+		"dryRun":        "When present, indicates that modifications should not be\npersisted. An invalid or unrecognized dryRun directive will\nresult in an error response and no further processing of the\nrequest. Valid values are:\n- All: all dry run stages will be processed\n+optional\n+listType=atomic",
+	}
+}
+
 func (TokenBucketRateLimiter) SwaggerDoc() map[string]string {
 	return map[string]string{
 		"qps":   "QPS indicates the maximum QPS to the apiserver from this client.\nIf it's zero, the component default will be used",
@@ -793,6 +1067,86 @@ func (KubeVirtConfiguration) SwaggerDoc() map[string]string {
 		"vmRolloutStrategy":                  "VMRolloutStrategy defines how live-updatable fields, like CPU sockets, memory,\ntolerations, and affinity, are propagated from a VM to its VMI.\n+nullable\n+kubebuilder:validation:Enum=Stage;LiveUpdate",
 		"commonInstancetypesDeployment":      "CommonInstancetypesDeployment controls the deployment of common-instancetypes resources\n+nullable",
 		"instancetype":                       "Instancetype configuration\n+nullable",
+		"namespaceConfiguration":             "NamespaceConfiguration controls which settings KubeVirtNamespaceConfig objects may override\n+nullable",
+		"vncTokenAccess":                     "VNCTokenAccess configures how the VNC consoles opened with tokens are reached\n+nullable",
+	}
+}
+
+func (VNCTokenAccessConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VNCTokenAccessConfiguration configures how the VNC consoles opened with tokens are reached",
+		"externalURL": "ExternalURL is the base URL virt-api is exposed at outside of the cluster, for example\nthrough an Ingress or Route which passes TLS through to the virt-api service.\nVNC tokens are returned with the URL of the vncproxy endpoint below it, which a browser can\nopen with the token alone. Without it, the vncproxy endpoint is only reachable through the\nKubernetes API server, which requires clients to authenticate first.\n+optional",
+	}
+}
+
+func (NamespaceConfiguration) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                 "NamespaceConfiguration controls the per-namespace overrides of the cluster-wide configuration",
+		"allowedOverrides": "AllowedOverrides lists the fields of KubeVirtNamespaceConfig objects which are merged over the\ncluster-wide configuration. Overrides of other fields are ignored. Defaults to none.\n+listType=set\n+optional",
+	}
+}
+
+func (KubeVirtNamespaceConfig) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "KubeVirtNamespaceConfig overrides parts of the cluster-wide KubeVirt configuration for the\nVirtualMachineInstances of its namespace. Only the fields allowed by\nspec.configuration.namespaceConfiguration of the KubeVirt CR are applied.\nNamespace admins can read but not modify them.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+genclient\n+genclient:noStatus",
+	}
+}
+
+func (KubeVirtNamespaceConfigList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "KubeVirtNamespaceConfigList is a list of KubeVirtNamespaceConfigs\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (KubeVirtNamespaceConfigSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":                          "KubeVirtNamespaceConfigSpec holds the overrides of the cluster-wide configuration. Fields which are set are\nmerged over their cluster-wide counterpart, nested fields which are not set keep their cluster-wide value.\nLists replace the cluster-wide list.",
+		"cpuModel":                  "CPUModel overrides the default CPU model of VMIs\n+optional",
+		"evictionStrategy":          "EvictionStrategy overrides the cluster-wide eviction strategy\n+optional",
+		"migrations":                "MigrationConfiguration overrides the settings of migrations of VMIs in the namespace, like the bandwidth.\nThe parallel migration limits, disableTLS, unsafeMigrationOverride and allowPostCopy can't be overridden.\n+optional",
+		"network":                   "NetworkConfiguration overrides the network defaults, like the default network interface.\nThe binding plugins can't be overridden.\n+optional",
+		"smbios":                    "SMBIOSConfig overrides the SMBIOS values exposed to guests\n+optional",
+		"supportContainerResources": "SupportContainerResources overrides the resource requirements of the supporting containers\n+listType=map\n+listMapKey=type\n+optional",
+		"virtualMachineOptions":     "VirtualMachineOptions overrides the default options of VMs\n+optional",
+	}
+}
+
+func (VirtualMachineQuota) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":       "VirtualMachineQuota caps the VM specific resources which the VirtualMachineInstances of its namespace may consume.\nUnlike a ResourceQuota it accounts for the guest and not for the virt-launcher pods.\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object\n+genclient",
+		"status": "+optional",
+	}
+}
+
+func (VirtualMachineQuotaList) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"": "VirtualMachineQuotaList is a list of VirtualMachineQuotas\n\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object",
+	}
+}
+
+func (VirtualMachineQuotaSpec) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineQuotaSpec holds the limits of a VirtualMachineQuota",
+		"hard": "Hard is the set of limits enforced when VirtualMachineInstances are created, VirtualMachines are\nstarted or VirtualMachineInstanceMigrations are created. Resources which are not set are not limited.",
+	}
+}
+
+func (VirtualMachineQuotaStatus) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":     "VirtualMachineQuotaStatus holds the usage of a VirtualMachineQuota",
+		"hard": "Hard is the set of limits the usage was last computed for\n+optional",
+		"used": "Used is the current usage of the limited resources in the namespace\n+optional",
+	}
+}
+
+func (VirtualMachineQuotaResources) SwaggerDoc() map[string]string {
+	return map[string]string{
+		"":            "VirtualMachineQuotaResources are the VM specific resources a VirtualMachineQuota accounts for.\nVirtualMachineInstances and VirtualMachineInstanceMigrations in a final phase don't consume any.",
+		"runningVMIs": "RunningVMIs is the number of VirtualMachineInstances\n+optional",
+		"guestMemory": "GuestMemory is the total memory visible to the guests, without the overhead of the virt-launcher pods\n+optional",
+		"vcpus":       "VCPUs is the total number of vCPUs, which are sockets * cores * threads of every VirtualMachineInstance\n+optional",
+		"devices":     "Devices is the number of GPUs and host devices by their device name\n+optional",
+		"migrations":  "Migrations is the number of concurrent VirtualMachineInstanceMigrations\n+optional",
 	}
 }
 
@@ -5,6 +5,7 @@ package kubecli
 
 import (
 	context "context"
+	io "io"
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
@@ -1155,6 +1156,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VNC(arg0 interface{}) *
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "VNC", arg0)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) SPICE(name string) (v122.StreamInterface, error) {
+	ret := _m.ctrl.Call(_m, "SPICE", name)
+	ret0, _ := ret[0].(v122.StreamInterface)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SPICE(arg0 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SPICE", arg0)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) Screenshot(ctx context.Context, name string, options *v121.ScreenshotOptions) ([]byte, error) {
 	ret := _m.ctrl.Call(_m, "Screenshot", ctx, name, options)
 	ret0, _ := ret[0].([]byte)
@@ -1166,6 +1178,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Screenshot(arg0, arg1,
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Screenshot", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) VNCToken(ctx context.Context, name string, options *v121.VNCTokenOptions) (v121.VNCToken, error) {
+	ret := _m.ctrl.Call(_m, "VNCToken", ctx, name, options)
+	ret0, _ := ret[0].(v121.VNCToken)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) VNCToken(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "VNCToken", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) PortForward(name string, port int, protocol string) (v122.StreamInterface, error) {
 	ret := _m.ctrl.Call(_m, "PortForward", name, port, protocol)
 	ret0, _ := ret[0].(v122.StreamInterface)
@@ -1217,6 +1240,17 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) Unfreeze(arg0, arg1 int
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "Unfreeze", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) FreezeStatus(ctx context.Context, name string) (v121.FreezeHooksStatus, error) {
+	ret := _m.ctrl.Call(_m, "FreezeStatus", ctx, name)
+	ret0, _ := ret[0].(v121.FreezeHooksStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FreezeStatus(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "FreezeStatus", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) Reset(ctx context.Context, name string) error {
 	ret := _m.ctrl.Call(_m, "Reset", ctx, name)
 	ret0, _ := ret[0].(error)
@@ -1270,6 +1304,49 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) FilesystemList(arg0, ar
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "FilesystemList", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) GuestFileRead(ctx context.Context, name string, path string) (v121.GuestFile, error) {
+	ret := _m.ctrl.Call(_m, "GuestFileRead", ctx, name, path)
+	ret0, _ := ret[0].(v121.GuestFile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestFileRead(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileRead", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) GuestFileWrite(ctx context.Context, name string, guestFile *v121.GuestFile) error {
+	ret := _m.ctrl.Call(_m, "GuestFileWrite", ctx, name, guestFile)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestFileWrite(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestFileWrite", arg0, arg1, arg2)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) GuestExec(ctx context.Context, name string, options *v121.GuestExecOptions, stdout io.Writer, stderr io.Writer) (v121.GuestExecResult, error) {
+	ret := _m.ctrl.Call(_m, "GuestExec", ctx, name, options, stdout, stderr)
+	ret0, _ := ret[0].(v121.GuestExecResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) GuestExec(arg0, arg1, arg2, arg3, arg4 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "GuestExec", arg0, arg1, arg2, arg3, arg4)
+}
+
+func (_m *MockVirtualMachineInstanceInterface) EFIVars(ctx context.Context, name string) (v121.EFIVars, error) {
+	ret := _m.ctrl.Call(_m, "EFIVars", ctx, name)
+	ret0, _ := ret[0].(v121.EFIVars)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) EFIVars(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "EFIVars", arg0, arg1)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) AddVolume(ctx context.Context, name string, addVolumeOptions *v121.AddVolumeOptions) error {
 	ret := _m.ctrl.Call(_m, "AddVolume", ctx, name, addVolumeOptions)
 	ret0, _ := ret[0].(error)
@@ -1290,6 +1367,16 @@ func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) RemoveVolume(arg0, arg1
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveVolume", arg0, arg1, arg2)
 }
 
+func (_m *MockVirtualMachineInstanceInterface) SetInterfaceLinkState(ctx context.Context, name string, options *v121.SetInterfaceLinkStateOptions) error {
+	ret := _m.ctrl.Call(_m, "SetInterfaceLinkState", ctx, name, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInstanceInterfaceRecorder) SetInterfaceLinkState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "SetInterfaceLinkState", arg0, arg1, arg2)
+}
+
 func (_m *MockVirtualMachineInstanceInterface) VSOCK(name string, options *v121.VSOCKOptions) (v122.StreamInterface, error) {
 	ret := _m.ctrl.Call(_m, "VSOCK", name, options)
 	ret0, _ := ret[0].(v122.StreamInterface)
@@ -1847,6 +1934,37 @@ func (_mr *_MockVirtualMachineInterfaceRecorder) RemoveMemoryDump(arg0, arg1 int
 	return _mr.mock.ctrl.RecordCall(_mr.mock, "RemoveMemoryDump", arg0, arg1)
 }
 
+func (_m *MockVirtualMachineInterface) PersistentState(ctx context.Context, name string) (v121.VirtualMachinePersistentState, error) {
+	ret := _m.ctrl.Call(_m, "PersistentState", ctx, name)
+	ret0, _ := ret[0].(v121.VirtualMachinePersistentState)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) PersistentState(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "PersistentState", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInterface) ResetPersistentState(ctx context.Context, name string) error {
+	ret := _m.ctrl.Call(_m, "ResetPersistentState", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) ResetPersistentState(arg0, arg1 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ResetPersistentState", arg0, arg1)
+}
+
+func (_m *MockVirtualMachineInterface) ImportPersistentState(ctx context.Context, name string, importOptions *v121.ImportPersistentStateOptions) error {
+	ret := _m.ctrl.Call(_m, "ImportPersistentState", ctx, name, importOptions)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+func (_mr *_MockVirtualMachineInterfaceRecorder) ImportPersistentState(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return _mr.mock.ctrl.RecordCall(_mr.mock, "ImportPersistentState", arg0, arg1, arg2)
+}
+
 // Mock of VirtualMachineInstanceMigrationInterface interface
 type MockVirtualMachineInstanceMigrationInterface struct {
 	ctrl     *gomock.Controller
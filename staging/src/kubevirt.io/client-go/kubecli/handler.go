@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"net/url"
 
 	v1 "k8s.io/api/core/v1"
 	k8smetav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -21,6 +22,7 @@ const (
 	consoleTemplateURI        = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/console"
 	usbredirTemplateURI       = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/usbredir"
 	vncTemplateURI            = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vnc"
+	spiceTemplateURI          = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/spice"
 	vsockTemplateURI          = "wss://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/vsock"
 	pauseTemplateURI          = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/pause"
 	unpauseTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/unpause"
@@ -31,6 +33,10 @@ const (
 	guestInfoTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestosinfo"
 	userListTemplateURI       = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/userlist"
 	filesystemListTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/filesystemlist"
+	guestFileReadTemplateURI  = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestfile/read"
+	guestFileWriteTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestfile/write"
+	guestExecTemplateURI      = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/guestexec"
+	efiVarsTemplateURI        = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/efivars"
 
 	sevFetchCertChainTemplateURI         = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/fetchcertchain"
 	sevQueryLaunchMeasurementTemplateURI = "https://%s:%v/v1/namespaces/%s/virtualmachineinstances/%s/sev/querylaunchmeasurement"
@@ -57,6 +63,7 @@ type VirtHandlerConn interface {
 	ConsoleURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	USBRedirURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VNCURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	SPICEURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error)
 	PauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UnpauseURI(vmi *virtv1.VirtualMachineInstance) (string, error)
@@ -73,6 +80,10 @@ type VirtHandlerConn interface {
 	GuestInfoURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	UserListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 	FilesystemListURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestFileReadURI(vmi *virtv1.VirtualMachineInstance, path string) (string, error)
+	GuestFileWriteURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error)
+	EFIVarsURI(vmi *virtv1.VirtualMachineInstance) (string, error)
 }
 
 type virtHandler struct {
@@ -187,6 +198,10 @@ func (v *virtHandlerConn) VNCURI(vmi *virtv1.VirtualMachineInstance) (string, er
 	return v.formatURI(vncTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) SPICEURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(spiceTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) VSOCKURI(vmi *virtv1.VirtualMachineInstance, port string, tls string) (string, error) {
 	baseURI, err := v.formatURI(vsockTemplateURI, vmi)
 	if err != nil {
@@ -298,6 +313,26 @@ func (v *virtHandlerConn) FilesystemListURI(vmi *virtv1.VirtualMachineInstance)
 	return v.formatURI(filesystemListTemplateURI, vmi)
 }
 
+func (v *virtHandlerConn) GuestFileReadURI(vmi *virtv1.VirtualMachineInstance, path string) (string, error) {
+	uri, err := v.formatURI(guestFileReadTemplateURI, vmi)
+	if err != nil {
+		return "", err
+	}
+	return uri + "?path=" + url.QueryEscape(path), nil
+}
+
+func (v *virtHandlerConn) GuestFileWriteURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestFileWriteTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) GuestExecURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(guestExecTemplateURI, vmi)
+}
+
+func (v *virtHandlerConn) EFIVarsURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
+	return v.formatURI(efiVarsTemplateURI, vmi)
+}
+
 func (v *virtHandlerConn) SEVFetchCertChainURI(vmi *virtv1.VirtualMachineInstance) (string, error) {
 	return v.formatURI(sevFetchCertChainTemplateURI, vmi)
 }
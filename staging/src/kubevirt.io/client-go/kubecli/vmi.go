@@ -67,6 +67,10 @@ func (v *vmis) VNC(name string) (kvcorev1.StreamInterface, error) {
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "vnc", url.Values{})
 }
 
+func (v *vmis) SPICE(name string) (kvcorev1.StreamInterface, error) {
+	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, "spice", url.Values{})
+}
+
 func (v *vmis) PortForward(name string, port int, protocol string) (kvcorev1.StreamInterface, error) {
 	return kvcorev1.AsyncSubresourceHelper(v.config, v.resource, v.namespace, name, buildPortForwardResourcePath(port, protocol), url.Values{})
 }
@@ -29,11 +29,13 @@ import (
 type KubevirtV1Interface interface {
 	RESTClient() rest.Interface
 	KubeVirtsGetter
+	KubeVirtNamespaceConfigsGetter
 	VirtualMachinesGetter
 	VirtualMachineInstancesGetter
 	VirtualMachineInstanceMigrationsGetter
 	VirtualMachineInstancePresetsGetter
 	VirtualMachineInstanceReplicaSetsGetter
+	VirtualMachineQuotasGetter
 }
 
 // KubevirtV1Client is used to interact with features provided by the kubevirt.io group.
@@ -45,6 +47,10 @@ func (c *KubevirtV1Client) KubeVirts(namespace string) KubeVirtInterface {
 	return newKubeVirts(c, namespace)
 }
 
+func (c *KubevirtV1Client) KubeVirtNamespaceConfigs(namespace string) KubeVirtNamespaceConfigInterface {
+	return newKubeVirtNamespaceConfigs(c, namespace)
+}
+
 func (c *KubevirtV1Client) VirtualMachines(namespace string) VirtualMachineInterface {
 	return newVirtualMachines(c, namespace)
 }
@@ -65,6 +71,10 @@ func (c *KubevirtV1Client) VirtualMachineInstanceReplicaSets(namespace string) V
 	return newVirtualMachineInstanceReplicaSets(c, namespace)
 }
 
+func (c *KubevirtV1Client) VirtualMachineQuotas(namespace string) VirtualMachineQuotaInterface {
+	return newVirtualMachineQuotas(c, namespace)
+}
+
 // NewForConfig creates a new KubevirtV1Client for the given config.
 // NewForConfig is equivalent to NewForConfigAndClient(c, httpClient),
 // where httpClient was generated with rest.HTTPClientFor(c).
@@ -32,6 +32,10 @@ func (c *FakeKubevirtV1) KubeVirts(namespace string) v1.KubeVirtInterface {
 	return &FakeKubeVirts{c, namespace}
 }
 
+func (c *FakeKubevirtV1) KubeVirtNamespaceConfigs(namespace string) v1.KubeVirtNamespaceConfigInterface {
+	return &FakeKubeVirtNamespaceConfigs{c, namespace}
+}
+
 func (c *FakeKubevirtV1) VirtualMachines(namespace string) v1.VirtualMachineInterface {
 	return &FakeVirtualMachines{c, namespace}
 }
@@ -52,6 +56,10 @@ func (c *FakeKubevirtV1) VirtualMachineInstanceReplicaSets(namespace string) v1.
 	return &FakeVirtualMachineInstanceReplicaSets{c, namespace}
 }
 
+func (c *FakeKubevirtV1) VirtualMachineQuotas(namespace string) v1.VirtualMachineQuotaInterface {
+	return &FakeVirtualMachineQuotas{c, namespace}
+}
+
 // RESTClient returns a RESTClient that is used to communicate
 // with API server by this client implementation.
 func (c *FakeKubevirtV1) RESTClient() rest.Interface {
@@ -104,3 +104,24 @@ func (c *FakeVirtualMachines) RemoveVolume(ctx context.Context, name string, rem
 func (c *FakeVirtualMachines) PortForward(name string, port int, protocol string) (kubevirtv1.StreamInterface, error) {
 	return nil, nil
 }
+
+func (c *FakeVirtualMachines) PersistentState(ctx context.Context, name string) (v1.VirtualMachinePersistentState, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachinesResource, c.ns, "persistentstate", name), &v1.VirtualMachinePersistentState{})
+
+	return v1.VirtualMachinePersistentState{}, err
+}
+
+func (c *FakeVirtualMachines) ResetPersistentState(ctx context.Context, name string) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "resetpersistentstate", name, struct{}{}), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachines) ImportPersistentState(ctx context.Context, name string, importOptions *v1.ImportPersistentStateOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachinesResource, c.ns, "importpersistentstate", name, importOptions), nil)
+
+	return err
+}
@@ -21,6 +21,7 @@ package fake
 
 import (
 	"context"
+	"io"
 	"time"
 
 	"k8s.io/client-go/testing"
@@ -42,10 +43,21 @@ func (c *FakeVirtualMachineInstances) VNC(name string) (kvcorev1.StreamInterface
 	return nil, nil
 }
 
+func (c *FakeVirtualMachineInstances) SPICE(name string) (kvcorev1.StreamInterface, error) {
+	return nil, nil
+}
+
 func (c *FakeVirtualMachineInstances) Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error) {
 	return nil, nil
 }
 
+func (c *FakeVirtualMachineInstances) VNCToken(ctx context.Context, name string, options *v1.VNCTokenOptions) (v1.VNCToken, error) {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "vnctoken", name, options), &v1.VNCToken{})
+
+	return v1.VNCToken{}, err
+}
+
 func (c *FakeVirtualMachineInstances) PortForward(name string, port int, protocol string) (kvcorev1.StreamInterface, error) {
 	return nil, nil
 }
@@ -78,6 +90,13 @@ func (c *FakeVirtualMachineInstances) Unfreeze(ctx context.Context, name string)
 	return err
 }
 
+func (c *FakeVirtualMachineInstances) FreezeStatus(ctx context.Context, name string) (v1.FreezeHooksStatus, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "freeze", name), nil)
+
+	return v1.FreezeHooksStatus{}, err
+}
+
 func (c *FakeVirtualMachineInstances) Reset(ctx context.Context, name string) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "reset", name, struct{}{}), nil)
@@ -114,6 +133,34 @@ func (c *FakeVirtualMachineInstances) FilesystemList(ctx context.Context, name s
 	return v1.VirtualMachineInstanceFileSystemList{}, err
 }
 
+func (c *FakeVirtualMachineInstances) GuestFileRead(ctx context.Context, name string, path string) (v1.GuestFile, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "guestfile/read", name), &v1.GuestFile{})
+
+	return v1.GuestFile{}, err
+}
+
+func (c *FakeVirtualMachineInstances) GuestFileWrite(ctx context.Context, name string, guestFile *v1.GuestFile) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "guestfile/write", name, guestFile), nil)
+
+	return err
+}
+
+func (c *FakeVirtualMachineInstances) EFIVars(ctx context.Context, name string) (v1.EFIVars, error) {
+	_, err := c.Fake.
+		Invokes(testing.NewGetSubresourceAction(virtualmachineinstancesResource, c.ns, "efivars", name), &v1.EFIVars{})
+
+	return v1.EFIVars{}, err
+}
+
+func (c *FakeVirtualMachineInstances) GuestExec(ctx context.Context, name string, options *v1.GuestExecOptions, stdout, stderr io.Writer) (v1.GuestExecResult, error) {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "guestexec", name, options), &v1.GuestExecResult{})
+
+	return v1.GuestExecResult{}, err
+}
+
 func (c *FakeVirtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	_, err := c.Fake.
 		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "addvolume", name, addVolumeOptions), nil)
@@ -128,6 +175,13 @@ func (c *FakeVirtualMachineInstances) RemoveVolume(ctx context.Context, name str
 	return err
 }
 
+func (c *FakeVirtualMachineInstances) SetInterfaceLinkState(ctx context.Context, name string, options *v1.SetInterfaceLinkStateOptions) error {
+	_, err := c.Fake.
+		Invokes(fake2.NewPutSubresourceAction(virtualmachineinstancesResource, c.ns, "setlinkstate", name, options), nil)
+
+	return err
+}
+
 func (c *FakeVirtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (kvcorev1.StreamInterface, error) {
 	return nil, nil
 }
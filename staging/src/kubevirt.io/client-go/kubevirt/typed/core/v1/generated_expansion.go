@@ -18,4 +18,8 @@ limitations under the License.
 
 package v1
 
+type KubeVirtNamespaceConfigExpansion interface{}
+
 type VirtualMachineInstancePresetExpansion interface{}
+
+type VirtualMachineQuotaExpansion interface{}
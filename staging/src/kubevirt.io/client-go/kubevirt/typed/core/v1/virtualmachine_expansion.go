@@ -46,6 +46,9 @@ type VirtualMachineExpansion interface {
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	MemoryDump(ctx context.Context, name string, memoryDumpRequest *v1.VirtualMachineMemoryDumpRequest) error
 	RemoveMemoryDump(ctx context.Context, name string) error
+	PersistentState(ctx context.Context, name string) (v1.VirtualMachinePersistentState, error)
+	ResetPersistentState(ctx context.Context, name string) error
+	ImportPersistentState(ctx context.Context, name string, importOptions *v1.ImportPersistentStateOptions) error
 }
 
 func (c *virtualMachines) GetWithExpandedSpec(ctx context.Context, name string) (*v1.VirtualMachine, error) {
@@ -197,3 +200,49 @@ func (c *virtualMachines) RemoveMemoryDump(ctx context.Context, name string) err
 		Do(ctx).
 		Error()
 }
+
+func (c *virtualMachines) PersistentState(ctx context.Context, name string) (v1.VirtualMachinePersistentState, error) {
+	state := v1.VirtualMachinePersistentState{}
+	rawState, err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("persistentstate").
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return state, err
+	}
+
+	err = json.Unmarshal(rawState, &state)
+	return state, err
+}
+
+func (c *virtualMachines) ResetPersistentState(ctx context.Context, name string) error {
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("resetpersistentstate").
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachines) ImportPersistentState(ctx context.Context, name string, importOptions *v1.ImportPersistentStateOptions) error {
+	body, err := json.Marshal(importOptions)
+	if err != nil {
+		return fmt.Errorf(cannotMarshalJSONErrFmt, err)
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmSubresourceURLFmt, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachines").
+		Name(name).
+		SubResource("importpersistentstate").
+		Body(body).
+		Do(ctx).
+		Error()
+}
@@ -23,6 +23,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -41,19 +42,27 @@ type VirtualMachineInstanceExpansion interface {
 	SerialConsole(name string, options *SerialConsoleOptions) (StreamInterface, error)
 	USBRedir(vmiName string) (StreamInterface, error)
 	VNC(name string) (StreamInterface, error)
+	SPICE(name string) (StreamInterface, error)
 	Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error)
+	VNCToken(ctx context.Context, name string, options *v1.VNCTokenOptions) (v1.VNCToken, error)
 	PortForward(name string, port int, protocol string) (StreamInterface, error)
 	Pause(ctx context.Context, name string, pauseOptions *v1.PauseOptions) error
 	Unpause(ctx context.Context, name string, unpauseOptions *v1.UnpauseOptions) error
 	Freeze(ctx context.Context, name string, unfreezeTimeout time.Duration) error
 	Unfreeze(ctx context.Context, name string) error
+	FreezeStatus(ctx context.Context, name string) (v1.FreezeHooksStatus, error)
 	Reset(ctx context.Context, name string) error
 	SoftReboot(ctx context.Context, name string) error
 	GuestOsInfo(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestAgentInfo, error)
 	UserList(ctx context.Context, name string) (v1.VirtualMachineInstanceGuestOSUserList, error)
 	FilesystemList(ctx context.Context, name string) (v1.VirtualMachineInstanceFileSystemList, error)
+	GuestFileRead(ctx context.Context, name string, path string) (v1.GuestFile, error)
+	GuestFileWrite(ctx context.Context, name string, guestFile *v1.GuestFile) error
+	GuestExec(ctx context.Context, name string, options *v1.GuestExecOptions, stdout, stderr io.Writer) (v1.GuestExecResult, error)
+	EFIVars(ctx context.Context, name string) (v1.EFIVars, error)
 	AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error
 	RemoveVolume(ctx context.Context, name string, removeVolumeOptions *v1.RemoveVolumeOptions) error
+	SetInterfaceLinkState(ctx context.Context, name string, options *v1.SetInterfaceLinkStateOptions) error
 	VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error)
 	SEVFetchCertChain(ctx context.Context, name string) (v1.SEVPlatformInfo, error)
 	SEVQueryLaunchMeasurement(ctx context.Context, name string) (v1.SEVMeasurementInfo, error)
@@ -79,6 +88,12 @@ func (c *virtualMachineInstances) VNC(name string) (StreamInterface, error) {
 	return nil, fmt.Errorf("VNC is not implemented yet in generated client")
 }
 
+func (c *virtualMachineInstances) SPICE(name string) (StreamInterface, error) {
+	// TODO not implemented yet
+	//  requires clientConfig
+	return nil, fmt.Errorf("SPICE is not implemented yet in generated client")
+}
+
 func (c *virtualMachineInstances) Screenshot(ctx context.Context, name string, options *v1.ScreenshotOptions) ([]byte, error) {
 	moveCursor := "false"
 	if options.MoveCursor == true {
@@ -101,6 +116,26 @@ func (c *virtualMachineInstances) Screenshot(ctx context.Context, name string, o
 	return raw, nil
 }
 
+func (c *virtualMachineInstances) VNCToken(ctx context.Context, name string, options *v1.VNCTokenOptions) (v1.VNCToken, error) {
+	vncToken := v1.VNCToken{}
+	body, err := json.Marshal(options)
+	if err != nil {
+		return vncToken, fmt.Errorf("cannot Marshal to json: %s", err)
+	}
+
+	err = c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("vnctoken").
+		Body(body).
+		Do(ctx).
+		Into(&vncToken)
+
+	return vncToken, err
+}
+
 func (c *virtualMachineInstances) PortForward(name string, port int, protocol string) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig
@@ -177,6 +212,24 @@ func (c *virtualMachineInstances) Unfreeze(ctx context.Context, name string) err
 		Error()
 }
 
+func (c *virtualMachineInstances) FreezeStatus(ctx context.Context, name string) (v1.FreezeHooksStatus, error) {
+	freezeHooksStatus := v1.FreezeHooksStatus{}
+	rawStatus, err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("freeze").
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return freezeHooksStatus, err
+	}
+
+	err = json.Unmarshal(rawStatus, &freezeHooksStatus)
+	return freezeHooksStatus, err
+}
+
 func (c *virtualMachineInstances) Reset(ctx context.Context, name string) error {
 	log.Log.Infof("Reset VMI")
 	return c.GetClient().Put().
@@ -275,6 +328,100 @@ func (c *virtualMachineInstances) FilesystemList(ctx context.Context, name strin
 	return fsList, err
 }
 
+func (c *virtualMachineInstances) GuestFileRead(ctx context.Context, name string, path string) (v1.GuestFile, error) {
+	guestFile := v1.GuestFile{}
+	rawFile, err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfile", "read").
+		Param("path", path).
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return guestFile, err
+	}
+
+	err = json.Unmarshal(rawFile, &guestFile)
+	return guestFile, err
+}
+
+func (c *virtualMachineInstances) GuestFileWrite(ctx context.Context, name string, guestFile *v1.GuestFile) error {
+	body, err := json.Marshal(guestFile)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestfile", "write").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
+func (c *virtualMachineInstances) EFIVars(ctx context.Context, name string) (v1.EFIVars, error) {
+	efiVars := v1.EFIVars{}
+	rawVars, err := c.GetClient().Get().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("efivars").
+		Do(ctx).
+		Raw()
+	if err != nil {
+		return efiVars, err
+	}
+
+	err = json.Unmarshal(rawVars, &efiVars)
+	return efiVars, err
+}
+
+// GuestExec writes the output of the command to stdout and stderr while it runs and returns the result holding its exit code
+func (c *virtualMachineInstances) GuestExec(ctx context.Context, name string, options *v1.GuestExecOptions, stdout, stderr io.Writer) (v1.GuestExecResult, error) {
+	result := v1.GuestExecResult{}
+	body, err := json.Marshal(options)
+	if err != nil {
+		return result, err
+	}
+
+	stream, err := c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("guestexec").
+		Body(body).
+		Stream(ctx)
+	if err != nil {
+		return result, err
+	}
+	defer stream.Close()
+
+	decoder := json.NewDecoder(stream)
+	for !result.Exited {
+		result = v1.GuestExecResult{}
+		if err := decoder.Decode(&result); err != nil {
+			if err == io.EOF {
+				err = fmt.Errorf("the output of the command ended before it exited")
+			}
+			return result, err
+		}
+		if _, err := io.WriteString(stdout, result.Stdout); err != nil {
+			return result, err
+		}
+		if _, err := io.WriteString(stderr, result.Stderr); err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
 func (c *virtualMachineInstances) AddVolume(ctx context.Context, name string, addVolumeOptions *v1.AddVolumeOptions) error {
 	body, err := json.Marshal(addVolumeOptions)
 	if err != nil {
@@ -309,6 +456,23 @@ func (c *virtualMachineInstances) RemoveVolume(ctx context.Context, name string,
 		Error()
 }
 
+func (c *virtualMachineInstances) SetInterfaceLinkState(ctx context.Context, name string, options *v1.SetInterfaceLinkStateOptions) error {
+	body, err := json.Marshal(options)
+	if err != nil {
+		return err
+	}
+
+	return c.GetClient().Put().
+		AbsPath(fmt.Sprintf(vmiSubresourceURL, v1.ApiStorageVersion)).
+		Namespace(c.GetNamespace()).
+		Resource("virtualmachineinstances").
+		Name(name).
+		SubResource("setlinkstate").
+		Body(body).
+		Do(ctx).
+		Error()
+}
+
 func (c *virtualMachineInstances) VSOCK(name string, options *v1.VSOCKOptions) (StreamInterface, error) {
 	// TODO not implemented yet
 	//  requires clientConfig